@@ -2,6 +2,7 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -14,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -26,6 +28,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -33,8 +36,13 @@ import (
 
 	"cosmossdk.io/log"
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cast"
 
+	"github.com/interbank-netting/cosmos/client/bankauth"
+	"github.com/interbank-netting/cosmos/client/docs"
+	"github.com/interbank-netting/cosmos/client/signer"
 	"github.com/interbank-netting/cosmos/x/oracle"
+	oracleante "github.com/interbank-netting/cosmos/x/oracle/ante"
 	oraclekeeper "github.com/interbank-netting/cosmos/x/oracle/keeper"
 	oracletypes "github.com/interbank-netting/cosmos/x/oracle/types"
 	"github.com/interbank-netting/cosmos/x/netting"
@@ -48,6 +56,17 @@ import (
 const (
 	AccountAddressPrefix = "cosmos"
 	Name                 = "interbank-netting"
+
+	// SignerAddressKey is the app.toml key of the signer service address
+	SignerAddressKey = "signer.address"
+
+	// QueryCacheKey is the app.toml key enabling the query cache of the
+	// credit balance and pending transfers queries
+	QueryCacheKey = "query-cache.enabled"
+
+	// BankAuthCredentialsKey is the app.toml key of the credentials file
+	// authenticating bank clients of the API server
+	BankAuthCredentialsKey = "bank-auth.credentials-file"
 )
 
 var (
@@ -106,6 +125,9 @@ type App struct {
 
 	// Custom module keepers will be added here
 
+	// bankAuth authenticates API server requests when credentials are configured
+	bankAuth *bankauth.Authenticator
+
 	// the module manager
 	mm *module.Manager
 
@@ -136,6 +158,11 @@ func New(
 	bApp.SetInterfaceRegistry(interfaceRegistry)
 	bApp.SetTxEncoder(txConfig.TxEncoder())
 
+	// Execute the confirmations of a block before its netting triggers
+	proposalHandler := baseapp.NewDefaultProposalHandler(bApp.Mempool(), bApp)
+	bApp.SetPrepareProposal(NewPrepareProposalHandler(txConfig.TxDecoder(), proposalHandler.PrepareProposalHandler()))
+	bApp.SetProcessProposal(NewProcessProposalHandler(txConfig.TxDecoder(), proposalHandler.ProcessProposalHandler()))
+
 	keys := storetypes.NewKVStoreKeys(
 		authtypes.StoreKey, 
 		banktypes.StoreKey, 
@@ -161,6 +188,9 @@ func New(
 	}
 
 	// Initialize keepers and modules here
+	// Module params and manual interventions are governed by the gov module account
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
 	// Initialize Oracle Keeper (simplified without params for now)
 	app.OracleKeeper = *oraclekeeper.NewKeeper(
 		appCodec,
@@ -169,6 +199,7 @@ func New(
 		paramtypes.Subspace{}, // Empty subspace for now
 		app.BankKeeper,
 		app.StakingKeeper,
+		authority,
 	)
 
 	// Initialize Netting Keeper
@@ -179,6 +210,7 @@ func New(
 		paramtypes.Subspace{}, // Empty subspace for now
 		app.BankKeeper,
 		app.AccountKeeper,
+		authority,
 	)
 
 	// Initialize Multisig Keeper
@@ -189,10 +221,46 @@ func New(
 		paramtypes.Subspace{}, // Empty subspace for now
 		app.BankKeeper,
 		app.StakingKeeper,
+		authority,
 	)
 
+	// Validator keys stay in the signer service when one is configured
+	if appOpts != nil {
+		if address, _ := appOpts.Get(SignerAddressKey).(string); address != "" {
+			conn, err := signer.Dial(address)
+			if err != nil {
+				panic(fmt.Errorf("failed to dial signer service %s: %w", address, err))
+			}
+			app.MultisigKeeper.SetSignerService(signer.NewClient(conn, signer.DefaultTimeout))
+		}
+
+		// Memory stores are not committed, so the cache is a node-local choice
+		if cast.ToBool(appOpts.Get(QueryCacheKey)) {
+			app.OracleKeeper.EnableQueryCache()
+			app.NettingKeeper.EnableQueryCache()
+		}
+
+		// Bank clients of the API server only read their own bank-scoped data
+		if path, _ := appOpts.Get(BankAuthCredentialsKey).(string); path != "" {
+			cfg, err := bankauth.LoadConfig(path)
+			if err != nil {
+				panic(fmt.Errorf("failed to load bank credentials: %w", err))
+			}
+			if app.bankAuth, err = bankauth.New(cfg); err != nil {
+				panic(fmt.Errorf("invalid bank credentials %s: %w", path, err))
+			}
+		}
+	}
+
 	// Set cross-module dependencies
 	app.OracleKeeper.SetNettingKeeper(&app.NettingKeeper)
+	app.NettingKeeper.SetMultisigKeeper(&app.MultisigKeeper)
+	app.NettingKeeper.SetOracleKeeper(&app.OracleKeeper)
+
+	// Refund fees of oracle votes that contribute to a confirmation
+	app.SetPostHandler(sdk.ChainPostDecorators(
+		oracleante.NewVoteRefundDecorator(app.OracleKeeper),
+	))
 
 	// This is a basic structure - full implementation will be added in subsequent tasks
 
@@ -245,6 +313,19 @@ func (app *App) GetMemKey(storeKey string) *storetypes.MemoryStoreKey {
 // RegisterAPIRoutes registers all application module routes with the provided API server.
 func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
 	// Module API routes will be registered here
+
+	if app.bankAuth != nil {
+		apiSvr.Router.Use(app.bankAuth.Middleware)
+	}
+
+	// Serve the OpenAPI document of the module queries when api.swagger is set
+	if apiConfig.Swagger {
+		handler, err := docs.Handler(Name, version.Version, docs.Routes)
+		if err != nil {
+			panic(fmt.Sprintf("failed to generate OpenAPI document: %v", err))
+		}
+		apiSvr.Router.PathPrefix(docs.SwaggerPrefix).Handler(handler)
+	}
 }
 
 // RegisterGRPCServer registers gRPC services directly with the gRPC server.
@@ -0,0 +1,70 @@
+package app
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/interbank-netting/cosmos/types"
+)
+
+// NewPrepareProposalHandler orders the transactions selected by next by
+// message phase, so every confirmation of the block is executed before its
+// netting triggers (see types.MsgPhase). Transactions that cannot be placed
+// in phase order are left for a later block.
+func NewPrepareProposalHandler(txDecoder sdk.TxDecoder, next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		resp, err := next(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		txMsgs, err := decodeTxMsgs(txDecoder, resp.Txs)
+		if err != nil {
+			return nil, err
+		}
+
+		order := types.OrderByPhase(txMsgs)
+		if deferred := len(resp.Txs) - len(order); deferred > 0 {
+			ctx.Logger().Info("deferred out of phase order transactions", "height", req.Height, "count", deferred)
+		}
+
+		txs := make([][]byte, len(order))
+		for i, index := range order {
+			txs[i] = resp.Txs[index]
+		}
+		resp.Txs = txs
+		return resp, nil
+	}
+}
+
+// NewProcessProposalHandler rejects proposals whose transactions are not in
+// message phase order before handing them to next, so a proposer that skips
+// the ordering cannot get a netting trigger executed ahead of a confirmation.
+func NewProcessProposalHandler(txDecoder sdk.TxDecoder, next sdk.ProcessProposalHandler) sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		txMsgs, err := decodeTxMsgs(txDecoder, req.Txs)
+		if err == nil {
+			err = types.ValidatePhaseOrder(txMsgs)
+		}
+		if err != nil {
+			ctx.Logger().Error("rejected proposal", "height", req.Height, "error", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		return next(ctx, req)
+	}
+}
+
+func decodeTxMsgs(txDecoder sdk.TxDecoder, txs [][]byte) ([][]sdk.Msg, error) {
+	txMsgs := make([][]sdk.Msg, len(txs))
+	for i, txBz := range txs {
+		tx, err := txDecoder(txBz)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode tx %d: %w", i, err)
+		}
+		txMsgs[i] = tx.GetMsgs()
+	}
+	return txMsgs, nil
+}
@@ -0,0 +1,170 @@
+// Package audit exports the oracle audit logs as JSON Lines for regulator
+// submission pipelines. Every line carries one log together with the chain ID,
+// state height and app hash it was read at, and is signed by the exporting
+// node's key, so a regulator can check both who exported a log and, against a
+// trusted header, that the log was in the chain state.
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/interbank-netting/cosmos/types"
+	oracletypes "github.com/interbank-netting/cosmos/x/oracle/types"
+)
+
+// Schema identifies the layout of an exported line. It changes whenever a
+// field is added, renamed or signed differently.
+const Schema = "interbank-netting/audit-log/v1"
+
+// MaxLineLength bounds a line read back by Verify. Audit logs are bounded by
+// the audit log size limits, so valid lines stay far below it.
+const MaxLineLength = 1 << 20
+
+// Source is the chain state the logs are exported from
+type Source struct {
+	ChainID string
+	Height  int64  // State height; its app hash is in the header of Height+1
+	AppHash []byte // App hash committing to the state at Height
+}
+
+// Record is one exported line
+type Record struct {
+	Schema    string            `json:"schema"`
+	ChainID   string            `json:"chain_id"`
+	Height    int64             `json:"height"`
+	AppHash   cmtbytes.HexBytes `json:"app_hash"`
+	Log       types.AuditLog    `json:"log"`
+	PubKey    []byte            `json:"pub_key"`             // Ed25519 key of the exporting node
+	Signature []byte            `json:"signature,omitempty"` // Over SignBytes
+}
+
+// SignBytes returns the canonical JSON of the record without its signature
+func (r Record) SignBytes() ([]byte, error) {
+	r.Signature = nil
+	bz, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.SortJSON(bz)
+}
+
+// Verify checks the schema and the signature of the record. The app hash is
+// not checked; compare it with the header at Height+1 of a trusted source.
+func (r Record) Verify() error {
+	if r.Schema != Schema {
+		return fmt.Errorf("unsupported schema %q", r.Schema)
+	}
+	if len(r.PubKey) != ed25519.PubKeySize {
+		return fmt.Errorf("invalid public key length %d", len(r.PubKey))
+	}
+
+	bz, err := r.SignBytes()
+	if err != nil {
+		return err
+	}
+	if !ed25519.PubKey(r.PubKey).VerifySignature(bz, r.Signature) {
+		return fmt.Errorf("invalid signature of audit log %d", r.Log.ID)
+	}
+	return nil
+}
+
+// LogSource pages through the audit logs, as the oracle keeper does
+type LogSource interface {
+	FilterAuditLogs(ctx sdk.Context, filter oracletypes.AuditLogFilter) ([]types.AuditLog, uint64, error)
+}
+
+// Exporter writes signed audit log lines
+type Exporter struct {
+	logs LogSource
+	key  cmtcrypto.PrivKey
+}
+
+// NewExporter returns an exporter reading logs from logs and signing with the
+// node key, which must be an ed25519 key
+func NewExporter(logs LogSource, key cmtcrypto.PrivKey) (Exporter, error) {
+	if key.Type() != ed25519.KeyType {
+		return Exporter{}, fmt.Errorf("unsupported node key type %s", key.Type())
+	}
+	return Exporter{logs: logs, key: key}, nil
+}
+
+// Export writes one signed line per log matching the filter, in ID order,
+// starting after filter.AfterID. It returns the number of lines written.
+func (e Exporter) Export(ctx sdk.Context, source Source, filter oracletypes.AuditLogFilter, w io.Writer) (int, error) {
+	count := 0
+	for {
+		logs, nextID, err := e.logs.FilterAuditLogs(ctx, filter)
+		if err != nil {
+			return count, err
+		}
+
+		for _, log := range logs {
+			record, err := e.sign(source, log)
+			if err != nil {
+				return count, err
+			}
+
+			bz, err := json.Marshal(record)
+			if err != nil {
+				return count, err
+			}
+			if _, err := w.Write(append(bz, '\n')); err != nil {
+				return count, err
+			}
+			count++
+		}
+
+		if nextID == 0 {
+			return count, nil
+		}
+		filter.AfterID = nextID
+	}
+}
+
+func (e Exporter) sign(source Source, log types.AuditLog) (Record, error) {
+	record := Record{
+		Schema:  Schema,
+		ChainID: source.ChainID,
+		Height:  source.Height,
+		AppHash: source.AppHash,
+		Log:     log,
+		PubKey:  e.key.PubKey().Bytes(),
+	}
+
+	bz, err := record.SignBytes()
+	if err != nil {
+		return Record{}, err
+	}
+	if record.Signature, err = e.key.Sign(bz); err != nil {
+		return Record{}, err
+	}
+	return record, nil
+}
+
+// Verify reads exported lines and checks every record. It returns the records
+// and fails on the first line that does not parse or verify.
+func Verify(r io.Reader) ([]Record, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), MaxLineLength)
+
+	records := []Record{}
+	for line := 1; scanner.Scan(); line++ {
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if err := record.Verify(); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		records = append(records, record)
+	}
+	return records, scanner.Err()
+}
@@ -0,0 +1,116 @@
+package audit_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/interbank-netting/cosmos/client/audit"
+	"github.com/interbank-netting/cosmos/types"
+	oracletypes "github.com/interbank-netting/cosmos/x/oracle/types"
+)
+
+// pagedLogs serves audit logs in pages of pageSize, as the oracle keeper does
+// with the filter limit
+type pagedLogs struct {
+	logs     []types.AuditLog
+	pageSize int
+}
+
+func (p pagedLogs) FilterAuditLogs(ctx sdk.Context, filter oracletypes.AuditLogFilter) ([]types.AuditLog, uint64, error) {
+	page := []types.AuditLog{}
+	for _, log := range p.logs {
+		if log.ID <= filter.AfterID || (filter.EventType != "" && log.EventType != filter.EventType) {
+			continue
+		}
+		if len(page) == p.pageSize {
+			return page, page[len(page)-1].ID, nil
+		}
+		page = append(page, log)
+	}
+	return page, 0, nil
+}
+
+func testLogs(count int) []types.AuditLog {
+	logs := make([]types.AuditLog, count)
+	for i := range logs {
+		logs[i] = types.AuditLog{
+			ID:          uint64(i + 1),
+			EventType:   types.EventTypeTransferConfirmed,
+			TxHash:      "0xabc",
+			Details:     map[string]string{"source_chain": "bank-a", "dest_chain": "bank-b", "amount": "100"},
+			Timestamp:   1700000000 + int64(i),
+			BlockHeight: int64(10 + i),
+		}
+	}
+	return logs
+}
+
+// **Unit Test: 서명된 감사 로그 내보내기**
+func TestExport_SignedLinesVerify(t *testing.T) {
+	key := ed25519.GenPrivKey()
+	exporter, err := audit.NewExporter(pagedLogs{logs: testLogs(5), pageSize: 2}, key)
+	require.NoError(t, err)
+
+	source := audit.Source{ChainID: "interbank-1", Height: 42, AppHash: bytes.Repeat([]byte{0xab}, 32)}
+	var out bytes.Buffer
+	count, err := exporter.Export(sdk.Context{}, source, oracletypes.AuditLogFilter{}, &out)
+	require.NoError(t, err)
+	require.Equal(t, 5, count)
+
+	// One JSON object per line, across pages, in ID order
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	require.Len(t, lines, 5)
+
+	records, err := audit.Verify(strings.NewReader(out.String()))
+	require.NoError(t, err)
+	require.Len(t, records, 5)
+	for i, record := range records {
+		require.Equal(t, audit.Schema, record.Schema)
+		require.Equal(t, "interbank-1", record.ChainID)
+		require.Equal(t, int64(42), record.Height)
+		require.Equal(t, source.AppHash, []byte(record.AppHash))
+		require.Equal(t, uint64(i+1), record.Log.ID)
+		require.Equal(t, key.PubKey().Bytes(), record.PubKey)
+	}
+
+	// A tampered line fails verification
+	var record audit.Record
+	require.NoError(t, json.Unmarshal([]byte(lines[2]), &record))
+	record.Log.Details["amount"] = "1000"
+	tampered, err := json.Marshal(record)
+	require.NoError(t, err)
+	lines[2] = string(tampered)
+	_, err = audit.Verify(strings.NewReader(strings.Join(lines, "\n")))
+	require.ErrorContains(t, err, "line 3")
+
+	// So does a line signed under another schema
+	record.Log.Details["amount"] = "100"
+	record.Schema = "interbank-netting/audit-log/v0"
+	require.Error(t, record.Verify())
+}
+
+func TestExport_FilterAndKeyType(t *testing.T) {
+	_, err := audit.NewExporter(pagedLogs{}, secp256k1.GenPrivKey())
+	require.Error(t, err)
+
+	logs := testLogs(3)
+	logs[1].EventType = types.EventTypeCreditIssued
+	exporter, err := audit.NewExporter(pagedLogs{logs: logs, pageSize: 10}, ed25519.GenPrivKey())
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	count, err := exporter.Export(sdk.Context{}, audit.Source{ChainID: "interbank-1", Height: 1}, oracletypes.AuditLogFilter{EventType: types.EventTypeCreditIssued}, &out)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	records, err := audit.Verify(&out)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), records[0].Log.ID)
+}
@@ -0,0 +1,209 @@
+// Package bankauth authenticates bank clients of the REST and gRPC gateway.
+// API keys and verified mTLS client certificates are mapped to bank IDs, and
+// queries of bank-scoped data (credit balances, credit lots, credit velocity
+// and audit logs) are only answered for the caller's own bank. Other queries
+// return network-wide data and only need an authenticated caller.
+package bankauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/interbank-netting/cosmos/client/docs"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
+	oracletypes "github.com/interbank-netting/cosmos/x/oracle/types"
+)
+
+// AllBanks is the bank of a credential that may read the data of every bank,
+// e.g. the operator's or a regulator's
+const AllBanks = "*"
+
+// Credential maps an API key or a client certificate to a bank
+type Credential struct {
+	Bank       string `json:"bank"`                   // Bank ID, or AllBanks
+	APIKeyHash string `json:"api_key_hash,omitempty"` // Hex SHA-256 of the API key
+	CommonName string `json:"common_name,omitempty"`  // Subject CN of a verified client certificate
+}
+
+// Config is the credentials file of the gateway
+type Config struct {
+	Credentials []Credential `json:"credentials"`
+}
+
+// LoadConfig reads a JSON credentials file
+func LoadConfig(path string) (Config, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(bz, &cfg); err != nil {
+		return Config{}, fmt.Errorf("failed to parse credentials file %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// HashAPIKey returns the hex SHA-256 of an API key, as stored in a Credential
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// scope is a bank-scoped query. The caller's bank must be the value of one
+// of its request fields, given as dotted JSON names.
+type scope struct {
+	module string
+	method string
+	fields []string
+}
+
+// scopes are the queries returning the data of the banks in their request
+var scopes = []scope{
+	{module: nettingtypes.ModuleName, method: "CreditBalance", fields: []string{"bank"}},
+	{module: nettingtypes.ModuleName, method: "CreditLots", fields: []string{"bank"}},
+	{module: nettingtypes.ModuleName, method: "CreditVelocity", fields: []string{"issuer_bank", "holder_bank"}},
+	{module: oracletypes.ModuleName, method: "AuditLogs", fields: []string{"filter.bank"}},
+}
+
+// QueryMethod returns the full gRPC method name of a module query
+func QueryMethod(module, method string) string {
+	return "/" + docs.QueryServiceName(module) + "/" + method
+}
+
+var pathParamPattern = regexp.MustCompile(`\{([a-z_]+)\}`)
+
+// restScope is a scope with the pattern of its gateway path
+type restScope struct {
+	scope
+	path   *regexp.Regexp
+	params []string // Path parameter names, in path order
+}
+
+// Authenticator maps credentials to banks and checks bank-scoped queries
+type Authenticator struct {
+	apiKeys     map[string]string // API key hash to bank
+	commonNames map[string]string // Certificate common name to bank
+	grpcScopes  map[string]scope  // By full gRPC method name
+	restScopes  []restScope
+}
+
+// New returns an authenticator of the credentials of cfg
+func New(cfg Config) (*Authenticator, error) {
+	a := &Authenticator{
+		apiKeys:     make(map[string]string),
+		commonNames: make(map[string]string),
+		grpcScopes:  make(map[string]scope, len(scopes)),
+	}
+
+	for i, credential := range cfg.Credentials {
+		if credential.Bank == "" {
+			return nil, fmt.Errorf("credential %d: bank cannot be empty", i)
+		}
+		if credential.APIKeyHash == "" && credential.CommonName == "" {
+			return nil, fmt.Errorf("credential %d: needs an API key hash or a common name", i)
+		}
+		if credential.APIKeyHash != "" {
+			hash := strings.ToLower(credential.APIKeyHash)
+			if bz, err := hex.DecodeString(hash); err != nil || len(bz) != sha256.Size {
+				return nil, fmt.Errorf("credential %d: API key hash must be a hex SHA-256", i)
+			}
+			if _, found := a.apiKeys[hash]; found {
+				return nil, fmt.Errorf("credential %d: duplicate API key hash", i)
+			}
+			a.apiKeys[hash] = credential.Bank
+		}
+		if credential.CommonName != "" {
+			if _, found := a.commonNames[credential.CommonName]; found {
+				return nil, fmt.Errorf("credential %d: duplicate common name %s", i, credential.CommonName)
+			}
+			a.commonNames[credential.CommonName] = credential.Bank
+		}
+	}
+
+	for _, s := range scopes {
+		a.grpcScopes[QueryMethod(s.module, s.method)] = s
+	}
+	for _, route := range docs.Routes {
+		for _, s := range scopes {
+			if route.Module != s.module || route.Method != s.method {
+				continue
+			}
+			rs := restScope{scope: s}
+			for _, match := range pathParamPattern.FindAllStringSubmatch(route.Path, -1) {
+				rs.params = append(rs.params, match[1])
+			}
+			literals := pathParamPattern.Split(route.Path, -1)
+			for i := range literals {
+				literals[i] = regexp.QuoteMeta(literals[i])
+			}
+			rs.path = regexp.MustCompile("^" + strings.Join(literals, `([^/]+)`) + "$")
+			a.restScopes = append(a.restScopes, rs)
+		}
+	}
+	return a, nil
+}
+
+// Authenticate returns the bank of an API key or, without a key, of the
+// leaf of the first verified client certificate chain
+func (a *Authenticator) Authenticate(apiKey string, state *tls.ConnectionState) (string, error) {
+	if apiKey != "" {
+		bank, found := a.apiKeys[HashAPIKey(apiKey)]
+		if !found {
+			return "", fmt.Errorf("unknown API key")
+		}
+		return bank, nil
+	}
+
+	if cert := verifiedLeaf(state); cert != nil {
+		bank, found := a.commonNames[cert.Subject.CommonName]
+		if !found {
+			return "", fmt.Errorf("unknown client certificate %s", cert.Subject.CommonName)
+		}
+		return bank, nil
+	}
+	return "", fmt.Errorf("missing API key or client certificate")
+}
+
+// verifiedLeaf returns the client certificate the TLS stack verified. Peer
+// certificates that were not verified against a client CA are ignored.
+func verifiedLeaf(state *tls.ConnectionState) *x509.Certificate {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return state.VerifiedChains[0][0]
+}
+
+// authorize checks that bank is one of the values of a bank-scoped query
+func authorize(bank string, s scope, values []string) error {
+	if bank == AllBanks {
+		return nil
+	}
+	for _, value := range values {
+		if value == bank {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s of %s must be the caller's bank %s", strings.Join(s.fields, " or "), s.method, bank)
+}
+
+type bankContextKey struct{}
+
+// WithBank returns ctx carrying the authenticated bank
+func WithBank(ctx context.Context, bank string) context.Context {
+	return context.WithValue(ctx, bankContextKey{}, bank)
+}
+
+// BankFromContext returns the authenticated bank of a request context
+func BankFromContext(ctx context.Context) (string, bool) {
+	bank, ok := ctx.Value(bankContextKey{}).(string)
+	return bank, ok
+}
@@ -0,0 +1,158 @@
+package bankauth_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/interbank-netting/cosmos/client/bankauth"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
+	oracletypes "github.com/interbank-netting/cosmos/x/oracle/types"
+)
+
+func newAuthenticator(t *testing.T) *bankauth.Authenticator {
+	a, err := bankauth.New(bankauth.Config{Credentials: []bankauth.Credential{
+		{Bank: "bank-a", APIKeyHash: bankauth.HashAPIKey("key-a")},
+		{Bank: "bank-b", CommonName: "gateway.bank-b"},
+		{Bank: bankauth.AllBanks, APIKeyHash: bankauth.HashAPIKey("key-regulator")},
+	}})
+	require.NoError(t, err)
+	return a
+}
+
+// serve runs a request through the middleware and returns the status code and
+// the bank the wrapped handler saw
+func serve(a *bankauth.Authenticator, r *http.Request) (int, string) {
+	var bank string
+	handler := a.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bank, _ = bankauth.BankFromContext(r.Context())
+	}))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, r)
+	return recorder.Code, bank
+}
+
+func withAPIKey(r *http.Request, key string) *http.Request {
+	r.Header.Set(bankauth.APIKeyHeader, key)
+	return r
+}
+
+func TestMiddleware_RestrictsBankScopedQueriesToCallersBank(t *testing.T) {
+	a := newAuthenticator(t)
+
+	tests := []struct {
+		name   string
+		key    string
+		path   string
+		status int
+	}{
+		{"own balance", "key-a", "/interbank/netting/netting/v1/credit_balance/bank-a/cred-bank-c", http.StatusOK},
+		{"other bank's balance", "key-a", "/interbank/netting/netting/v1/credit_balance/bank-b/cred-bank-c", http.StatusForbidden},
+		{"other bank's lots", "key-a", "/interbank/netting/netting/v1/credit_lots/bank-b/cred-bank-c", http.StatusForbidden},
+		{"velocity as holder", "key-a", "/interbank/netting/netting/v1/credit_velocity/bank-c/bank-a", http.StatusOK},
+		{"velocity of other banks", "key-a", "/interbank/netting/netting/v1/credit_velocity/bank-b/bank-c", http.StatusForbidden},
+		{"own audit logs", "key-a", "/interbank/netting/oracle/v1/audit_logs?filter.bank=bank-a", http.StatusOK},
+		{"unfiltered audit logs", "key-a", "/interbank/netting/oracle/v1/audit_logs", http.StatusForbidden},
+		{"repeated bank filter", "key-a", "/interbank/netting/oracle/v1/audit_logs?filter.bank=bank-a&filter.bank=bank-b", http.StatusForbidden},
+		{"network-wide query", "key-a", "/interbank/netting/netting/v1/banks", http.StatusOK},
+		{"all banks", "key-regulator", "/interbank/netting/netting/v1/credit_balance/bank-b/cred-bank-c", http.StatusOK},
+		{"unknown key", "key-x", "/interbank/netting/netting/v1/banks", http.StatusUnauthorized},
+		{"no credential", "", "/interbank/netting/netting/v1/banks", http.StatusUnauthorized},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			code, _ := serve(a, withAPIKey(httptest.NewRequest(http.MethodGet, tc.path, nil), tc.key))
+			require.Equal(t, tc.status, code)
+		})
+	}
+}
+
+func TestMiddleware_AuthenticatesVerifiedClientCertificates(t *testing.T) {
+	a := newAuthenticator(t)
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "gateway.bank-b"}}
+
+	// A verified certificate identifies its bank
+	r := httptest.NewRequest(http.MethodGet, "/interbank/netting/netting/v1/credit_balance/bank-b/cred-bank-c", nil)
+	r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}, VerifiedChains: [][]*x509.Certificate{{cert}}}
+	code, bank := serve(a, r)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "bank-b", bank)
+
+	// A certificate that was not verified against a client CA is ignored
+	r = httptest.NewRequest(http.MethodGet, "/interbank/netting/netting/v1/banks", nil)
+	r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+	code, _ = serve(a, r)
+	require.Equal(t, http.StatusUnauthorized, code)
+}
+
+func TestUnaryServerInterceptor_ChecksRequestFields(t *testing.T) {
+	a := newAuthenticator(t)
+	interceptor := a.UnaryServerInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		bank, _ := bankauth.BankFromContext(ctx)
+		return bank, nil
+	}
+	call := func(key, method string, req interface{}) (interface{}, error) {
+		ctx := context.Background()
+		if key != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(bankauth.APIKeyMetadata, key))
+		}
+		return interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	}
+	balance := bankauth.QueryMethod(nettingtypes.ModuleName, "CreditBalance")
+	auditLogs := bankauth.QueryMethod(oracletypes.ModuleName, "AuditLogs")
+
+	bank, err := call("key-a", balance, &nettingtypes.QueryCreditBalanceRequest{Bank: "bank-a", Denom: "cred-bank-c"})
+	require.NoError(t, err)
+	require.Equal(t, "bank-a", bank)
+
+	_, err = call("key-a", balance, &nettingtypes.QueryCreditBalanceRequest{Bank: "bank-b", Denom: "cred-bank-c"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = call("key-a", auditLogs, &oracletypes.QueryAuditLogsRequest{Filter: oracletypes.AuditLogFilter{Bank: "bank-a"}})
+	require.NoError(t, err)
+
+	_, err = call("key-a", auditLogs, &oracletypes.QueryAuditLogsRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = call("key-regulator", auditLogs, &oracletypes.QueryAuditLogsRequest{})
+	require.NoError(t, err)
+
+	_, err = call("", balance, &nettingtypes.QueryCreditBalanceRequest{Bank: "bank-a"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestNew_RejectsInvalidCredentials(t *testing.T) {
+	tests := []struct {
+		name        string
+		credentials []bankauth.Credential
+	}{
+		{"no bank", []bankauth.Credential{{APIKeyHash: bankauth.HashAPIKey("key")}}},
+		{"no key or name", []bankauth.Credential{{Bank: "bank-a"}}},
+		{"plain key", []bankauth.Credential{{Bank: "bank-a", APIKeyHash: "key"}}},
+		{"duplicate key", []bankauth.Credential{
+			{Bank: "bank-a", APIKeyHash: bankauth.HashAPIKey("key")},
+			{Bank: "bank-b", APIKeyHash: bankauth.HashAPIKey("key")},
+		}},
+		{"duplicate name", []bankauth.Credential{
+			{Bank: "bank-a", CommonName: "gateway"},
+			{Bank: "bank-b", CommonName: "gateway"},
+		}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := bankauth.New(bankauth.Config{Credentials: tc.credentials})
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package bankauth
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// APIKeyHeader is the HTTP header carrying the API key
+const APIKeyHeader = "X-API-Key"
+
+// Middleware authenticates every request before next serves it. It answers
+// 401 without a known API key or verified client certificate, and 403 for a
+// bank-scoped query of another bank's data. The bank is in the context of
+// the request next serves (BankFromContext).
+func (a *Authenticator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bank, err := a.Authenticate(r.Header.Get(APIKeyHeader), r.TLS)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if err := a.authorizeREST(bank, r); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(WithBank(r.Context(), bank)))
+	})
+}
+
+// authorizeREST checks a gateway request against the scope of its route.
+// Fields are read from the path parameters, or from the query parameters the
+// gateway maps to request fields.
+func (a *Authenticator) authorizeREST(bank string, r *http.Request) error {
+	for _, rs := range a.restScopes {
+		match := rs.path.FindStringSubmatch(r.URL.Path)
+		if match == nil {
+			continue
+		}
+
+		params := make(map[string]string, len(rs.params))
+		for i, name := range rs.params {
+			params[name] = match[i+1]
+		}
+		query := r.URL.Query()
+
+		var values []string
+		for _, field := range rs.fields {
+			if value, found := params[field]; found {
+				values = append(values, value)
+				continue
+			}
+			// A repeated parameter could carry the caller's bank next to another
+			if len(query[field]) > 1 {
+				return fmt.Errorf("%s of %s cannot be repeated", field, rs.method)
+			}
+			values = append(values, query.Get(field))
+		}
+		return authorize(bank, rs.scope, values)
+	}
+	return nil
+}
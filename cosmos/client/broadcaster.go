@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TxBroadcaster signs transactions with a key of the client context keyring
+// and broadcasts them to its node
+type TxBroadcaster struct {
+	clientCtx sdkclient.Context
+	factory   tx.Factory
+}
+
+var _ Broadcaster = TxBroadcaster{}
+
+// NewTxBroadcaster returns a broadcaster signing with clientCtx.FromName.
+// The factory sets the chain ID, gas and fees.
+func NewTxBroadcaster(clientCtx sdkclient.Context, factory tx.Factory) TxBroadcaster {
+	return TxBroadcaster{clientCtx: clientCtx, factory: factory}
+}
+
+// Broadcast implements Broadcaster. The account number and sequence are read
+// from the node on every call, so a retry after a wrong sequence picks up the
+// current one.
+func (b TxBroadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg) (string, error) {
+	clientCtx := b.clientCtx.WithCmdContext(ctx)
+
+	factory, err := b.factory.Prepare(clientCtx)
+	if err != nil {
+		return "", fmt.Errorf("failed to prepare transaction: %w", err)
+	}
+	builder, err := factory.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return "", fmt.Errorf("failed to build transaction: %w", err)
+	}
+	if err := tx.Sign(ctx, factory, clientCtx.FromName, builder, true); err != nil {
+		return "", fmt.Errorf("failed to sign transaction: %w", err)
+	}
+	bz, err := clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return "", fmt.Errorf("failed to encode transaction: %w", err)
+	}
+
+	res, err := clientCtx.BroadcastTx(bz)
+	if err != nil {
+		return "", err
+	}
+	if res.Code != 0 {
+		return res.TxHash, errorsmod.ABCIError(res.Codespace, res.Code, res.RawLog)
+	}
+	return res.TxHash, nil
+}
@@ -0,0 +1,297 @@
+// Package client is the Go SDK of the interbank netting chain for banks and
+// relayers. It calls the module query services over gRPC and broadcasts
+// transactions with per-call timeouts, retrying transient failures, so
+// callers use typed helpers instead of hand-rolling requests.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/interbank-netting/cosmos/client/bankauth"
+	"github.com/interbank-netting/cosmos/types"
+	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
+	oracletypes "github.com/interbank-netting/cosmos/x/oracle/types"
+)
+
+const (
+	// DefaultTimeout bounds each attempt of a call
+	DefaultTimeout = 10 * time.Second
+	// DefaultMaxRetries is the number of retries of a failed call
+	DefaultMaxRetries = 3
+	// DefaultBackoff is the wait before the first retry; it doubles with
+	// every further retry
+	DefaultBackoff = 500 * time.Millisecond
+	// DefaultPollInterval is the interval of WatchCommands
+	DefaultPollInterval = 5 * time.Second
+)
+
+// Config configures a Client. Zero values use the defaults.
+type Config struct {
+	Timeout    time.Duration
+	MaxRetries int // Negative to never retry
+	Backoff    time.Duration
+	APIKey     string // Sent as bankauth.APIKeyMetadata when set
+}
+
+// Broadcaster signs and broadcasts a transaction of msgs and returns its
+// hash. A failed transaction is returned as its registered ABCI error, so
+// types.IsRetryable classifies it.
+type Broadcaster interface {
+	Broadcast(ctx context.Context, msgs ...sdk.Msg) (string, error)
+}
+
+// Client calls the module query services over a gRPC connection and submits
+// transactions through a Broadcaster
+type Client struct {
+	conn        grpc.ClientConnInterface
+	broadcaster Broadcaster
+	codec       encoding.Codec
+	cfg         Config
+}
+
+// New returns a client over a gRPC connection. The broadcaster may be nil
+// for a read-only client.
+func New(conn grpc.ClientConnInterface, broadcaster Broadcaster, cfg Config) *Client {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = DefaultMaxRetries
+	}
+	if cfg.Backoff <= 0 {
+		cfg.Backoff = DefaultBackoff
+	}
+	return &Client{conn: conn, broadcaster: broadcaster, codec: Codec(), cfg: cfg}
+}
+
+// Codec returns the gRPC codec of the query messages. The query types are
+// hand-written with JSON tags, so messages are JSON. Servers must use it too,
+// with grpc.ForceServerCodec(Codec()).
+func Codec() encoding.Codec {
+	return jsonCodec{}
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error)      { return json.Marshal(v) }
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error { return json.Unmarshal(data, v) }
+func (jsonCodec) Name() string                               { return "json" }
+
+// Query calls a query method of a module, e.g. Query(ctx, "oracle",
+// "WorkQueue", &req, &res), retrying transient failures
+func (c *Client) Query(ctx context.Context, module, method string, req, res interface{}) error {
+	if c.cfg.APIKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, bankauth.APIKeyMetadata, c.cfg.APIKey)
+	}
+	return c.retry(ctx, isTransientGRPC, func(ctx context.Context) error {
+		return c.conn.Invoke(ctx, bankauth.QueryMethod(module, method), req, res, grpc.ForceCodec(c.codec))
+	})
+}
+
+// QueryBalances returns the balance, frozen and available credit of a bank
+// in each denom, in the order of denoms
+func (c *Client) QueryBalances(ctx context.Context, bank string, denoms ...string) ([]nettingtypes.CreditBalanceSummary, error) {
+	balances := make([]nettingtypes.CreditBalanceSummary, 0, len(denoms))
+	for _, denom := range denoms {
+		var res nettingtypes.QueryCreditBalanceResponse
+		req := &nettingtypes.QueryCreditBalanceRequest{Bank: bank, Denom: denom}
+		if err := c.Query(ctx, nettingtypes.ModuleName, "CreditBalance", req, &res); err != nil {
+			return nil, fmt.Errorf("failed to query balance of %s in %s: %w", bank, denom, err)
+		}
+		balances = append(balances, res.Balance)
+	}
+	return balances, nil
+}
+
+// SubmitVote broadcasts a validator's signed vote on a transfer event and
+// returns the transaction hash. Votes failing with a retryable error, such as
+// a wrong sequence or an event below its confirmation depth, are resubmitted.
+func (c *Client) SubmitVote(ctx context.Context, validator string, event types.TransferEvent, signature []byte) (string, error) {
+	if c.broadcaster == nil {
+		return "", errors.New("client has no broadcaster")
+	}
+
+	msg := oracletypes.NewMsgVote(event.TxHash, validator, event, signature)
+	if err := msg.ValidateBasic(); err != nil {
+		return "", err
+	}
+
+	var txHash string
+	err := c.retry(ctx, types.IsRetryable, func(ctx context.Context) error {
+		var err error
+		txHash, err = c.broadcaster.Broadcast(ctx, msg)
+		return err
+	})
+	return txHash, err
+}
+
+// WatchCommands polls the validator queue every interval and calls handle
+// once with every pending command the validator has not signed, oldest poll
+// first. It returns when ctx is done, a poll fails after its retries or
+// handle fails. A zero interval uses DefaultPollInterval.
+func (c *Client) WatchCommands(ctx context.Context, validator string, interval time.Duration, handle func(types.MintCommand) error) error {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	seen := make(map[string]bool)
+	for {
+		var res oracletypes.QueryValidatorQueueResponse
+		req := &oracletypes.QueryValidatorQueueRequest{Validator: validator}
+		if err := c.Query(ctx, oracletypes.ModuleName, "ValidatorQueue", req, &res); err != nil {
+			return err
+		}
+
+		// Only commands still pending are remembered, so seen stays bounded
+		pending := make(map[string]bool, len(res.ValidatorQueue.PendingCommands))
+		for _, command := range res.ValidatorQueue.PendingCommands {
+			pending[command.CommandID] = true
+			if seen[command.CommandID] {
+				continue
+			}
+			if err := handle(command); err != nil {
+				return err
+			}
+		}
+		seen = pending
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// SignCommands is the signing loop of a validator's own node or sidecar. It
+// watches the validator queue like WatchCommands and signs every pending
+// command with SignCommand. Commands that were signed by the validator, have
+// expired or were executed in the meantime are skipped.
+func (c *Client) SignCommands(ctx context.Context, validator, account string, signer multisigtypes.SignerService, interval time.Duration) error {
+	return c.WatchCommands(ctx, validator, interval, func(command types.MintCommand) error {
+		_, err := c.SignCommand(ctx, validator, account, signer, command)
+		if errors.Is(err, multisigtypes.ErrDuplicateSignature) ||
+			errors.Is(err, multisigtypes.ErrCommandExpired) ||
+			errors.Is(err, multisigtypes.ErrInvalidCommandStatus) {
+			return nil
+		}
+		return err
+	})
+}
+
+// SignCommand signs the hash of a mint command with the validator's key
+// through signer, e.g. an HSM or a cloud KMS, and broadcasts the signature
+// from the validator's operator account with MsgSignCommand. It returns the
+// transaction hash. Signatures failing with a retryable error are resubmitted.
+func (c *Client) SignCommand(ctx context.Context, validator, account string, signer multisigtypes.SignerService, command types.MintCommand) (string, error) {
+	if c.broadcaster == nil {
+		return "", errors.New("client has no broadcaster")
+	}
+
+	res, err := signer.SignCommandHash(ctx, &multisigtypes.SignCommandHashRequest{
+		Validator: validator,
+		CommandID: command.CommandID,
+		Hash:      multisigtypes.CommandHash(command),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to sign command %s: %w", command.CommandID, err)
+	}
+	signature, err := multisigtypes.DecodeSignature(validator, res.Signature, time.Now().Unix())
+	if err != nil {
+		return "", err
+	}
+
+	msg := multisigtypes.NewMsgSignCommand(account, command.CommandID, signature)
+	if err := msg.ValidateBasic(); err != nil {
+		return "", err
+	}
+
+	var txHash string
+	err = c.retry(ctx, types.IsRetryable, func(ctx context.Context) error {
+		var err error
+		txHash, err = c.broadcaster.Broadcast(ctx, msg)
+		return err
+	})
+	return txHash, err
+}
+
+// SignBatch signs the Merkle root of a command batch with the validator's key
+// through signer and broadcasts the signature from the validator's operator
+// account with MsgSignBatch. The batch ID is sent as the command ID of the
+// signing request. It returns the transaction hash.
+func (c *Client) SignBatch(ctx context.Context, validator, account string, signer multisigtypes.SignerService, batch multisigtypes.CommandBatch) (string, error) {
+	if c.broadcaster == nil {
+		return "", errors.New("client has no broadcaster")
+	}
+
+	res, err := signer.SignCommandHash(ctx, &multisigtypes.SignCommandHashRequest{
+		Validator: validator,
+		CommandID: batch.BatchID,
+		Hash:      batch.Root,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to sign batch %s: %w", batch.BatchID, err)
+	}
+	signature, err := multisigtypes.DecodeSignature(validator, res.Signature, time.Now().Unix())
+	if err != nil {
+		return "", err
+	}
+
+	msg := multisigtypes.NewMsgSignBatch(account, batch.BatchID, signature)
+	if err := msg.ValidateBasic(); err != nil {
+		return "", err
+	}
+
+	var txHash string
+	err = c.retry(ctx, types.IsRetryable, func(ctx context.Context) error {
+		var err error
+		txHash, err = c.broadcaster.Broadcast(ctx, msg)
+		return err
+	})
+	return txHash, err
+}
+
+// retry runs call with a per-attempt timeout until it succeeds, fails with an
+// error retryable does not accept, or runs out of retries
+func (c *Client) retry(ctx context.Context, retryable func(error) bool, call func(ctx context.Context) error) error {
+	backoff := c.cfg.Backoff
+	for attempt := 0; ; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+		err := call(attemptCtx)
+		cancel()
+		if err == nil || !retryable(err) || attempt >= c.cfg.MaxRetries || ctx.Err() != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// isTransientGRPC returns true for gRPC failures of the connection or the
+// server's capacity rather than of the request
+func isTransientGRPC(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
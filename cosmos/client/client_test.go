@@ -0,0 +1,304 @@
+package client_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/interbank-netting/cosmos/client"
+	"github.com/interbank-netting/cosmos/client/bankauth"
+	"github.com/interbank-netting/cosmos/types"
+	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
+	oracletypes "github.com/interbank-netting/cosmos/x/oracle/types"
+)
+
+// nettingQueries answers CreditBalance from a map, failing the first
+// unavailable calls
+type nettingQueries struct {
+	nettingtypes.QueryServer
+	balances    map[string]math.Int
+	unavailable int
+	calls       int
+}
+
+func (q *nettingQueries) CreditBalance(ctx context.Context, req *nettingtypes.QueryCreditBalanceRequest) (*nettingtypes.QueryCreditBalanceResponse, error) {
+	q.calls++
+	if q.calls <= q.unavailable {
+		return nil, status.Error(codes.Unavailable, "node restarting")
+	}
+	balance, found := q.balances[req.Bank+"/"+req.Denom]
+	if !found {
+		balance = math.ZeroInt()
+	}
+	return &nettingtypes.QueryCreditBalanceResponse{Balance: nettingtypes.CreditBalanceSummary{
+		Bank: req.Bank, Denom: req.Denom, Balance: balance, Frozen: math.ZeroInt(), Available: balance,
+	}}, nil
+}
+
+// oracleQueries answers ValidatorQueue with the next of a list of queues
+type oracleQueries struct {
+	oracletypes.QueryServer
+	queues [][]types.MintCommand
+	polls  int
+}
+
+func (q *oracleQueries) ValidatorQueue(ctx context.Context, req *oracletypes.QueryValidatorQueueRequest) (*oracletypes.QueryValidatorQueueResponse, error) {
+	commands := q.queues[len(q.queues)-1]
+	if q.polls < len(q.queues) {
+		commands = q.queues[q.polls]
+	}
+	q.polls++
+	return &oracletypes.QueryValidatorQueueResponse{ValidatorQueue: oracletypes.ValidatorQueue{
+		Validator: req.Validator, Active: true, PendingCommands: commands,
+	}}, nil
+}
+
+type multisigQueries struct {
+	multisigtypes.QueryServer
+}
+
+// serve starts the query services behind bank authentication and returns a
+// connection to them
+func serve(t *testing.T, oracle oracletypes.QueryServer, netting nettingtypes.QueryServer) *grpc.ClientConn {
+	auth, err := bankauth.New(bankauth.Config{Credentials: []bankauth.Credential{
+		{Bank: "bank-a", APIKeyHash: bankauth.HashAPIKey("key-a")},
+		{Bank: bankauth.AllBanks, APIKeyHash: bankauth.HashAPIKey("key-relayer")},
+	}})
+	require.NoError(t, err)
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.ForceServerCodec(client.Codec()), grpc.UnaryInterceptor(auth.UnaryServerInterceptor()))
+	client.RegisterQueryServers(server, oracle, netting, multisigQueries{})
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestQueryBalances_RetriesUnavailableNode(t *testing.T) {
+	netting := &nettingQueries{
+		balances:    map[string]math.Int{"bank-a/cred-bank-b": math.NewInt(700)},
+		unavailable: 2,
+	}
+	conn := serve(t, &oracleQueries{}, netting)
+	c := client.New(conn, nil, client.Config{APIKey: "key-a", Backoff: time.Millisecond})
+
+	balances, err := c.QueryBalances(context.Background(), "bank-a", "cred-bank-b", "cred-bank-c")
+	require.NoError(t, err)
+	require.Len(t, balances, 2)
+	require.Equal(t, math.NewInt(700), balances[0].Balance)
+	require.True(t, balances[1].Balance.IsZero())
+	require.Equal(t, 4, netting.calls)
+
+	// Another bank's balance is rejected by the gateway and not retried
+	calls := netting.calls
+	_, err = c.QueryBalances(context.Background(), "bank-b", "cred-bank-a")
+	require.Equal(t, codes.PermissionDenied, status.Code(errors.Unwrap(err)))
+	require.Equal(t, calls, netting.calls)
+
+	// Without retries the unavailable node is returned
+	netting.calls, netting.unavailable = 0, 1
+	noRetry := client.New(conn, nil, client.Config{APIKey: "key-a", MaxRetries: -1})
+	_, err = noRetry.QueryBalances(context.Background(), "bank-a", "cred-bank-b")
+	require.Equal(t, codes.Unavailable, status.Code(errors.Unwrap(err)))
+}
+
+// broadcaster fails with the errors in order, then succeeds
+type broadcaster struct {
+	errs []error
+	msgs []sdk.Msg
+}
+
+func (b *broadcaster) Broadcast(ctx context.Context, msgs ...sdk.Msg) (string, error) {
+	b.msgs = append(b.msgs, msgs...)
+	if len(b.errs) > 0 {
+		err := b.errs[0]
+		b.errs = b.errs[1:]
+		return "", err
+	}
+	return "COSMOS-TX", nil
+}
+
+func TestSubmitVote_RetriesRetryableErrors(t *testing.T) {
+	validator := sdk.AccAddress("validator-1_________").String()
+	event := types.TransferEvent{
+		TxHash:      "0xabc",
+		Sender:      "0xsender",
+		Recipient:   "0xrecipient",
+		Amount:      math.NewInt(1000),
+		SourceChain: "bank-a",
+		DestChain:   "bank-b",
+	}
+
+	// A vote below its confirmation depth is resubmitted
+	b := &broadcaster{errs: []error{oracletypes.ErrInsufficientConfirmations}}
+	c := client.New(nil, b, client.Config{Backoff: time.Millisecond})
+	txHash, err := c.SubmitVote(context.Background(), validator, event, []byte("signature"))
+	require.NoError(t, err)
+	require.Equal(t, "COSMOS-TX", txHash)
+	require.Len(t, b.msgs, 2)
+	require.Equal(t, event, b.msgs[0].(*oracletypes.MsgVote).EventData)
+
+	// A duplicate vote fails again unchanged, so it is not resubmitted
+	b = &broadcaster{errs: []error{oracletypes.ErrDuplicateVote}}
+	c = client.New(nil, b, client.Config{Backoff: time.Millisecond})
+	_, err = c.SubmitVote(context.Background(), validator, event, []byte("signature"))
+	require.ErrorIs(t, err, oracletypes.ErrDuplicateVote)
+	require.Len(t, b.msgs, 1)
+
+	// An invalid vote is never broadcast
+	_, err = c.SubmitVote(context.Background(), validator, event, nil)
+	require.Error(t, err)
+	require.Len(t, b.msgs, 1)
+}
+
+func TestWatchCommands_HandlesEachPendingCommandOnce(t *testing.T) {
+	oracle := &oracleQueries{queues: [][]types.MintCommand{
+		{{CommandID: "cmd-1"}},
+		{{CommandID: "cmd-1"}, {CommandID: "cmd-2"}},
+		{{CommandID: "cmd-2"}},
+		{{CommandID: "cmd-3"}},
+	}}
+	conn := serve(t, oracle, &nettingQueries{})
+	c := client.New(conn, nil, client.Config{APIKey: "key-relayer"})
+
+	done := errors.New("done")
+	var handled []string
+	err := c.WatchCommands(context.Background(), "validator-1", time.Millisecond, func(command types.MintCommand) error {
+		handled = append(handled, command.CommandID)
+		if command.CommandID == "cmd-3" {
+			return done
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, done)
+	require.Equal(t, []string{"cmd-1", "cmd-2", "cmd-3"}, handled)
+
+	// A cancelled watch returns the context error
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = c.WatchCommands(ctx, "validator-1", time.Millisecond, func(types.MintCommand) error { return nil })
+	require.Error(t, err)
+}
+
+// hashSigner signs command hashes with one key, returning V as 0 or 1 like
+// most KMS APIs, and calls stop once it signed the last command
+type hashSigner struct {
+	key    *ecdsa.PrivateKey
+	signed []string
+	last   string
+	stop   func()
+}
+
+func (s *hashSigner) SignCommandHash(ctx context.Context, req *multisigtypes.SignCommandHashRequest) (*multisigtypes.SignCommandHashResponse, error) {
+	signature, err := crypto.Sign(req.Hash, s.key)
+	if err != nil {
+		return nil, err
+	}
+	s.signed = append(s.signed, req.CommandID)
+	if req.CommandID == s.last && s.stop != nil {
+		s.stop()
+	}
+	return &multisigtypes.SignCommandHashResponse{Signature: signature}, nil
+}
+
+func (s *hashSigner) GetPublicKey(ctx context.Context, req *multisigtypes.GetPublicKeyRequest) (*multisigtypes.GetPublicKeyResponse, error) {
+	return &multisigtypes.GetPublicKeyResponse{PubKey: crypto.CompressPubkey(&s.key.PublicKey)}, nil
+}
+
+func TestSignCommands_SubmitsOwnSignatures(t *testing.T) {
+	validator := sdk.ValAddress("validator-1_________").String()
+	account := sdk.AccAddress("validator-1_________").String()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := &hashSigner{key: key}
+
+	oracle := &oracleQueries{queues: [][]types.MintCommand{
+		{{CommandID: "cmd-1", TargetChain: "bank-b", Recipient: "0xrecipient", Amount: math.NewInt(1000)}},
+		{{CommandID: "cmd-1"}, {CommandID: "cmd-2", Amount: math.NewInt(2000)}},
+		{{CommandID: "cmd-3", Amount: math.NewInt(3000)}},
+	}}
+	conn := serve(t, oracle, &nettingQueries{})
+
+	// The second command expired before its signature landed, which is skipped
+	b := &broadcaster{errs: []error{nil, multisigtypes.ErrCommandExpired}}
+	c := client.New(conn, b, client.Config{APIKey: "key-relayer", Backoff: time.Millisecond})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	signer.last, signer.stop = "cmd-3", cancel
+	err = c.SignCommands(ctx, validator, account, signer, time.Millisecond)
+	require.Error(t, err)
+	require.Equal(t, []string{"cmd-1", "cmd-2", "cmd-3"}, signer.signed)
+	require.Len(t, b.msgs, 3)
+
+	// Each message is the validator's own signature of the command hash,
+	// submitted from its operator account
+	msg := b.msgs[0].(*multisigtypes.MsgSignCommand)
+	require.Equal(t, account, msg.Signer)
+	require.Equal(t, "cmd-1", msg.CommandID)
+	require.Equal(t, validator, msg.Signature.Validator)
+	require.Contains(t, []uint32{27, 28}, msg.Signature.V)
+	encoded, err := multisigtypes.EncodeSignature(msg.Signature)
+	require.NoError(t, err)
+	encoded[64] -= 27
+	hash := multisigtypes.CommandHash(oracle.queues[0][0])
+	recovered, err := crypto.SigToPub(hash, encoded)
+	require.NoError(t, err)
+	require.Equal(t, key.PublicKey, *recovered)
+
+	// Without a broadcaster nothing is signed
+	_, err = client.New(conn, nil, client.Config{}).SignCommand(context.Background(), validator, account, signer, oracle.queues[0][0])
+	require.Error(t, err)
+	require.Len(t, signer.signed, 3)
+}
+
+func TestSignBatch_SubmitsOwnRootSignature(t *testing.T) {
+	validator := sdk.ValAddress("validator-1_________").String()
+	account := sdk.AccAddress("validator-1_________").String()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := &hashSigner{key: key}
+	conn := serve(t, &oracleQueries{}, &nettingQueries{})
+
+	root := multisigtypes.MerkleLeafHash(types.HashAlgorithmSHA256, []byte("command"))
+	batch := multisigtypes.CommandBatch{BatchID: "batch-1", TargetChain: "bank-b", Root: root}
+	b := &broadcaster{}
+	txHash, err := client.New(conn, b, client.Config{}).SignBatch(context.Background(), validator, account, signer, batch)
+	require.NoError(t, err)
+	require.Equal(t, "COSMOS-TX", txHash)
+	require.Equal(t, []string{"batch-1"}, signer.signed)
+
+	// The message is the validator's own signature of the root, submitted
+	// from its operator account
+	msg := b.msgs[0].(*multisigtypes.MsgSignBatch)
+	require.Equal(t, account, msg.Signer)
+	require.Equal(t, "batch-1", msg.BatchID)
+	require.Equal(t, validator, msg.Signature.Validator)
+	encoded, err := multisigtypes.EncodeSignature(msg.Signature)
+	require.NoError(t, err)
+	encoded[64] -= 27
+	recovered, err := crypto.SigToPub(root, encoded)
+	require.NoError(t, err)
+	require.Equal(t, key.PublicKey, *recovered)
+}
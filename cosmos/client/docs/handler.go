@@ -0,0 +1,49 @@
+package docs
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// SwaggerPrefix is the API server path the OpenAPI document and UI are served under
+const SwaggerPrefix = "/swagger/"
+
+// swaggerUI renders the document with the Swagger UI bundle from a CDN, so the
+// node does not ship UI assets
+const swaggerUI = `<!DOCTYPE html>
+<html>
+<head>
+  <title>%[1]s API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+  <script>SwaggerUIBundle({url: "%[2]sopenapi.json", dom_id: "#swagger-ui"});</script>
+</body>
+</html>
+`
+
+// Handler serves the OpenAPI document of the given routes at
+// SwaggerPrefix + "openapi.json" and a Swagger UI at SwaggerPrefix. The
+// document is generated once, when the handler is created.
+func Handler(title, version string, routes []Route) (http.Handler, error) {
+	spec, err := Spec(title, version, routes)
+	if err != nil {
+		return nil, err
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch strings.TrimPrefix(r.URL.Path, SwaggerPrefix) {
+		case "openapi.json":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(spec)
+		case "", "index.html":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			fmt.Fprintf(w, swaggerUI, title, SwaggerPrefix)
+		default:
+			http.NotFound(w, r)
+		}
+	}), nil
+}
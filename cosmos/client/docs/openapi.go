@@ -0,0 +1,234 @@
+package docs
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/math"
+)
+
+// The query types are hand-written Go structs rather than generated from
+// .proto files, so the OpenAPI document is derived from them by reflection.
+// Schemas follow the proto3 JSON mapping the gRPC gateway uses: 64-bit
+// integers and math.Int are strings, bytes are base64 strings.
+
+// Route describes one GET endpoint of a module query service
+type Route struct {
+	Module   string      // Module the query service belongs to
+	Method   string      // Query service method name
+	Path     string      // HTTP path, with {field} placeholders for path parameters
+	Summary  string      // One-line description
+	Request  interface{} // Request message, a zero value of the request struct
+	Response interface{} // Response message, a zero value of the response struct
+}
+
+// QueryServiceName returns the gRPC service name of a module's query service
+func QueryServiceName(module string) string {
+	return fmt.Sprintf("interbank.%s.v1.Query", module)
+}
+
+var pathParamPattern = regexp.MustCompile(`\{([a-z_]+)\}`)
+
+var mathIntType = reflect.TypeOf(math.Int{})
+
+// Spec returns the OpenAPI 3.0 document of the given routes as JSON
+func Spec(title, version string, routes []Route) ([]byte, error) {
+	g := &generator{schemas: map[string]interface{}{
+		// gRPC gateway error body; message carries "codespace/code" errors
+		"Status": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"code":    map[string]interface{}{"type": "integer", "format": "int32"},
+				"message": map[string]interface{}{"type": "string"},
+				"details": map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "object"}},
+			},
+		},
+	}}
+
+	paths := make(map[string]interface{}, len(routes))
+	for _, route := range routes {
+		operation, err := g.operation(route)
+		if err != nil {
+			return nil, fmt.Errorf("%s/%s: %w", route.Module, route.Method, err)
+		}
+		if _, exists := paths[route.Path]; exists {
+			return nil, fmt.Errorf("%s/%s: duplicate path %s", route.Module, route.Method, route.Path)
+		}
+		paths[route.Path] = map[string]interface{}{"get": operation}
+	}
+
+	return json.MarshalIndent(map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":   title,
+			"version": version,
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": g.schemas,
+		},
+	}, "", "  ")
+}
+
+type generator struct {
+	schemas map[string]interface{}
+}
+
+func (g *generator) operation(route Route) (map[string]interface{}, error) {
+	if route.Module == "" || route.Method == "" {
+		return nil, fmt.Errorf("module and method must be set")
+	}
+
+	requestType := reflect.TypeOf(route.Request)
+	if requestType == nil || requestType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("request must be a struct")
+	}
+	if reflect.TypeOf(route.Response) == nil {
+		return nil, fmt.Errorf("response must be set")
+	}
+
+	pathParams := make(map[string]bool)
+	for _, match := range pathParamPattern.FindAllStringSubmatch(route.Path, -1) {
+		pathParams[match[1]] = true
+	}
+
+	// Request fields become path parameters or query parameters
+	parameters := []interface{}{}
+	for _, field := range jsonFields(requestType) {
+		in := "query"
+		if pathParams[field.name] {
+			in = "path"
+			delete(pathParams, field.name)
+		}
+		parameters = append(parameters, map[string]interface{}{
+			"name":     field.name,
+			"in":       in,
+			"required": in == "path",
+			"schema":   g.schema(field.typ),
+		})
+	}
+	if len(pathParams) > 0 {
+		return nil, fmt.Errorf("path parameters %v are not request fields", sortedKeys(pathParams))
+	}
+
+	return map[string]interface{}{
+		"operationId": strings.ToUpper(route.Module[:1]) + route.Module[1:] + route.Method,
+		"summary":     route.Summary,
+		"tags":        []string{route.Module},
+		"parameters":  parameters,
+		"responses": map[string]interface{}{
+			"200": map[string]interface{}{
+				"description": "A successful response.",
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{
+						"schema": g.schema(reflect.TypeOf(route.Response)),
+					},
+				},
+			},
+			"default": map[string]interface{}{
+				"description": "An error response.",
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{
+						"schema": map[string]interface{}{"$ref": "#/components/schemas/Status"},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// schema returns the schema of t, registering named structs as components
+func (g *generator) schema(t reflect.Type) map[string]interface{} {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == mathIntType:
+		return map[string]interface{}{"type": "string", "format": "integer"}
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		return map[string]interface{}{"type": "string", "format": "byte"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Int32, reflect.Int16, reflect.Int8:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case reflect.Uint32, reflect.Uint16, reflect.Uint8:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case reflect.Int, reflect.Int64:
+		return map[string]interface{}{"type": "string", "format": "int64"}
+	case reflect.Uint, reflect.Uint64:
+		return map[string]interface{}{"type": "string", "format": "uint64"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": g.schema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": g.schema(t.Elem())}
+	case reflect.Struct:
+		name := schemaName(t)
+		if _, registered := g.schemas[name]; !registered {
+			// Register before recursing so self-referencing types terminate
+			g.schemas[name] = nil
+			properties := make(map[string]interface{})
+			for _, field := range jsonFields(t) {
+				properties[field.name] = g.schema(field.typ)
+			}
+			g.schemas[name] = map[string]interface{}{"type": "object", "properties": properties}
+		}
+		return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+type jsonField struct {
+	name string
+	typ  reflect.Type
+}
+
+// jsonFields returns the exported fields of a struct under their JSON names
+func jsonFields(t reflect.Type) []jsonField {
+	var fields []jsonField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields = append(fields, jsonField{name: name, typ: field.Type})
+	}
+	return fields
+}
+
+// schemaName qualifies a type with its module, or "common" for the shared
+// types package, since every module names its package "types"
+func schemaName(t reflect.Type) string {
+	pkg := "common"
+	if idx := strings.LastIndex(t.PkgPath(), "/x/"); idx >= 0 {
+		pkg = strings.SplitN(t.PkgPath()[idx+len("/x/"):], "/", 2)[0]
+	}
+	return pkg + "." + t.Name()
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,73 @@
+package docs_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/interbank-netting/cosmos/client/docs"
+	oracletypes "github.com/interbank-netting/cosmos/x/oracle/types"
+)
+
+// **Unit Test: OpenAPI 문서 생성**
+func TestSpec_CoversAllRoutes(t *testing.T) {
+	spec, err := docs.Spec("interbank-netting", "test", docs.Routes)
+	require.NoError(t, err)
+
+	var document struct {
+		Paths map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+			Parameters  []struct {
+				Name string `json:"name"`
+				In   string `json:"in"`
+			} `json:"parameters"`
+		} `json:"paths"`
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal(spec, &document))
+	require.Len(t, document.Paths, len(docs.Routes))
+
+	proof := document.Paths["/interbank/netting/oracle/v1/transfer_proof/{tx_hash}"]["get"]
+	require.Equal(t, "OracleTransferProof", proof.OperationID)
+	require.Len(t, proof.Parameters, 1)
+	require.Equal(t, "path", proof.Parameters[0].In)
+
+	// Shared and module types are qualified so equal names do not collide
+	require.Contains(t, document.Components.Schemas, "common.Vote")
+	require.Contains(t, document.Components.Schemas, "oracle.TransferProof")
+}
+
+func TestSpec_RejectsUnknownPathParameter(t *testing.T) {
+	_, err := docs.Spec("interbank-netting", "test", []docs.Route{{
+		Module:   oracletypes.ModuleName,
+		Method:   "TransferProof",
+		Path:     "/interbank/netting/oracle/v1/transfer_proof/{hash}",
+		Request:  oracletypes.QueryTransferProofRequest{},
+		Response: oracletypes.QueryTransferProofResponse{},
+	}})
+	require.ErrorContains(t, err, "hash")
+}
+
+func TestHandler_ServesDocumentAndUI(t *testing.T) {
+	handler, err := docs.Handler("interbank-netting", "test", docs.Routes)
+	require.NoError(t, err)
+
+	for path, contentType := range map[string]string{
+		docs.SwaggerPrefix + "openapi.json": "application/json",
+		docs.SwaggerPrefix:                  "text/html; charset=utf-8",
+	} {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		require.Equal(t, http.StatusOK, recorder.Code, path)
+		require.Equal(t, contentType, recorder.Header().Get("Content-Type"), path)
+	}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, docs.SwaggerPrefix+"missing", nil))
+	require.Equal(t, http.StatusNotFound, recorder.Code)
+}
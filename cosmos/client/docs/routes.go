@@ -0,0 +1,308 @@
+package docs
+
+import (
+	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
+	oracletypes "github.com/interbank-netting/cosmos/x/oracle/types"
+)
+
+// Routes are the gRPC gateway routes of every module query service. Add a
+// route here when a QueryServer method is added.
+var Routes = []Route{
+	{
+		Module:   oracletypes.ModuleName,
+		Method:   "TransferProof",
+		Path:     "/interbank/netting/oracle/v1/transfer_proof/{tx_hash}",
+		Summary:  "Confirmation proof of a transfer with its votes and credit token",
+		Request:  oracletypes.QueryTransferProofRequest{},
+		Response: oracletypes.QueryTransferProofResponse{},
+	},
+	{
+		Module:   oracletypes.ModuleName,
+		Method:   "TransferCredit",
+		Path:     "/interbank/netting/oracle/v1/transfer_credit/{tx_hash}",
+		Summary:  "Credit token issued by a confirmed transfer",
+		Request:  oracletypes.QueryTransferCreditRequest{},
+		Response: oracletypes.QueryTransferCreditResponse{},
+	},
+	{
+		Module:   oracletypes.ModuleName,
+		Method:   "WorkQueue",
+		Path:     "/interbank/netting/oracle/v1/work_queue",
+		Summary:  "Pending votes, confirmed transfers awaiting netting and unsigned commands",
+		Request:  oracletypes.QueryWorkQueueRequest{},
+		Response: oracletypes.QueryWorkQueueResponse{},
+	},
+	{
+		Module:   oracletypes.ModuleName,
+		Method:   "ValidatorQueue",
+		Path:     "/interbank/netting/oracle/v1/validator_queue/{validator}",
+		Summary:  "Pending transfers a validator has not voted on and commands it has not signed",
+		Request:  oracletypes.QueryValidatorQueueRequest{},
+		Response: oracletypes.QueryValidatorQueueResponse{},
+	},
+	{
+		Module:   oracletypes.ModuleName,
+		Method:   "ForecastNetting",
+		Path:     "/interbank/netting/oracle/v1/forecast_netting",
+		Summary:  "Projected net positions of the next netting cycle including pending transfers",
+		Request:  oracletypes.QueryForecastNettingRequest{},
+		Response: oracletypes.QueryForecastNettingResponse{},
+	},
+	{
+		Module:   oracletypes.ModuleName,
+		Method:   "Suspensions",
+		Path:     "/interbank/netting/oracle/v1/suspensions",
+		Summary:  "Suspended chains and banks",
+		Request:  oracletypes.QuerySuspensionsRequest{},
+		Response: oracletypes.QuerySuspensionsResponse{},
+	},
+	{
+		Module:   oracletypes.ModuleName,
+		Method:   "ParamsHistory",
+		Path:     "/interbank/netting/oracle/v1/params_history",
+		Summary:  "Oracle params changes with the height and proposal that made them",
+		Request:  oracletypes.QueryParamsHistoryRequest{},
+		Response: oracletypes.QueryParamsHistoryResponse{},
+	},
+	{
+		Module:   oracletypes.ModuleName,
+		Method:   "AuditLogs",
+		Path:     "/interbank/netting/oracle/v1/audit_logs",
+		Summary:  "Audit logs matching an event type, time range, bank, chain and detail filter",
+		Request:  oracletypes.QueryAuditLogsRequest{},
+		Response: oracletypes.QueryAuditLogsResponse{},
+	},
+	{
+		Module:   oracletypes.ModuleName,
+		Method:   "ChainHealth",
+		Path:     "/interbank/netting/oracle/v1/chain_health",
+		Summary:  "Chain health from the latest relayer heartbeats",
+		Request:  oracletypes.QueryChainHealthRequest{},
+		Response: oracletypes.QueryChainHealthResponse{},
+	},
+	{
+		Module:   oracletypes.ModuleName,
+		Method:   "Reporters",
+		Path:     "/interbank/netting/oracle/v1/reporters",
+		Summary:  "First report mismatch counts of validators and the reporters flagged for them",
+		Request:  oracletypes.QueryReportersRequest{},
+		Response: oracletypes.QueryReportersResponse{},
+	},
+	{
+		Module:   oracletypes.ModuleName,
+		Method:   "ChainFinality",
+		Path:     "/interbank/netting/oracle/v1/chain_finality",
+		Summary:  "Finality model and confirmation depth of registered Besu chains",
+		Request:  oracletypes.QueryChainFinalityRequest{},
+		Response: oracletypes.QueryChainFinalityResponse{},
+	},
+	{
+		Module:   oracletypes.ModuleName,
+		Method:   "PendingTransfers",
+		Path:     "/interbank/netting/oracle/v1/pending_transfers",
+		Summary:  "Vote statuses of the transfers not confirmed yet",
+		Request:  oracletypes.QueryPendingTransfersRequest{},
+		Response: oracletypes.QueryPendingTransfersResponse{},
+	},
+	{
+		Module:   oracletypes.ModuleName,
+		Method:   "RejectedTransfer",
+		Path:     "/interbank/netting/oracle/v1/rejected_transfer/{tx_hash}",
+		Summary:  "Final tally and votes of a rejected transfer",
+		Request:  oracletypes.QueryRejectedTransferRequest{},
+		Response: oracletypes.QueryRejectedTransferResponse{},
+	},
+	{
+		Module:   oracletypes.ModuleName,
+		Method:   "MessageCatalog",
+		Path:     "/interbank/netting/oracle/v1/message_catalog/{locale}",
+		Summary:  "Human-readable messages of the error and reason codes in a locale",
+		Request:  oracletypes.QueryMessageCatalogRequest{},
+		Response: oracletypes.QueryMessageCatalogResponse{},
+	},
+	{
+		Module:   multisigtypes.ModuleName,
+		Method:   "CommandBatch",
+		Path:     "/interbank/netting/multisig/v1/command_batch/{batch_id}",
+		Summary:  "Command batch with its Merkle root signatures",
+		Request:  multisigtypes.QueryCommandBatchRequest{},
+		Response: multisigtypes.QueryCommandBatchResponse{},
+	},
+	{
+		Module:   multisigtypes.ModuleName,
+		Method:   "CommandProof",
+		Path:     "/interbank/netting/multisig/v1/command_proof/{command_id}",
+		Summary:  "Merkle proof of a batched command",
+		Request:  multisigtypes.QueryCommandProofRequest{},
+		Response: multisigtypes.QueryCommandProofResponse{},
+	},
+	{
+		Module:   multisigtypes.ModuleName,
+		Method:   "LateSigners",
+		Path:     "/interbank/netting/multisig/v1/late_signers/{command_id}",
+		Summary:  "Validators that have not signed a pending command",
+		Request:  multisigtypes.QueryLateSignersRequest{},
+		Response: multisigtypes.QueryLateSignersResponse{},
+	},
+	{
+		Module:   multisigtypes.ModuleName,
+		Method:   "ValidatorSetConsistency",
+		Path:     "/interbank/netting/multisig/v1/validator_set_consistency",
+		Summary:  "Drift between the multisig validator set and the active staking set",
+		Request:  multisigtypes.QueryValidatorSetConsistencyRequest{},
+		Response: multisigtypes.QueryValidatorSetConsistencyResponse{},
+	},
+	{
+		Module:   multisigtypes.ModuleName,
+		Method:   "ExecutionCost",
+		Path:     "/interbank/netting/multisig/v1/execution_cost/{command_id}",
+		Summary:  "Gas and wei the relayer reported for executing a command",
+		Request:  multisigtypes.QueryExecutionCostRequest{},
+		Response: multisigtypes.QueryExecutionCostResponse{},
+	},
+	{
+		Module:   multisigtypes.ModuleName,
+		Method:   "ExecutionCosts",
+		Path:     "/interbank/netting/multisig/v1/execution_costs",
+		Summary:  "Execution costs per target chain over a period",
+		Request:  multisigtypes.QueryExecutionCostsRequest{},
+		Response: multisigtypes.QueryExecutionCostsResponse{},
+	},
+	{
+		Module:   multisigtypes.ModuleName,
+		Method:   "Probations",
+		Path:     "/interbank/netting/multisig/v1/probations",
+		Summary:  "Validators on probation with their completed and missed signing cycles",
+		Request:  multisigtypes.QueryProbationsRequest{},
+		Response: multisigtypes.QueryProbationsResponse{},
+	},
+	{
+		Module:   multisigtypes.ModuleName,
+		Method:   "NonceGaps",
+		Path:     "/interbank/netting/multisig/v1/nonce_gaps",
+		Summary:  "Target chains paused by skipped command nonces, with the missing nonces",
+		Request:  multisigtypes.QueryNonceGapsRequest{},
+		Response: multisigtypes.QueryNonceGapsResponse{},
+	},
+	{
+		Module:   nettingtypes.ModuleName,
+		Method:   "CreditBalance",
+		Path:     "/interbank/netting/netting/v1/credit_balance/{bank}/{denom}",
+		Summary:  "Balance, frozen and available credit a bank holds in a denom",
+		Request:  nettingtypes.QueryCreditBalanceRequest{},
+		Response: nettingtypes.QueryCreditBalanceResponse{},
+	},
+	{
+		Module:   nettingtypes.ModuleName,
+		Method:   "CreditVelocity",
+		Path:     "/interbank/netting/netting/v1/credit_velocity/{issuer_bank}/{holder_bank}",
+		Summary:  "Credit issued and netted between an issuer and a holder over the last 24 hours and 7 days",
+		Request:  nettingtypes.QueryCreditVelocityRequest{},
+		Response: nettingtypes.QueryCreditVelocityResponse{},
+	},
+	{
+		Module:   nettingtypes.ModuleName,
+		Method:   "CycleSettlement",
+		Path:     "/interbank/netting/netting/v1/cycle_settlement/{cycle_id}",
+		Summary:  "Settlement commands of a netting cycle and how much is still outstanding",
+		Request:  nettingtypes.QueryCycleSettlementRequest{},
+		Response: nettingtypes.QueryCycleSettlementResponse{},
+	},
+	{
+		Module:   nettingtypes.ModuleName,
+		Method:   "OpenSettlements",
+		Path:     "/interbank/netting/netting/v1/open_settlements",
+		Summary:  "Netting cycles with settlement commands not executed yet",
+		Request:  nettingtypes.QueryOpenSettlementsRequest{},
+		Response: nettingtypes.QueryOpenSettlementsResponse{},
+	},
+	{
+		Module:   nettingtypes.ModuleName,
+		Method:   "ParamsHistory",
+		Path:     "/interbank/netting/netting/v1/params_history",
+		Summary:  "Netting params changes with the height and proposal that made them",
+		Request:  nettingtypes.QueryParamsHistoryRequest{},
+		Response: nettingtypes.QueryParamsHistoryResponse{},
+	},
+	{
+		Module:   nettingtypes.ModuleName,
+		Method:   "CreditLineage",
+		Path:     "/interbank/netting/netting/v1/credit_lineage/{token_id}",
+		Summary:  "Provenance graph of credit from the Besu transfers it was issued for to the burns retiring it",
+		Request:  nettingtypes.QueryCreditLineageRequest{},
+		Response: nettingtypes.QueryCreditLineageResponse{},
+	},
+	{
+		Module:   nettingtypes.ModuleName,
+		Method:   "CreditLots",
+		Path:     "/interbank/netting/netting/v1/credit_lots/{bank}/{denom}",
+		Summary:  "Unspent credit lots making up a bank's balance of a denom, oldest first",
+		Request:  nettingtypes.QueryCreditLotsRequest{},
+		Response: nettingtypes.QueryCreditLotsResponse{},
+	},
+	{
+		Module:   nettingtypes.ModuleName,
+		Method:   "Banks",
+		Path:     "/interbank/netting/netting/v1/banks",
+		Summary:  "Banks known to the netting module with their registered accounts and outstanding credit counts",
+		Request:  nettingtypes.QueryBanksRequest{},
+		Response: nettingtypes.QueryBanksResponse{},
+	},
+	{
+		Module:   nettingtypes.ModuleName,
+		Method:   "DenomMigration",
+		Path:     "/interbank/netting/netting/v1/denom_migration",
+		Summary:  "Credit denom migration scheduled by governance and the report of the last one applied",
+		Request:  nettingtypes.QueryDenomMigrationRequest{},
+		Response: nettingtypes.QueryDenomMigrationResponse{},
+	},
+	{
+		Module:   nettingtypes.ModuleName,
+		Method:   "DenomMigrationDryRun",
+		Path:     "/interbank/netting/netting/v1/denom_migration/dry_run",
+		Summary:  "Credit tokens, balances, lots and cycle snapshots renaming credit denoms would change",
+		Request:  nettingtypes.QueryDenomMigrationDryRunRequest{},
+		Response: nettingtypes.QueryDenomMigrationDryRunResponse{},
+	},
+	{
+		Module:   nettingtypes.ModuleName,
+		Method:   "DailyReport",
+		Path:     "/interbank/netting/netting/v1/daily_report/{date}",
+		Summary:  "Transfers, credit issued and burned, cycles, settled amounts and settlement commands of a UTC calendar day",
+		Request:  nettingtypes.QueryDailyReportRequest{},
+		Response: nettingtypes.QueryDailyReportResponse{},
+	},
+	{
+		Module:   nettingtypes.ModuleName,
+		Method:   "MicroCycle",
+		Path:     "/interbank/netting/netting/v1/micro_cycle/{id}",
+		Summary:  "Offset of a continuous corridor recorded when credit was issued between its banks",
+		Request:  nettingtypes.QueryMicroCycleRequest{},
+		Response: nettingtypes.QueryMicroCycleResponse{},
+	},
+	{
+		Module:   nettingtypes.ModuleName,
+		Method:   "ReserveAttestation",
+		Path:     "/interbank/netting/netting/v1/reserve_attestation/{bank}",
+		Summary:  "Proof-of-reserves attestation of a bank's outstanding issued credit against its reported reserves",
+		Request:  nettingtypes.QueryReserveAttestationRequest{},
+		Response: nettingtypes.QueryReserveAttestationResponse{},
+	},
+	{
+		Module:   nettingtypes.ModuleName,
+		Method:   "BusinessDay",
+		Path:     "/interbank/netting/netting/v1/business_day",
+		Summary:  "Business day currently open and the time of its end-of-day cycle",
+		Request:  nettingtypes.QueryBusinessDayRequest{},
+		Response: nettingtypes.QueryBusinessDayResponse{},
+	},
+	{
+		Module:   nettingtypes.ModuleName,
+		Method:   "ClosingReport",
+		Path:     "/interbank/netting/netting/v1/closing_report/{business_date}",
+		Summary:  "End-of-day cycles and activity of a closed business day",
+		Request:  nettingtypes.QueryClosingReportRequest{},
+		Response: nettingtypes.QueryClosingReportResponse{},
+	},
+}
@@ -0,0 +1,169 @@
+// Package proof queries module state over ABCI together with its Merkle proof,
+// so a bank client talking to an untrusted RPC provider can check each result
+// against the app hash of a block header it trusts (e.g. from a light client).
+package proof
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/rootmulti"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/interbank-netting/cosmos/types"
+	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
+)
+
+// ABCIClient is the part of a CometBFT RPC client the proof client needs
+type ABCIClient interface {
+	ABCIQueryWithOptions(ctx context.Context, path string, data cmtbytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error)
+}
+
+// Result is a raw store entry with the proof tying it to the app hash. Value
+// is empty when the proof shows the key is absent.
+type Result struct {
+	StoreKey string
+	Key      []byte
+	Value    []byte
+	Height   int64 // State height; its app hash is in the header of Height+1
+	ProofOps *cmtcrypto.ProofOps
+}
+
+// Verify checks the proof of the result against an app hash
+func (r Result) Verify(appHash []byte) error {
+	if r.ProofOps == nil || len(r.ProofOps.Ops) == 0 {
+		return fmt.Errorf("result at height %d has no proof", r.Height)
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(r.StoreKey), merkle.KeyEncodingURL).
+		AppendKey(r.Key, merkle.KeyEncodingURL).
+		String()
+
+	runtime := rootmulti.DefaultProofRuntime()
+	if len(r.Value) == 0 {
+		return runtime.VerifyAbsence(r.ProofOps, appHash, keyPath)
+	}
+	return runtime.VerifyValue(r.ProofOps, appHash, keyPath, r.Value)
+}
+
+// VerifyHeader checks the proof of the result against a trusted header. The
+// app hash of a header commits to the state after the previous block, so the
+// header must be at Height+1.
+func (r Result) VerifyHeader(header cmttypes.Header) error {
+	if header.Height != r.Height+1 {
+		return fmt.Errorf("result at height %d needs the header at height %d, got %d", r.Height, r.Height+1, header.Height)
+	}
+	return r.Verify(header.AppHash)
+}
+
+// Client runs proven queries against a node
+type Client struct {
+	rpc ABCIClient
+	cdc codec.BinaryCodec
+}
+
+// NewClient returns a proof client over an RPC client, decoding values with cdc
+func NewClient(rpc ABCIClient, cdc codec.BinaryCodec) Client {
+	return Client{rpc: rpc, cdc: cdc}
+}
+
+// Query returns the raw value of key in a module store at height (0 for the
+// latest height) with its proof. The proof is returned unverified.
+func (c Client) Query(ctx context.Context, storeKey string, key []byte, height int64) (Result, error) {
+	res, err := c.rpc.ABCIQueryWithOptions(ctx, fmt.Sprintf("/store/%s/key", storeKey), key,
+		rpcclient.ABCIQueryOptions{Height: height, Prove: true})
+	if err != nil {
+		return Result{}, err
+	}
+
+	resp := res.Response
+	if resp.Code != 0 {
+		return Result{}, fmt.Errorf("query %s failed with code %d: %s", storeKey, resp.Code, resp.Log)
+	}
+	if !bytes.Equal(resp.Key, key) {
+		return Result{}, fmt.Errorf("response is for key %X, requested %X", resp.Key, key)
+	}
+
+	return Result{
+		StoreKey: storeKey,
+		Key:      key,
+		Value:    resp.Value,
+		Height:   resp.Height,
+		ProofOps: resp.ProofOps,
+	}, nil
+}
+
+// CreditBalance returns a bank's balance of a credit denom with its proof
+func (c Client) CreditBalance(ctx context.Context, bank, denom string, height int64) (math.Int, Result, error) {
+	result, err := c.Query(ctx, nettingtypes.StoreKey, nettingtypes.GetCreditBalanceKey(bank, denom), height)
+	if err != nil || len(result.Value) == 0 {
+		return math.ZeroInt(), result, err
+	}
+
+	var balance math.Int
+	if err := balance.Unmarshal(result.Value); err != nil {
+		return math.ZeroInt(), result, err
+	}
+	return balance, result, nil
+}
+
+// NettingCycle returns a netting cycle with its proof, or false if the proof
+// shows no such cycle
+func (c Client) NettingCycle(ctx context.Context, cycleID uint64, height int64) (types.NettingCycle, bool, Result, error) {
+	result, err := c.Query(ctx, nettingtypes.StoreKey, nettingtypes.GetNettingCycleKey(cycleID), height)
+	if err != nil || len(result.Value) == 0 {
+		return types.NettingCycle{}, false, result, err
+	}
+
+	var cycle types.NettingCycle
+	if err := c.cdc.Unmarshal(result.Value, &cycle); err != nil {
+		return types.NettingCycle{}, false, result, err
+	}
+	return cycle, true, result, nil
+}
+
+// ReserveAttestation returns a proof-of-reserves attestation of a bank with
+// its proof, or false if the proof shows no such attestation
+func (c Client) ReserveAttestation(ctx context.Context, bank string, id uint64, height int64) (nettingtypes.ReserveAttestation, bool, Result, error) {
+	result, err := c.Query(ctx, nettingtypes.StoreKey, nettingtypes.GetReserveAttestationKey(bank, id), height)
+	if err != nil || len(result.Value) == 0 {
+		return nettingtypes.ReserveAttestation{}, false, result, err
+	}
+
+	var attestation nettingtypes.ReserveAttestation
+	if err := c.cdc.Unmarshal(result.Value, &attestation); err != nil {
+		return nettingtypes.ReserveAttestation{}, false, result, err
+	}
+	return attestation, true, result, nil
+}
+
+// Command returns a mint command with its proof, or false if the proof shows
+// no such command
+func (c Client) Command(ctx context.Context, commandID string, height int64) (types.MintCommand, bool, Result, error) {
+	key, err := collections.EncodeKeyWithPrefix(multisigtypes.MintCommandKeyPrefix, collections.StringKey, commandID)
+	if err != nil {
+		return types.MintCommand{}, false, Result{}, err
+	}
+
+	result, err := c.Query(ctx, multisigtypes.StoreKey, key, height)
+	if err != nil || len(result.Value) == 0 {
+		return types.MintCommand{}, false, result, err
+	}
+
+	var command types.MintCommand
+	if err := c.cdc.Unmarshal(result.Value, &command); err != nil {
+		return types.MintCommand{}, false, result, err
+	}
+	return command, true, result, nil
+}
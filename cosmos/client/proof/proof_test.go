@@ -0,0 +1,126 @@
+package proof_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/interbank-netting/cosmos/client/proof"
+	"github.com/interbank-netting/cosmos/types"
+	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
+)
+
+// storeNode answers ABCI store queries from a committed multistore, as a
+// node's baseapp does for /store/ paths
+type storeNode struct {
+	store *rootmulti.Store
+	forge []byte // Returned instead of the stored value, as by a lying RPC provider
+}
+
+func (n storeNode) ABCIQueryWithOptions(ctx context.Context, path string, data cmtbytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	res, err := n.store.Query(&storetypes.RequestQuery{
+		Path:   strings.TrimPrefix(path, "/store"),
+		Data:   data,
+		Height: opts.Height,
+		Prove:  opts.Prove,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	value := res.Value
+	if n.forge != nil {
+		value = n.forge
+	}
+	return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{
+		Key:      res.Key,
+		Value:    value,
+		ProofOps: res.ProofOps,
+		Height:   res.Height,
+	}}, nil
+}
+
+func setupStore(t *testing.T, cdc codec.Codec) (*rootmulti.Store, []byte) {
+	store := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	nettingKey := storetypes.NewKVStoreKey(nettingtypes.StoreKey)
+	multisigKey := storetypes.NewKVStoreKey(multisigtypes.StoreKey)
+	store.MountStoreWithDB(nettingKey, storetypes.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(multisigKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+
+	balance, err := math.NewInt(1500).Marshal()
+	require.NoError(t, err)
+	netting := store.GetCommitKVStore(nettingKey)
+	netting.Set(nettingtypes.GetCreditBalanceKey("bank-b", "cred-bank-a"), balance)
+	netting.Set(nettingtypes.GetNettingCycleKey(7), cdc.MustMarshal(&types.NettingCycle{
+		CycleID:     7,
+		BlockHeight: 1,
+		Status:      int32(types.NettingStatusCompleted),
+	}))
+
+	command := types.MintCommand{CommandID: "cmd-1", TargetChain: "bank-b", Recipient: "recipient", Amount: math.NewInt(1500)}
+	commandKey, err := collections.EncodeKeyWithPrefix(multisigtypes.MintCommandKeyPrefix, collections.StringKey, command.CommandID)
+	require.NoError(t, err)
+	store.GetCommitKVStore(multisigKey).Set(commandKey, cdc.MustMarshal(&command))
+
+	return store, store.Commit().Hash
+}
+
+// **Unit Test: 증명 포함 조회 결과 검증**
+func TestClient_ProvenQueriesVerifyAgainstAppHash(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	store, appHash := setupStore(t, cdc)
+	client := proof.NewClient(storeNode{store: store}, cdc)
+	ctx := context.Background()
+
+	balance, result, err := client.CreditBalance(ctx, "bank-b", "cred-bank-a", 0)
+	require.NoError(t, err)
+	require.True(t, balance.Equal(math.NewInt(1500)))
+	require.NoError(t, result.Verify(appHash))
+	require.NoError(t, result.VerifyHeader(cmttypes.Header{Height: result.Height + 1, AppHash: appHash}))
+	require.Error(t, result.VerifyHeader(cmttypes.Header{Height: result.Height, AppHash: appHash}))
+
+	cycle, found, result, err := client.NettingCycle(ctx, 7, 0)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(7), cycle.CycleID)
+	require.NoError(t, result.Verify(appHash))
+
+	command, found, result, err := client.Command(ctx, "cmd-1", 0)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "bank-b", command.TargetChain)
+	require.NoError(t, result.Verify(appHash))
+
+	// Absence is proven too
+	_, found, result, err = client.Command(ctx, "cmd-missing", 0)
+	require.NoError(t, err)
+	require.False(t, found)
+	require.NoError(t, result.Verify(appHash))
+
+	// A proof does not verify against another app hash or for another value
+	require.Error(t, result.Verify(make([]byte, len(appHash))))
+	forged, err := math.NewInt(1_000_000).Marshal()
+	require.NoError(t, err)
+	balance, result, err = proof.NewClient(storeNode{store: store, forge: forged}, cdc).CreditBalance(ctx, "bank-b", "cred-bank-a", 0)
+	require.NoError(t, err)
+	require.True(t, balance.Equal(math.NewInt(1_000_000)))
+	require.Error(t, result.Verify(appHash))
+}
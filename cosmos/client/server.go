@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"google.golang.org/grpc"
+
+	"github.com/interbank-netting/cosmos/client/bankauth"
+	"github.com/interbank-netting/cosmos/client/docs"
+	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
+	oracletypes "github.com/interbank-netting/cosmos/x/oracle/types"
+)
+
+// RegisterQueryServers registers the module query services with a gRPC
+// server created with grpc.ForceServerCodec(Codec()), for hosts serving the
+// queries Client calls
+func RegisterQueryServers(server grpc.ServiceRegistrar, oracle oracletypes.QueryServer, netting nettingtypes.QueryServer, multisig multisigtypes.QueryServer) {
+	server.RegisterService(queryServiceDesc(oracletypes.ModuleName, (*oracletypes.QueryServer)(nil)), oracle)
+	server.RegisterService(queryServiceDesc(nettingtypes.ModuleName, (*nettingtypes.QueryServer)(nil)), netting)
+	server.RegisterService(queryServiceDesc(multisigtypes.ModuleName, (*multisigtypes.QueryServer)(nil)), multisig)
+}
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// queryServiceDesc describes every method of a QueryServer interface. The
+// query servers are hand-written rather than generated, so the descriptor is
+// derived from the interface by reflection.
+func queryServiceDesc(module string, handlerType interface{}) *grpc.ServiceDesc {
+	iface := reflect.TypeOf(handlerType).Elem()
+	desc := &grpc.ServiceDesc{
+		ServiceName: docs.QueryServiceName(module),
+		HandlerType: handlerType,
+		Streams:     []grpc.StreamDesc{},
+	}
+
+	for i := 0; i < iface.NumMethod(); i++ {
+		method := iface.Method(i)
+		if method.Type.NumIn() != 2 || method.Type.In(0) != contextType || method.Type.NumOut() != 2 || method.Type.Out(1) != errorType {
+			panic(fmt.Sprintf("%s.%s is not a query method", iface.Name(), method.Name))
+		}
+		desc.Methods = append(desc.Methods, grpc.MethodDesc{
+			MethodName: method.Name,
+			Handler:    queryHandler(bankauth.QueryMethod(module, method.Name), method.Name, method.Type.In(1).Elem()),
+		})
+	}
+	return desc
+}
+
+func queryHandler(fullMethod, name string, reqType reflect.Type) func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+	return func(service interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		req := reflect.New(reqType).Interface()
+		if err := dec(req); err != nil {
+			return nil, err
+		}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			out := reflect.ValueOf(service).MethodByName(name).Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(req)})
+			if err, _ := out[1].Interface().(error); err != nil {
+				return nil, err
+			}
+			return out[0].Interface(), nil
+		}
+		if interceptor == nil {
+			return handler(ctx, req)
+		}
+		return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: service, FullMethod: fullMethod}, handler)
+	}
+}
@@ -0,0 +1,124 @@
+// Package signer connects the multisig keeper to an external signer service
+// over gRPC. Validator operators run the service next to the node with their
+// keys in an HSM or a cloud KMS, so the node never holds an ECDSA key.
+package signer
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding"
+
+	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
+)
+
+const (
+	signCommandHashMethod = "/" + multisigtypes.SignerServiceName + "/SignCommandHash"
+	getPublicKeyMethod    = "/" + multisigtypes.SignerServiceName + "/GetPublicKey"
+
+	// DefaultTimeout bounds each call to the signer service, so an
+	// unresponsive signer holds up the signing path for at most this long
+	DefaultTimeout = 5 * time.Second
+)
+
+// Codec returns the gRPC codec of the signer service messages. Servers must
+// use it too, with grpc.ForceServerCodec(Codec()).
+func Codec() encoding.Codec {
+	return codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).GRPCCodec()
+}
+
+// Client calls a signer service over a gRPC connection
+type Client struct {
+	conn    grpc.ClientConnInterface
+	codec   encoding.Codec
+	timeout time.Duration
+}
+
+var _ multisigtypes.SignerService = Client{}
+
+// NewClient returns a signer service client over a connection. A zero
+// timeout uses DefaultTimeout.
+func NewClient(conn grpc.ClientConnInterface, timeout time.Duration) Client {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return Client{conn: conn, codec: Codec(), timeout: timeout}
+}
+
+// Dial connects to a signer service. The connection is not encrypted, so the
+// service should listen on a unix socket (unix:///path) or on loopback.
+func Dial(address string) (*grpc.ClientConn, error) {
+	return grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+}
+
+// SignCommandHash implements multisigtypes.SignerService
+func (c Client) SignCommandHash(ctx context.Context, req *multisigtypes.SignCommandHashRequest) (*multisigtypes.SignCommandHashResponse, error) {
+	response := new(multisigtypes.SignCommandHashResponse)
+	if err := c.invoke(ctx, signCommandHashMethod, req, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetPublicKey implements multisigtypes.SignerService
+func (c Client) GetPublicKey(ctx context.Context, req *multisigtypes.GetPublicKeyRequest) (*multisigtypes.GetPublicKeyResponse, error) {
+	response := new(multisigtypes.GetPublicKeyResponse)
+	if err := c.invoke(ctx, getPublicKeyMethod, req, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c Client) invoke(ctx context.Context, method string, req, response interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	return c.conn.Invoke(ctx, method, req, response, grpc.ForceCodec(c.codec))
+}
+
+// RegisterSignerServiceServer registers a signer service implementation with
+// a gRPC server created with grpc.ForceServerCodec(Codec())
+func RegisterSignerServiceServer(server grpc.ServiceRegistrar, service multisigtypes.SignerService) {
+	server.RegisterService(&serviceDesc, service)
+}
+
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: multisigtypes.SignerServiceName,
+	HandlerType: (*multisigtypes.SignerService)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "SignCommandHash", Handler: signCommandHashHandler},
+		{MethodName: "GetPublicKey", Handler: getPublicKeyHandler},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+func signCommandHashHandler(service interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(multisigtypes.SignCommandHashRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return service.(multisigtypes.SignerService).SignCommandHash(ctx, req.(*multisigtypes.SignCommandHashRequest))
+	}
+	if interceptor == nil {
+		return handler(ctx, req)
+	}
+	return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: service, FullMethod: signCommandHashMethod}, handler)
+}
+
+func getPublicKeyHandler(service interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(multisigtypes.GetPublicKeyRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return service.(multisigtypes.SignerService).GetPublicKey(ctx, req.(*multisigtypes.GetPublicKeyRequest))
+	}
+	if interceptor == nil {
+		return handler(ctx, req)
+	}
+	return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: service, FullMethod: getPublicKeyMethod}, handler)
+}
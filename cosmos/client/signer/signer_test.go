@@ -0,0 +1,83 @@
+package signer_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/interbank-netting/cosmos/client/signer"
+	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
+)
+
+// kmsSigner is a signer service backed by in-memory keys, standing in for a KMS
+type kmsSigner struct {
+	keys map[string]*ecdsa.PrivateKey
+}
+
+func (s kmsSigner) SignCommandHash(ctx context.Context, req *multisigtypes.SignCommandHashRequest) (*multisigtypes.SignCommandHashResponse, error) {
+	key, found := s.keys[req.Validator]
+	if !found {
+		return nil, errors.New("no key for validator")
+	}
+	signature, err := crypto.Sign(req.Hash, key)
+	if err != nil {
+		return nil, err
+	}
+	return &multisigtypes.SignCommandHashResponse{Signature: signature}, nil
+}
+
+func (s kmsSigner) GetPublicKey(ctx context.Context, req *multisigtypes.GetPublicKeyRequest) (*multisigtypes.GetPublicKeyResponse, error) {
+	key, found := s.keys[req.Validator]
+	if !found {
+		return nil, errors.New("no key for validator")
+	}
+	return &multisigtypes.GetPublicKeyResponse{PubKey: crypto.CompressPubkey(&key.PublicKey)}, nil
+}
+
+func TestClient_CallsSignerServiceOverGRPC(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.ForceServerCodec(signer.Codec()))
+	signer.RegisterSignerServiceServer(server, kmsSigner{keys: map[string]*ecdsa.PrivateKey{"validator-1": key}})
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := signer.NewClient(conn, 0)
+	ctx := context.Background()
+
+	pubKey, err := client.GetPublicKey(ctx, &multisigtypes.GetPublicKeyRequest{Validator: "validator-1"})
+	require.NoError(t, err)
+	require.Equal(t, crypto.CompressPubkey(&key.PublicKey), pubKey.PubKey)
+
+	hash := sha256.Sum256([]byte("command"))
+	response, err := client.SignCommandHash(ctx, &multisigtypes.SignCommandHashRequest{
+		Validator: "validator-1",
+		CommandID: "cmd-1",
+		Hash:      hash[:],
+	})
+	require.NoError(t, err)
+	recovered, err := crypto.SigToPub(hash[:], response.Signature)
+	require.NoError(t, err)
+	require.Equal(t, key.PublicKey, *recovered)
+
+	// Errors of the service reach the node
+	_, err = client.SignCommandHash(ctx, &multisigtypes.SignCommandHashRequest{Validator: "validator-2", Hash: hash[:]})
+	require.ErrorContains(t, err, "no key for validator")
+}
@@ -0,0 +1,300 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/interbank-netting/cosmos/app"
+	"github.com/interbank-netting/cosmos/types"
+	"github.com/interbank-netting/cosmos/x/netting"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
+	"github.com/interbank-netting/cosmos/x/oracle"
+	oracletypes "github.com/interbank-netting/cosmos/x/oracle/types"
+)
+
+const (
+	flagBanks     = "banks"
+	flagTransfers = "transfers"
+	flagSeed      = "seed"
+
+	// maxDemoBanks bounds the bank-a ... bank-z naming scheme
+	maxDemoBanks = 26
+)
+
+// DemoScenario is a generated set of demo state
+type DemoScenario struct {
+	Banks        []string
+	Transfers    []types.TransferEvent
+	CreditTokens []types.CreditToken
+	Cycle        types.NettingCycle
+}
+
+// NewGenesisCmd returns the genesis tooling commands
+func NewGenesisCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "genesis",
+		Short: "Genesis file tools",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(NewGenesisDemoCmd())
+
+	return cmd
+}
+
+// NewGenesisDemoCmd returns a command that populates genesis with a demo scenario
+func NewGenesisDemoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "demo",
+		Short: "Populate genesis with demo banks, transfers, credit tokens and a pending netting cycle",
+		Long: `Generate random confirmed transfers between demo banks and write them to the
+oracle and netting genesis state together with the resulting credit tokens and
+a pending netting cycle. Banks are identified by their chain ID (bank-a, bank-b, ...).
+
+If the genesis file does not exist yet it is created from the default genesis.
+The same seed always produces the same scenario.`,
+		Example: "interbank-nettingd genesis demo --banks 5 --transfers 50",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bankCount, err := cmd.Flags().GetInt(flagBanks)
+			if err != nil {
+				return err
+			}
+			transferCount, err := cmd.Flags().GetInt(flagTransfers)
+			if err != nil {
+				return err
+			}
+			seed, err := cmd.Flags().GetInt64(flagSeed)
+			if err != nil {
+				return err
+			}
+			home, err := cmd.Flags().GetString(flags.FlagHome)
+			if err != nil {
+				return err
+			}
+			chainID, err := cmd.Flags().GetString(flags.FlagChainID)
+			if err != nil {
+				return err
+			}
+
+			cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+			genFile := filepath.Join(home, "config", "genesis.json")
+
+			appGenesis, err := loadOrCreateGenesis(cdc, genFile, chainID)
+			if err != nil {
+				return err
+			}
+
+			genTime := appGenesis.GenesisTime
+			if genTime.IsZero() {
+				genTime = time.Now().UTC()
+				appGenesis.GenesisTime = genTime
+			}
+
+			var appState map[string]json.RawMessage
+			if err := json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+				return fmt.Errorf("failed to unmarshal app state: %w", err)
+			}
+
+			nettingGenState := netting.DefaultGenesisState()
+			if bz, ok := appState[nettingtypes.ModuleName]; ok {
+				cdc.MustUnmarshalJSON(bz, nettingGenState)
+			}
+
+			scenario, err := GenerateDemoScenario(bankCount, transferCount, seed, genTime, nettingGenState.Params.SettlementUnit)
+			if err != nil {
+				return err
+			}
+
+			// Oracle: the transfers were confirmed before genesis
+			oracleGenState := oracle.DefaultGenesisState()
+			if bz, ok := appState[oracletypes.ModuleName]; ok {
+				cdc.MustUnmarshalJSON(bz, oracleGenState)
+			}
+			oracleGenState.ConfirmedTransfers = scenario.Transfers
+			if err := oracle.ValidateGenesis(oracleGenState); err != nil {
+				return err
+			}
+			appState[oracletypes.ModuleName] = cdc.MustMarshalJSON(oracleGenState)
+
+			// Netting: credit tokens of the transfers and a cycle waiting to run
+			nettingGenState.CreditTokens = scenario.CreditTokens
+			nettingGenState.NettingCycles = []types.NettingCycle{scenario.Cycle}
+			if err := netting.ValidateGenesis(nettingGenState); err != nil {
+				return err
+			}
+			appState[nettingtypes.ModuleName] = cdc.MustMarshalJSON(nettingGenState)
+
+			appGenesis.AppState, err = json.MarshalIndent(appState, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal app state: %w", err)
+			}
+
+			if err := genutil.ExportGenesisFile(appGenesis, genFile); err != nil {
+				return fmt.Errorf("failed to write genesis file: %w", err)
+			}
+
+			_, err = fmt.Fprintf(cmd.OutOrStdout(),
+				"wrote %d banks, %d transfers and %d netting pairs to %s\n",
+				len(scenario.Banks), len(scenario.Transfers), len(scenario.Cycle.Pairs), genFile)
+			return err
+		},
+	}
+
+	cmd.Flags().Int(flagBanks, 5, "Number of demo banks (2-26)")
+	cmd.Flags().Int(flagTransfers, 50, "Number of confirmed transfers to generate")
+	cmd.Flags().Int64(flagSeed, 1, "Random seed for the scenario")
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagChainID, app.Name, "Chain ID used when creating a new genesis file")
+
+	return cmd
+}
+
+// GenerateDemoScenario generates a deterministic demo scenario for the given
+// seed. The cycle's net amounts are the whole settlement units each bank nets.
+func GenerateDemoScenario(bankCount, transferCount int, seed int64, genTime time.Time, settlementUnit int64) (DemoScenario, error) {
+	if bankCount < 2 || bankCount > maxDemoBanks {
+		return DemoScenario{}, fmt.Errorf("--%s must be between 2 and %d: %d", flagBanks, maxDemoBanks, bankCount)
+	}
+	if transferCount < 0 {
+		return DemoScenario{}, fmt.Errorf("--%s cannot be negative: %d", flagTransfers, transferCount)
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+
+	banks := make([]string, bankCount)
+	for i := range banks {
+		banks[i] = fmt.Sprintf("bank-%c", 'a'+i)
+	}
+
+	scenario := DemoScenario{
+		Banks:        banks,
+		Transfers:    make([]types.TransferEvent, 0, transferCount),
+		CreditTokens: make([]types.CreditToken, 0, transferCount),
+	}
+
+	nonces := make(map[string]uint64)
+	obligations := make([]nettingtypes.Obligation, 0, transferCount)
+
+	for i := 0; i < transferCount; i++ {
+		sourceIdx := rng.Intn(bankCount)
+		destIdx := rng.Intn(bankCount - 1)
+		if destIdx >= sourceIdx {
+			// Skip the source bank so source != dest
+			destIdx++
+		}
+		source, dest := banks[sourceIdx], banks[destIdx]
+
+		nonces[source]++
+		timestamp := genTime.Add(-time.Duration(transferCount-i) * time.Minute).Unix()
+		amount := math.NewInt(rng.Int63n(99_000) + 1_000)
+
+		transfer := types.TransferEvent{
+			TxHash:      demoTxHash(seed, i),
+			Sender:      demoAddress(rng),
+			Recipient:   demoAddress(rng),
+			Amount:      amount,
+			Nonce:       nonces[source],
+			SourceChain: source,
+			DestChain:   dest,
+			BlockHeight: uint64(i + 1),
+			Timestamp:   timestamp,
+		}
+		scenario.Transfers = append(scenario.Transfers, transfer)
+
+		// The destination bank holds credit issued by the source bank
+		scenario.CreditTokens = append(scenario.CreditTokens, types.CreditToken{
+			Denom:      types.CreditDenom(source, types.BaseCurrency),
+			IssuerBank: source,
+			HolderBank: dest,
+			Amount:     amount,
+			OriginTx:   transfer.TxHash,
+			IssuedAt:   timestamp,
+		})
+
+		obligations = append(obligations, nettingtypes.Obligation{
+			Debtor:   source,
+			Creditor: dest,
+			Amount:   amount,
+		})
+	}
+
+	pairs := nettingtypes.CalculateBilateralPairs(obligations)
+	scenario.Cycle = types.NettingCycle{
+		CycleID:     1,
+		BlockHeight: 0,
+		Pairs:       pairs,
+		NetAmounts:  demoNetAmounts(pairs, settlementUnit),
+		StartTime:   genTime.Unix(),
+		Status:      int32(types.NettingStatusPending),
+	}
+
+	return scenario, nil
+}
+
+// demoNetAmounts returns the amount each bank nets across the pairs, as the
+// netting keeper records it: the whole settlement units of the smaller side
+// of every pair, added to both of its banks
+func demoNetAmounts(pairs []types.BankPair, settlementUnit int64) map[string]math.Int {
+	netAmounts := make(map[string]math.Int)
+	for _, pair := range pairs {
+		netted, _ := nettingtypes.SplitDust(math.MinInt(pair.AmountA, pair.AmountB), settlementUnit)
+		for _, bank := range []string{pair.BankA, pair.BankB} {
+			if _, ok := netAmounts[bank]; !ok {
+				netAmounts[bank] = math.ZeroInt()
+			}
+			netAmounts[bank] = netAmounts[bank].Add(netted)
+		}
+	}
+	return netAmounts
+}
+
+// loadOrCreateGenesis reads the genesis file, or builds one from the module defaults
+func loadOrCreateGenesis(cdc codec.JSONCodec, genFile, chainID string) (*genutiltypes.AppGenesis, error) {
+	if _, err := os.Stat(genFile); err == nil {
+		return genutiltypes.AppGenesisFromFile(genFile)
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(genFile), 0o755); err != nil {
+		return nil, err
+	}
+
+	appState, err := json.MarshalIndent(app.ModuleBasics.DefaultGenesis(cdc), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal default genesis: %w", err)
+	}
+
+	return genutiltypes.NewAppGenesisWithVersion(chainID, appState), nil
+}
+
+// demoTxHash returns a deterministic source chain transaction hash
+func demoTxHash(seed int64, index int) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("demo-%d-%d", seed, index)))
+	return "0x" + hex.EncodeToString(hash[:])
+}
+
+// demoAddress returns a random Besu account address
+func demoAddress(rng *rand.Rand) string {
+	bz := make([]byte, 20)
+	rng.Read(bz)
+	return "0x" + hex.EncodeToString(bz)
+}
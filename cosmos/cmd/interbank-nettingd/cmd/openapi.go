@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/interbank-netting/cosmos/app"
+	"github.com/interbank-netting/cosmos/client/docs"
+)
+
+const flagOutput = "output"
+
+// NewOpenAPICmd returns the command that writes the OpenAPI document of the
+// module queries, the same document the API server serves under /swagger/
+func NewOpenAPICmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "openapi",
+		Short: "Write the OpenAPI document of the module query services",
+		Long: `Write the OpenAPI document of the netting, oracle and multisig query services to
+stdout or to --output. Bank integration teams can generate typed REST clients
+from it; a running node serves the same document at /swagger/openapi.json when
+api.swagger is enabled.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spec, err := docs.Spec(app.Name, version.Version, docs.Routes)
+			if err != nil {
+				return err
+			}
+
+			output, _ := cmd.Flags().GetString(flagOutput)
+			if output == "" {
+				_, err = cmd.OutOrStdout().Write(append(spec, '\n'))
+				return err
+			}
+			return os.WriteFile(output, append(spec, '\n'), 0o644)
+		},
+	}
+
+	cmd.Flags().String(flagOutput, "", "File to write the document to instead of stdout")
+
+	return cmd
+}
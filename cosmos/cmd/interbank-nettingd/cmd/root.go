@@ -6,12 +6,15 @@ import (
 	"github.com/spf13/cobra"
 
 	"cosmossdk.io/log"
+	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/interbank-netting/cosmos/app"
+	multisigcli "github.com/interbank-netting/cosmos/x/multisig/client/cli"
+	nettingcli "github.com/interbank-netting/cosmos/x/netting/client/cli"
 )
 
 // NewRootCmd creates a new root command for interbank-nettingd. It is called once in the
@@ -33,6 +36,24 @@ It provides credit tokenization and periodic netting for interbank transfers.`,
 
 	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, nil, addModuleInitFlags)
 
+	// Only the node loads its config, so that log_level accepts per-module
+	// levels, e.g. --log_level "x/oracle:debug,x/netting:debug,*:info".
+	// Offline commands don't write a config to their home directory.
+	if startCmd, _, err := rootCmd.Find([]string{"start"}); err == nil {
+		startCmd.PersistentPreRunE = func(cmd *cobra.Command, _ []string) error {
+			return server.InterceptConfigsPreRunHandler(cmd, "", nil, cmtcfg.DefaultConfig())
+		}
+	}
+
+	// Offline tooling
+	rootCmd.AddCommand(
+		NewGenesisCmd(),
+		NewOpenAPICmd(),
+		NewAuditCmd(),
+		nettingcli.GetNettingCmd(),
+		multisigcli.GetMultisigCmd(),
+	)
+
 	return rootCmd, nil
 }
 
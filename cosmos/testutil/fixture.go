@@ -0,0 +1,86 @@
+package testutil
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"reflect"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// StoreFixture is a serialized module store as a node of an earlier
+// consensus version left it, together with the query responses the same
+// state must produce once migrated. Upgrade compatibility tests load it into
+// an empty store, run the migrations and compare the responses, so a later
+// format change that cannot read live state fails them.
+type StoreFixture struct {
+	Module           string                     `json:"module"`
+	ConsensusVersion uint64                     `json:"consensus_version"` // Version that wrote the entries
+	Entries          []StoreFixtureEntry        `json:"entries"`           // In key order
+	Queries          map[string]json.RawMessage `json:"queries"`           // Expected responses, by query name
+}
+
+// StoreFixtureEntry is one raw store entry of a fixture
+type StoreFixtureEntry struct {
+	Note  string `json:"note"`  // What the entry holds and in which format
+	Key   string `json:"key"`   // Hex
+	Value string `json:"value"` // Hex
+}
+
+// LoadStoreFixture reads a store fixture from a JSON file
+func LoadStoreFixture(t testing.TB, path string) StoreFixture {
+	t.Helper()
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read store fixture: %v", err)
+	}
+	var fixture StoreFixture
+	if err := json.Unmarshal(bz, &fixture); err != nil {
+		t.Fatalf("decode store fixture %s: %v", path, err)
+	}
+	return fixture
+}
+
+// Write sets every entry of the fixture in a store
+func (f StoreFixture) Write(t testing.TB, store storetypes.KVStore) {
+	t.Helper()
+
+	for _, entry := range f.Entries {
+		key, err := hex.DecodeString(entry.Key)
+		if err != nil {
+			t.Fatalf("store fixture entry %q: key: %v", entry.Note, err)
+		}
+		value, err := hex.DecodeString(entry.Value)
+		if err != nil {
+			t.Fatalf("store fixture entry %q: value: %v", entry.Note, err)
+		}
+		store.Set(key, value)
+	}
+}
+
+// MatchesQuery returns true if a query response encodes to the JSON the
+// fixture expects for the query, ignoring formatting and field order
+func (f StoreFixture) MatchesQuery(t testing.TB, name string, response any) bool {
+	t.Helper()
+
+	expected, found := f.Queries[name]
+	if !found {
+		t.Fatalf("store fixture has no %s response", name)
+	}
+	bz, err := json.Marshal(response)
+	if err != nil {
+		t.Fatalf("encode %s response: %v", name, err)
+	}
+
+	var want, got any
+	if err := json.Unmarshal(expected, &want); err != nil {
+		t.Fatalf("decode expected %s response: %v", name, err)
+	}
+	if err := json.Unmarshal(bz, &got); err != nil {
+		t.Fatalf("decode %s response: %v", name, err)
+	}
+	return reflect.DeepEqual(want, got)
+}
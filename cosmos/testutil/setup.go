@@ -59,12 +59,34 @@ func GenBankID() gopter.Gen {
 	return gen.OneConstOf("bank-a", "bank-b", "bank-c", "bank-d")
 }
 
+// GenBoundedString generates arbitrary strings of at most max bytes
+func GenBoundedString(max int) gopter.Gen {
+	return gen.AnyString().Map(func(s string) string {
+		runes := []rune(s)
+		for len(string(runes)) > max {
+			runes = runes[:len(runes)-1]
+		}
+		return string(runes)
+	})
+}
+
+// GenEventString generates non-empty event strings, at most half of
+// types.MaxFieldLength so tests can suffix them
+func GenEventString() gopter.Gen {
+	return gen.AlphaString().SuchThat(func(s string) bool { return len(s) > 0 }).Map(func(s string) string {
+		if len(s) > types.MaxFieldLength/2 {
+			return s[:types.MaxFieldLength/2]
+		}
+		return s
+	})
+}
+
 // GenTransferEvent generates valid transfer events
 func GenTransferEvent() gopter.Gen {
 	return gopter.CombineGens(
-		gen.AlphaString().SuchThat(func(s string) bool { return len(s) > 0 }),
-		gen.AlphaString().SuchThat(func(s string) bool { return len(s) > 0 }),
-		gen.AlphaString().SuchThat(func(s string) bool { return len(s) > 0 }),
+		GenEventString(),
+		GenEventString(),
+		GenEventString(),
 		GenValidAmount(),
 		gen.UInt64(),
 		GenBankID(),
@@ -103,7 +125,7 @@ func GenCreditToken() gopter.Gen {
 	}).Map(func(values []interface{}) types.CreditToken {
 		issuerBank := values[0].(string)
 		return types.CreditToken{
-			Denom:      "cred-" + issuerBank,
+			Denom:      types.CreditDenom(issuerBank, types.BaseCurrency),
 			IssuerBank: issuerBank,
 			HolderBank: values[1].(string),
 			Amount:     values[2].(math.Int),
@@ -124,30 +146,7 @@ func GenBankPair() gopter.Gen {
 		// Ensure banks are different
 		return values[0].(string) != values[1].(string)
 	}).Map(func(values []interface{}) types.BankPair {
-		bankA := values[0].(string)
-		bankB := values[1].(string)
-		amountA := values[2].(math.Int)
-		amountB := values[3].(math.Int)
-		
-		var netAmount math.Int
-		var netDebtor string
-		
-		if amountA.GT(amountB) {
-			netAmount = amountA.Sub(amountB)
-			netDebtor = bankB
-		} else {
-			netAmount = amountB.Sub(amountA)
-			netDebtor = bankA
-		}
-		
-		return types.BankPair{
-			BankA:     bankA,
-			BankB:     bankB,
-			AmountA:   amountA,
-			AmountB:   amountB,
-			NetAmount: netAmount,
-			NetDebtor: netDebtor,
-		}
+		return types.NewBankPair(values[0].(string), values[1].(string), values[2].(math.Int), values[3].(math.Int), types.PriorityNormal)
 	})
 }
 
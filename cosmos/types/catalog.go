@@ -0,0 +1,198 @@
+package types
+
+import (
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Locale is a language of the message catalog
+type Locale string
+
+// Catalog locales
+const (
+	LocaleEnglish Locale = "en"
+	LocaleKorean  Locale = "ko"
+
+	// DefaultLocale is the locale of requests without one, and the fallback
+	// of messages missing in a locale
+	DefaultLocale = LocaleEnglish
+)
+
+// Locales lists the catalog locales
+var Locales = []Locale{LocaleEnglish, LocaleKorean}
+
+// IsValid returns true if l is one of the catalog locales
+func (l Locale) IsValid() bool {
+	for _, locale := range Locales {
+		if l == locale {
+			return true
+		}
+	}
+	return false
+}
+
+// Kinds of catalog entries
+const (
+	CatalogKindError  = "error"
+	CatalogKindReason = "reason"
+)
+
+// CatalogEntry is the human-readable message of an error or a reason code in
+// one locale. Operations teams render it from the machine codes carried by
+// transaction results (codespace and code) and events (reason_code).
+type CatalogEntry struct {
+	Kind       string     `json:"kind"`
+	Codespace  string     `json:"codespace,omitempty"`   // Errors only
+	Code       uint32     `json:"code,omitempty"`        // Errors only
+	ReasonCode ReasonCode `json:"reason_code,omitempty"` // Reasons only
+	Message    string     `json:"message"`
+	Fallback   bool       `json:"fallback,omitempty"` // The locale has no message and Message is the English one
+}
+
+// catalogKey identifies the error or reason code of a catalog entry
+type catalogKey struct {
+	codespace string
+	code      uint32
+	reason    ReasonCode
+}
+
+// catalog holds the messages of each error and reason code per locale.
+// Modules register their messages at init, next to their errors.
+var catalog = make(map[catalogKey]map[Locale]string)
+
+func init() {
+	RegisterErrorMessages(LocaleKorean, map[*errorsmod.Error]string{
+		sdkerrors.ErrInvalidRequest:    "잘못된 요청",
+		sdkerrors.ErrInvalidAddress:    "잘못된 주소",
+		sdkerrors.ErrUnauthorized:      "권한 없음",
+		sdkerrors.ErrInsufficientFunds: "잔액 부족",
+		sdkerrors.ErrInsufficientFee:   "수수료 부족",
+		sdkerrors.ErrWrongSequence:     "계정 시퀀스 불일치",
+		sdkerrors.ErrMempoolIsFull:     "멤풀이 가득 참",
+		sdkerrors.ErrOutOfGas:          "가스 부족",
+	})
+
+	RegisterReasonMessages(LocaleEnglish, map[ReasonCode]string{
+		ReasonConsensusTimeout:    "The transfer did not reach the vote threshold before the consensus timeout",
+		ReasonGovernance:          "Decided by governance",
+		ReasonStaleHeartbeat:      "The chain's relayers stopped posting heartbeats",
+		ReasonInsufficientBalance: "The operation needs more credit than the bank holds",
+		ReasonInvalidState:        "The operation conflicts with the stored state",
+		ReasonInternal:            "Internal failure",
+	})
+	RegisterReasonMessages(LocaleKorean, map[ReasonCode]string{
+		ReasonConsensusTimeout:    "합의 제한 시간 내에 투표 임계값에 도달하지 못한 이체",
+		ReasonGovernance:          "거버넌스 결정",
+		ReasonStaleHeartbeat:      "체인의 릴레이어가 하트비트 전송을 중단함",
+		ReasonInsufficientBalance: "은행이 보유한 크레딧보다 많은 크레딧이 필요한 작업",
+		ReasonInvalidState:        "저장된 상태와 충돌하는 작업",
+		ReasonInternal:            "내부 오류",
+	})
+}
+
+// RegisterErrorMessages adds the messages of registered errors in a locale to
+// the catalog. The English message of an error defaults to its description.
+func RegisterErrorMessages(locale Locale, messages map[*errorsmod.Error]string) {
+	for err, message := range messages {
+		key := catalogKey{codespace: err.Codespace(), code: err.ABCICode()}
+		if catalog[key] == nil {
+			catalog[key] = map[Locale]string{LocaleEnglish: err.Error()}
+		}
+		catalog[key][locale] = message
+	}
+}
+
+// RegisterReasonMessages adds the messages of reason codes in a locale to the
+// catalog
+func RegisterReasonMessages(locale Locale, messages map[ReasonCode]string) {
+	for code, message := range messages {
+		key := catalogKey{reason: code}
+		if catalog[key] == nil {
+			catalog[key] = make(map[Locale]string)
+		}
+		catalog[key][locale] = message
+	}
+}
+
+// localize returns the message of a catalog key in a locale, falling back to
+// English
+func localize(key catalogKey, locale Locale) (string, bool, bool) {
+	messages, found := catalog[key]
+	if !found {
+		return "", false, false
+	}
+	if message, found := messages[locale]; found {
+		return message, false, true
+	}
+	message, found := messages[DefaultLocale]
+	return message, true, found
+}
+
+// MessageCatalog returns the messages of every error and reason code in a
+// locale: errors ordered by codespace and code, then reasons by code. Reason
+// codes without a message have the code as their message.
+func MessageCatalog(locale Locale) []CatalogEntry {
+	var entries []CatalogEntry
+	for key := range catalog {
+		if key.reason != "" {
+			continue
+		}
+		message, fallback, _ := localize(key, locale)
+		entries = append(entries, CatalogEntry{
+			Kind:      CatalogKindError,
+			Codespace: key.codespace,
+			Code:      key.code,
+			Message:   message,
+			Fallback:  fallback,
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Codespace != entries[j].Codespace {
+			return entries[i].Codespace < entries[j].Codespace
+		}
+		return entries[i].Code < entries[j].Code
+	})
+
+	reasons := make([]ReasonCode, 0, len(reasonCodes))
+	for code := range reasonCodes {
+		reasons = append(reasons, code)
+	}
+	sort.Slice(reasons, func(i, j int) bool { return reasons[i] < reasons[j] })
+	for _, code := range reasons {
+		message, fallback, found := localize(catalogKey{reason: code}, locale)
+		if !found {
+			message, fallback = code.String(), true
+		}
+		entries = append(entries, CatalogEntry{
+			Kind:       CatalogKindReason,
+			ReasonCode: code,
+			Message:    message,
+			Fallback:   fallback,
+		})
+	}
+	return entries
+}
+
+// LocalizeError returns the catalog message of err, or of the registered
+// error it wraps, in a locale. Errors without a message keep their text.
+func LocalizeError(err error, locale Locale) string {
+	if err == nil {
+		return ""
+	}
+	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+	if message, _, found := localize(catalogKey{codespace: codespace, code: code}, locale); found {
+		return message
+	}
+	return err.Error()
+}
+
+// LocalizeReason returns the catalog message of a reason code in a locale,
+// or the code itself if it has no message
+func LocalizeReason(code ReasonCode, locale Locale) string {
+	if message, _, found := localize(catalogKey{reason: code}, locale); found {
+		return message
+	}
+	return code.String()
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+)
+
+// Module state is stored in collections whose values are encoded with the
+// module codec. A value that fails to encode or decode means the store is
+// corrupt, so the helpers below panic as codec.MustUnmarshal does and keep
+// keeper getters free of storage errors.
+
+// MustCollection panics on a storage error
+func MustCollection(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+// CollectionValue returns the value of key in m, or false if it is absent
+func CollectionValue[K, V any](ctx context.Context, m collections.Map[K, V], key K) (V, bool) {
+	value, err := m.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return value, false
+	}
+	MustCollection(err)
+	return value, true
+}
+
+// CollectionHas returns true if key is set in m
+func CollectionHas[K, V any](ctx context.Context, m collections.Map[K, V], key K) bool {
+	found, err := m.Has(ctx, key)
+	MustCollection(err)
+	return found
+}
+
+// CollectionValues returns the values of m in key order, limited to ranger
+// (nil for all)
+func CollectionValues[K, V any](ctx context.Context, m collections.Map[K, V], ranger collections.Ranger[K]) []V {
+	values := make([]V, 0)
+	MustCollection(m.Walk(ctx, ranger, func(_ K, value V) (bool, error) {
+		values = append(values, value)
+		return false, nil
+	}))
+	return values
+}
@@ -0,0 +1,40 @@
+package types
+
+import "strings"
+
+// Credit denoms are cred-{issuer} for a bank's base currency and
+// cred-{issuer}:{currency}[:{instance}] for other currencies, optionally
+// suffixed with an issuance instance. Bank IDs never contain ':'.
+const (
+	CreditDenomPrefix = "cred-"
+
+	// BaseCurrency is the currency of cred-{issuer} denoms
+	BaseCurrency = "base"
+
+	creditDenomSeparator = ":"
+)
+
+// CreditDenom returns the denom of credit issued by a bank in a currency
+func CreditDenom(issuer, currency string) string {
+	if currency == "" || currency == BaseCurrency {
+		return CreditDenomPrefix + issuer
+	}
+	return CreditDenomPrefix + issuer + creditDenomSeparator + currency
+}
+
+// ParseCreditDenom returns the issuer and currency of a credit denom. Instance
+// suffixes are ignored, so every instance of a currency aggregates together.
+func ParseCreditDenom(denom string) (issuer, currency string, ok bool) {
+	if !strings.HasPrefix(denom, CreditDenomPrefix) {
+		return "", "", false
+	}
+
+	parts := strings.Split(strings.TrimPrefix(denom, CreditDenomPrefix), creditDenomSeparator)
+	if parts[0] == "" {
+		return "", "", false
+	}
+	if len(parts) == 1 || parts[1] == "" {
+		return parts[0], BaseCurrency, true
+	}
+	return parts[0], parts[1], true
+}
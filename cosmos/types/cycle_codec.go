@@ -0,0 +1,124 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// gogoproto's reflection marshaler reads map values of pointer-shaped custom
+// types such as math.Int from the wrong address, so NettingCycle.NetAmounts
+// was stored as zeros. NettingCycle is therefore encoded through
+// nettingCycleWire, which spells the map out as its entry messages. The bytes
+// are identical to a generated map<string, string> field, sorted by bank so
+// the encoding is deterministic.
+
+// netAmountEntry is the wire form of one NettingCycle.NetAmounts entry
+type netAmountEntry struct {
+	Key   string   `protobuf:"bytes,1,opt,name=key,proto3"`
+	Value math.Int `protobuf:"bytes,2,opt,name=value,proto3,customtype=cosmossdk.io/math.Int"`
+}
+
+func (e *netAmountEntry) ProtoMessage()  {}
+func (e *netAmountEntry) Reset()         { *e = netAmountEntry{} }
+func (e *netAmountEntry) String() string { return fmt.Sprintf("%s=%s", e.Key, e.Value) }
+
+// nettingCycleWire is the wire form of NettingCycle
+type nettingCycleWire struct {
+	CycleID        uint64           `protobuf:"varint,1,opt,name=cycle_id,json=cycleId,proto3"`
+	BlockHeight    int64            `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3"`
+	Pairs          []BankPair       `protobuf:"bytes,3,rep,name=pairs,proto3"`
+	NetAmounts     []netAmountEntry `protobuf:"bytes,4,rep,name=net_amounts,json=netAmounts,proto3"`
+	StartTime      int64            `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3"`
+	EndTime        int64            `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3"`
+	Status         int32            `protobuf:"varint,7,opt,name=status,proto3"`
+	TriggeredBy    string           `protobuf:"bytes,8,opt,name=triggered_by,json=triggeredBy,proto3"`
+	Deferred       int32            `protobuf:"varint,9,opt,name=deferred,proto3"`
+	SettlementUnit int64            `protobuf:"varint,10,opt,name=settlement_unit,json=settlementUnit,proto3"`
+	DustPolicy     int32            `protobuf:"varint,11,opt,name=dust_policy,json=dustPolicy,proto3"`
+	Dust           math.Int         `protobuf:"bytes,12,opt,name=dust,proto3,customtype=cosmossdk.io/math.Int"`
+	CancelReason   string           `protobuf:"bytes,13,opt,name=cancel_reason,json=cancelReason,proto3"`
+	Loops          []ObligationLoop `protobuf:"bytes,14,rep,name=loops,proto3"`
+	ReasonCode     ReasonCode       `protobuf:"bytes,15,opt,name=reason_code,json=reasonCode,proto3"`
+	NettingSet     string           `protobuf:"bytes,16,opt,name=netting_set,json=nettingSet,proto3"`
+	EndOfDay       bool             `protobuf:"varint,17,opt,name=end_of_day,json=endOfDay,proto3"`
+}
+
+func (w *nettingCycleWire) ProtoMessage() {}
+func (w *nettingCycleWire) Reset()        { *w = nettingCycleWire{} }
+func (w *nettingCycleWire) String() string {
+	return fmt.Sprintf("nettingCycleWire{CycleID: %d}", w.CycleID)
+}
+
+// Marshal implements proto.Marshaler
+func (nc *NettingCycle) Marshal() ([]byte, error) {
+	banks := make([]string, 0, len(nc.NetAmounts))
+	for bank := range nc.NetAmounts {
+		banks = append(banks, bank)
+	}
+	sort.Strings(banks)
+
+	entries := make([]netAmountEntry, len(banks))
+	for i, bank := range banks {
+		entries[i] = netAmountEntry{Key: bank, Value: nc.NetAmounts[bank]}
+	}
+
+	return proto.Marshal(&nettingCycleWire{
+		CycleID:        nc.CycleID,
+		BlockHeight:    nc.BlockHeight,
+		Pairs:          nc.Pairs,
+		NetAmounts:     entries,
+		StartTime:      nc.StartTime,
+		EndTime:        nc.EndTime,
+		Status:         nc.Status,
+		TriggeredBy:    nc.TriggeredBy,
+		Deferred:       nc.Deferred,
+		SettlementUnit: nc.SettlementUnit,
+		DustPolicy:     nc.DustPolicy,
+		Dust:           nc.Dust,
+		CancelReason:   nc.CancelReason,
+		Loops:          nc.Loops,
+		ReasonCode:     nc.ReasonCode,
+		NettingSet:     nc.NettingSet,
+		EndOfDay:       nc.EndOfDay,
+	})
+}
+
+// Unmarshal implements proto.Unmarshaler
+func (nc *NettingCycle) Unmarshal(bz []byte) error {
+	var w nettingCycleWire
+	if err := proto.Unmarshal(bz, &w); err != nil {
+		return err
+	}
+
+	*nc = NettingCycle{
+		CycleID:        w.CycleID,
+		BlockHeight:    w.BlockHeight,
+		Pairs:          w.Pairs,
+		NetAmounts:     make(map[string]math.Int, len(w.NetAmounts)),
+		StartTime:      w.StartTime,
+		EndTime:        w.EndTime,
+		Status:         w.Status,
+		TriggeredBy:    w.TriggeredBy,
+		Deferred:       w.Deferred,
+		SettlementUnit: w.SettlementUnit,
+		DustPolicy:     w.DustPolicy,
+		Dust:           w.Dust,
+		CancelReason:   w.CancelReason,
+		Loops:          w.Loops,
+		ReasonCode:     w.ReasonCode,
+		NettingSet:     w.NettingSet,
+		EndOfDay:       w.EndOfDay,
+	}
+	// Cycles stored before dust tracking have no residual
+	if nc.Dust.IsNil() {
+		nc.Dust = math.ZeroInt()
+	}
+	for _, entry := range w.NetAmounts {
+		nc.NetAmounts[entry.Key] = entry.Value
+	}
+
+	return nil
+}
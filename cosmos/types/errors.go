@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Every module registers its errors under its own codespace (the module name)
+// with codes that never change once released, so clients and the relayer can
+// branch on (codespace, code) instead of parsing messages. Modules classify
+// their errors at init; unclassified errors are treated as internal failures.
+var (
+	retryableErrors = make(map[string]bool)
+	userErrors      = make(map[string]bool)
+)
+
+func init() {
+	RegisterUserErrors(
+		sdkerrors.ErrInvalidRequest,
+		sdkerrors.ErrInvalidAddress,
+		sdkerrors.ErrUnauthorized,
+		sdkerrors.ErrInsufficientFunds,
+		sdkerrors.ErrInsufficientFee,
+	)
+	RegisterRetryableErrors(
+		sdkerrors.ErrWrongSequence,
+		sdkerrors.ErrMempoolIsFull,
+		sdkerrors.ErrOutOfGas,
+	)
+}
+
+func errorClassKey(codespace string, code uint32) string {
+	return fmt.Sprintf("%s/%d", codespace, code)
+}
+
+// RegisterRetryableErrors marks errors caused by transient state, such as a
+// transfer that has not reached consensus yet. The same message may succeed
+// when resubmitted later.
+func RegisterRetryableErrors(errs ...*errorsmod.Error) {
+	for _, err := range errs {
+		retryableErrors[errorClassKey(err.Codespace(), err.ABCICode())] = true
+	}
+}
+
+// RegisterUserErrors marks errors caused by the message itself, such as an
+// invalid field or a missing permission. Resubmitting it unchanged fails again.
+func RegisterUserErrors(errs ...*errorsmod.Error) {
+	for _, err := range errs {
+		userErrors[errorClassKey(err.Codespace(), err.ABCICode())] = true
+	}
+}
+
+// IsRetryable returns true if err, or the registered error it wraps, is
+// classified retryable
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+	return retryableErrors[errorClassKey(codespace, code)]
+}
+
+// IsUserError returns true if err, or the registered error it wraps, is
+// classified as caused by the message
+func IsUserError(err error) bool {
+	if err == nil {
+		return false
+	}
+	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+	return userErrors[errorClassKey(codespace, code)]
+}
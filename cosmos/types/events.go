@@ -0,0 +1,30 @@
+package types
+
+// Event attribute keys emitted by more than one module. Modules define their
+// own keys only for attributes no other module emits.
+const (
+	AttributeKeyTxHash     = "tx_hash"
+	AttributeKeyAmount     = "amount"
+	AttributeKeyReason     = "reason"      // Free-text detail of the reason code
+	AttributeKeyReasonCode = "reason_code" // A ReasonCode
+	AttributeKeyDenom      = "denom"
+	AttributeKeyHolderBank = "holder_bank"
+	AttributeKeyRecipient  = "recipient"
+	AttributeKeyValidator  = "validator"
+	AttributeKeyThreshold  = "threshold"
+	AttributeKeyReporter   = "reporter"
+	AttributeKeyVersion    = "version"
+	AttributeKeyProposalID = "proposal_id"
+	AttributeKeyNonce      = "nonce"
+)
+
+// Trace attribute keys. Confirmed transfers and signed commands carry them so
+// the relayer can build gateway calls from events alone.
+const (
+	AttributeKeyPayloadHash         = "payload_hash"          // Hex hash of the signed payload encoding
+	AttributeKeyHashAlgorithm       = "hash_algorithm"        // Algorithm of the payload hash
+	AttributeKeyValidatorSetVersion = "validator_set_version" // Multisig validator set signing the commands
+	AttributeKeySignatures          = "signatures"            // JSON list of {validator, r, s, v}
+	AttributeKeyCreatedAt           = "created_at"
+	AttributeKeyTokenID             = "token_id" // Wrapped asset to mint, empty for the default token
+)
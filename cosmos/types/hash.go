@@ -0,0 +1,120 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// HashAlgorithm is the hash function of a hashing context, such as the mint
+// commands of a target chain or the payload hashes of transfers to a chain.
+// Records store the algorithm they were hashed with, so changing the
+// algorithm of a context only affects new records. The empty algorithm is
+// SHA-256, the algorithm of records from before it was configurable.
+type HashAlgorithm string
+
+// Hash algorithms
+const (
+	// HashAlgorithmSHA256 is SHA-256, the default
+	HashAlgorithmSHA256 HashAlgorithm = "sha256"
+	// HashAlgorithmKeccak256 is the keccak256 of the EVM, which Besu tooling
+	// and contracts compute natively
+	HashAlgorithmKeccak256 HashAlgorithm = "keccak256"
+)
+
+// HashSize is the size in bytes of the hashes of every algorithm
+const HashSize = 32
+
+// OrDefault returns the algorithm, or SHA-256 for the empty algorithm
+func (a HashAlgorithm) OrDefault() HashAlgorithm {
+	if a == "" {
+		return HashAlgorithmSHA256
+	}
+	return a
+}
+
+// IsValid returns true if a is a known algorithm or empty
+func (a HashAlgorithm) IsValid() bool {
+	switch a.OrDefault() {
+	case HashAlgorithmSHA256, HashAlgorithmKeccak256:
+		return true
+	}
+	return false
+}
+
+// Sum returns the hash of the concatenation of data. It returns nil for an
+// unknown algorithm, which matches no hash.
+func (a HashAlgorithm) Sum(data ...[]byte) []byte {
+	switch a.OrDefault() {
+	case HashAlgorithmSHA256:
+		hasher := sha256.New()
+		for _, bz := range data {
+			hasher.Write(bz)
+		}
+		return hasher.Sum(nil)
+	case HashAlgorithmKeccak256:
+		return crypto.Keccak256(data...)
+	}
+	return nil
+}
+
+// String returns the algorithm as stored and emitted in events
+func (a HashAlgorithm) String() string {
+	return string(a.OrDefault())
+}
+
+// ChainHashAlgorithm selects the hash algorithm of a chain in a hashing
+// context. Chains without an entry use SHA-256.
+type ChainHashAlgorithm struct {
+	Chain     string        `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain"`
+	Algorithm HashAlgorithm `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm"`
+}
+
+// ProtoMessage implements proto.Message
+func (c *ChainHashAlgorithm) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (c *ChainHashAlgorithm) Reset() { *c = ChainHashAlgorithm{} }
+
+// String implements proto.Message
+func (c *ChainHashAlgorithm) String() string {
+	return fmt.Sprintf("ChainHashAlgorithm{Chain: %s, Algorithm: %s}", c.Chain, c.Algorithm)
+}
+
+// Validate checks the chain and the algorithm
+func (c ChainHashAlgorithm) Validate() error {
+	if c.Chain == "" {
+		return fmt.Errorf("chain cannot be empty")
+	}
+	if c.Algorithm == "" || !c.Algorithm.IsValid() {
+		return fmt.Errorf("chain %s: unknown hash algorithm %q", c.Chain, c.Algorithm)
+	}
+	return nil
+}
+
+// ValidateChainHashAlgorithms checks every entry and that no chain has two
+func ValidateChainHashAlgorithms(algorithms []ChainHashAlgorithm) error {
+	chains := make(map[string]bool, len(algorithms))
+	for i, algorithm := range algorithms {
+		if err := algorithm.Validate(); err != nil {
+			return fmt.Errorf("hash algorithm %d: %w", i, err)
+		}
+		if chains[algorithm.Chain] {
+			return fmt.Errorf("hash algorithm %d: duplicate chain %s", i, algorithm.Chain)
+		}
+		chains[algorithm.Chain] = true
+	}
+	return nil
+}
+
+// ChainHashAlgorithmOf returns the algorithm of chain, or SHA-256 for a chain
+// without an entry
+func ChainHashAlgorithmOf(algorithms []ChainHashAlgorithm, chain string) HashAlgorithm {
+	for _, algorithm := range algorithms {
+		if algorithm.Chain == chain {
+			return algorithm.Algorithm
+		}
+	}
+	return HashAlgorithmSHA256
+}
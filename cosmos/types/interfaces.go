@@ -22,19 +22,36 @@ type OracleKeeper interface {
 	VerifySignature(ctx sdk.Context, validator string, data []byte, signature []byte) bool
 }
 
-// NettingKeeper defines the expected interface for the netting module
+// NettingKeeper defines the expected interface for the netting module.
+// Consumers that need only part of it depend on CreditKeeper, BalanceReader or
+// NettingRunner instead, so their mocks and permissions stay minimal.
 type NettingKeeper interface {
-	// Credit token management
+	CreditKeeper
+	BalanceReader
+	NettingRunner
+}
+
+// CreditKeeper defines the credit token mutations of the netting module
+type CreditKeeper interface {
 	IssueCreditToken(ctx sdk.Context, token CreditToken) error
 	BurnCreditToken(ctx sdk.Context, denom string, amount math.Int) error
+	BurnHeldCreditToken(ctx sdk.Context, holder, denom string, amount math.Int) error
 	TransferCreditToken(ctx sdk.Context, from, to, denom string, amount math.Int) error
+	FreezeCredit(ctx sdk.Context, bank, denom string, amount math.Int) (math.Int, error)
+	UnfreezeCredit(ctx sdk.Context, bank, denom string, amount math.Int) error
+}
 
-	// Balance queries
+// BalanceReader defines the read-only credit balance queries of the netting
+// module, for modules that only read balances, e.g. fee calculation
+type BalanceReader interface {
 	GetCreditBalance(ctx sdk.Context, bank, denom string) math.Int
+	GetAvailableCreditBalance(ctx sdk.Context, bank, denom string) math.Int
 	GetAllCreditBalances(ctx sdk.Context, bank string) map[string]math.Int
-	GetDebtPosition(ctx sdk.Context, bankA, bankB string) (math.Int, math.Int)
+	GetDebtPosition(ctx sdk.Context, bankA, bankB string) DebtPosition
+}
 
-	// Netting operations
+// NettingRunner defines the netting cycle operations of the netting module
+type NettingRunner interface {
 	TriggerNetting(ctx sdk.Context) error
 	CalculateNetting(ctx sdk.Context) ([]BankPair, error)
 	ExecuteNetting(ctx sdk.Context, pairs []BankPair) error
@@ -51,6 +68,7 @@ type MultisigKeeper interface {
 
 	// Command generation and signing
 	GenerateMintCommand(ctx sdk.Context, targetChain, recipient string, amount math.Int) (MintCommand, error)
+	GenerateTokenMintCommand(ctx sdk.Context, targetChain, recipient, tokenID string, amount math.Int) (MintCommand, error)
 	CollectSignatures(ctx sdk.Context, commandID string) error
 	VerifyCommand(ctx sdk.Context, command MintCommand) bool
 	GetCommand(ctx sdk.Context, commandID string) (MintCommand, bool)
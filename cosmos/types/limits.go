@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Size limits of message and state fields, so a single oversized message
+// can't dominate block space or state
+const (
+	MaxFieldLength             = 128 // Tx hashes, addresses, chain and bank IDs
+	MaxReasonLength            = 512 // Free-form reasons and resolutions
+	MaxAuditLogDetails         = 32  // Details entries of one audit log
+	MaxAuditLogDetailKeyLength = 64
+	MaxAuditLogDetailLength    = 1024 // Value of one details entry
+)
+
+// ValidateFieldLength checks that a field is at most max bytes long
+func ValidateFieldLength(name, value string, max int) error {
+	if len(value) > max {
+		return fmt.Errorf("%s longer than %d bytes: %d", name, max, len(value))
+	}
+	return nil
+}
+
+// ValidateEventSize checks the string fields of a transfer event against
+// MaxFieldLength
+func ValidateEventSize(event TransferEvent) error {
+	fields := []struct{ name, value string }{
+		{"tx hash", event.TxHash},
+		{"sender", event.Sender},
+		{"recipient", event.Recipient},
+		{"source chain", event.SourceChain},
+		{"dest chain", event.DestChain},
+	}
+	for _, field := range fields {
+		if err := ValidateFieldLength(field.name, field.value, MaxFieldLength); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateAuditLogSize checks the event type, tx hash and details of an audit
+// log against the audit log limits
+func ValidateAuditLogSize(log AuditLog) error {
+	if err := ValidateFieldLength("event type", log.EventType, MaxFieldLength); err != nil {
+		return err
+	}
+	if err := ValidateFieldLength("tx hash", log.TxHash, MaxFieldLength); err != nil {
+		return err
+	}
+	if len(log.Details) > MaxAuditLogDetails {
+		return fmt.Errorf("more than %d details: %d", MaxAuditLogDetails, len(log.Details))
+	}
+	// In key order, so every node reports the same oversized entry
+	keys := make([]string, 0, len(log.Details))
+	for key := range log.Details {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := ValidateFieldLength("detail key", key, MaxAuditLogDetailKeyLength); err != nil {
+			return err
+		}
+		if err := ValidateFieldLength("detail "+key, log.Details[key], MaxAuditLogDetailLength); err != nil {
+			return err
+		}
+	}
+	return nil
+}
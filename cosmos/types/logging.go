@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LogKeyCorrelationID is the log key carrying the correlation ID of a log line
+const LogKeyCorrelationID = "correlation_id"
+
+// correlationIDKey is the context key holding the correlation ID
+type correlationIDKey struct{}
+
+// WithCorrelationID returns a context whose module loggers tag every line with id.
+// Transfers are correlated by their source tx hash and netting by CycleCorrelationID,
+// so one transfer can be followed across oracle, netting and multisig logs.
+func WithCorrelationID(ctx sdk.Context, id string) sdk.Context {
+	return ctx.WithValue(correlationIDKey{}, id)
+}
+
+// GetCorrelationID returns the correlation ID of a context, if any
+func GetCorrelationID(ctx sdk.Context) string {
+	id, _ := ctx.Value(correlationIDKey{}).(string)
+	return id
+}
+
+// CycleCorrelationID returns the correlation ID of a netting cycle
+func CycleCorrelationID(cycleID uint64) string {
+	return fmt.Sprintf("cycle-%d", cycleID)
+}
+
+// ModuleLogger returns the logger of a module, tagged with the correlation ID of ctx.
+// The "module" key is x/<name>, so levels can be set per module through the
+// node log_level, e.g. "x/oracle:debug,*:info".
+func ModuleLogger(ctx sdk.Context, moduleName string) log.Logger {
+	logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", moduleName))
+	if id := GetCorrelationID(ctx); id != "" {
+		logger = logger.With(LogKeyCorrelationID, id)
+	}
+	return logger
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgPhase orders the messages of a block. Every message of a phase is
+// executed before any message of a later phase, so a transfer confirmed by a
+// vote is issued as credit before a netting trigger in the same block reads
+// the balances.
+type MsgPhase int32
+
+const (
+	// MsgPhaseConfirmation holds votes, approvals and every other message that
+	// may confirm transfers or move credit
+	MsgPhaseConfirmation MsgPhase = iota
+	// MsgPhaseNetting holds the netting triggers
+	MsgPhaseNetting
+)
+
+// String returns the name of the phase
+func (p MsgPhase) String() string {
+	switch p {
+	case MsgPhaseConfirmation:
+		return "confirmation"
+	case MsgPhaseNetting:
+		return "netting"
+	default:
+		return fmt.Sprintf("phase-%d", int32(p))
+	}
+}
+
+// PhasedMsg is implemented by messages executed after the confirmation phase
+type PhasedMsg interface {
+	MsgPhase() MsgPhase
+}
+
+// MsgPhaseOf returns the phase of a message, MsgPhaseConfirmation unless it
+// implements PhasedMsg
+func MsgPhaseOf(msg sdk.Msg) MsgPhase {
+	if phased, ok := msg.(PhasedMsg); ok {
+		return phased.MsgPhase()
+	}
+	return MsgPhaseConfirmation
+}
+
+// TxPhases returns the phases of the first and last messages of a
+// transaction, and false if its messages go back to an earlier phase. A
+// transaction without messages is in the confirmation phase.
+func TxPhases(msgs []sdk.Msg) (first, last MsgPhase, ordered bool) {
+	if len(msgs) == 0 {
+		return MsgPhaseConfirmation, MsgPhaseConfirmation, true
+	}
+
+	first = MsgPhaseOf(msgs[0])
+	last = first
+	for _, msg := range msgs[1:] {
+		phase := MsgPhaseOf(msg)
+		if phase < last {
+			return first, last, false
+		}
+		last = phase
+	}
+	return first, last, true
+}
+
+// OrderByPhase returns the indexes of the transactions of a proposal in
+// execution order: sorted by phase, keeping the original order within a
+// phase. Transactions whose messages go back to an earlier phase are left
+// out, as is a transaction that starts in a phase before the last phase of
+// the transaction ahead of it; the latter stays in the mempool for a later
+// block.
+func OrderByPhase(txMsgs [][]sdk.Msg) []int {
+	type phased struct {
+		index       int
+		first, last MsgPhase
+	}
+
+	var txs []phased
+	for i, msgs := range txMsgs {
+		first, last, ordered := TxPhases(msgs)
+		if ordered {
+			txs = append(txs, phased{index: i, first: first, last: last})
+		}
+	}
+
+	// Stable insertion sort by (first, last): proposals are small and the
+	// original order within a phase must be kept
+	for i := 1; i < len(txs); i++ {
+		for j := i; j > 0 && (txs[j].first < txs[j-1].first || txs[j].first == txs[j-1].first && txs[j].last < txs[j-1].last); j-- {
+			txs[j], txs[j-1] = txs[j-1], txs[j]
+		}
+	}
+
+	order := make([]int, 0, len(txs))
+	current := MsgPhaseConfirmation
+	for _, tx := range txs {
+		if tx.first < current {
+			continue
+		}
+		order = append(order, tx.index)
+		current = tx.last
+	}
+	return order
+}
+
+// ValidatePhaseOrder checks that the messages of the transactions of a block,
+// in order, never go back to an earlier phase
+func ValidatePhaseOrder(txMsgs [][]sdk.Msg) error {
+	current := MsgPhaseConfirmation
+	for i, msgs := range txMsgs {
+		for j, msg := range msgs {
+			phase := MsgPhaseOf(msg)
+			if phase < current {
+				return fmt.Errorf("tx %d message %d (%s) in the %s phase runs after the %s phase", i, j, sdk.MsgTypeURL(msg), phase, current)
+			}
+			current = phase
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// queryCacheHeightKey is the key of the height the cache was filled at
+	queryCacheHeightKey = []byte{0x00}
+
+	// queryCacheEntryPrefix is the prefix of the cached entries
+	queryCacheEntryPrefix = []byte{0x01}
+)
+
+// QueryCache keeps the answers of hot query endpoints in a module's memory
+// store, so bank clients polling every block read them without walking the
+// IAVL tree. A module fills it at the end of its EndBlock and serves it only to
+// queries of the height it was filled at, so every block invalidates the
+// entries of the one before it.
+//
+// Memory stores are not part of the app hash, so the cache is node-local and
+// enabling it does not change consensus. The zero QueryCache is disabled and
+// all of its methods are no-ops.
+type QueryCache struct {
+	storeKey storetypes.StoreKey
+}
+
+// NewQueryCache returns a cache in the memory store of memKey, which it owns.
+// A nil memKey returns a disabled cache.
+func NewQueryCache(memKey storetypes.StoreKey) QueryCache {
+	return QueryCache{storeKey: memKey}
+}
+
+// Enabled returns true if the cache has a memory store
+func (c QueryCache) Enabled() bool {
+	return c.storeKey != nil
+}
+
+// Reset drops all entries and marks the cache as filled at the height of ctx
+func (c QueryCache) Reset(ctx sdk.Context) {
+	if !c.Enabled() {
+		return
+	}
+
+	store := ctx.KVStore(c.storeKey)
+	entries := prefix.NewStore(store, queryCacheEntryPrefix)
+	iterator := entries.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		entries.Delete(key)
+	}
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(ctx.BlockHeight()))
+	store.Set(queryCacheHeightKey, bz)
+}
+
+// Fresh returns true if the cache was filled at the height of ctx
+func (c QueryCache) Fresh(ctx sdk.Context) bool {
+	if !c.Enabled() {
+		return false
+	}
+
+	bz := ctx.KVStore(c.storeKey).Get(queryCacheHeightKey)
+	return len(bz) == 8 && binary.BigEndian.Uint64(bz) == uint64(ctx.BlockHeight())
+}
+
+// Set stores value under key
+func (c QueryCache) Set(ctx sdk.Context, key, value []byte) {
+	if !c.Enabled() {
+		return
+	}
+	prefix.NewStore(ctx.KVStore(c.storeKey), queryCacheEntryPrefix).Set(key, value)
+}
+
+// Get returns the value under key if the cache is fresh at the height of ctx
+func (c QueryCache) Get(ctx sdk.Context, key []byte) ([]byte, bool) {
+	if !c.Fresh(ctx) {
+		return nil, false
+	}
+
+	bz := prefix.NewStore(ctx.KVStore(c.storeKey), queryCacheEntryPrefix).Get(key)
+	return bz, bz != nil
+}
+
+// Iterate calls cb with every entry under keyPrefix in key order. Callers check
+// Fresh first; a stale cache is iterated as well.
+func (c QueryCache) Iterate(ctx sdk.Context, keyPrefix []byte, cb func(key, value []byte)) {
+	if !c.Enabled() {
+		return
+	}
+
+	entries := prefix.NewStore(ctx.KVStore(c.storeKey), queryCacheEntryPrefix)
+	iterator := storetypes.KVStorePrefixIterator(entries, keyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		cb(iterator.Key(), iterator.Value())
+	}
+}
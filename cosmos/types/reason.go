@@ -0,0 +1,49 @@
+package types
+
+// ReasonCode is the machine-readable reason of a rejection or failure. Events
+// carry it in the reason_code attribute next to the free-text reason, and
+// records store it next to their reason, so downstream systems branch on the
+// code instead of parsing the text. Records stored before reason codes have
+// an empty code.
+type ReasonCode string
+
+// Reason codes
+const (
+	// ReasonConsensusTimeout is a transfer that did not reach the vote
+	// threshold before the consensus timeout
+	ReasonConsensusTimeout ReasonCode = "CONSENSUS_TIMEOUT"
+	// ReasonGovernance is a decision of the module authority; the free-text
+	// reason is the one it gave
+	ReasonGovernance ReasonCode = "GOVERNANCE"
+	// ReasonStaleHeartbeat is a chain whose relayers stopped posting
+	// heartbeats
+	ReasonStaleHeartbeat ReasonCode = "STALE_HEARTBEAT"
+	// ReasonInsufficientBalance is an operation that needed more credit than
+	// a bank holds
+	ReasonInsufficientBalance ReasonCode = "INSUFFICIENT_BALANCE"
+	// ReasonInvalidState is an operation that conflicts with the stored
+	// state, e.g. a cycle in progress or a denom that already exists
+	ReasonInvalidState ReasonCode = "INVALID_STATE"
+	// ReasonInternal is any other failure; the free-text reason has the error
+	ReasonInternal ReasonCode = "INTERNAL"
+)
+
+// reasonCodes lists the valid reason codes
+var reasonCodes = map[ReasonCode]bool{
+	ReasonConsensusTimeout:    true,
+	ReasonGovernance:          true,
+	ReasonStaleHeartbeat:      true,
+	ReasonInsufficientBalance: true,
+	ReasonInvalidState:        true,
+	ReasonInternal:            true,
+}
+
+// IsValid returns true if c is one of the reason codes
+func (c ReasonCode) IsValid() bool {
+	return reasonCodes[c]
+}
+
+// String returns the code as emitted in events
+func (c ReasonCode) String() string {
+	return string(c)
+}
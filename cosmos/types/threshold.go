@@ -0,0 +1,18 @@
+package types
+
+// ConsensusThreshold returns the number of validators needed for a 2/3+
+// majority of validatorCount validators, at least 1. Oracle vote consensus and
+// multisig command signing share it, so both modules agree on the threshold of
+// any validator set.
+func ConsensusThreshold(validatorCount int) int32 {
+	threshold := (validatorCount * 2) / 3
+	if (validatorCount*2)%3 != 0 {
+		threshold++ // Round up for 2/3+ majority
+	}
+
+	if threshold < 1 {
+		threshold = 1
+	}
+
+	return int32(threshold)
+}
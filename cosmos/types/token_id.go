@@ -0,0 +1,24 @@
+package types
+
+import "fmt"
+
+// Token IDs name the wrapped asset a gateway mints for a command, one per
+// underlying currency, e.g. KRW-stable or USD-stable. An empty token ID is the
+// destination chain's default token.
+const MaxTokenIDLength = 32
+
+// ValidateTokenID checks that a token ID is empty or at most
+// MaxTokenIDLength letters, digits, '-', '_' and '.'
+func ValidateTokenID(tokenID string) error {
+	if len(tokenID) > MaxTokenIDLength {
+		return fmt.Errorf("token ID longer than %d characters: %s", MaxTokenIDLength, tokenID)
+	}
+	for _, c := range tokenID {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_', c == '.':
+		default:
+			return fmt.Errorf("invalid character %q in token ID %s", c, tokenID)
+		}
+	}
+	return nil
+}
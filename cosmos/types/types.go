@@ -17,6 +17,8 @@ type TransferEvent struct {
 	DestChain   string   `protobuf:"bytes,7,opt,name=dest_chain,json=destChain,proto3" json:"dest_chain"`
 	BlockHeight uint64   `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height"`
 	Timestamp   int64    `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp"`
+	Priority    int32    `protobuf:"varint,10,opt,name=priority,proto3" json:"priority"`                       // Settlement priority class, see PriorityNormal
+	AssetID     string   `protobuf:"bytes,11,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"` // Wrapped asset to mint on DestChain, empty for its default token
 }
 
 func (t *TransferEvent) ProtoMessage()  {}
@@ -25,11 +27,12 @@ func (t *TransferEvent) String() string { return fmt.Sprintf("TransferEvent{TxHa
 
 // Vote represents a validator's vote on a transfer event
 type Vote struct {
-	TxHash    string        `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash"`
-	Validator string        `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator"`
-	EventData TransferEvent `protobuf:"bytes,3,opt,name=event_data,json=eventData,proto3" json:"event_data"`
-	Signature []byte        `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature"`
-	VoteTime  int64         `protobuf:"varint,5,opt,name=vote_time,json=voteTime,proto3" json:"vote_time"`
+	TxHash           string        `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash"`
+	Validator        string        `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator"`
+	EventData        TransferEvent `protobuf:"bytes,3,opt,name=event_data,json=eventData,proto3" json:"event_data"`
+	Signature        []byte        `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature"`
+	VoteTime         int64         `protobuf:"varint,5,opt,name=vote_time,json=voteTime,proto3" json:"vote_time"`
+	SignatureVersion uint32        `protobuf:"varint,6,opt,name=signature_version,json=signatureVersion,proto3" json:"signature_version"` // Signing envelope version of Signature
 }
 
 func (v *Vote) ProtoMessage()  {}
@@ -38,13 +41,15 @@ func (v *Vote) String() string { return fmt.Sprintf("Vote{TxHash: %s, Validator:
 
 // VoteStatus tracks the voting status for a transfer event
 type VoteStatus struct {
-	TxHash      string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash"`
-	Votes       []Vote `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes"`
-	Confirmed   bool   `protobuf:"varint,3,opt,name=confirmed,proto3" json:"confirmed"`
-	Threshold   int32  `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold"`
-	VoteCount   int32  `protobuf:"varint,5,opt,name=vote_count,json=voteCount,proto3" json:"vote_count"`
-	CreatedAt   int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	ConfirmedAt int64  `protobuf:"varint,7,opt,name=confirmed_at,json=confirmedAt,proto3" json:"confirmed_at"`
+	TxHash            string   `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash"`
+	Votes             []Vote   `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes"`
+	Confirmed         bool     `protobuf:"varint,3,opt,name=confirmed,proto3" json:"confirmed"`
+	Threshold         int32    `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold"`
+	VoteCount         int32    `protobuf:"varint,5,opt,name=vote_count,json=voteCount,proto3" json:"vote_count"`
+	CreatedAt         int64    `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	ConfirmedAt       int64    `protobuf:"varint,7,opt,name=confirmed_at,json=confirmedAt,proto3" json:"confirmed_at"`
+	ConfirmedHeight   int64    `protobuf:"varint,8,opt,name=confirmed_height,json=confirmedHeight,proto3" json:"confirmed_height"`
+	RequiredAttestors []string `protobuf:"bytes,9,rep,name=required_attestors,json=requiredAttestors,proto3" json:"required_attestors"` // Validators that must vote besides the threshold
 }
 
 func (vs *VoteStatus) ProtoMessage()  {}
@@ -61,6 +66,7 @@ type CreditToken struct {
 	Amount     math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 	OriginTx   string   `protobuf:"bytes,5,opt,name=origin_tx,json=originTx,proto3" json:"origin_tx"`
 	IssuedAt   int64    `protobuf:"varint,6,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at"`
+	Priority   int32    `protobuf:"varint,7,opt,name=priority,proto3" json:"priority"` // Priority class of the originating transfer
 }
 
 func (ct *CreditToken) ProtoMessage()  {}
@@ -71,13 +77,23 @@ func (ct *CreditToken) String() string {
 
 // NettingCycle represents a netting operation cycle
 type NettingCycle struct {
-	CycleID     uint64              `protobuf:"varint,1,opt,name=cycle_id,json=cycleId,proto3" json:"cycle_id"`
-	BlockHeight int64               `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height"`
-	Pairs       []BankPair          `protobuf:"bytes,3,rep,name=pairs,proto3" json:"pairs"`
-	NetAmounts  map[string]math.Int `protobuf:"bytes,4,rep,name=net_amounts,json=netAmounts,proto3" json:"net_amounts" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3,customtype=cosmossdk.io/math.Int"`
-	StartTime   int64               `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time"`
-	EndTime     int64               `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time"`
-	Status      int32               `protobuf:"varint,7,opt,name=status,proto3" json:"status"`
+	CycleID        uint64              `protobuf:"varint,1,opt,name=cycle_id,json=cycleId,proto3" json:"cycle_id"`
+	BlockHeight    int64               `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height"`
+	Pairs          []BankPair          `protobuf:"bytes,3,rep,name=pairs,proto3" json:"pairs"`
+	NetAmounts     map[string]math.Int `protobuf:"bytes,4,rep,name=net_amounts,json=netAmounts,proto3" json:"net_amounts" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3,customtype=cosmossdk.io/math.Int"`
+	StartTime      int64               `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time"`
+	EndTime        int64               `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time"`
+	Status         int32               `protobuf:"varint,7,opt,name=status,proto3" json:"status"`
+	TriggeredBy    string              `protobuf:"bytes,8,opt,name=triggered_by,json=triggeredBy,proto3" json:"triggered_by"`     // MsgTriggerNetting sender, empty for EndBlock cycles
+	Deferred       int32               `protobuf:"varint,9,opt,name=deferred,proto3" json:"deferred"`                             // Pairs left for a later cycle by MaxNettingPairs
+	SettlementUnit int64               `protobuf:"varint,10,opt,name=settlement_unit,json=settlementUnit,proto3" json:"settlement_unit"`
+	DustPolicy     int32               `protobuf:"varint,11,opt,name=dust_policy,json=dustPolicy,proto3" json:"dust_policy"`      // Policy applied to the cycle's residuals
+	Dust           math.Int            `protobuf:"bytes,12,opt,name=dust,proto3,customtype=cosmossdk.io/math.Int" json:"dust"`    // Residual below SettlementUnit summed over the cycle's pairs
+	CancelReason   string              `protobuf:"bytes,13,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason"` // Detail of ReasonCode when a stuck cycle is cancelled
+	Loops          []ObligationLoop    `protobuf:"bytes,14,rep,name=loops,proto3" json:"loops,omitempty"`                          // Obligation loops compressed by multilateral netting
+	ReasonCode     ReasonCode          `protobuf:"bytes,15,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code"`       // Set when the cycle failed or was cancelled
+	NettingSet     string              `protobuf:"bytes,16,opt,name=netting_set,json=nettingSet,proto3" json:"netting_set"`       // Netting set whose currencies the cycle nets; empty for cycles before netting sets
+	EndOfDay       bool                `protobuf:"varint,17,opt,name=end_of_day,json=endOfDay,proto3" json:"end_of_day,omitempty"` // Cycle of a business-day close, netting pairs below MinNettingAmount too
 }
 
 func (nc *NettingCycle) ProtoMessage()  {}
@@ -86,6 +102,52 @@ func (nc *NettingCycle) String() string {
 	return fmt.Sprintf("NettingCycle{CycleID: %d, Status: %d}", nc.CycleID, nc.Status)
 }
 
+// NettingBacklog summarizes the netting work waiting for the next cycles
+type NettingBacklog struct {
+	LastNettingBlock int64 `protobuf:"varint,1,opt,name=last_netting_block,json=lastNettingBlock,proto3" json:"last_netting_block"`
+	BlocksUntilNext  int64 `protobuf:"varint,2,opt,name=blocks_until_next,json=blocksUntilNext,proto3" json:"blocks_until_next"`
+	PendingPairs     int32 `protobuf:"varint,3,opt,name=pending_pairs,json=pendingPairs,proto3" json:"pending_pairs"`
+	DueCycles        int32 `protobuf:"varint,4,opt,name=due_cycles,json=dueCycles,proto3" json:"due_cycles"` // Cycles needed to settle PendingPairs once netting is due
+}
+
+func (nb *NettingBacklog) ProtoMessage()  {}
+func (nb *NettingBacklog) Reset()         { *nb = NettingBacklog{} }
+func (nb *NettingBacklog) String() string {
+	return fmt.Sprintf("NettingBacklog{PendingPairs: %d, DueCycles: %d}", nb.PendingPairs, nb.DueCycles)
+}
+
+// NetPositionForecast is the projected multilateral net position of a bank.
+// A positive position means the bank is a net creditor, a negative one a net debtor.
+type NetPositionForecast struct {
+	Bank      string   `protobuf:"bytes,1,opt,name=bank,proto3" json:"bank"`
+	Confirmed math.Int `protobuf:"bytes,2,opt,name=confirmed,proto3,customtype=cosmossdk.io/math.Int" json:"confirmed"` // From confirmed credit not netted yet
+	Pending   math.Int `protobuf:"bytes,3,opt,name=pending,proto3,customtype=cosmossdk.io/math.Int" json:"pending"`     // From pending transfers
+	Projected math.Int `protobuf:"bytes,4,opt,name=projected,proto3,customtype=cosmossdk.io/math.Int" json:"projected"` // Confirmed plus pending
+}
+
+func (np *NetPositionForecast) ProtoMessage()  {}
+func (np *NetPositionForecast) Reset()         { *np = NetPositionForecast{} }
+func (np *NetPositionForecast) String() string {
+	return fmt.Sprintf("NetPositionForecast{Bank: %s, Projected: %s}", np.Bank, np.Projected)
+}
+
+// NettingForecast projects the next netting cycle from the confirmed credit
+// not netted yet and the transfers still pending confirmation
+type NettingForecast struct {
+	BlockHeight      int64                 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height"`
+	PendingTransfers int32                 `protobuf:"varint,2,opt,name=pending_transfers,json=pendingTransfers,proto3" json:"pending_transfers"`
+	PendingAmount    math.Int              `protobuf:"bytes,3,opt,name=pending_amount,json=pendingAmount,proto3,customtype=cosmossdk.io/math.Int" json:"pending_amount"`
+	Pairs            []BankPair            `protobuf:"bytes,4,rep,name=pairs,proto3" json:"pairs"`         // Projected pairs in settlement order
+	Deferred         int32                 `protobuf:"varint,5,opt,name=deferred,proto3" json:"deferred"`  // Projected pairs left for a later cycle by MaxNettingPairs
+	Positions        []NetPositionForecast `protobuf:"bytes,6,rep,name=positions,proto3" json:"positions"` // Sorted by bank
+}
+
+func (nf *NettingForecast) ProtoMessage()  {}
+func (nf *NettingForecast) Reset()         { *nf = NettingForecast{} }
+func (nf *NettingForecast) String() string {
+	return fmt.Sprintf("NettingForecast{PendingTransfers: %d, Pairs: %d}", nf.PendingTransfers, len(nf.Pairs))
+}
+
 // BankPair represents a pair of banks involved in netting
 type BankPair struct {
 	BankA     string   `protobuf:"bytes,1,opt,name=bank_a,json=bankA,proto3" json:"bank_a"`
@@ -94,12 +156,94 @@ type BankPair struct {
 	AmountB   math.Int `protobuf:"bytes,4,opt,name=amount_b,json=amountB,proto3,customtype=cosmossdk.io/math.Int" json:"amount_b"`
 	NetAmount math.Int `protobuf:"bytes,5,opt,name=net_amount,json=netAmount,proto3,customtype=cosmossdk.io/math.Int" json:"net_amount"`
 	NetDebtor string   `protobuf:"bytes,6,opt,name=net_debtor,json=netDebtor,proto3" json:"net_debtor"`
+	Priority  int32    `protobuf:"varint,7,opt,name=priority,proto3" json:"priority"` // Highest priority of the netted obligations
 }
 
 func (bp *BankPair) ProtoMessage()  {}
 func (bp *BankPair) Reset()         { *bp = BankPair{} }
 func (bp *BankPair) String() string { return fmt.Sprintf("BankPair{BankA: %s, BankB: %s}", bp.BankA, bp.BankB) }
 
+// NewBankPair nets what two banks owe each other. BankA is the bank with the
+// lexicographically smaller ID and AmountA is what it owes BankB, whichever
+// order the banks are given in. The bank owing more is the NetDebtor; when
+// both owe the same, NetAmount is zero and BankA is the NetDebtor.
+func NewBankPair(bank1, bank2 string, owedBy1, owedBy2 math.Int, priority int32) BankPair {
+	if bank2 < bank1 {
+		bank1, bank2 = bank2, bank1
+		owedBy1, owedBy2 = owedBy2, owedBy1
+	}
+
+	pair := BankPair{
+		BankA:     bank1,
+		BankB:     bank2,
+		AmountA:   owedBy1,
+		AmountB:   owedBy2,
+		NetAmount: owedBy1.Sub(owedBy2),
+		NetDebtor: bank1,
+		Priority:  priority,
+	}
+	if owedBy2.GT(owedBy1) {
+		pair.NetAmount = owedBy2.Sub(owedBy1)
+		pair.NetDebtor = bank2
+	}
+	return pair
+}
+
+// ObligationLoop is a loop of obligations among three or more banks: each
+// bank owes the next, and the last owes the first. Multilateral netting
+// removes Amount from every obligation of the loop, which leaves every net
+// position unchanged.
+type ObligationLoop struct {
+	Banks  []string `protobuf:"bytes,1,rep,name=banks,proto3" json:"banks"`
+	Amount math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (ol *ObligationLoop) ProtoMessage()  {}
+func (ol *ObligationLoop) Reset()         { *ol = ObligationLoop{} }
+func (ol *ObligationLoop) String() string {
+	return fmt.Sprintf("ObligationLoop{Banks: %v, Amount: %s}", ol.Banks, ol.Amount)
+}
+
+// CurrencyPosition is the mutual credit of two banks in one currency
+type CurrencyPosition struct {
+	Currency string   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency"`
+	AFromB   math.Int `protobuf:"bytes,2,opt,name=a_from_b,json=aFromB,proto3,customtype=cosmossdk.io/math.Int" json:"a_from_b"` // Credit issued by BankB held by BankA: BankB owes BankA
+	BFromA   math.Int `protobuf:"bytes,3,opt,name=b_from_a,json=bFromA,proto3,customtype=cosmossdk.io/math.Int" json:"b_from_a"` // Credit issued by BankA held by BankB: BankA owes BankB
+}
+
+func (cp *CurrencyPosition) ProtoMessage()  {}
+func (cp *CurrencyPosition) Reset()         { *cp = CurrencyPosition{} }
+func (cp *CurrencyPosition) String() string {
+	return fmt.Sprintf("CurrencyPosition{Currency: %s, AFromB: %s, BFromA: %s}", cp.Currency, cp.AFromB, cp.BFromA)
+}
+
+// DebtPosition is the mutual credit of two banks across every denom each of
+// them issued, per currency and in total
+type DebtPosition struct {
+	BankA       string             `protobuf:"bytes,1,opt,name=bank_a,json=bankA,proto3" json:"bank_a"`
+	BankB       string             `protobuf:"bytes,2,opt,name=bank_b,json=bankB,proto3" json:"bank_b"`
+	Currencies  []CurrencyPosition `protobuf:"bytes,3,rep,name=currencies,proto3" json:"currencies"` // Sorted by currency
+	TotalAFromB math.Int           `protobuf:"bytes,4,opt,name=total_a_from_b,json=totalAFromB,proto3,customtype=cosmossdk.io/math.Int" json:"total_a_from_b"`
+	TotalBFromA math.Int           `protobuf:"bytes,5,opt,name=total_b_from_a,json=totalBFromA,proto3,customtype=cosmossdk.io/math.Int" json:"total_b_from_a"`
+}
+
+func (dp *DebtPosition) ProtoMessage()  {}
+func (dp *DebtPosition) Reset()         { *dp = DebtPosition{} }
+func (dp *DebtPosition) String() string {
+	return fmt.Sprintf("DebtPosition{BankA: %s, BankB: %s, Currencies: %d}", dp.BankA, dp.BankB, len(dp.Currencies))
+}
+
+// Currency returns the position in a currency, zero if the banks hold no
+// credit of each other in it
+func (dp DebtPosition) Currency(currency string) CurrencyPosition {
+	for _, position := range dp.Currencies {
+		if position.Currency == currency {
+			return position
+		}
+	}
+	return CurrencyPosition{Currency: currency, AFromB: math.ZeroInt(), BFromA: math.ZeroInt()}
+}
+
 // NettingStatus represents the status of a netting cycle
 type NettingStatus int
 
@@ -108,8 +252,21 @@ const (
 	NettingStatusInProgress
 	NettingStatusCompleted
 	NettingStatusFailed
+	NettingStatusCancelled
+)
+
+// Obligation priority classes. When a netting cycle cannot settle every pair,
+// pairs with a higher priority are settled first.
+const (
+	PriorityNormal int32 = 0 // Interbank positions
+	PriorityUrgent int32 = 1 // Urgent customer payments
 )
 
+// IsValidPriority returns true if p is a known priority class
+func IsValidPriority(p int32) bool {
+	return p >= PriorityNormal && p <= PriorityUrgent
+}
+
 // ValidatorSet represents the set of validators for multi-signature operations
 type ValidatorSet struct {
 	Validators   []Validator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
@@ -139,13 +296,17 @@ func (v *Validator) String() string { return fmt.Sprintf("Validator{Address: %s,
 
 // MintCommand represents a command to mint tokens on a destination chain
 type MintCommand struct {
-	CommandID   string           `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3" json:"command_id"`
-	TargetChain string           `protobuf:"bytes,2,opt,name=target_chain,json=targetChain,proto3" json:"target_chain"`
-	Recipient   string           `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient"`
-	Amount      math.Int         `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
-	Signatures  []ECDSASignature `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures"`
-	CreatedAt   int64            `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	Status      int32            `protobuf:"varint,7,opt,name=status,proto3" json:"status"`
+	CommandID      string           `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3" json:"command_id"`
+	TargetChain    string           `protobuf:"bytes,2,opt,name=target_chain,json=targetChain,proto3" json:"target_chain"`
+	Recipient      string           `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient"`
+	Amount         math.Int         `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Signatures     []ECDSASignature `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures"`
+	CreatedAt      int64            `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	Status         int32            `protobuf:"varint,7,opt,name=status,proto3" json:"status"`
+	Nonce          uint64           `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce"`                                                // Per target chain sequence
+	IdempotencyKey string           `protobuf:"bytes,9,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key"`         // Hash of command ID, nonce and target chain
+	TokenID        string           `protobuf:"bytes,10,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                   // Wrapped asset the gateway mints, empty for the chain's default token
+	HashAlgorithm  HashAlgorithm    `protobuf:"bytes,11,opt,name=hash_algorithm,json=hashAlgorithm,proto3" json:"hash_algorithm,omitempty"` // Algorithm of the command hash, empty for SHA-256
 }
 
 func (mc *MintCommand) ProtoMessage()  {}
@@ -199,6 +360,13 @@ const (
 	EventTypeCreditBurned      = "credit_burned"
 	EventTypeNettingStarted    = "netting_started"
 	EventTypeNettingCompleted  = "netting_completed"
+	EventTypeNettingCancelled  = "netting_cancelled"
 	EventTypeValidatorAdded    = "validator_added"
 	EventTypeValidatorRemoved  = "validator_removed"
+	EventTypeDisputeOpened     = "dispute_opened"
+	EventTypeDisputeResolved   = "dispute_resolved"
+
+	EventTypeMicroCycleCompleted = "micro_cycle_completed"
+	EventTypeReservesAttested    = "reserves_attested"
+	EventTypeBusinessDayClosed   = "business_day_closed"
 )
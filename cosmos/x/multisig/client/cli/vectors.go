@@ -0,0 +1,357 @@
+package cli
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+
+	"github.com/interbank-netting/cosmos/types"
+	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
+)
+
+const (
+	// FlagFile is the flag for the test vector input file
+	FlagFile = "file"
+)
+
+// TestVectorInput lists the validators and commands to generate test vectors for
+type TestVectorInput struct {
+	Validators []TestValidator     `json:"validators"`
+	Threshold  int32               `json:"threshold,omitempty"` // 2/3+ of the validators if zero
+	Version    uint64              `json:"version"`
+	Commands   []types.MintCommand `json:"commands"`
+}
+
+// TestValidator is a validator with a throwaway key for test vectors
+type TestValidator struct {
+	Address    string `json:"address"`
+	PrivateKey string `json:"private_key"` // Hex secp256k1 private key
+}
+
+// TestVectors are the byte-level encodings the gateway must reproduce for
+// the commands and validator set of a TestVectorInput
+type TestVectors struct {
+	ValidatorSet ValidatorSetVector `json:"validator_set"`
+	Commands     []CommandVector    `json:"commands"`
+	Batches      []BatchVector      `json:"batches"`
+}
+
+// ValidatorSetVector is a validator set with its hash
+type ValidatorSetVector struct {
+	Version      uint64            `json:"version"`
+	Threshold    int32             `json:"threshold"`
+	Validators   []ValidatorVector `json:"validators"`    // Input order
+	EthAddresses []string          `json:"eth_addresses"` // Ascending, as hashed
+	Hash         string            `json:"hash"`          // ValidatorSetHash
+}
+
+// ValidatorVector is a validator with its keys
+type ValidatorVector struct {
+	Address    string `json:"address"`
+	PubKey     string `json:"pub_key"` // Compressed
+	EthAddress string `json:"eth_address"`
+}
+
+// CommandVector is a mint command with its hash and signatures
+type CommandVector struct {
+	Command              types.MintCommand `json:"command"`
+	CommandHash          string            `json:"command_hash"`
+	SignBytes            string            `json:"sign_bytes"` // The 32 bytes each validator signs
+	LeafHash             string            `json:"leaf_hash"`  // Merkle leaf of the command in its batch
+	Signatures           []SignatureVector `json:"signatures"`
+	AggregatedSignatures string            `json:"aggregated_signatures"` // ABI encoded bytes[] of the signatures
+}
+
+// BatchVector is the command batch of a target chain with its root signatures
+type BatchVector struct {
+	TargetChain          string            `json:"target_chain"`
+	HashAlgorithm        string            `json:"hash_algorithm"` // Of the Merkle tree, the algorithm of its commands
+	CommandIDs           []string          `json:"command_ids"`    // Leaf order
+	Root                 string            `json:"root"`
+	SignBytes            string            `json:"sign_bytes"`
+	Proofs               []ProofVector     `json:"proofs"`
+	Signatures           []SignatureVector `json:"signatures"`
+	AggregatedSignatures string            `json:"aggregated_signatures"`
+}
+
+// ProofVector is the Merkle audit path of a command in its batch
+type ProofVector struct {
+	CommandID string   `json:"command_id"`
+	Index     uint32   `json:"index"`
+	Path      []string `json:"path"` // Leaf side first
+}
+
+// SignatureVector is the signature of a validator in its gateway encoding
+type SignatureVector struct {
+	Validator  string `json:"validator"`
+	EthAddress string `json:"eth_address"`
+	R          string `json:"r"`
+	S          string `json:"s"`
+	V          uint32 `json:"v"`
+	Encoded    string `json:"encoded"` // R || S || V
+}
+
+// GetMultisigCmd returns the offline multisig tooling commands
+func GetMultisigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   multisigtypes.ModuleName,
+		Short: "Offline multisig tools",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(NewTestVectorsCmd())
+
+	return cmd
+}
+
+// NewTestVectorsCmd returns a command that prints the canonical encodings of
+// commands, signatures and validator sets for gateway implementers
+func NewTestVectorsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "test-vectors",
+		Short: "Print canonical command, signature and validator set encodings",
+		Long: `Print test vectors a Solidity gateway can check its encoding against: the
+hash and sign bytes of each mint command, the 65 byte R || S || V signature of
+every validator and their ABI encoded bytes[] aggregation, the command batches
+with their Merkle roots and audit paths, and the validator set hash.
+
+Without --file a fixed set of three validators and three commands is used. An
+input file lists the validators with throwaway hex private keys and the
+commands; signatures are deterministic (RFC 6979), so the same input always
+produces the same vectors. Never use real validator keys.
+
+Example input.json:
+{
+  "version": 1,
+  "validators": [{"address": "validator-1", "private_key": "4c0883a6..."}],
+  "commands": [{"command_id": "cmd-1", "target_chain": "bank-b", "recipient": "0x...", "amount": "100", "nonce": "1"}]
+}
+
+Commands are hashed with SHA-256 unless they set "hash_algorithm" to
+"keccak256"; the commands of a target chain must share one algorithm, which
+also hashes their batch.`,
+		Example: fmt.Sprintf("interbank-nettingd %s test-vectors --file input.json", multisigtypes.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, err := cmd.Flags().GetString(FlagFile)
+			if err != nil {
+				return err
+			}
+
+			input := DefaultTestVectorInput()
+			if path != "" {
+				if input, err = readTestVectorInput(path); err != nil {
+					return err
+				}
+			}
+
+			vectors, err := GenerateTestVectors(input)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(vectors, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+
+	cmd.Flags().String(FlagFile, "", "Path to a JSON file containing the validators and commands")
+
+	return cmd
+}
+
+// DefaultTestVectorInput returns three validators with keys derived from
+// their address and four commands over three target chains, one of them for
+// a wrapped asset and one hashed with keccak256
+func DefaultTestVectorInput() TestVectorInput {
+	input := TestVectorInput{Version: 1}
+	for _, address := range []string{"validator-1", "validator-2", "validator-3"} {
+		key := sha256.Sum256([]byte(address))
+		input.Validators = append(input.Validators, TestValidator{Address: address, PrivateKey: hexutil.Encode(key[:])[2:]})
+	}
+
+	input.Commands = []types.MintCommand{
+		{CommandID: "cmd-0001", TargetChain: "bank-b", Recipient: "0x1111111111111111111111111111111111111111", Amount: math.NewInt(1000), Nonce: 1},
+		{CommandID: "cmd-0002", TargetChain: "bank-b", Recipient: "0x2222222222222222222222222222222222222222", Amount: math.NewInt(250), Nonce: 2, TokenID: "USD-stable"},
+		{CommandID: "cmd-0003", TargetChain: "bank-c", Recipient: "0x3333333333333333333333333333333333333333", Amount: math.NewInt(1), Nonce: 1},
+		{CommandID: "cmd-0004", TargetChain: "bank-d", Recipient: "0x4444444444444444444444444444444444444444", Amount: math.NewInt(75), Nonce: 1, HashAlgorithm: types.HashAlgorithmKeccak256},
+	}
+	for i := range input.Commands {
+		command := &input.Commands[i]
+		command.IdempotencyKey = multisigtypes.CommandIdempotencyKey(command.CommandID, command.Nonce, command.TargetChain)
+	}
+	return input
+}
+
+// GenerateTestVectors signs the commands and their batches with every
+// validator of the input. Batches group the commands by target chain in
+// command ID order, as the multisig module builds them.
+func GenerateTestVectors(input TestVectorInput) (TestVectors, error) {
+	if len(input.Validators) == 0 {
+		return TestVectors{}, fmt.Errorf("no validators")
+	}
+
+	keys := make([]*ecdsa.PrivateKey, len(input.Validators))
+	set := types.ValidatorSet{Threshold: input.Threshold, Version: input.Version}
+	if set.Threshold == 0 {
+		set.Threshold = types.ConsensusThreshold(len(input.Validators))
+	}
+
+	vectors := TestVectors{Commands: []CommandVector{}, Batches: []BatchVector{}}
+	for i, validator := range input.Validators {
+		key, err := crypto.HexToECDSA(validator.PrivateKey)
+		if err != nil {
+			return TestVectors{}, fmt.Errorf("validator %s: invalid private key: %w", validator.Address, err)
+		}
+		keys[i] = key
+
+		pubKey := crypto.CompressPubkey(&key.PublicKey)
+		set.Validators = append(set.Validators, types.Validator{Address: validator.Address, PubKey: pubKey, Power: 1, Active: true})
+		vectors.ValidatorSet.Validators = append(vectors.ValidatorSet.Validators, ValidatorVector{
+			Address:    validator.Address,
+			PubKey:     hexutil.Encode(pubKey),
+			EthAddress: crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		})
+	}
+
+	hash, err := multisigtypes.ValidatorSetHash(set)
+	if err != nil {
+		return TestVectors{}, err
+	}
+	addresses, err := multisigtypes.ActiveEthereumAddresses(set)
+	if err != nil {
+		return TestVectors{}, err
+	}
+	vectors.ValidatorSet.Version = set.Version
+	vectors.ValidatorSet.Threshold = set.Threshold
+	vectors.ValidatorSet.Hash = hexutil.Encode(hash)
+	for _, address := range addresses {
+		vectors.ValidatorSet.EthAddresses = append(vectors.ValidatorSet.EthAddresses, address.Hex())
+	}
+
+	sign := func(data []byte) ([]SignatureVector, string, error) {
+		signatures := make([]types.ECDSASignature, len(keys))
+		encoded := make([]SignatureVector, len(keys))
+		for i, key := range keys {
+			sig, err := crypto.Sign(data, key)
+			if err != nil {
+				return nil, "", err
+			}
+			signatures[i] = types.ECDSASignature{Validator: input.Validators[i].Address, R: sig[:32], S: sig[32:64], V: uint32(sig[64]) + 27}
+			bz, err := multisigtypes.EncodeSignature(signatures[i])
+			if err != nil {
+				return nil, "", err
+			}
+			encoded[i] = SignatureVector{
+				Validator:  signatures[i].Validator,
+				EthAddress: vectors.ValidatorSet.Validators[i].EthAddress,
+				R:          hexutil.Encode(signatures[i].R),
+				S:          hexutil.Encode(signatures[i].S),
+				V:          signatures[i].V,
+				Encoded:    hexutil.Encode(bz),
+			}
+		}
+		aggregated, err := multisigtypes.EncodeSignatures(signatures)
+		if err != nil {
+			return nil, "", err
+		}
+		return encoded, hexutil.Encode(aggregated), nil
+	}
+
+	byChain := make(map[string][]types.MintCommand)
+	for _, command := range input.Commands {
+		if command.Amount.IsNil() {
+			return TestVectors{}, fmt.Errorf("command %s: amount is required", command.CommandID)
+		}
+		if !command.HashAlgorithm.IsValid() {
+			return TestVectors{}, fmt.Errorf("command %s: unknown hash algorithm %q", command.CommandID, command.HashAlgorithm)
+		}
+		command.Signatures = nil
+
+		commandHash := multisigtypes.CommandHash(command)
+		signatures, aggregated, err := sign(commandHash)
+		if err != nil {
+			return TestVectors{}, err
+		}
+		vectors.Commands = append(vectors.Commands, CommandVector{
+			Command:              command,
+			CommandHash:          hexutil.Encode(commandHash),
+			SignBytes:            hexutil.Encode(commandHash),
+			LeafHash:             hexutil.Encode(multisigtypes.MerkleLeafHash(command.HashAlgorithm, commandHash)),
+			Signatures:           signatures,
+			AggregatedSignatures: aggregated,
+		})
+		byChain[command.TargetChain] = append(byChain[command.TargetChain], command)
+	}
+
+	chains := make([]string, 0, len(byChain))
+	for chain := range byChain {
+		chains = append(chains, chain)
+	}
+	sort.Strings(chains)
+
+	for _, chain := range chains {
+		commands := byChain[chain]
+		sort.Slice(commands, func(i, j int) bool {
+			return commands[i].CommandID < commands[j].CommandID
+		})
+
+		// The module hashes the commands and the batch of a chain alike
+		algorithm := commands[0].HashAlgorithm
+		batch := BatchVector{TargetChain: chain, HashAlgorithm: algorithm.String()}
+		leaves := make([][]byte, len(commands))
+		for i, command := range commands {
+			if command.HashAlgorithm.OrDefault() != algorithm.OrDefault() {
+				return TestVectors{}, fmt.Errorf("command %s: hash algorithm %s differs from the %s of target chain %s", command.CommandID, command.HashAlgorithm, algorithm, chain)
+			}
+			batch.CommandIDs = append(batch.CommandIDs, command.CommandID)
+			leaves[i] = multisigtypes.MerkleLeafHash(algorithm, multisigtypes.CommandHash(command))
+		}
+		root := multisigtypes.MerkleRoot(algorithm, leaves)
+		batch.Root = hexutil.Encode(root)
+		batch.SignBytes = batch.Root
+
+		for i, command := range commands {
+			proof := ProofVector{CommandID: command.CommandID, Index: uint32(i), Path: []string{}}
+			for _, sibling := range multisigtypes.MerkleAuditPath(algorithm, leaves, i) {
+				proof.Path = append(proof.Path, hexutil.Encode(sibling))
+			}
+			batch.Proofs = append(batch.Proofs, proof)
+		}
+
+		if batch.Signatures, batch.AggregatedSignatures, err = sign(root); err != nil {
+			return TestVectors{}, err
+		}
+		vectors.Batches = append(vectors.Batches, batch)
+	}
+
+	return vectors, nil
+}
+
+func readTestVectorInput(path string) (TestVectorInput, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return TestVectorInput{}, fmt.Errorf("failed to read test vector input: %w", err)
+	}
+
+	var input TestVectorInput
+	if err := json.Unmarshal(bz, &input); err != nil {
+		return TestVectorInput{}, fmt.Errorf("failed to parse test vector input: %w", err)
+	}
+	return input, nil
+}
@@ -7,13 +7,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/interbank-netting/cosmos/types"
 	"github.com/interbank-netting/cosmos/x/multisig/keeper"
+	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
 )
 
 // GenesisState defines the multisig module's genesis state.
 type GenesisState struct {
-	ValidatorSet types.ValidatorSet     `json:"validator_set"`
-	MintCommands []types.MintCommand    `json:"mint_commands"`
-	Params       Params                 `json:"params"`
+	ValidatorSet types.ValidatorSet                 `json:"validator_set"`
+	MintCommands []types.MintCommand                `json:"mint_commands"`
+	Params       multisigtypes.Params               `json:"params"`
+	Probations   []multisigtypes.ValidatorProbation `json:"probations,omitempty"`
 }
 
 // ProtoMessage implements proto.Message
@@ -27,24 +29,6 @@ func (gs *GenesisState) String() string {
 	return fmt.Sprintf("GenesisState{Validators: %d, MintCommands: %d}", len(gs.ValidatorSet.Validators), len(gs.MintCommands))
 }
 
-// Params defines the parameters for the multisig module.
-type Params struct {
-	SigningTimeout    int64 `json:"signing_timeout"`     // Signing timeout in seconds
-	MaxCommandAge     int64 `json:"max_command_age"`     // Maximum command age in seconds
-	MinValidatorCount int   `json:"min_validator_count"` // Minimum validator count
-	MaxValidatorCount int   `json:"max_validator_count"` // Maximum validator count
-}
-
-// DefaultParams returns a default set of parameters
-func DefaultParams() Params {
-	return Params{
-		SigningTimeout:    3600, // 1 hour
-		MaxCommandAge:     7200, // 2 hours
-		MinValidatorCount: 1,    // Minimum 1 validator
-		MaxValidatorCount: 100,  // Maximum 100 validators
-	}
-}
-
 // DefaultGenesisState returns the default genesis state
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
@@ -55,31 +39,14 @@ func DefaultGenesisState() *GenesisState {
 			Version:      1,
 		},
 		MintCommands: []types.MintCommand{},
-		Params:       DefaultParams(),
+		Params:       multisigtypes.DefaultParams(),
 	}
 }
 
 // ValidateGenesis validates the multisig genesis parameters
 func ValidateGenesis(data *GenesisState) error {
-	if data.Params.SigningTimeout <= 0 {
-		return fmt.Errorf("signing timeout must be positive: %d", data.Params.SigningTimeout)
-	}
-	
-	if data.Params.MaxCommandAge <= 0 {
-		return fmt.Errorf("max command age must be positive: %d", data.Params.MaxCommandAge)
-	}
-	
-	if data.Params.MinValidatorCount <= 0 {
-		return fmt.Errorf("minimum validator count must be positive: %d", data.Params.MinValidatorCount)
-	}
-	
-	if data.Params.MaxValidatorCount <= 0 {
-		return fmt.Errorf("maximum validator count must be positive: %d", data.Params.MaxValidatorCount)
-	}
-	
-	if data.Params.MinValidatorCount > data.Params.MaxValidatorCount {
-		return fmt.Errorf("minimum validator count cannot be greater than maximum: %d > %d", 
-			data.Params.MinValidatorCount, data.Params.MaxValidatorCount)
+	if err := data.Params.Validate(); err != nil {
+		return err
 	}
 	
 	// Validate validator set
@@ -90,12 +57,6 @@ func ValidateGenesis(data *GenesisState) error {
 	if len(data.ValidatorSet.Validators) > 0 {
 		// Check threshold is reasonable for validator count
 		validatorCount := int32(len(data.ValidatorSet.Validators))
-		expectedThreshold := (validatorCount * 2) / 3
-		if (validatorCount*2)%3 != 0 {
-			expectedThreshold++
-		}
-		_ = expectedThreshold // Silence unused variable warning
-
 		if data.ValidatorSet.Threshold > validatorCount {
 			return fmt.Errorf("threshold cannot be greater than validator count: %d > %d",
 				data.ValidatorSet.Threshold, validatorCount)
@@ -115,6 +76,20 @@ func ValidateGenesis(data *GenesisState) error {
 		}
 	}
 	
+	// Validate probations
+	members := make(map[string]bool, len(data.ValidatorSet.Validators))
+	for _, validator := range data.ValidatorSet.Validators {
+		members[validator.Address] = true
+	}
+	for i, probation := range data.Probations {
+		if !members[probation.Address] {
+			return fmt.Errorf("probation %d: %s is not in the validator set", i, probation.Address)
+		}
+		if probation.RequiredCycles == 0 {
+			return fmt.Errorf("probation %d: required cycles must be positive", i)
+		}
+	}
+	
 	// Validate mint commands
 	for i, command := range data.MintCommands {
 		if command.CommandID == "" {
@@ -136,6 +111,11 @@ func ValidateGenesis(data *GenesisState) error {
 
 // InitGenesis initializes the multisig module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState *GenesisState) {
+	// Probations come first so the threshold leaves them out
+	for _, probation := range genState.Probations {
+		keeper.SetProbation(ctx, probation)
+	}
+	
 	// Initialize validator set
 	if len(genState.ValidatorSet.Validators) > 0 {
 		err := keeper.UpdateValidatorSet(ctx, genState.ValidatorSet.Validators)
@@ -145,11 +125,12 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState *GenesisState)
 	}
 	
 	// Initialize mint commands
-	// TODO: Implement when keeper methods are available
-	_ = genState.MintCommands
+	for _, command := range genState.MintCommands {
+		keeper.ImportCommand(ctx, command)
+	}
 	
-	// Set parameters (would need parameter store implementation)
-	// keeper.SetParams(ctx, genState.Params)
+	// Set parameters
+	keeper.SetParams(ctx, genState.Params)
 }
 
 // ExportGenesis returns the multisig module's exported genesis.
@@ -159,11 +140,14 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *GenesisState {
 	// Export validator set
 	genesis.ValidatorSet = keeper.GetValidatorSet(ctx)
 	
-	// Export mint commands (would need keeper methods)
-	// genesis.MintCommands = keeper.GetAllMintCommands(ctx)
+	// Export mint commands
+	genesis.MintCommands = keeper.GetAllCommands(ctx)
+	
+	// Export parameters
+	genesis.Params = keeper.GetParams(ctx)
 	
-	// Export parameters (would need parameter store)
-	// genesis.Params = keeper.GetParams(ctx)
+	// Export probations
+	genesis.Probations = keeper.GetAllProbations(ctx)
 	
 	return genesis
 }
\ No newline at end of file
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/interbank-netting/cosmos/types"
+	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
+)
+
+type querier struct {
+	keeper ViewKeeper
+}
+
+// NewQueryServerImpl returns an implementation of the QueryServer interface
+// for the provided Keeper. The querier only holds the keeper's ViewKeeper.
+func NewQueryServerImpl(keeper Keeper) multisigtypes.QueryServer {
+	return &querier{keeper: keeper}
+}
+
+var _ multisigtypes.QueryServer = querier{}
+
+// CommandBatch returns a command batch with its root signatures
+func (q querier) CommandBatch(goCtx context.Context, req *multisigtypes.QueryCommandBatchRequest) (*multisigtypes.QueryCommandBatchResponse, error) {
+	if req == nil || req.BatchID == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "batch ID cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	batch, found := q.keeper.GetCommandBatch(ctx, req.BatchID)
+	if !found {
+		return nil, multisigtypes.ErrBatchNotFound
+	}
+
+	return &multisigtypes.QueryCommandBatchResponse{Batch: batch}, nil
+}
+
+// CommandProof returns the Merkle proof of a batched command
+func (q querier) CommandProof(goCtx context.Context, req *multisigtypes.QueryCommandProofRequest) (*multisigtypes.QueryCommandProofResponse, error) {
+	if req == nil || req.CommandID == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "command ID cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proof, err := q.keeper.GetCommandBatchProof(ctx, req.CommandID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &multisigtypes.QueryCommandProofResponse{Proof: proof}, nil
+}
+
+// LateSigners returns the validators that have not signed a pending command
+// and when the command is escalated and times out
+func (q querier) LateSigners(goCtx context.Context, req *multisigtypes.QueryLateSignersRequest) (*multisigtypes.QueryLateSignersResponse, error) {
+	if req == nil || req.CommandID == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "command ID cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	command, found := q.keeper.GetCommand(ctx, req.CommandID)
+	if !found {
+		return nil, multisigtypes.ErrCommandNotFound
+	}
+	if command.Status != int32(types.CommandStatusPending) {
+		return nil, errorsmod.Wrapf(multisigtypes.ErrInvalidCommandStatus, "command %s is not pending", req.CommandID)
+	}
+
+	params := q.keeper.GetParams(ctx)
+	return &multisigtypes.QueryLateSignersResponse{
+		CommandID:   command.CommandID,
+		LateSigners: q.keeper.LateSigners(ctx, command),
+		EscalateAt:  command.CreatedAt + params.EscalationAge(),
+		Deadline:    command.CreatedAt + params.SigningTimeout,
+		Escalated:   q.keeper.IsSigningEscalated(ctx, command.CommandID),
+	}, nil
+}
+
+// ValidatorSetConsistency compares the multisig validator set with the active
+// staking set
+func (q querier) ValidatorSetConsistency(goCtx context.Context, req *multisigtypes.QueryValidatorSetConsistencyRequest) (*multisigtypes.QueryValidatorSetConsistencyResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	consistency, err := q.keeper.CheckValidatorSetConsistency(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &multisigtypes.QueryValidatorSetConsistencyResponse{
+		Consistency:         consistency,
+		ValidatorSetVersion: q.keeper.GetValidatorSet(ctx).Version,
+		ReconcileInterval:   q.keeper.GetParams(ctx).ReconcileInterval,
+	}, nil
+}
+
+// ExecutionCost returns the reported execution cost of a command
+func (q querier) ExecutionCost(goCtx context.Context, req *multisigtypes.QueryExecutionCostRequest) (*multisigtypes.QueryExecutionCostResponse, error) {
+	if req == nil || req.CommandID == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "command ID cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	cost, found := q.keeper.GetExecutionCost(ctx, req.CommandID)
+	if !found {
+		return nil, errorsmod.Wrapf(multisigtypes.ErrCommandNotFound, "no execution cost of command %s", req.CommandID)
+	}
+
+	return &multisigtypes.QueryExecutionCostResponse{Cost: cost}, nil
+}
+
+// ExecutionCosts totals the execution costs per target chain over a period
+func (q querier) ExecutionCosts(goCtx context.Context, req *multisigtypes.QueryExecutionCostsRequest) (*multisigtypes.QueryExecutionCostsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	if req.StartTime < 0 || req.EndTime < 0 || (req.EndTime != 0 && req.EndTime < req.StartTime) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid time range [%d, %d]", req.StartTime, req.EndTime)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &multisigtypes.QueryExecutionCostsResponse{
+		Summaries: q.keeper.SummarizeExecutionCosts(ctx, req.TargetChain, req.StartTime, req.EndTime),
+	}, nil
+}
+
+// Probations returns the validators on probation with their signing progress
+func (q querier) Probations(goCtx context.Context, req *multisigtypes.QueryProbationsRequest) (*multisigtypes.QueryProbationsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &multisigtypes.QueryProbationsResponse{Probations: q.keeper.GetAllProbations(ctx)}, nil
+}
+
+// NonceGaps returns the open nonce gaps of the target chains whose command
+// generation is paused
+func (q querier) NonceGaps(goCtx context.Context, req *multisigtypes.QueryNonceGapsRequest) (*multisigtypes.QueryNonceGapsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &multisigtypes.QueryNonceGapsResponse{Gaps: q.keeper.GetAllNonceGaps(ctx)}, nil
+}
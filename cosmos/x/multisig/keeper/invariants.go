@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
+)
+
+// RegisterInvariants registers the multisig module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(multisigtypes.ModuleName, "validator-set-consistency", ValidatorSetConsistencyInvariant(k))
+}
+
+// ValidatorSetConsistencyInvariant checks that the active multisig validators
+// and threshold match the active staking set the oracle votes with
+func ValidatorSetConsistencyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		consistency, err := k.CheckValidatorSetConsistency(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(multisigtypes.ModuleName, "validator-set-consistency",
+				fmt.Sprintf("failed to read the staking validators: %v", err)), true
+		}
+
+		return sdk.FormatInvariant(multisigtypes.ModuleName, "validator-set-consistency",
+			fmt.Sprintf("missing from multisig: %v, extra in multisig: %v, staking threshold: %d, multisig threshold: %d",
+				consistency.MissingFromMultisig, consistency.ExtraInMultisig,
+				consistency.StakingThreshold, consistency.MultisigThreshold)), !consistency.Consistent
+	}
+}
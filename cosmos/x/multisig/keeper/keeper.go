@@ -1,17 +1,27 @@
 package keeper
 
 import (
+	"bytes"
 	"crypto/rand"
 	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/interbank-netting/cosmos/types"
 	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
@@ -26,6 +36,30 @@ type Keeper struct {
 
 	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
+
+	// signer signs commands with validator keys held outside the node
+	signer multisigtypes.SignerService
+
+	// the address capable of executing governance messages, usually the gov module account
+	authority string
+
+	Schema             collections.Schema
+	ValidatorSet       collections.Item[types.ValidatorSet]
+	MintCommands       collections.Map[string, types.MintCommand]
+	Validators         collections.Map[string, types.Validator]
+	CommandBatches     collections.Map[string, multisigtypes.CommandBatch]
+	CommandBatchIndex  collections.Map[string, string] // Command ID → batch ID
+	CommandNonces      collections.Map[string, uint64] // Target chain → last nonce
+	IdempotencyKeys    collections.Map[string, string] // Idempotency key → command ID
+	Executed           collections.KeySet[string]      // Idempotency keys reported executed
+	Params             collections.Item[multisigtypes.Params]
+	SigningEscalations collections.KeySet[string] // Command IDs whose late signers were escalated
+	ExecutionCosts     collections.Map[string, multisigtypes.ExecutionCost]
+	ExecutionCostIndex collections.KeySet[collections.Triple[string, int64, string]] // (target chain, executed at, command ID)
+	Probations         collections.Map[string, multisigtypes.ValidatorProbation]
+	ExecutedNonces     collections.Map[string, uint64] // Target chain → highest nonce reported executed
+	NonceGaps          collections.Map[string, multisigtypes.NonceGap]
+	PendingBatches     collections.KeySet[string] // Batch IDs awaiting root signatures
 }
 
 // NewKeeper creates a new multisig Keeper instance
@@ -36,35 +70,73 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	bankKeeper types.BankKeeper,
 	stakingKeeper types.StakingKeeper,
+	authority string,
 ) *Keeper {
-	return &Keeper{
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(storeKey.(*storetypes.KVStoreKey)))
+	k := &Keeper{
 		cdc:           cdc,
 		storeKey:      storeKey,
 		memKey:        memKey,
 		paramstore:    ps,
 		bankKeeper:    bankKeeper,
 		stakingKeeper: stakingKeeper,
+		authority:     authority,
+
+		ValidatorSet:       collections.NewItem(sb, multisigtypes.ValidatorSetKey, "validator_set", codec.CollValue[types.ValidatorSet](cdc)),
+		MintCommands:       collections.NewMap(sb, multisigtypes.MintCommandKeyPrefix, "mint_commands", collections.StringKey, codec.CollValue[types.MintCommand](cdc)),
+		Validators:         collections.NewMap(sb, multisigtypes.ValidatorKeyPrefix, "validators", collections.StringKey, codec.CollValue[types.Validator](cdc)),
+		CommandBatches:     collections.NewMap(sb, multisigtypes.CommandBatchKeyPrefix, "command_batches", collections.StringKey, codec.CollValue[multisigtypes.CommandBatch](cdc)),
+		CommandBatchIndex:  collections.NewMap(sb, multisigtypes.CommandBatchIndexKeyPrefix, "command_batch_index", collections.StringKey, collections.StringValue),
+		CommandNonces:      collections.NewMap(sb, multisigtypes.CommandNonceKeyPrefix, "command_nonces", collections.StringKey, collections.Uint64Value),
+		IdempotencyKeys:    collections.NewMap(sb, multisigtypes.IdempotencyKeyPrefix, "idempotency_keys", collections.StringKey, collections.StringValue),
+		Executed:           collections.NewKeySet(sb, multisigtypes.ExecutedKeyPrefix, "executed", collections.StringKey),
+		Params:             collections.NewItem(sb, multisigtypes.ParamsKey, "params", codec.CollValue[multisigtypes.Params](cdc)),
+		SigningEscalations: collections.NewKeySet(sb, multisigtypes.SigningEscalationKeyPrefix, "signing_escalations", collections.StringKey),
+		ExecutionCosts:     collections.NewMap(sb, multisigtypes.ExecutionCostKeyPrefix, "execution_costs", collections.StringKey, codec.CollValue[multisigtypes.ExecutionCost](cdc)),
+		ExecutionCostIndex: collections.NewKeySet(sb, multisigtypes.ExecutionCostIndexKeyPrefix, "execution_cost_index", collections.TripleKeyCodec(collections.StringKey, collections.Int64Key, collections.StringKey)),
+		Probations:         collections.NewMap(sb, multisigtypes.ProbationKeyPrefix, "probations", collections.StringKey, codec.CollValue[multisigtypes.ValidatorProbation](cdc)),
+		ExecutedNonces:     collections.NewMap(sb, multisigtypes.ExecutedNonceKeyPrefix, "executed_nonces", collections.StringKey, collections.Uint64Value),
+		NonceGaps:          collections.NewMap(sb, multisigtypes.NonceGapKeyPrefix, "nonce_gaps", collections.StringKey, codec.CollValue[multisigtypes.NonceGap](cdc)),
+		PendingBatches:     collections.NewKeySet(sb, multisigtypes.PendingBatchKeyPrefix, "pending_batches", collections.StringKey),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
 	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the module's authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetSignerService sets the external service that signs commands for the
+// validators run by this node
+func (k *Keeper) SetSignerService(signer multisigtypes.SignerService) {
+	k.signer = signer
+}
+
+// GetStoreKey returns the store key
+func (k Keeper) GetStoreKey() storetypes.StoreKey {
+	return k.storeKey
 }
 
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
-	return ctx.Logger().With("module", fmt.Sprintf("x/%s", multisigtypes.ModuleName))
+	return types.ModuleLogger(ctx, multisigtypes.ModuleName)
 }
 
 // GetValidatorSet retrieves the current validator set
 func (k Keeper) GetValidatorSet(ctx sdk.Context) types.ValidatorSet {
-	store := ctx.KVStore(k.storeKey)
-	key := multisigtypes.GetValidatorSetKey()
-	
-	bz := store.Get(key)
-	if bz == nil {
+	validatorSet, err := k.ValidatorSet.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
 		// Return default validator set if none exists
 		return k.getDefaultValidatorSet(ctx)
 	}
-
-	var validatorSet types.ValidatorSet
-	k.cdc.MustUnmarshal(bz, &validatorSet)
+	types.MustCollection(err)
 	return validatorSet
 }
 
@@ -73,16 +145,24 @@ func (k Keeper) UpdateValidatorSet(ctx sdk.Context, validators []types.Validator
 	if len(validators) == 0 {
 		return multisigtypes.ErrValidatorSetEmpty
 	}
+	if maxCount := k.GetParams(ctx).MaxValidatorCount; len(validators) > int(maxCount) {
+		return errorsmod.Wrapf(multisigtypes.ErrValidatorSetTooLarge, "%d validators, limit %d", len(validators), maxCount)
+	}
 
-	// Calculate 2/3 threshold
-	threshold := (len(validators) * 2) / 3
-	if (len(validators)*2)%3 != 0 {
-		threshold++ // Round up for 2/3+ majority
+	// Probations end with the validator's membership
+	keep := make(map[string]bool, len(validators))
+	for _, validator := range validators {
+		keep[validator.Address] = true
 	}
-	if threshold < 1 {
-		threshold = 1
+	for _, probation := range k.GetAllProbations(ctx) {
+		if !keep[probation.Address] {
+			types.MustCollection(k.Probations.Remove(ctx, probation.Address))
+		}
 	}
 
+	// Calculate 2/3 threshold of the validators off probation
+	threshold := k.signingThreshold(ctx, validators)
+
 	// Get current validator set for version increment
 	currentSet := k.GetValidatorSet(ctx)
 	newVersion := currentSet.Version + 1
@@ -90,7 +170,7 @@ func (k Keeper) UpdateValidatorSet(ctx sdk.Context, validators []types.Validator
 	// Create new validator set
 	validatorSet := types.ValidatorSet{
 		Validators:   validators,
-		Threshold:    int32(threshold),
+		Threshold:    threshold,
 		UpdateHeight: ctx.BlockHeight(),
 		Version:      newVersion,
 	}
@@ -108,7 +188,7 @@ func (k Keeper) UpdateValidatorSet(ctx sdk.Context, validators []types.Validator
 		sdk.NewEvent(
 			multisigtypes.EventTypeValidatorSetUpdated,
 			sdk.NewAttribute(multisigtypes.AttributeKeyValidatorCount, strconv.Itoa(len(validators))),
-			sdk.NewAttribute(multisigtypes.AttributeKeyThreshold, strconv.Itoa(threshold)),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.Itoa(int(threshold))),
 			sdk.NewAttribute(multisigtypes.AttributeKeyVersion, strconv.FormatUint(newVersion, 10)),
 			sdk.NewAttribute(multisigtypes.AttributeKeyUpdateHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
 		),
@@ -117,6 +197,120 @@ func (k Keeper) UpdateValidatorSet(ctx sdk.Context, validators []types.Validator
 	return nil
 }
 
+// CheckValidatorSetConsistency compares the active validators of the
+// multisig set with the bonded, unjailed staking validators, and the stored
+// multisig threshold with the threshold the oracle derives from staking
+func (k Keeper) CheckValidatorSetConsistency(ctx sdk.Context) (multisigtypes.ValidatorSetConsistency, error) {
+	stakingValidators, err := k.stakingValidators(ctx)
+	if err != nil {
+		return multisigtypes.ValidatorSetConsistency{}, err
+	}
+
+	var stakingActive []string
+	signing := 0
+	for _, validator := range stakingValidators {
+		if validator.Active {
+			stakingActive = append(stakingActive, validator.Address)
+			if !k.isOnProbation(ctx, validator.Address) {
+				signing++
+			}
+		}
+	}
+
+	validatorSet := k.GetValidatorSet(ctx)
+	var multisigActive []string
+	for _, validator := range validatorSet.Validators {
+		if validator.Active {
+			multisigActive = append(multisigActive, validator.Address)
+		}
+	}
+
+	return multisigtypes.NewValidatorSetConsistency(
+		stakingActive,
+		multisigActive,
+		types.ConsensusThreshold(signing),
+		validatorSet.Threshold,
+	), nil
+}
+
+// ReconcileValidatorSet replaces the multisig validator set with the active
+// staking validators if the two have drifted. Validators already in the
+// multisig set keep their registered public key and validators that left
+// staking are removed. An empty staking set is left
+// alone since the multisig set cannot be empty. It returns true if the set
+// was replaced.
+func (k Keeper) ReconcileValidatorSet(ctx sdk.Context) (bool, error) {
+	consistency, err := k.CheckValidatorSetConsistency(ctx)
+	if err != nil {
+		return false, err
+	}
+	if consistency.Consistent || len(consistency.StakingValidators) == 0 {
+		return false, nil
+	}
+
+	stakingValidators, err := k.stakingValidators(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	validators := make([]types.Validator, 0, len(stakingValidators))
+	for _, validator := range stakingValidators {
+		if !validator.Active {
+			continue
+		}
+		if existing, found := k.getValidator(ctx, validator.Address); found {
+			validator.PubKey = existing.PubKey
+			validator.JoinedAt = existing.JoinedAt
+		}
+		validators = append(validators, validator)
+	}
+
+	if err := k.UpdateValidatorSet(ctx, validators); err != nil {
+		return false, err
+	}
+
+	// Validators that left staking can no longer sign
+	for _, address := range consistency.ExtraInMultisig {
+		k.removeValidator(ctx, address)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			multisigtypes.EventTypeValidatorSetReconciled,
+			sdk.NewAttribute(multisigtypes.AttributeKeyMissing, strings.Join(consistency.MissingFromMultisig, ",")),
+			sdk.NewAttribute(multisigtypes.AttributeKeyExtra, strings.Join(consistency.ExtraInMultisig, ",")),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.Itoa(int(consistency.StakingThreshold))),
+		),
+	)
+
+	k.Logger(ctx).Info("reconciled multisig validator set from staking",
+		"missing", len(consistency.MissingFromMultisig),
+		"extra", len(consistency.ExtraInMultisig),
+	)
+
+	return true, nil
+}
+
+// ReportValidatorSetDrift emits a drift event if the multisig validator set
+// no longer matches staking. This is called in EndBlock when the validator
+// set is not reconciled at the height.
+func (k Keeper) ReportValidatorSetDrift(ctx sdk.Context) error {
+	consistency, err := k.CheckValidatorSetConsistency(ctx)
+	if err != nil || consistency.Consistent {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			multisigtypes.EventTypeValidatorSetDrift,
+			sdk.NewAttribute(multisigtypes.AttributeKeyMissing, strings.Join(consistency.MissingFromMultisig, ",")),
+			sdk.NewAttribute(multisigtypes.AttributeKeyExtra, strings.Join(consistency.ExtraInMultisig, ",")),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.Itoa(int(consistency.MultisigThreshold))),
+		),
+	)
+	return nil
+}
+
 // AddValidator adds a new validator to the set
 func (k Keeper) AddValidator(ctx sdk.Context, validator types.Validator) error {
 	// Check if validator already exists
@@ -126,19 +320,16 @@ func (k Keeper) AddValidator(ctx sdk.Context, validator types.Validator) error {
 
 	// Get current validator set
 	validatorSet := k.GetValidatorSet(ctx)
+	if maxCount := k.GetParams(ctx).MaxValidatorCount; len(validatorSet.Validators) >= int(maxCount) {
+		return errorsmod.Wrapf(multisigtypes.ErrValidatorSetTooLarge, "set already has %d validators, limit %d", len(validatorSet.Validators), maxCount)
+	}
 	
 	// Add new validator
 	validatorSet.Validators = append(validatorSet.Validators, validator)
 	
 	// Recalculate threshold
-	threshold := (len(validatorSet.Validators) * 2) / 3
-	if (len(validatorSet.Validators)*2)%3 != 0 {
-		threshold++
-	}
-	if threshold < 1 {
-		threshold = 1
-	}
-	validatorSet.Threshold = int32(threshold)
+	threshold := k.signingThreshold(ctx, validatorSet.Validators)
+	validatorSet.Threshold = threshold
 	validatorSet.Version++
 	validatorSet.UpdateHeight = ctx.BlockHeight()
 
@@ -152,7 +343,7 @@ func (k Keeper) AddValidator(ctx sdk.Context, validator types.Validator) error {
 			multisigtypes.EventTypeValidatorAdded,
 			sdk.NewAttribute(multisigtypes.AttributeKeyValidatorAddress, validator.Address),
 			sdk.NewAttribute(multisigtypes.AttributeKeyValidatorPower, strconv.FormatInt(validator.Power, 10)),
-			sdk.NewAttribute(multisigtypes.AttributeKeyThreshold, strconv.Itoa(threshold)),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.Itoa(int(threshold))),
 		),
 	)
 
@@ -185,16 +376,11 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address string) error {
 
 	// Update validator set
 	validatorSet.Validators = newValidators
+	types.MustCollection(k.Probations.Remove(ctx, address))
 	
 	// Recalculate threshold
-	threshold := (len(newValidators) * 2) / 3
-	if (len(newValidators)*2)%3 != 0 {
-		threshold++
-	}
-	if threshold < 1 {
-		threshold = 1
-	}
-	validatorSet.Threshold = int32(threshold)
+	threshold := k.signingThreshold(ctx, newValidators)
+	validatorSet.Threshold = threshold
 	validatorSet.Version++
 	validatorSet.UpdateHeight = ctx.BlockHeight()
 
@@ -206,31 +392,301 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address string) error {
 		sdk.NewEvent(
 			multisigtypes.EventTypeValidatorRemoved,
 			sdk.NewAttribute(multisigtypes.AttributeKeyValidatorAddress, address),
-			sdk.NewAttribute(multisigtypes.AttributeKeyThreshold, strconv.Itoa(int(threshold))),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.Itoa(int(threshold))),
+		),
+	)
+
+	return nil
+}
+
+// OverrideValidatorSet replaces the validator set wholesale, for recovery
+// after the set lost its signing quorum. Unlike UpdateValidatorSet, every
+// validator of the new set is fully active: probations are cleared and
+// validators of the replaced set are removed. In-flight commands, pending or
+// signed but not executed, hold signatures of the replaced set. With
+// InFlightResign their signatures are dropped, their signing timeout restarts
+// and they wait for MsgSignCommand from the new set; signed ones leave their
+// batch and are batched again once signed. With InFlightInvalidate they are
+// marked failed. It returns the new set and the IDs of the in-flight
+// commands, in command ID order.
+func (k Keeper) OverrideValidatorSet(ctx sdk.Context, validators []types.Validator, inFlight, reason, authority string) (types.ValidatorSet, []string, error) {
+	if err := multisigtypes.ValidateInFlight(inFlight); err != nil {
+		return types.ValidatorSet{}, nil, err
+	}
+	if len(validators) == 0 {
+		return types.ValidatorSet{}, nil, multisigtypes.ErrValidatorSetEmpty
+	}
+	if maxCount := k.GetParams(ctx).MaxValidatorCount; len(validators) > int(maxCount) {
+		return types.ValidatorSet{}, nil, errorsmod.Wrapf(multisigtypes.ErrValidatorSetTooLarge, "%d validators, limit %d", len(validators), maxCount)
+	}
+
+	keep := make(map[string]bool, len(validators))
+	for _, validator := range validators {
+		keep[validator.Address] = true
+	}
+	for _, probation := range k.GetAllProbations(ctx) {
+		types.MustCollection(k.Probations.Remove(ctx, probation.Address))
+	}
+	previous := k.GetValidatorSet(ctx)
+	for _, validator := range previous.Validators {
+		if !keep[validator.Address] {
+			k.removeValidator(ctx, validator.Address)
+		}
+	}
+
+	validatorSet := types.ValidatorSet{
+		Validators:   validators,
+		Threshold:    k.signingThreshold(ctx, validators),
+		UpdateHeight: ctx.BlockHeight(),
+		Version:      previous.Version + 1,
+	}
+	k.setValidatorSet(ctx, validatorSet)
+	for _, validator := range validators {
+		k.setValidator(ctx, validator)
+	}
+
+	// Root signatures collected so far may come from the removed keys, so
+	// pending batches are expired rather than left to reach the new threshold
+	for _, batch := range k.getPendingBatches(ctx) {
+		batch.Signatures = nil
+		k.expireBatch(types.WithCorrelationID(ctx, batch.BatchID), batch)
+	}
+
+	var commandIDs []string
+	for _, command := range k.GetAllCommands(ctx) {
+		if command.Status != int32(types.CommandStatusPending) && command.Status != int32(types.CommandStatusSigned) {
+			continue
+		}
+		commandIDs = append(commandIDs, command.CommandID)
+		types.MustCollection(k.CommandBatchIndex.Remove(ctx, command.CommandID))
+
+		if inFlight == multisigtypes.InFlightInvalidate {
+			command.Status = int32(types.CommandStatusFailed)
+			k.setMintCommand(ctx, command)
+			continue
+		}
+
+		command.Signatures = nil
+		command.Status = int32(types.CommandStatusPending)
+		command.CreatedAt = ctx.BlockTime().Unix()
+		k.setMintCommand(ctx, command)
+		types.MustCollection(k.SigningEscalations.Remove(ctx, command.CommandID))
+	}
+
+	k.Logger(ctx).Error("validator set overridden by governance",
+		"version", validatorSet.Version,
+		"validators", len(validators),
+		"threshold", validatorSet.Threshold,
+		"in_flight", inFlight,
+		"commands", len(commandIDs),
+		"reason", reason,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			multisigtypes.EventTypeValidatorSetOverridden,
+			sdk.NewAttribute(multisigtypes.AttributeKeyValidatorCount, strconv.Itoa(len(validators))),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.Itoa(int(validatorSet.Threshold))),
+			sdk.NewAttribute(multisigtypes.AttributeKeyVersion, strconv.FormatUint(validatorSet.Version, 10)),
+			sdk.NewAttribute(multisigtypes.AttributeKeyInFlight, inFlight),
+			sdk.NewAttribute(multisigtypes.AttributeKeyCommandCount, strconv.Itoa(len(commandIDs))),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(multisigtypes.AttributeKeyAuthority, authority),
+		),
+	)
+
+	return validatorSet, commandIDs, nil
+}
+
+// =============================================================================
+// Validator Probation
+// =============================================================================
+
+// AdmitValidator adds a validator admitted by governance. With a positive
+// probationCycles the validator starts on probation: it signs commands, but
+// its signatures only count once it signed probationCycles signing cycles in
+// a row, after which it becomes fully active automatically.
+func (k Keeper) AdmitValidator(ctx sdk.Context, validator types.Validator, probationCycles uint32) error {
+	if probationCycles == 0 {
+		return k.AddValidator(ctx, validator)
+	}
+	if k.validatorExists(ctx, validator.Address) {
+		return multisigtypes.ErrValidatorAlreadyExists
+	}
+
+	// Record the probation first so the threshold leaves the validator out
+	k.setProbation(ctx, multisigtypes.ValidatorProbation{
+		Address:        validator.Address,
+		RequiredCycles: probationCycles,
+		AdmittedAt:     ctx.BlockTime().Unix(),
+		AdmittedHeight: ctx.BlockHeight(),
+	})
+	if err := k.AddValidator(ctx, validator); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			multisigtypes.EventTypeValidatorProbation,
+			sdk.NewAttribute(multisigtypes.AttributeKeyValidatorAddress, validator.Address),
+			sdk.NewAttribute(multisigtypes.AttributeKeyRequiredCycles, strconv.FormatUint(uint64(probationCycles), 10)),
 		),
 	)
 
 	return nil
 }
 
-// GenerateMintCommand generates a new mint command
+// GetProbation returns the probation of a validator
+func (k Keeper) GetProbation(ctx sdk.Context, address string) (multisigtypes.ValidatorProbation, bool) {
+	return types.CollectionValue(ctx, k.Probations, address)
+}
+
+// GetAllProbations returns the validators on probation
+func (k Keeper) GetAllProbations(ctx sdk.Context) []multisigtypes.ValidatorProbation {
+	return types.CollectionValues(ctx, k.Probations, nil)
+}
+
+// SetProbation stores a probation (used by genesis import)
+func (k Keeper) SetProbation(ctx sdk.Context, probation multisigtypes.ValidatorProbation) {
+	k.setProbation(ctx, probation)
+}
+
+func (k Keeper) setProbation(ctx sdk.Context, probation multisigtypes.ValidatorProbation) {
+	types.MustCollection(k.Probations.Set(ctx, probation.Address, probation))
+}
+
+func (k Keeper) isOnProbation(ctx sdk.Context, address string) bool {
+	return types.CollectionHas(ctx, k.Probations, address)
+}
+
+// signingThreshold returns the threshold of the validators whose signatures
+// count, leaving out the ones on probation
+func (k Keeper) signingThreshold(ctx sdk.Context, validators []types.Validator) int32 {
+	signing := 0
+	for _, validator := range validators {
+		if !k.isOnProbation(ctx, validator.Address) {
+			signing++
+		}
+	}
+	return types.ConsensusThreshold(signing)
+}
+
+// countedSignatures returns the signatures that count toward the threshold:
+// those of validators in the current set that are not on probation
+func (k Keeper) countedSignatures(ctx sdk.Context, signatures []types.ECDSASignature) []types.ECDSASignature {
+	counted := make([]types.ECDSASignature, 0, len(signatures))
+	for _, signature := range signatures {
+		if k.validatorExists(ctx, signature.Validator) && !k.isOnProbation(ctx, signature.Validator) {
+			counted = append(counted, signature)
+		}
+	}
+	return counted
+}
+
+// recordProbationCycles counts a finished command as a signing cycle of every
+// validator that was on probation when it was created, and activates the
+// validators whose probation is complete
+func (k Keeper) recordProbationCycles(ctx sdk.Context, command types.MintCommand) {
+	signed := make(map[string]bool, len(command.Signatures))
+	for _, signature := range command.Signatures {
+		signed[signature.Validator] = true
+	}
+
+	for _, probation := range k.GetAllProbations(ctx) {
+		if probation.AdmittedAt > command.CreatedAt {
+			continue
+		}
+		if !probation.RecordCycle(signed[probation.Address]) {
+			k.setProbation(ctx, probation)
+			continue
+		}
+		k.completeProbation(ctx, probation)
+	}
+}
+
+// completeProbation makes a validator fully active. Its signatures count from
+// now on, so the threshold is recalculated.
+func (k Keeper) completeProbation(ctx sdk.Context, probation multisigtypes.ValidatorProbation) {
+	types.MustCollection(k.Probations.Remove(ctx, probation.Address))
+
+	validatorSet := k.GetValidatorSet(ctx)
+	validatorSet.Threshold = k.signingThreshold(ctx, validatorSet.Validators)
+	validatorSet.Version++
+	validatorSet.UpdateHeight = ctx.BlockHeight()
+	k.setValidatorSet(ctx, validatorSet)
+
+	k.Logger(ctx).Info("validator completed probation",
+		"validator", probation.Address,
+		"cycles", probation.CompletedCycles,
+		"missed", probation.MissedCycles,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			multisigtypes.EventTypeValidatorActivated,
+			sdk.NewAttribute(multisigtypes.AttributeKeyValidatorAddress, probation.Address),
+			sdk.NewAttribute(multisigtypes.AttributeKeyMissedCycles, strconv.FormatUint(uint64(probation.MissedCycles), 10)),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.Itoa(int(validatorSet.Threshold))),
+		),
+	)
+}
+
+// GenerateMintCommand generates a new mint command of the target chain's
+// default token
 func (k Keeper) GenerateMintCommand(ctx sdk.Context, targetChain, recipient string, amount math.Int) (types.MintCommand, error) {
+	return k.GenerateTokenMintCommand(ctx, targetChain, recipient, "", amount)
+}
+
+// GenerateTokenMintCommand generates a new mint command of a wrapped asset.
+// The token ID is part of the signed command hash, so signatures of a command
+// cannot be replayed to mint another asset. The recipient must be a payout
+// address bound to the target chain.
+func (k Keeper) GenerateTokenMintCommand(ctx sdk.Context, targetChain, recipient, tokenID string, amount math.Int) (types.MintCommand, error) {
+	if err := types.ValidateTokenID(tokenID); err != nil {
+		return types.MintCommand{}, errorsmod.Wrap(multisigtypes.ErrInvalidTokenID, err.Error())
+	}
+
+	params := k.GetParams(ctx)
+	if err := params.CheckPayoutAddress(targetChain, recipient); err != nil {
+		return types.MintCommand{}, errorsmod.Wrap(multisigtypes.ErrUnboundRecipient, err.Error())
+	}
+
+	if gap, found := k.GetNonceGap(ctx, targetChain); found {
+		return types.MintCommand{}, errorsmod.Wrapf(multisigtypes.ErrNonceGap, "chain %s is missing nonces %v", targetChain, gap.MissingNonces)
+	}
+
 	// Generate unique command ID
-	commandID := k.generateCommandID(ctx, targetChain, recipient, amount)
+	commandID := k.generateCommandID(ctx, targetChain, recipient, tokenID, amount)
+
+	// Assign the next nonce of the target chain
+	nonce := k.getCommandNonce(ctx, targetChain) + 1
+	k.setCommandNonce(ctx, targetChain, nonce)
 
 	// Create mint command
 	command := types.MintCommand{
-		CommandID:   commandID,
-		TargetChain: targetChain,
-		Recipient:   recipient,
-		Amount:      amount,
-		Signatures:  []types.ECDSASignature{},
-		CreatedAt:   ctx.BlockTime().Unix(),
-		Status:      int32(types.CommandStatusPending),
+		CommandID:      commandID,
+		TargetChain:    targetChain,
+		Recipient:      recipient,
+		Amount:         amount,
+		Signatures:     []types.ECDSASignature{},
+		CreatedAt:      ctx.BlockTime().Unix(),
+		Status:         int32(types.CommandStatusPending),
+		Nonce:          nonce,
+		IdempotencyKey: multisigtypes.CommandIdempotencyKey(commandID, nonce, targetChain),
+		TokenID:        tokenID,
+		HashAlgorithm:  params.CommandHashAlgorithm(targetChain),
 	}
 
 	// Store command
 	k.setMintCommand(ctx, command)
+	k.setIdempotencyKey(ctx, command.IdempotencyKey, commandID)
+
+	k.Logger(ctx).Info("mint command generated",
+		"command_id", commandID,
+		"target_chain", targetChain,
+		"token_id", tokenID,
+		"amount", amount.String(),
+	)
 
 	// Emit mint command generated event
 	ctx.EventManager().EmitEvent(
@@ -238,8 +694,11 @@ func (k Keeper) GenerateMintCommand(ctx sdk.Context, targetChain, recipient stri
 			multisigtypes.EventTypeMintCommandGenerated,
 			sdk.NewAttribute(multisigtypes.AttributeKeyCommandID, commandID),
 			sdk.NewAttribute(multisigtypes.AttributeKeyTargetChain, targetChain),
-			sdk.NewAttribute(multisigtypes.AttributeKeyRecipient, recipient),
-			sdk.NewAttribute(multisigtypes.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyNonce, strconv.FormatUint(nonce, 10)),
+			sdk.NewAttribute(multisigtypes.AttributeKeyIdempotencyKey, command.IdempotencyKey),
+			sdk.NewAttribute(types.AttributeKeyTokenID, tokenID),
 		),
 	)
 
@@ -257,19 +716,32 @@ func (k Keeper) CollectSignatures(ctx sdk.Context, commandID string) error {
 	// Get validator set
 	validatorSet := k.GetValidatorSet(ctx)
 
-	// Check if we have enough signatures
-	if int32(len(command.Signatures)) >= validatorSet.Threshold {
+	// Check if we have enough signatures. Signatures of validators on
+	// probation are kept but don't count.
+	counted := k.countedSignatures(ctx, command.Signatures)
+	if int32(len(counted)) >= validatorSet.Threshold {
 		// Mark command as signed
 		command.Status = int32(types.CommandStatusSigned)
 		k.setMintCommand(ctx, command)
 
-		// Emit threshold reached event
+		// Emit threshold reached event with everything the gateway call needs
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				multisigtypes.EventTypeThresholdReached,
 				sdk.NewAttribute(multisigtypes.AttributeKeyCommandID, commandID),
-				sdk.NewAttribute(multisigtypes.AttributeKeySignatureCount, strconv.Itoa(len(command.Signatures))),
-				sdk.NewAttribute(multisigtypes.AttributeKeyThreshold, strconv.FormatInt(int64(validatorSet.Threshold), 10)),
+				sdk.NewAttribute(multisigtypes.AttributeKeySignatureCount, strconv.Itoa(len(counted))),
+				sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatInt(int64(validatorSet.Threshold), 10)),
+				sdk.NewAttribute(multisigtypes.AttributeKeyTargetChain, command.TargetChain),
+				sdk.NewAttribute(types.AttributeKeyRecipient, command.Recipient),
+				sdk.NewAttribute(types.AttributeKeyAmount, command.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyTokenID, command.TokenID),
+				sdk.NewAttribute(types.AttributeKeyNonce, strconv.FormatUint(command.Nonce, 10)),
+				sdk.NewAttribute(multisigtypes.AttributeKeyIdempotencyKey, command.IdempotencyKey),
+				sdk.NewAttribute(types.AttributeKeyCreatedAt, strconv.FormatInt(command.CreatedAt, 10)),
+				sdk.NewAttribute(types.AttributeKeyValidatorSetVersion, strconv.FormatUint(validatorSet.Version, 10)),
+				sdk.NewAttribute(types.AttributeKeyPayloadHash, hex.EncodeToString(multisigtypes.CommandHash(command))),
+				sdk.NewAttribute(types.AttributeKeyHashAlgorithm, command.HashAlgorithm.String()),
+				sdk.NewAttribute(types.AttributeKeySignatures, eventSignatures(counted)),
 			),
 		)
 	}
@@ -277,20 +749,48 @@ func (k Keeper) CollectSignatures(ctx sdk.Context, commandID string) error {
 	return nil
 }
 
+// eventSignature is the event encoding of a command signature, matching the
+// ECDSASignature of the relayer
+type eventSignature struct {
+	Validator string `json:"validator"`
+	R         string `json:"r"`
+	S         string `json:"s"`
+	V         uint32 `json:"v"`
+}
+
+// eventSignatures encodes signatures as a JSON list with 0x-prefixed r and s
+func eventSignatures(signatures []types.ECDSASignature) string {
+	encoded := make([]eventSignature, len(signatures))
+	for i, signature := range signatures {
+		encoded[i] = eventSignature{
+			Validator: signature.Validator,
+			R:         "0x" + hex.EncodeToString(signature.R),
+			S:         "0x" + hex.EncodeToString(signature.S),
+			V:         signature.V,
+		}
+	}
+	bz, err := json.Marshal(encoded)
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
+
 // VerifyCommand verifies a mint command's signatures
 func (k Keeper) VerifyCommand(ctx sdk.Context, command types.MintCommand) bool {
 	validatorSet := k.GetValidatorSet(ctx)
+	counted := k.countedSignatures(ctx, command.Signatures)
 
 	// Check if we have enough signatures
-	if int32(len(command.Signatures)) < validatorSet.Threshold {
+	if int32(len(counted)) < validatorSet.Threshold {
 		return false
 	}
 
 	// Verify each signature
 	validSignatures := int32(0)
-	commandHash := k.hashCommand(command)
+	commandHash := multisigtypes.CommandHash(command)
 
-	for _, signature := range command.Signatures {
+	for _, signature := range counted {
 		if k.VerifyECDSASignature(ctx, commandHash, signature) {
 			validSignatures++
 		}
@@ -301,26 +801,20 @@ func (k Keeper) VerifyCommand(ctx sdk.Context, command types.MintCommand) bool {
 
 // GetCommand retrieves a mint command by ID
 func (k Keeper) GetCommand(ctx sdk.Context, commandID string) (types.MintCommand, bool) {
-	store := ctx.KVStore(k.storeKey)
-	key := multisigtypes.GetMintCommandKey(commandID)
-	
-	bz := store.Get(key)
-	if bz == nil {
-		return types.MintCommand{}, false
-	}
-
-	var command types.MintCommand
-	k.cdc.MustUnmarshal(bz, &command)
-	return command, true
+	return types.CollectionValue(ctx, k.MintCommands, commandID)
 }
 
-// SignData signs data with a validator's key (mock implementation)
+// SignData signs data with a validator's key through the signer service.
+// Without a signer service it returns a mock signature.
 func (k Keeper) SignData(ctx sdk.Context, validator string, data []byte) (types.ECDSASignature, error) {
 	// Get validator info
-	_, found := k.getValidator(ctx, validator)
+	info, found := k.getValidator(ctx, validator)
 	if !found {
 		return types.ECDSASignature{}, multisigtypes.ErrValidatorNotFound
 	}
+	if k.signer != nil {
+		return k.signWithService(ctx, info, data)
+	}
 
 	// Mock ECDSA signature generation
 	// In a real implementation, this would use the validator's private key
@@ -340,6 +834,74 @@ func (k Keeper) SignData(ctx sdk.Context, validator string, data []byte) (types.
 	return signature, nil
 }
 
+// signWithService signs data with the signer service, checking that the
+// service holds the validator's registered key and that its signature recovers
+// to it. The command being signed is the correlation ID of ctx.
+func (k Keeper) signWithService(ctx sdk.Context, validator types.Validator, data []byte) (types.ECDSASignature, error) {
+	expected, err := uncompressedPubKey(validator.PubKey)
+	if err != nil {
+		return types.ECDSASignature{}, errorsmod.Wrapf(multisigtypes.ErrInvalidValidator, "validator %s: %s", validator.Address, err)
+	}
+
+	key, err := k.signer.GetPublicKey(ctx, &multisigtypes.GetPublicKeyRequest{Validator: validator.Address})
+	if err != nil {
+		return types.ECDSASignature{}, fmt.Errorf("signer service: %w", err)
+	}
+	if actual, err := uncompressedPubKey(key.PubKey); err != nil || !bytes.Equal(actual, expected) {
+		return types.ECDSASignature{}, errorsmod.Wrapf(multisigtypes.ErrSignatureVerification, "signer service key does not match validator %s", validator.Address)
+	}
+
+	response, err := k.signer.SignCommandHash(ctx, &multisigtypes.SignCommandHashRequest{
+		Validator: validator.Address,
+		CommandID: types.GetCorrelationID(ctx),
+		Hash:      data,
+	})
+	if err != nil {
+		return types.ECDSASignature{}, fmt.Errorf("signer service: %w", err)
+	}
+	signature := response.Signature
+	if len(signature) != 65 {
+		return types.ECDSASignature{}, errorsmod.Wrapf(multisigtypes.ErrInvalidECDSASignature, "signature length %d", len(signature))
+	}
+
+	recoveryID := signature[64]
+	if recoveryID >= 27 {
+		recoveryID -= 27
+	}
+	recovered, err := crypto.SigToPub(data, append(signature[:64:64], recoveryID))
+	if err != nil || !bytes.Equal(crypto.FromECDSAPub(recovered), expected) {
+		return types.ECDSASignature{}, errorsmod.Wrapf(multisigtypes.ErrSignatureVerification, "signature does not recover to validator %s", validator.Address)
+	}
+
+	return types.ECDSASignature{
+		Validator: validator.Address,
+		R:         signature[:32],
+		S:         signature[32:64],
+		V:         uint32(recoveryID) + 27,
+		Timestamp: ctx.BlockTime().Unix(),
+	}, nil
+}
+
+// uncompressedPubKey returns a compressed or uncompressed secp256k1 public
+// key in uncompressed form
+func uncompressedPubKey(pubKey []byte) ([]byte, error) {
+	switch len(pubKey) {
+	case 33:
+		key, err := crypto.DecompressPubkey(pubKey)
+		if err != nil {
+			return nil, err
+		}
+		return crypto.FromECDSAPub(key), nil
+	case 65:
+		if _, err := crypto.UnmarshalPubkey(pubKey); err != nil {
+			return nil, err
+		}
+		return pubKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key length %d", len(pubKey))
+	}
+}
+
 // VerifyECDSASignature verifies an ECDSA signature
 func (k Keeper) VerifyECDSASignature(ctx sdk.Context, data []byte, signature types.ECDSASignature) bool {
 	// Get validator info
@@ -361,6 +923,13 @@ func (k Keeper) AddSignatureToCommand(ctx sdk.Context, commandID string, signatu
 		return multisigtypes.ErrCommandNotFound
 	}
 
+	if command.Status == int32(types.CommandStatusFailed) {
+		return errorsmod.Wrapf(multisigtypes.ErrCommandExpired, "command %s", commandID)
+	}
+	if command.Status == int32(types.CommandStatusExecuted) {
+		return errorsmod.Wrapf(multisigtypes.ErrInvalidCommandStatus, "command %s already executed", commandID)
+	}
+
 	// Check if validator already signed
 	for _, sig := range command.Signatures {
 		if sig.Validator == signature.Validator {
@@ -369,7 +938,7 @@ func (k Keeper) AddSignatureToCommand(ctx sdk.Context, commandID string, signatu
 	}
 
 	// Verify signature
-	commandHash := k.hashCommand(command)
+	commandHash := multisigtypes.CommandHash(command)
 	if !k.VerifyECDSASignature(ctx, commandHash, signature) {
 		return multisigtypes.ErrInvalidECDSASignature
 	}
@@ -383,7 +952,7 @@ func (k Keeper) AddSignatureToCommand(ctx sdk.Context, commandID string, signatu
 		sdk.NewEvent(
 			multisigtypes.EventTypeCommandSigned,
 			sdk.NewAttribute(multisigtypes.AttributeKeyCommandID, commandID),
-			sdk.NewAttribute(multisigtypes.AttributeKeyValidator, signature.Validator),
+			sdk.NewAttribute(types.AttributeKeyValidator, signature.Validator),
 			sdk.NewAttribute(multisigtypes.AttributeKeySignatureCount, strconv.Itoa(len(command.Signatures))),
 		),
 	)
@@ -396,11 +965,29 @@ func (k Keeper) AddSignatureToCommand(ctx sdk.Context, commandID string, signatu
 
 func (k Keeper) getDefaultValidatorSet(ctx sdk.Context) types.ValidatorSet {
 	// Get validators from staking module
-	stakingValidators, err := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
+	validators, err := k.stakingValidators(ctx)
 	if err != nil {
 		return types.ValidatorSet{Threshold: 1}
 	}
 
+	threshold := types.ConsensusThreshold(len(validators))
+
+	return types.ValidatorSet{
+		Validators:   validators,
+		Threshold:    threshold,
+		UpdateHeight: ctx.BlockHeight(),
+		Version:      1,
+	}
+}
+
+// stakingValidators converts the bonded staking validators. Jailed validators
+// are inactive, matching the set the oracle derives its threshold from.
+func (k Keeper) stakingValidators(ctx sdk.Context) ([]types.Validator, error) {
+	stakingValidators, err := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	validators := make([]types.Validator, 0, len(stakingValidators))
 	for _, stakingVal := range stakingValidators {
 		pubKey, err := stakingVal.ConsPubKey()
@@ -412,89 +999,48 @@ func (k Keeper) getDefaultValidatorSet(ctx sdk.Context) types.ValidatorSet {
 			Address:  stakingVal.GetOperator(),
 			PubKey:   pubKey.Bytes(),
 			Power:    stakingVal.GetTokens().Int64(),
-			Active:   stakingVal.IsBonded(),
+			Active:   stakingVal.IsBonded() && !stakingVal.IsJailed(),
 			JoinedAt: ctx.BlockTime().Unix(),
 		}
 		validators = append(validators, validator)
 	}
-
-	threshold := (len(validators) * 2) / 3
-	if (len(validators)*2)%3 != 0 {
-		threshold++
-	}
-	if threshold < 1 {
-		threshold = 1
-	}
-
-	return types.ValidatorSet{
-		Validators:   validators,
-		Threshold:    int32(threshold),
-		UpdateHeight: ctx.BlockHeight(),
-		Version:      1,
-	}
+	return validators, nil
 }
 
 func (k Keeper) setValidatorSet(ctx sdk.Context, validatorSet types.ValidatorSet) {
-	store := ctx.KVStore(k.storeKey)
-	key := multisigtypes.GetValidatorSetKey()
-	bz := k.cdc.MustMarshal(&validatorSet)
-	store.Set(key, bz)
+	types.MustCollection(k.ValidatorSet.Set(ctx, validatorSet))
 }
 
 func (k Keeper) setValidator(ctx sdk.Context, validator types.Validator) {
-	store := ctx.KVStore(k.storeKey)
-	key := multisigtypes.GetValidatorKey(validator.Address)
-	bz := k.cdc.MustMarshal(&validator)
-	store.Set(key, bz)
+	types.MustCollection(k.Validators.Set(ctx, validator.Address, validator))
 }
 
 func (k Keeper) getValidator(ctx sdk.Context, address string) (types.Validator, bool) {
-	store := ctx.KVStore(k.storeKey)
-	key := multisigtypes.GetValidatorKey(address)
-	
-	bz := store.Get(key)
-	if bz == nil {
-		return types.Validator{}, false
-	}
-
-	var validator types.Validator
-	k.cdc.MustUnmarshal(bz, &validator)
-	return validator, true
+	return types.CollectionValue(ctx, k.Validators, address)
 }
 
 func (k Keeper) removeValidator(ctx sdk.Context, address string) {
-	store := ctx.KVStore(k.storeKey)
-	key := multisigtypes.GetValidatorKey(address)
-	store.Delete(key)
+	types.MustCollection(k.Validators.Remove(ctx, address))
 }
 
 func (k Keeper) validatorExists(ctx sdk.Context, address string) bool {
-	store := ctx.KVStore(k.storeKey)
-	key := multisigtypes.GetValidatorKey(address)
-	return store.Has(key)
+	return types.CollectionHas(ctx, k.Validators, address)
 }
 
 func (k Keeper) setMintCommand(ctx sdk.Context, command types.MintCommand) {
-	store := ctx.KVStore(k.storeKey)
-	key := multisigtypes.GetMintCommandKey(command.CommandID)
-	bz := k.cdc.MustMarshal(&command)
-	store.Set(key, bz)
+	types.MustCollection(k.MintCommands.Set(ctx, command.CommandID, command))
 }
 
-func (k Keeper) generateCommandID(ctx sdk.Context, targetChain, recipient string, amount math.Int) string {
+func (k Keeper) generateCommandID(ctx sdk.Context, targetChain, recipient, tokenID string, amount math.Int) string {
 	// Generate deterministic command ID based on block height, target chain, recipient, and amount
 	data := fmt.Sprintf("%d-%s-%s-%s", ctx.BlockHeight(), targetChain, recipient, amount.String())
+	if tokenID != "" {
+		data += "-" + tokenID
+	}
 	hash := sha256.Sum256([]byte(data))
 	return fmt.Sprintf("cmd-%x", hash[:8]) // Use first 8 bytes of hash
 }
 
-func (k Keeper) hashCommand(command types.MintCommand) []byte {
-	// Create hash of command for signing
-	data := fmt.Sprintf("%s-%s-%s-%s", command.CommandID, command.TargetChain, command.Recipient, command.Amount.String())
-	hash := sha256.Sum256([]byte(data))
-	return hash[:]
-}
-
 // GetAllPendingCommands returns all commands awaiting signatures
 func (k Keeper) GetAllPendingCommands(ctx sdk.Context) []types.MintCommand {
 	return k.getCommandsByStatus(ctx, int32(types.CommandStatusPending))
@@ -507,20 +1053,26 @@ func (k Keeper) GetSignedCommands(ctx sdk.Context) []types.MintCommand {
 
 // GetAllCommands returns all mint commands in the store
 func (k Keeper) GetAllCommands(ctx sdk.Context) []types.MintCommand {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, multisigtypes.MintCommandKeyPrefix)
-	defer iterator.Close()
-
-	commands := make([]types.MintCommand, 0)
-	for ; iterator.Valid(); iterator.Next() {
-		var command types.MintCommand
-		k.cdc.MustUnmarshal(iterator.Value(), &command)
-		commands = append(commands, command)
-	}
-	return commands
+	return types.CollectionValues(ctx, k.MintCommands, nil)
 }
 
-// getCommandsByStatus returns commands filtered by status
+// ImportCommand stores a mint command from genesis together with its
+// idempotency key index and the nonce of its target chain. Nonce gaps are
+// detected again from the imported commands at the end of the first block.
+func (k Keeper) ImportCommand(ctx sdk.Context, command types.MintCommand) {
+	k.setMintCommand(ctx, command)
+	if command.IdempotencyKey != "" {
+		k.setIdempotencyKey(ctx, command.IdempotencyKey, command.CommandID)
+	}
+	if command.Nonce > k.getCommandNonce(ctx, command.TargetChain) {
+		k.setCommandNonce(ctx, command.TargetChain, command.Nonce)
+	}
+	if command.Status == int32(types.CommandStatusExecuted) {
+		k.recordExecutedNonce(ctx, command)
+	}
+}
+
+// getCommandsByStatus returns commands filtered by status
 func (k Keeper) getCommandsByStatus(ctx sdk.Context, status int32) []types.MintCommand {
 	allCommands := k.GetAllCommands(ctx)
 	filtered := make([]types.MintCommand, 0)
@@ -532,50 +1084,33 @@ func (k Keeper) getCommandsByStatus(ctx sdk.Context, status int32) []types.MintC
 	return filtered
 }
 
-// ProcessPendingCommands processes all pending commands and collects signatures
-// This is called in EndBlock to automatically collect signatures from validators
+// SignCommand adds the signature a validator's own node or signer sidecar
+// submitted with MsgSignCommand. The signer must be the active validator the
+// signature is for, or its operator account, so a validator can only claim
+// its own signature. Signatures are never produced by the chain itself.
 // Requirement 5.2: Collect ECDSA signatures from active validators
-func (k Keeper) ProcessPendingCommands(ctx sdk.Context) error {
-	pendingCommands := k.GetAllPendingCommands(ctx)
-	validatorSet := k.GetValidatorSet(ctx)
-
-	for _, command := range pendingCommands {
-		// Each active validator signs the pending command
-		for _, validator := range validatorSet.Validators {
-			if !validator.Active {
-				continue
-			}
-
-			// Check if validator already signed
-			alreadySigned := false
-			for _, sig := range command.Signatures {
-				if sig.Validator == validator.Address {
-					alreadySigned = true
-					break
-				}
-			}
-
-			if alreadySigned {
-				continue
-			}
+func (k Keeper) SignCommand(ctx sdk.Context, signer, commandID string, signature types.ECDSASignature) error {
+	validator, found := k.activeValidatorOf(ctx, signer)
+	if !found {
+		return errorsmod.Wrapf(multisigtypes.ErrUnauthorized, "%s is not an active validator", signer)
+	}
+	if signature.Validator != validator.Address {
+		return errorsmod.Wrapf(multisigtypes.ErrUnauthorized, "%s cannot sign for validator %s", signer, signature.Validator)
+	}
 
-			// Sign the command
-			commandHash := k.hashCommand(command)
-			signature, err := k.SignData(ctx, validator.Address, commandHash)
-			if err != nil {
-				// Log error but continue with other validators
-				k.Logger(ctx).Error("failed to sign command", "command_id", command.CommandID, "validator", validator.Address, "error", err)
-				continue
-			}
+	return k.AddSignatureToCommand(types.WithCorrelationID(ctx, commandID), commandID, signature)
+}
 
-			// Add signature to command
-			if err := k.AddSignatureToCommand(ctx, command.CommandID, signature); err != nil {
-				k.Logger(ctx).Error("failed to add signature", "command_id", command.CommandID, "validator", validator.Address, "error", err)
-				continue
-			}
+// EvaluatePendingCommands marks every pending command whose counted signatures
+// reach the threshold as signed. Signatures arrive with MsgSignCommand, but
+// the threshold and the counted signatures change with the validator set and
+// probations, so this is called first in EndBlock.
+func (k Keeper) EvaluatePendingCommands(ctx sdk.Context) error {
+	for _, command := range k.GetAllPendingCommands(ctx) {
+		if err := k.CollectSignatures(types.WithCorrelationID(ctx, command.CommandID), command.CommandID); err != nil {
+			return err
 		}
 	}
-
 	return nil
 }
 
@@ -592,6 +1127,11 @@ func (k Keeper) MarkCommandExecuted(ctx sdk.Context, commandID string) error {
 
 	command.Status = int32(types.CommandStatusExecuted)
 	k.setMintCommand(ctx, command)
+	if command.IdempotencyKey != "" {
+		k.setExecutedKey(ctx, command.IdempotencyKey)
+	}
+	k.recordExecutedNonce(ctx, command)
+	k.recordProbationCycles(ctx, command)
 
 	// Emit command executed event
 	ctx.EventManager().EmitEvent(
@@ -602,4 +1142,780 @@ func (k Keeper) MarkCommandExecuted(ctx sdk.Context, commandID string) error {
 	)
 
 	return nil
-}
\ No newline at end of file
+}
+
+// ReportExecution records a relayer's report that the command with the given
+// idempotency key was executed on its target chain, together with the gas and
+// wei the execution cost. Keys already reported executed are acknowledged
+// without changing state, so duplicate submissions of the same Besu execution
+// are only counted, and charged, once.
+func (k Keeper) ReportExecution(ctx sdk.Context, reporter, idempotencyKey, txHash string, gasUsed uint64, costWei math.Int) (string, bool, error) {
+	if !k.isActiveValidatorAccount(ctx, reporter) {
+		return "", false, errorsmod.Wrapf(multisigtypes.ErrUnauthorized, "%s is not an active validator", reporter)
+	}
+
+	commandID, found := k.GetCommandIDByIdempotencyKey(ctx, idempotencyKey)
+	if !found {
+		return "", false, errorsmod.Wrapf(multisigtypes.ErrUnknownIdempotencyKey, "key %s", idempotencyKey)
+	}
+	ctx = types.WithCorrelationID(ctx, commandID)
+
+	if k.IsExecutionReported(ctx, idempotencyKey) {
+		k.Logger(ctx).Info("duplicate execution report ignored",
+			"command_id", commandID,
+			"reporter", reporter,
+			"tx_hash", txHash,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				multisigtypes.EventTypeDuplicateExecution,
+				sdk.NewAttribute(multisigtypes.AttributeKeyCommandID, commandID),
+				sdk.NewAttribute(multisigtypes.AttributeKeyIdempotencyKey, idempotencyKey),
+				sdk.NewAttribute(types.AttributeKeyReporter, reporter),
+				sdk.NewAttribute(types.AttributeKeyTxHash, txHash),
+			),
+		)
+		return commandID, true, nil
+	}
+
+	if err := k.MarkCommandExecuted(ctx, commandID); err != nil {
+		return "", false, err
+	}
+
+	if costWei.IsNil() {
+		costWei = math.ZeroInt()
+	}
+	command, _ := k.GetCommand(ctx, commandID)
+	k.recordExecutionCost(ctx, multisigtypes.ExecutionCost{
+		CommandID:      commandID,
+		TargetChain:    command.TargetChain,
+		TxHash:         txHash,
+		Reporter:       reporter,
+		GasUsed:        gasUsed,
+		CostWei:        costWei,
+		ExecutedAt:     ctx.BlockTime().Unix(),
+		ExecutedHeight: ctx.BlockHeight(),
+	})
+
+	k.Logger(ctx).Info("command execution reported",
+		"command_id", commandID,
+		"reporter", reporter,
+		"tx_hash", txHash,
+		"gas_used", gasUsed,
+		"cost_wei", costWei.String(),
+	)
+
+	return commandID, false, nil
+}
+
+// recordExecutionCost stores the cost of an executed command and indexes it
+// by target chain and execution time
+func (k Keeper) recordExecutionCost(ctx sdk.Context, cost multisigtypes.ExecutionCost) {
+	types.MustCollection(k.ExecutionCosts.Set(ctx, cost.CommandID, cost))
+	types.MustCollection(k.ExecutionCostIndex.Set(ctx, collections.Join3(cost.TargetChain, cost.ExecutedAt, cost.CommandID)))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			multisigtypes.EventTypeExecutionCostRecorded,
+			sdk.NewAttribute(multisigtypes.AttributeKeyCommandID, cost.CommandID),
+			sdk.NewAttribute(multisigtypes.AttributeKeyTargetChain, cost.TargetChain),
+			sdk.NewAttribute(types.AttributeKeyTxHash, cost.TxHash),
+			sdk.NewAttribute(multisigtypes.AttributeKeyGasUsed, strconv.FormatUint(cost.GasUsed, 10)),
+			sdk.NewAttribute(multisigtypes.AttributeKeyCostWei, cost.CostWei.String()),
+		),
+	)
+}
+
+// GetExecutionCost returns the reported execution cost of a command
+func (k Keeper) GetExecutionCost(ctx sdk.Context, commandID string) (multisigtypes.ExecutionCost, bool) {
+	return types.CollectionValue(ctx, k.ExecutionCosts, commandID)
+}
+
+// SummarizeExecutionCosts totals the execution costs per target chain of the
+// commands executed in [startTime, endTime]; a zero bound is open. An empty
+// target chain summarizes every chain, in chain order.
+func (k Keeper) SummarizeExecutionCosts(ctx sdk.Context, targetChain string, startTime, endTime int64) []multisigtypes.ExecutionCostSummary {
+	var ranger collections.Ranger[collections.Triple[string, int64, string]]
+	if targetChain != "" {
+		ranger = collections.NewPrefixedTripleRange[string, int64, string](targetChain)
+	}
+
+	iter, err := k.ExecutionCostIndex.Iterate(ctx, ranger)
+	types.MustCollection(err)
+	defer iter.Close()
+
+	summaries := []multisigtypes.ExecutionCostSummary{}
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		types.MustCollection(err)
+
+		executedAt := key.K2()
+		if executedAt < startTime || (endTime != 0 && executedAt > endTime) {
+			continue
+		}
+
+		cost, found := k.GetExecutionCost(ctx, key.K3())
+		if !found {
+			continue
+		}
+
+		if len(summaries) == 0 || summaries[len(summaries)-1].TargetChain != key.K1() {
+			summaries = append(summaries, multisigtypes.ExecutionCostSummary{
+				TargetChain: key.K1(),
+				StartTime:   startTime,
+				EndTime:     endTime,
+				CostWei:     math.ZeroInt(),
+			})
+		}
+		summaries[len(summaries)-1].Add(cost)
+	}
+
+	return summaries
+}
+
+// GetCommandIDByIdempotencyKey returns the command an idempotency key belongs to
+func (k Keeper) GetCommandIDByIdempotencyKey(ctx sdk.Context, idempotencyKey string) (string, bool) {
+	return types.CollectionValue(ctx, k.IdempotencyKeys, idempotencyKey)
+}
+
+// IsExecutionReported returns true if the idempotency key was reported executed
+func (k Keeper) IsExecutionReported(ctx sdk.Context, idempotencyKey string) bool {
+	found, err := k.Executed.Has(ctx, idempotencyKey)
+	types.MustCollection(err)
+	return found
+}
+
+// isActiveValidatorAccount accepts either a validator address or the account
+// address of a validator operator
+func (k Keeper) isActiveValidatorAccount(ctx sdk.Context, address string) bool {
+	_, found := k.activeValidatorOf(ctx, address)
+	return found
+}
+
+// activeValidatorOf returns the active validator of a validator address or of
+// the account address of a validator operator
+func (k Keeper) activeValidatorOf(ctx sdk.Context, address string) (types.Validator, bool) {
+	if validator, found := k.getValidator(ctx, address); found {
+		return validator, validator.Active
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return types.Validator{}, false
+	}
+	validator, found := k.getValidator(ctx, sdk.ValAddress(accAddr).String())
+	return validator, found && validator.Active
+}
+
+func (k Keeper) getCommandNonce(ctx sdk.Context, targetChain string) uint64 {
+	nonce, _ := types.CollectionValue(ctx, k.CommandNonces, targetChain)
+	return nonce
+}
+
+func (k Keeper) setCommandNonce(ctx sdk.Context, targetChain string, nonce uint64) {
+	types.MustCollection(k.CommandNonces.Set(ctx, targetChain, nonce))
+}
+
+func (k Keeper) setIdempotencyKey(ctx sdk.Context, idempotencyKey, commandID string) {
+	types.MustCollection(k.IdempotencyKeys.Set(ctx, idempotencyKey, commandID))
+}
+
+func (k Keeper) setExecutedKey(ctx sdk.Context, idempotencyKey string) {
+	types.MustCollection(k.Executed.Set(ctx, idempotencyKey))
+}
+
+// BatchSignedCommands groups the signed commands that are not batched yet by
+// target chain and commits each group to a Merkle root. Active validators sign
+// the root once per batch instead of once per command with MsgSignBatch, so a
+// gateway can accept all commands of the batch with one signature set and
+// per-command proofs. The chain never signs a root itself. This is called
+// last in EndBlock, after EvaluatePendingBatches and ExpirePendingBatches.
+func (k Keeper) BatchSignedCommands(ctx sdk.Context) error {
+	commandsByChain := make(map[string][]types.MintCommand)
+	for _, command := range k.GetSignedCommands(ctx) {
+		if k.isCommandBatched(ctx, command.CommandID) {
+			continue
+		}
+		commandsByChain[command.TargetChain] = append(commandsByChain[command.TargetChain], command)
+	}
+
+	// Iterate chains in a fixed order to keep state transitions deterministic
+	chains := make([]string, 0, len(commandsByChain))
+	for chain := range commandsByChain {
+		chains = append(chains, chain)
+	}
+	sort.Strings(chains)
+
+	params := k.GetParams(ctx)
+	for _, chain := range chains {
+		commands := commandsByChain[chain]
+		sort.Slice(commands, func(i, j int) bool {
+			return commands[i].CommandID < commands[j].CommandID
+		})
+
+		commandIDs := make([]string, len(commands))
+		for i, command := range commands {
+			commandIDs[i] = command.CommandID
+		}
+
+		algorithm := params.CommandHashAlgorithm(chain)
+		batch := multisigtypes.CommandBatch{
+			BatchID:       k.generateBatchID(ctx, chain),
+			TargetChain:   chain,
+			BlockHeight:   ctx.BlockHeight(),
+			CommandIDs:    commandIDs,
+			Root:          multisigtypes.MerkleRoot(algorithm, k.commandLeaves(algorithm, commands)),
+			Signatures:    []types.ECDSASignature{},
+			Status:        multisigtypes.BatchStatusPending,
+			CreatedAt:     ctx.BlockTime().Unix(),
+			HashAlgorithm: algorithm,
+		}
+
+		k.setCommandBatch(ctx, batch)
+		types.MustCollection(k.PendingBatches.Set(ctx, batch.BatchID))
+		for _, commandID := range commandIDs {
+			k.setCommandBatchIndex(ctx, commandID, batch.BatchID)
+		}
+
+		k.Logger(ctx).Info("command batch created",
+			"batch_id", batch.BatchID,
+			"target_chain", chain,
+			"command_count", len(commandIDs),
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				multisigtypes.EventTypeCommandBatchCreated,
+				sdk.NewAttribute(multisigtypes.AttributeKeyBatchID, batch.BatchID),
+				sdk.NewAttribute(multisigtypes.AttributeKeyTargetChain, chain),
+				sdk.NewAttribute(multisigtypes.AttributeKeyMerkleRoot, hex.EncodeToString(batch.Root)),
+				sdk.NewAttribute(types.AttributeKeyHashAlgorithm, algorithm.String()),
+				sdk.NewAttribute(multisigtypes.AttributeKeyCommandCount, strconv.Itoa(len(commandIDs))),
+			),
+		)
+	}
+
+	return nil
+}
+
+// SignBatch adds the root signature a validator's own node or signer sidecar
+// submitted with MsgSignBatch. As with SignCommand, the signer must be the
+// active validator the signature is for, or its operator account.
+func (k Keeper) SignBatch(ctx sdk.Context, signer, batchID string, signature types.ECDSASignature) error {
+	validator, found := k.activeValidatorOf(ctx, signer)
+	if !found {
+		return errorsmod.Wrapf(multisigtypes.ErrUnauthorized, "%s is not an active validator", signer)
+	}
+	if signature.Validator != validator.Address {
+		return errorsmod.Wrapf(multisigtypes.ErrUnauthorized, "%s cannot sign for validator %s", signer, signature.Validator)
+	}
+
+	return k.AddSignatureToBatch(types.WithCorrelationID(ctx, batchID), batchID, signature)
+}
+
+// AddSignatureToBatch adds a signature over the Merkle root of a batch
+func (k Keeper) AddSignatureToBatch(ctx sdk.Context, batchID string, signature types.ECDSASignature) error {
+	batch, found := k.GetCommandBatch(ctx, batchID)
+	if !found {
+		return multisigtypes.ErrBatchNotFound
+	}
+
+	switch batch.Status {
+	case multisigtypes.BatchStatusPending:
+	case multisigtypes.BatchStatusExpired:
+		return errorsmod.Wrapf(multisigtypes.ErrCommandExpired, "batch %s", batchID)
+	default:
+		return errorsmod.Wrapf(multisigtypes.ErrInvalidCommandStatus, "batch %s is not pending", batchID)
+	}
+
+	for _, sig := range batch.Signatures {
+		if sig.Validator == signature.Validator {
+			return multisigtypes.ErrDuplicateSignature
+		}
+	}
+
+	if !k.VerifyECDSASignature(ctx, batch.Root, signature) {
+		return multisigtypes.ErrInvalidECDSASignature
+	}
+
+	batch.Signatures = append(batch.Signatures, signature)
+	k.setCommandBatch(ctx, batch)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			multisigtypes.EventTypeBatchRootSigned,
+			sdk.NewAttribute(multisigtypes.AttributeKeyBatchID, batchID),
+			sdk.NewAttribute(types.AttributeKeyValidator, signature.Validator),
+			sdk.NewAttribute(multisigtypes.AttributeKeySignatureCount, strconv.Itoa(len(batch.Signatures))),
+		),
+	)
+
+	k.evaluateBatch(ctx, batch)
+	return nil
+}
+
+// EvaluatePendingBatches marks every pending batch whose counted root
+// signatures reach the threshold as signed. Like EvaluatePendingCommands it
+// runs in EndBlock because the threshold changes with the validator set.
+func (k Keeper) EvaluatePendingBatches(ctx sdk.Context) error {
+	for _, batch := range k.getPendingBatches(ctx) {
+		k.evaluateBatch(types.WithCorrelationID(ctx, batch.BatchID), batch)
+	}
+	return nil
+}
+
+// ExpirePendingBatches marks every pending batch that is still short of the
+// threshold SigningTimeout seconds after creation as expired and emits
+// command_batch_expired. Its commands are released from the batch, so
+// BatchSignedCommands commits them to a new root in the same EndBlock.
+func (k Keeper) ExpirePendingBatches(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	now := ctx.BlockTime().Unix()
+
+	for _, batch := range k.getPendingBatches(ctx) {
+		if now-batch.CreatedAt < params.SigningTimeout {
+			continue
+		}
+		k.expireBatch(types.WithCorrelationID(ctx, batch.BatchID), batch)
+	}
+
+	return nil
+}
+
+// expireBatch marks a pending batch as expired and releases its commands
+func (k Keeper) expireBatch(ctx sdk.Context, batch multisigtypes.CommandBatch) {
+	batch.Status = multisigtypes.BatchStatusExpired
+	k.setCommandBatch(ctx, batch)
+	types.MustCollection(k.PendingBatches.Remove(ctx, batch.BatchID))
+	for _, commandID := range batch.CommandIDs {
+		types.MustCollection(k.CommandBatchIndex.Remove(ctx, commandID))
+	}
+
+	k.Logger(ctx).Info("command batch expired",
+		"batch_id", batch.BatchID,
+		"signatures", len(batch.Signatures),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			multisigtypes.EventTypeCommandBatchExpired,
+			sdk.NewAttribute(multisigtypes.AttributeKeyBatchID, batch.BatchID),
+			sdk.NewAttribute(multisigtypes.AttributeKeyTargetChain, batch.TargetChain),
+			sdk.NewAttribute(multisigtypes.AttributeKeySignatureCount, strconv.Itoa(len(batch.Signatures))),
+		),
+	)
+}
+
+// evaluateBatch marks a pending batch as signed once its counted root
+// signatures reach the threshold. Signatures of validators on probation are
+// kept but don't count.
+func (k Keeper) evaluateBatch(ctx sdk.Context, batch multisigtypes.CommandBatch) {
+	if batch.Status != multisigtypes.BatchStatusPending {
+		return
+	}
+
+	threshold := k.GetValidatorSet(ctx).Threshold
+	counted := k.countedSignatures(ctx, batch.Signatures)
+	if int32(len(counted)) < threshold {
+		return
+	}
+
+	batch.Status = multisigtypes.BatchStatusSigned
+	k.setCommandBatch(ctx, batch)
+	types.MustCollection(k.PendingBatches.Remove(ctx, batch.BatchID))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			multisigtypes.EventTypeCommandBatchSigned,
+			sdk.NewAttribute(multisigtypes.AttributeKeyBatchID, batch.BatchID),
+			sdk.NewAttribute(multisigtypes.AttributeKeyTargetChain, batch.TargetChain),
+			sdk.NewAttribute(multisigtypes.AttributeKeyMerkleRoot, hex.EncodeToString(batch.Root)),
+			sdk.NewAttribute(types.AttributeKeyHashAlgorithm, batch.HashAlgorithm.String()),
+			sdk.NewAttribute(multisigtypes.AttributeKeyCommandCount, strconv.Itoa(len(batch.CommandIDs))),
+			sdk.NewAttribute(multisigtypes.AttributeKeySignatureCount, strconv.Itoa(len(counted))),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatInt(int64(threshold), 10)),
+		),
+	)
+}
+
+// getPendingBatches returns the batches awaiting root signatures, in batch ID order
+func (k Keeper) getPendingBatches(ctx sdk.Context) []multisigtypes.CommandBatch {
+	var batches []multisigtypes.CommandBatch
+	types.MustCollection(k.PendingBatches.Walk(ctx, nil, func(batchID string) (bool, error) {
+		batch, found := k.GetCommandBatch(ctx, batchID)
+		if found {
+			batches = append(batches, batch)
+		}
+		return false, nil
+	}))
+	return batches
+}
+
+// GetCommandBatch retrieves a command batch by ID
+func (k Keeper) GetCommandBatch(ctx sdk.Context, batchID string) (multisigtypes.CommandBatch, bool) {
+	return types.CollectionValue(ctx, k.CommandBatches, batchID)
+}
+
+// GetAllCommandBatches returns all command batches in the store
+func (k Keeper) GetAllCommandBatches(ctx sdk.Context) []multisigtypes.CommandBatch {
+	return types.CollectionValues(ctx, k.CommandBatches, nil)
+}
+
+// GetCommandBatchProof returns the Merkle proof that a command is part of its
+// batch, together with the batch's root signatures
+func (k Keeper) GetCommandBatchProof(ctx sdk.Context, commandID string) (multisigtypes.CommandBatchProof, error) {
+	batchID, found := types.CollectionValue(ctx, k.CommandBatchIndex, commandID)
+	if !found {
+		return multisigtypes.CommandBatchProof{}, multisigtypes.ErrCommandNotBatched
+	}
+
+	batch, found := k.GetCommandBatch(ctx, batchID)
+	if !found {
+		return multisigtypes.CommandBatchProof{}, multisigtypes.ErrBatchNotFound
+	}
+
+	commands := make([]types.MintCommand, 0, len(batch.CommandIDs))
+	index := -1
+	for i, id := range batch.CommandIDs {
+		command, found := k.GetCommand(ctx, id)
+		if !found {
+			return multisigtypes.CommandBatchProof{}, multisigtypes.ErrCommandNotFound
+		}
+		commands = append(commands, command)
+		if id == commandID {
+			index = i
+		}
+	}
+	if index < 0 {
+		return multisigtypes.CommandBatchProof{}, multisigtypes.ErrCommandNotBatched
+	}
+
+	leaves := k.commandLeaves(batch.HashAlgorithm, commands)
+	return multisigtypes.CommandBatchProof{
+		BatchID:       batch.BatchID,
+		CommandID:     commandID,
+		Root:          batch.Root,
+		LeafHash:      leaves[index],
+		Index:         uint32(index),
+		LeafCount:     uint32(len(leaves)),
+		Path:          multisigtypes.MerkleAuditPath(batch.HashAlgorithm, leaves, index),
+		Signatures:    batch.Signatures,
+		HashAlgorithm: batch.HashAlgorithm,
+	}, nil
+}
+
+// VerifyCommandBatchProof checks the audit path of a batch proof from an
+// untrusted source. The proof must be within the MaxProofDepth and
+// MaxValidatorCount params before any hash is computed.
+func (k Keeper) VerifyCommandBatchProof(ctx sdk.Context, proof multisigtypes.CommandBatchProof) error {
+	params := k.GetParams(ctx)
+	if err := proof.CheckLimits(params.MaxProofDepth, params.MaxValidatorCount); err != nil {
+		return errorsmod.Wrap(multisigtypes.ErrProofTooLarge, err.Error())
+	}
+
+	if !proof.Verify() {
+		return errorsmod.Wrapf(multisigtypes.ErrInvalidProof, "command %s is not in batch %s", proof.CommandID, proof.BatchID)
+	}
+	return nil
+}
+
+// commandLeaves returns the Merkle leaf hashes of commands, in order, over
+// the command hashes of their own algorithm
+func (k Keeper) commandLeaves(algorithm types.HashAlgorithm, commands []types.MintCommand) [][]byte {
+	leaves := make([][]byte, len(commands))
+	for i, command := range commands {
+		leaves[i] = multisigtypes.MerkleLeafHash(algorithm, multisigtypes.CommandHash(command))
+	}
+	return leaves
+}
+
+func (k Keeper) generateBatchID(ctx sdk.Context, targetChain string) string {
+	// One batch per target chain per block
+	data := fmt.Sprintf("batch-%d-%s", ctx.BlockHeight(), targetChain)
+	hash := sha256.Sum256([]byte(data))
+	return fmt.Sprintf("batch-%x", hash[:8])
+}
+
+func (k Keeper) isCommandBatched(ctx sdk.Context, commandID string) bool {
+	return types.CollectionHas(ctx, k.CommandBatchIndex, commandID)
+}
+
+func (k Keeper) setCommandBatch(ctx sdk.Context, batch multisigtypes.CommandBatch) {
+	types.MustCollection(k.CommandBatches.Set(ctx, batch.BatchID, batch))
+}
+
+func (k Keeper) setCommandBatchIndex(ctx sdk.Context, commandID, batchID string) {
+	types.MustCollection(k.CommandBatchIndex.Set(ctx, commandID, batchID))
+}
+
+// GetParams returns the module parameters, or the defaults if none are stored
+func (k Keeper) GetParams(ctx sdk.Context) multisigtypes.Params {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return multisigtypes.DefaultParams()
+	}
+	types.MustCollection(err)
+	return params
+}
+
+// SetParams stores the module parameters
+func (k Keeper) SetParams(ctx sdk.Context, params multisigtypes.Params) {
+	types.MustCollection(k.Params.Set(ctx, params))
+}
+
+// LateSigners returns the active validators that have not signed a command,
+// in validator set order
+func (k Keeper) LateSigners(ctx sdk.Context, command types.MintCommand) []string {
+	signed := make(map[string]bool, len(command.Signatures))
+	for _, sig := range command.Signatures {
+		signed[sig.Validator] = true
+	}
+
+	late := []string{}
+	for _, validator := range k.GetValidatorSet(ctx).Validators {
+		if validator.Active && !signed[validator.Address] {
+			late = append(late, validator.Address)
+		}
+	}
+	return late
+}
+
+// EscalateLateSigners emits a signing_escalated event, once per command, for
+// every pending command that has been waiting for signatures longer than
+// EscalationPercent of SigningTimeout, listing the validators that have not
+// signed yet. This is called in EndBlock after EvaluatePendingCommands.
+func (k Keeper) EscalateLateSigners(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	now := ctx.BlockTime().Unix()
+
+	for _, command := range k.GetAllPendingCommands(ctx) {
+		if now-command.CreatedAt < params.EscalationAge() || k.IsSigningEscalated(ctx, command.CommandID) {
+			continue
+		}
+
+		late := k.LateSigners(ctx, command)
+		if len(late) == 0 {
+			continue
+		}
+		ctx := types.WithCorrelationID(ctx, command.CommandID)
+		deadline := command.CreatedAt + params.SigningTimeout
+
+		k.Logger(ctx).Info("command signing escalated",
+			"command_id", command.CommandID,
+			"late_signers", late,
+			"deadline", deadline,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				multisigtypes.EventTypeSigningEscalated,
+				sdk.NewAttribute(multisigtypes.AttributeKeyCommandID, command.CommandID),
+				sdk.NewAttribute(multisigtypes.AttributeKeyTargetChain, command.TargetChain),
+				sdk.NewAttribute(multisigtypes.AttributeKeyLateSigners, strings.Join(late, ",")),
+				sdk.NewAttribute(multisigtypes.AttributeKeySignatureCount, strconv.Itoa(len(command.Signatures))),
+				sdk.NewAttribute(multisigtypes.AttributeKeyDeadline, strconv.FormatInt(deadline, 10)),
+			),
+		)
+
+		types.MustCollection(k.SigningEscalations.Set(ctx, command.CommandID))
+	}
+
+	return nil
+}
+
+// IsSigningEscalated returns true if a command's late signers were escalated
+func (k Keeper) IsSigningEscalated(ctx sdk.Context, commandID string) bool {
+	found, err := k.SigningEscalations.Has(ctx, commandID)
+	types.MustCollection(err)
+	return found
+}
+
+// ExpirePendingCommands marks every pending command that is still short of
+// the signature threshold SigningTimeout seconds after creation as failed and
+// emits command_expired. Expired commands accept no further signatures, so a
+// validator coming back online cannot revive them. This is called in EndBlock
+// after EvaluatePendingCommands, so a command reaching the threshold in the
+// block of its deadline is signed rather than expired.
+func (k Keeper) ExpirePendingCommands(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	now := ctx.BlockTime().Unix()
+	threshold := k.GetValidatorSet(ctx).Threshold
+
+	for _, command := range k.GetAllPendingCommands(ctx) {
+		if now-command.CreatedAt < params.SigningTimeout {
+			continue
+		}
+		ctx := types.WithCorrelationID(ctx, command.CommandID)
+
+		command.Status = int32(types.CommandStatusFailed)
+		k.setMintCommand(ctx, command)
+		k.recordProbationCycles(ctx, command)
+
+		k.Logger(ctx).Info("mint command expired",
+			"command_id", command.CommandID,
+			"signatures", len(command.Signatures),
+			"threshold", threshold,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				multisigtypes.EventTypeCommandExpired,
+				sdk.NewAttribute(multisigtypes.AttributeKeyCommandID, command.CommandID),
+				sdk.NewAttribute(multisigtypes.AttributeKeyTargetChain, command.TargetChain),
+				sdk.NewAttribute(multisigtypes.AttributeKeySignatureCount, strconv.Itoa(len(command.Signatures))),
+				sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatInt(int64(threshold), 10)),
+			),
+		)
+	}
+
+	return nil
+}
+
+// recordExecutedNonce raises the highest executed nonce of the command's
+// target chain
+func (k Keeper) recordExecutedNonce(ctx sdk.Context, command types.MintCommand) {
+	if command.Nonce > k.getExecutedNonce(ctx, command.TargetChain) {
+		types.MustCollection(k.ExecutedNonces.Set(ctx, command.TargetChain, command.Nonce))
+	}
+}
+
+func (k Keeper) getExecutedNonce(ctx sdk.Context, targetChain string) uint64 {
+	nonce, _ := types.CollectionValue(ctx, k.ExecutedNonces, targetChain)
+	return nonce
+}
+
+// GetNonceGap returns the open nonce gap of a target chain
+func (k Keeper) GetNonceGap(ctx sdk.Context, targetChain string) (multisigtypes.NonceGap, bool) {
+	return types.CollectionValue(ctx, k.NonceGaps, targetChain)
+}
+
+// GetAllNonceGaps returns the open nonce gaps in target chain order
+func (k Keeper) GetAllNonceGaps(ctx sdk.Context) []multisigtypes.NonceGap {
+	return types.CollectionValues(ctx, k.NonceGaps, nil)
+}
+
+// DetectNonceGaps compares the signed commands of every target chain against
+// the highest nonce reported executed on it. A signed command with a lower
+// nonce was skipped on Besu: the first block that sees it opens a nonce gap,
+// emits nonce_gap_detected and pauses command generation for the chain. A gap
+// whose skipped commands were all reported executed since is closed with
+// nonce_gap_resolved. Detection runs in EndBlock rather than per report, so
+// the executions of one batch may be reported in any order within a block.
+func (k Keeper) DetectNonceGaps(ctx sdk.Context) error {
+	missing := make(map[string][]uint64)
+	for _, command := range k.GetSignedCommands(ctx) {
+		if command.Nonce < k.getExecutedNonce(ctx, command.TargetChain) {
+			missing[command.TargetChain] = append(missing[command.TargetChain], command.Nonce)
+		}
+	}
+
+	for _, gap := range k.GetAllNonceGaps(ctx) {
+		if _, open := missing[gap.TargetChain]; !open {
+			k.closeNonceGap(ctx, gap, "")
+		}
+	}
+
+	chains := make([]string, 0, len(missing))
+	for chain := range missing {
+		chains = append(chains, chain)
+	}
+	sort.Strings(chains)
+
+	for _, chain := range chains {
+		nonces := missing[chain]
+		sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+		gap, found := k.GetNonceGap(ctx, chain)
+		if !found {
+			gap = multisigtypes.NonceGap{
+				TargetChain:    chain,
+				DetectedAt:     ctx.BlockTime().Unix(),
+				DetectedHeight: ctx.BlockHeight(),
+			}
+		}
+		gap.MissingNonces = nonces
+		gap.HighestExecuted = k.getExecutedNonce(ctx, chain)
+		types.MustCollection(k.NonceGaps.Set(ctx, chain, gap))
+
+		if found {
+			continue
+		}
+
+		k.Logger(ctx).Error("nonce gap detected, command generation paused",
+			"target_chain", chain,
+			"missing_nonces", nonces,
+			"highest_executed", gap.HighestExecuted,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				multisigtypes.EventTypeNonceGapDetected,
+				sdk.NewAttribute(multisigtypes.AttributeKeyTargetChain, chain),
+				sdk.NewAttribute(multisigtypes.AttributeKeyMissingNonces, formatNonces(nonces)),
+				sdk.NewAttribute(multisigtypes.AttributeKeyHighestExecuted, strconv.FormatUint(gap.HighestExecuted, 10)),
+			),
+		)
+	}
+
+	return nil
+}
+
+// ResolveNonceGap closes the nonce gap of a target chain whose skipped
+// commands will not be executed, e.g. after the gateway moved past them. The
+// skipped commands are marked failed, so they are not reported missing again,
+// and command generation for the chain resumes.
+func (k Keeper) ResolveNonceGap(ctx sdk.Context, targetChain, authority string) (multisigtypes.NonceGap, error) {
+	gap, found := k.GetNonceGap(ctx, targetChain)
+	if !found {
+		return multisigtypes.NonceGap{}, errorsmod.Wrapf(multisigtypes.ErrNonceGapNotFound, "chain %s", targetChain)
+	}
+
+	highest := k.getExecutedNonce(ctx, targetChain)
+	for _, command := range k.GetSignedCommands(ctx) {
+		if command.TargetChain != targetChain || command.Nonce >= highest {
+			continue
+		}
+		command.Status = int32(types.CommandStatusFailed)
+		k.setMintCommand(ctx, command)
+		k.recordProbationCycles(ctx, command)
+
+		k.Logger(types.WithCorrelationID(ctx, command.CommandID)).Info("skipped mint command failed",
+			"command_id", command.CommandID,
+			"nonce", command.Nonce,
+		)
+	}
+
+	k.closeNonceGap(ctx, gap, authority)
+	return gap, nil
+}
+
+// closeNonceGap removes a nonce gap and emits nonce_gap_resolved. The resolver
+// is empty when the skipped commands were reported executed.
+func (k Keeper) closeNonceGap(ctx sdk.Context, gap multisigtypes.NonceGap, resolvedBy string) {
+	types.MustCollection(k.NonceGaps.Remove(ctx, gap.TargetChain))
+
+	k.Logger(ctx).Info("nonce gap resolved, command generation resumed",
+		"target_chain", gap.TargetChain,
+		"missing_nonces", gap.MissingNonces,
+		"resolved_by", resolvedBy,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			multisigtypes.EventTypeNonceGapResolved,
+			sdk.NewAttribute(multisigtypes.AttributeKeyTargetChain, gap.TargetChain),
+			sdk.NewAttribute(multisigtypes.AttributeKeyMissingNonces, formatNonces(gap.MissingNonces)),
+			sdk.NewAttribute(multisigtypes.AttributeKeyResolvedBy, resolvedBy),
+		),
+	)
+}
+
+// formatNonces encodes nonces as a comma-separated list
+func formatNonces(nonces []uint64) string {
+	encoded := make([]string, len(nonces))
+	for i, nonce := range nonces {
+		encoded[i] = strconv.FormatUint(nonce, 10)
+	}
+	return strings.Join(encoded, ",")
+}
@@ -2,23 +2,38 @@ package keeper_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
 	"github.com/leanovate/gopter/prop"
 	"github.com/stretchr/testify/require"
 
 	"github.com/interbank-netting/cosmos/types"
+	"github.com/interbank-netting/cosmos/x/multisig/client/cli"
 	"github.com/interbank-netting/cosmos/x/multisig/keeper"
+	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
 )
 
 // **Feature: interbank-netting-engine, Property 7: 서명 검증**
@@ -390,9 +405,337 @@ func TestSignData_UnregisteredValidator(t *testing.T) {
 	require.Error(t, err)
 }
 
+// **Unit Test: 스테이킹과 다중 서명 검증자 집합 불일치 감지 및 재조정**
+func TestValidatorSetConsistency_DetectsAndReconcilesDrift(t *testing.T) {
+	ctx, k, stakingKeeper := setupMultisigTestEnvironmentWithStaking(t)
+
+	validators := generateValidators(3)
+	require.NoError(t, k.UpdateValidatorSet(ctx, validators))
+
+	// Validator 3 is jailed and a fourth validator joined staking
+	joined := sdk.ValAddress([]byte{4}).String()
+	stakingKeeper.SetBonded(validators[0].Address, false)
+	stakingKeeper.SetBonded(validators[1].Address, false)
+	stakingKeeper.SetBonded(validators[2].Address, true)
+	stakingKeeper.SetBonded(joined, false)
+
+	consistency, err := k.CheckValidatorSetConsistency(ctx)
+	require.NoError(t, err)
+	require.False(t, consistency.Consistent)
+	require.Equal(t, []string{joined}, consistency.MissingFromMultisig)
+	require.Equal(t, []string{validators[2].Address}, consistency.ExtraInMultisig)
+	require.Equal(t, int32(2), consistency.StakingThreshold)
+
+	_, broken := keeper.ValidatorSetConsistencyInvariant(*k)(ctx)
+	require.True(t, broken)
+
+	querier := keeper.NewQueryServerImpl(*k)
+	res, err := querier.ValidatorSetConsistency(ctx, &multisigtypes.QueryValidatorSetConsistencyRequest{})
+	require.NoError(t, err)
+	require.False(t, res.Consistency.Consistent)
+	require.Equal(t, uint64(2), res.ValidatorSetVersion)
+
+	reconciled, err := k.ReconcileValidatorSet(ctx)
+	require.NoError(t, err)
+	require.True(t, reconciled)
+
+	validatorSet := k.GetValidatorSet(ctx)
+	require.Len(t, validatorSet.Validators, 3)
+	require.Equal(t, int32(2), validatorSet.Threshold)
+	require.Equal(t, validators[0].PubKey, validatorSet.Validators[0].PubKey) // Registered key kept
+
+	_, err = k.SignData(ctx, validators[2].Address, []byte("test"))
+	require.Error(t, err) // Jailed validator removed
+
+	_, broken = keeper.ValidatorSetConsistencyInvariant(*k)(ctx)
+	require.False(t, broken)
+
+	reconciled, err = k.ReconcileValidatorSet(ctx)
+	require.NoError(t, err)
+	require.False(t, reconciled)
+}
+
+// **Unit Test: 서명 완료 명령 이벤트의 게이트웨이 호출 정보**
+func TestThresholdReached_EmitsGatewayCallContext(t *testing.T) {
+	ctx, k := setupMultisigTestEnvironment(t)
+	require.NoError(t, k.UpdateValidatorSet(ctx, generateValidators(3)))
+
+	command, err := k.GenerateMintCommand(ctx, "bank-a", "recipient1", math.NewInt(1000))
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, signPendingCommands(ctx, k))
+
+	attributes := map[string]string{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == multisigtypes.EventTypeThresholdReached {
+			for _, attribute := range event.Attributes {
+				attributes[attribute.Key] = attribute.Value
+			}
+		}
+	}
+	signed, _ := k.GetCommand(ctx, command.CommandID)
+
+	require.Equal(t, command.CommandID, attributes[multisigtypes.AttributeKeyCommandID])
+	require.Equal(t, "recipient1", attributes[types.AttributeKeyRecipient])
+	require.Equal(t, "1000", attributes[types.AttributeKeyAmount])
+	require.Equal(t, "1", attributes[types.AttributeKeyNonce])
+	require.Equal(t, command.IdempotencyKey, attributes[multisigtypes.AttributeKeyIdempotencyKey])
+	require.Equal(t, "2", attributes[types.AttributeKeyValidatorSetVersion])
+	require.Len(t, attributes[types.AttributeKeyPayloadHash], 64)
+
+	var signatures []struct {
+		Validator string `json:"validator"`
+		R         string `json:"r"`
+		S         string `json:"s"`
+		V         uint32 `json:"v"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(attributes[types.AttributeKeySignatures]), &signatures))
+	require.Len(t, signatures, len(signed.Signatures))
+	require.Equal(t, signed.Signatures[0].Validator, signatures[0].Validator)
+	require.True(t, strings.HasPrefix(signatures[0].R, "0x"))
+}
+
+// **Unit Test: 자산별 래핑 토큰 발행 명령**
+func TestGenerateTokenMintCommand_BindsTokenToSignatures(t *testing.T) {
+	ctx, k := setupMultisigTestEnvironment(t)
+	require.NoError(t, k.UpdateValidatorSet(ctx, generateValidators(3)))
+
+	command, err := k.GenerateTokenMintCommand(ctx, "bank-a", "recipient1", "USD-stable", math.NewInt(1000))
+	require.NoError(t, err)
+	require.Equal(t, "USD-stable", command.TokenID)
+
+	// Same transfer in the default token is a different command
+	other, err := k.GenerateMintCommand(ctx, "bank-a", "recipient1", math.NewInt(1000))
+	require.NoError(t, err)
+	require.NotEqual(t, command.CommandID, other.CommandID)
+	require.Empty(t, other.TokenID)
+
+	// The token is part of the signed payload
+	require.NoError(t, signPendingCommands(ctx, k))
+	payloadHashes := map[string]string{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "threshold_reached" {
+			continue
+		}
+		attrs := map[string]string{}
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		payloadHashes[attrs["token_id"]] = attrs[types.AttributeKeyPayloadHash]
+	}
+	require.Len(t, payloadHashes, 2)
+	require.NotEqual(t, payloadHashes["USD-stable"], payloadHashes[""])
+
+	_, err = k.GenerateTokenMintCommand(ctx, "bank-a", "recipient1", "USD stable", math.NewInt(1000))
+	require.ErrorIs(t, err, multisigtypes.ErrInvalidTokenID)
+}
+
+// **Unit Test: 지급 주소 바인딩**
+func TestGenerateMintCommand_RequiresBoundPayoutAddress(t *testing.T) {
+	ctx, k := setupMultisigTestEnvironment(t)
+	require.NoError(t, k.UpdateValidatorSet(ctx, generateValidators(3)))
+
+	bound := "0x1111111111111111111111111111111111111111"
+	params := multisigtypes.DefaultParams()
+	params.PayoutAddresses = []multisigtypes.PayoutAddresses{
+		{Chain: "bank-a", Addresses: []string{bound, "0xAbCdEf0123456789aBcDeF0123456789AbCdEf01"}},
+	}
+	require.NoError(t, params.Validate())
+	k.SetParams(ctx, params)
+
+	_, err := k.GenerateMintCommand(ctx, "bank-a", bound, math.NewInt(1000))
+	require.NoError(t, err)
+
+	// Addresses compare case-insensitively
+	_, err = k.GenerateMintCommand(ctx, "bank-a", "0xabcdef0123456789abcdef0123456789abcdef01", math.NewInt(1000))
+	require.NoError(t, err)
+
+	_, err = k.GenerateMintCommand(ctx, "bank-a", "0x2222222222222222222222222222222222222222", math.NewInt(1000))
+	require.ErrorIs(t, err, multisigtypes.ErrUnboundRecipient)
+
+	// Chains without bindings accept no recipient once any binding exists
+	_, err = k.GenerateMintCommand(ctx, "bank-b", bound, math.NewInt(1000))
+	require.ErrorIs(t, err, multisigtypes.ErrUnboundRecipient)
+
+	params.PayoutAddresses = append(params.PayoutAddresses, multisigtypes.PayoutAddresses{Chain: "bank-b", Addresses: []string{"recipient1"}})
+	require.Error(t, params.Validate())
+}
+
+// **Unit Test: 검증자 수습 기간**
+func TestAdmitValidator_CountsSignaturesAfterProbation(t *testing.T) {
+	ctx, k := setupMultisigTestEnvironment(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	validators := generateValidators(4)
+	require.NoError(t, k.UpdateValidatorSet(ctx, validators[:3]))
+	newcomer := validators[3]
+
+	_, err := msgServer.AdmitValidator(ctx, multisigtypes.NewMsgAdmitValidator(validators[0].Address, newcomer, 2))
+	require.ErrorIs(t, err, multisigtypes.ErrUnauthorized)
+
+	resp, err := msgServer.AdmitValidator(ctx, multisigtypes.NewMsgAdmitValidator(k.GetAuthority(), newcomer, 2))
+	require.NoError(t, err)
+	require.True(t, resp.Probation)
+	require.Equal(t, int32(2), resp.Threshold) // Two of the three full validators
+
+	sign := func(command types.MintCommand, signers ...types.Validator) {
+		for _, signer := range signers {
+			signature, err := k.SignData(ctx, signer.Address, []byte(command.CommandID))
+			require.NoError(t, err)
+			require.NoError(t, k.AddSignatureToCommand(ctx, command.CommandID, signature))
+		}
+	}
+	execute := func(command types.MintCommand) {
+		require.NoError(t, k.MarkCommandExecuted(ctx, command.CommandID))
+	}
+
+	// The newcomer's signature is kept but does not count
+	first, err := k.GenerateMintCommand(ctx, "bank-a", "recipient1", math.NewInt(1000))
+	require.NoError(t, err)
+	sign(first, newcomer, validators[0])
+	pending, _ := k.GetCommand(ctx, first.CommandID)
+	require.Equal(t, int32(types.CommandStatusPending), pending.Status)
+	require.Len(t, pending.Signatures, 2)
+	sign(first, validators[1])
+	execute(first)
+
+	probation, found := k.GetProbation(ctx, newcomer.Address)
+	require.True(t, found)
+	require.Equal(t, uint32(1), probation.CompletedCycles)
+
+	// A missed cycle restarts the count
+	second, err := k.GenerateMintCommand(ctx, "bank-a", "recipient1", math.NewInt(2000))
+	require.NoError(t, err)
+	sign(second, validators[0], validators[1])
+	execute(second)
+
+	probation, _ = k.GetProbation(ctx, newcomer.Address)
+	require.Equal(t, uint32(0), probation.CompletedCycles)
+	require.Equal(t, uint32(1), probation.MissedCycles)
+
+	querier := keeper.NewQueryServerImpl(*k)
+	probations, err := querier.Probations(ctx, &multisigtypes.QueryProbationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, probations.Probations, 1)
+
+	// Two cycles in a row make the newcomer fully active
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	for _, amount := range []int64{3000, 4000} {
+		command, err := k.GenerateMintCommand(ctx, "bank-a", "recipient1", math.NewInt(amount))
+		require.NoError(t, err)
+		sign(command, newcomer, validators[0], validators[1])
+		execute(command)
+	}
+
+	_, found = k.GetProbation(ctx, newcomer.Address)
+	require.False(t, found)
+	require.Equal(t, int32(3), k.GetValidatorSet(ctx).Threshold)
+
+	activated := false
+	for _, event := range ctx.EventManager().Events() {
+		activated = activated || event.Type == multisigtypes.EventTypeValidatorActivated
+	}
+	require.True(t, activated)
+
+	// Already a member
+	_, err = msgServer.AdmitValidator(ctx, multisigtypes.NewMsgAdmitValidator(k.GetAuthority(), newcomer, 2))
+	require.ErrorIs(t, err, multisigtypes.ErrValidatorAlreadyExists)
+}
+
+// **Unit Test: 재조정 주기**
+func TestParams_ReconcileDue(t *testing.T) {
+	params := multisigtypes.DefaultParams()
+	require.False(t, params.ReconcileDue(100)) // Report only by default
+
+	params.ReconcileInterval = 50
+	require.True(t, params.ReconcileDue(100))
+	require.False(t, params.ReconcileDue(101))
+
+	params.ReconcileInterval = -1
+	require.Error(t, params.Validate())
+}
+
 // Helper functions for testing
 
+// signPendingCommands signs every pending command with each active validator
+// that has not signed it, as their sidecars would with MsgSignCommand, and
+// then evaluates the thresholds as EndBlock does. Validators whose key cannot
+// sign are skipped.
+func signPendingCommands(ctx sdk.Context, k *keeper.Keeper) error {
+	validatorSet := k.GetValidatorSet(ctx)
+	for _, command := range k.GetAllPendingCommands(ctx) {
+		signed := make(map[string]bool, len(command.Signatures))
+		for _, signature := range command.Signatures {
+			signed[signature.Validator] = true
+		}
+
+		for _, validator := range validatorSet.Validators {
+			if !validator.Active || signed[validator.Address] {
+				continue
+			}
+			signature, err := k.SignData(types.WithCorrelationID(ctx, command.CommandID), validator.Address, multisigtypes.CommandHash(command))
+			if err != nil {
+				continue
+			}
+			if err := k.SignCommand(ctx, validatorAccount(validator), command.CommandID, signature); err != nil {
+				return err
+			}
+		}
+	}
+	return k.EvaluatePendingCommands(ctx)
+}
+
+// signPendingBatches signs the root of every pending batch with each active
+// validator that has not signed it, as their sidecars would with
+// MsgSignBatch, and then evaluates the thresholds as EndBlock does
+func signPendingBatches(ctx sdk.Context, k *keeper.Keeper) error {
+	validatorSet := k.GetValidatorSet(ctx)
+	for _, batch := range k.GetAllCommandBatches(ctx) {
+		if batch.Status != multisigtypes.BatchStatusPending {
+			continue
+		}
+		signed := make(map[string]bool, len(batch.Signatures))
+		for _, signature := range batch.Signatures {
+			signed[signature.Validator] = true
+		}
+
+		for _, validator := range validatorSet.Validators {
+			if !validator.Active || signed[validator.Address] {
+				continue
+			}
+			if current, _ := k.GetCommandBatch(ctx, batch.BatchID); current.Status != multisigtypes.BatchStatusPending {
+				break
+			}
+			signature, err := k.SignData(types.WithCorrelationID(ctx, batch.BatchID), validator.Address, batch.Root)
+			if err != nil {
+				continue
+			}
+			if err := k.SignBatch(ctx, validatorAccount(validator), batch.BatchID, signature); err != nil {
+				return err
+			}
+		}
+	}
+	return k.EvaluatePendingBatches(ctx)
+}
+
+// validatorAccount returns the operator account address of a validator, or
+// its address when it is no bech32 validator address
+func validatorAccount(validator types.Validator) string {
+	valAddr, err := sdk.ValAddressFromBech32(validator.Address)
+	if err != nil {
+		return validator.Address
+	}
+	return sdk.AccAddress(valAddr).String()
+}
+
 func setupMultisigTestEnvironment(t *testing.T) (sdk.Context, *keeper.Keeper) {
+	ctx, multisigKeeper, _ := setupMultisigTestEnvironmentWithStaking(t)
+	return ctx, multisigKeeper
+}
+
+func setupMultisigTestEnvironmentWithStaking(t *testing.T) (sdk.Context, *keeper.Keeper, *MockStakingKeeper) {
 	// Create store key
 	storeKey := storetypes.NewKVStoreKey("multisig")
 
@@ -416,9 +759,10 @@ func setupMultisigTestEnvironment(t *testing.T) (sdk.Context, *keeper.Keeper) {
 		paramtypes.Subspace{}, // empty paramstore for tests
 		mockBankKeeper,
 		mockStakingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
-	return ctx, multisigKeeper
+	return ctx, multisigKeeper, mockStakingKeeper
 }
 
 func generateValidators(count int) []types.Validator {
@@ -469,6 +813,7 @@ func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr sdk.AccAddress
 // MockStakingKeeper for testing - implements types.StakingKeeper
 type MockStakingKeeper struct {
 	validators map[string]types.Validator
+	bonded     []stakingtypes.Validator
 }
 
 func NewMockStakingKeeper() *MockStakingKeeper {
@@ -489,8 +834,20 @@ func (m *MockStakingKeeper) GetAllValidators(ctx context.Context) ([]stakingtype
 	return []stakingtypes.Validator{}, nil
 }
 
+// SetBonded adds a bonded staking validator
+func (m *MockStakingKeeper) SetBonded(address string, jailed bool) {
+	pkAny, _ := codectypes.NewAnyWithValue(secp256k1.GenPrivKey().PubKey())
+	m.bonded = append(m.bonded, stakingtypes.Validator{
+		OperatorAddress: address,
+		ConsensusPubkey: pkAny,
+		Status:          stakingtypes.Bonded,
+		Jailed:          jailed,
+		Tokens:          math.NewInt(1),
+	})
+}
+
 func (m *MockStakingKeeper) GetBondedValidatorsByPower(ctx context.Context) ([]stakingtypes.Validator, error) {
-	return []stakingtypes.Validator{}, nil
+	return append([]stakingtypes.Validator{}, m.bonded...), nil
 }
 
 // **Feature: interbank-netting-engine, Property 8: 다중 서명 임계값**
@@ -654,10 +1011,10 @@ func TestProperty_MultisigThreshold_InsufficientSignaturesNotConfirmed(t *testin
 
 // **Feature: interbank-netting-engine, Property 9: 발행 명령 수집**
 // **검증: 요구사항 5.2 - 활성 검증자들로부터 ECDSA 서명을 수집**
-func TestProperty_ProcessPendingCommands_CollectsSignatures(t *testing.T) {
+func TestProperty_SignCommand_CollectsSignatures(t *testing.T) {
 	properties := gopter.NewProperties(gopter.DefaultTestParameters())
 
-	properties.Property("ProcessPendingCommands collects signatures from active validators", prop.ForAll(
+	properties.Property("MsgSignCommand collects signatures from active validators", prop.ForAll(
 		func(validatorCount int) bool {
 			// Need at least 1 validator
 			if validatorCount <= 0 || validatorCount > 5 {
@@ -688,8 +1045,8 @@ func TestProperty_ProcessPendingCommands_CollectsSignatures(t *testing.T) {
 				return false // Should have one pending command
 			}
 
-			// Process pending commands (simulates EndBlock)
-			err = multisigKeeper.ProcessPendingCommands(ctx)
+			// Every validator signs through its own sidecar
+			err = signPendingCommands(ctx, multisigKeeper)
 			if err != nil {
 				return false
 			}
@@ -723,7 +1080,7 @@ func TestProperty_ProcessPendingCommands_CollectsSignatures(t *testing.T) {
 
 // **Feature: interbank-netting-engine, Property 9: 발행 명령 수집**
 // **검증: 비활성 검증자는 서명에서 제외**
-func TestProperty_ProcessPendingCommands_SkipsInactiveValidators(t *testing.T) {
+func TestProperty_SignCommand_SkipsInactiveValidators(t *testing.T) {
 	ctx, multisigKeeper := setupMultisigTestEnvironment(t)
 
 	// Generate 5 validators, 2 inactive
@@ -739,9 +1096,17 @@ func TestProperty_ProcessPendingCommands_SkipsInactiveValidators(t *testing.T) {
 	command, err := multisigKeeper.GenerateMintCommand(ctx, "bank-a", "recipient1", math.NewInt(1000))
 	require.NoError(t, err)
 
-	// Process pending commands
-	err = multisigKeeper.ProcessPendingCommands(ctx)
-	require.NoError(t, err)
+	// Every validator submits its signature; inactive ones are rejected
+	for i, validator := range validators {
+		signature, err := multisigKeeper.SignData(ctx, validator.Address, multisigtypes.CommandHash(command))
+		require.NoError(t, err)
+		err = multisigKeeper.SignCommand(ctx, validatorAccount(validator), command.CommandID, signature)
+		if i == 2 || i == 4 {
+			require.ErrorIs(t, err, multisigtypes.ErrUnauthorized)
+		} else {
+			require.NoError(t, err)
+		}
+	}
 
 	// Check signatures - only active validators should sign
 	updatedCommand, found := multisigKeeper.GetCommand(ctx, command.CommandID)
@@ -757,6 +1122,65 @@ func TestProperty_ProcessPendingCommands_SkipsInactiveValidators(t *testing.T) {
 	}
 }
 
+// **Unit Test: 검증자 서명 도용 방지**
+func TestSignCommand_ValidatorsOnlyClaimTheirOwnSignature(t *testing.T) {
+	ctx, multisigKeeper := setupMultisigTestEnvironment(t)
+	msgServer := keeper.NewMsgServerImpl(*multisigKeeper)
+
+	validators := generateValidators(4)
+	validators[3].Active = false
+	require.NoError(t, multisigKeeper.UpdateValidatorSet(ctx, validators))
+
+	command, err := multisigKeeper.GenerateMintCommand(ctx, "bank-a", "recipient1", math.NewInt(1000))
+	require.NoError(t, err)
+	hash := multisigtypes.CommandHash(command)
+
+	// EndBlock does not sign on behalf of validators
+	require.NoError(t, multisigKeeper.EvaluatePendingCommands(ctx))
+	pending, _ := multisigKeeper.GetCommand(ctx, command.CommandID)
+	require.Empty(t, pending.Signatures)
+	require.Equal(t, int32(types.CommandStatusPending), pending.Status)
+
+	// A validator cannot submit another validator's signature
+	first, err := multisigKeeper.SignData(ctx, validators[0].Address, hash)
+	require.NoError(t, err)
+	_, err = msgServer.SignCommand(ctx, multisigtypes.NewMsgSignCommand(validatorAccount(validators[1]), command.CommandID, first))
+	require.ErrorIs(t, err, multisigtypes.ErrUnauthorized)
+
+	// Inactive validators and other accounts cannot sign at all
+	inactive, err := multisigKeeper.SignData(ctx, validators[3].Address, hash)
+	require.NoError(t, err)
+	_, err = msgServer.SignCommand(ctx, multisigtypes.NewMsgSignCommand(validatorAccount(validators[3]), command.CommandID, inactive))
+	require.ErrorIs(t, err, multisigtypes.ErrUnauthorized)
+	_, err = msgServer.SignCommand(ctx, multisigtypes.NewMsgSignCommand(sdk.AccAddress("outsider____________").String(), command.CommandID, first))
+	require.ErrorIs(t, err, multisigtypes.ErrUnauthorized)
+
+	// The validator's own account claims its signature once
+	resp, err := msgServer.SignCommand(ctx, multisigtypes.NewMsgSignCommand(validatorAccount(validators[0]), command.CommandID, first))
+	require.NoError(t, err)
+	require.Equal(t, 1, resp.SignatureCount)
+	require.False(t, resp.ThresholdMet)
+	_, err = msgServer.SignCommand(ctx, multisigtypes.NewMsgSignCommand(validatorAccount(validators[0]), command.CommandID, first))
+	require.ErrorIs(t, err, multisigtypes.ErrDuplicateSignature)
+
+	// The threshold of three is met by the third active validator
+	for _, validator := range validators[1:3] {
+		signature, err := multisigKeeper.SignData(ctx, validator.Address, hash)
+		require.NoError(t, err)
+		resp, err = msgServer.SignCommand(ctx, multisigtypes.NewMsgSignCommand(validatorAccount(validator), command.CommandID, signature))
+		require.NoError(t, err)
+	}
+	require.True(t, resp.ThresholdMet)
+	signed, _ := multisigKeeper.GetCommand(ctx, command.CommandID)
+	require.Equal(t, int32(types.CommandStatusSigned), signed.Status)
+
+	// Executed commands accept no further signatures
+	require.NoError(t, multisigKeeper.MarkCommandExecuted(ctx, command.CommandID))
+	require.NoError(t, multisigKeeper.UpdateValidatorSet(ctx, generateValidators(4)))
+	_, err = msgServer.SignCommand(ctx, multisigtypes.NewMsgSignCommand(validatorAccount(validators[3]), command.CommandID, inactive))
+	require.ErrorIs(t, err, multisigtypes.ErrInvalidCommandStatus)
+}
+
 // **Feature: interbank-netting-engine, Property 10: 명령 상태 전환**
 // **검증: 요구사항 5.1 - 발행 명령 생성 및 상태 전환**
 func TestProperty_CommandStatusTransitions(t *testing.T) {
@@ -773,7 +1197,7 @@ func TestProperty_CommandStatusTransitions(t *testing.T) {
 	require.Equal(t, int32(types.CommandStatusPending), command.Status)
 
 	// 2. Process to collect signatures - should become Signed (threshold = 2)
-	err = multisigKeeper.ProcessPendingCommands(ctx)
+	err = signPendingCommands(ctx, multisigKeeper)
 	require.NoError(t, err)
 
 	updatedCommand, found := multisigKeeper.GetCommand(ctx, command.CommandID)
@@ -789,6 +1213,285 @@ func TestProperty_CommandStatusTransitions(t *testing.T) {
 	require.Equal(t, int32(types.CommandStatusExecuted), finalCommand.Status)
 }
 
+// **Feature: interbank-netting-engine, Property 23: 명령 실행 멱등성 키**
+// **검증: 요구사항 5.1 - 중복 실행 보고가 한 번만 집계되는지 검증**
+func TestProperty_ReportExecution_CountsDuplicatesOnce(t *testing.T) {
+	properties := gopter.NewProperties(gopter.DefaultTestParameters())
+
+	properties.Property("an idempotency key is counted executed at most once", prop.ForAll(
+		func(reports int) bool {
+			ctx, multisigKeeper := setupMultisigTestEnvironment(t)
+			validators := generateValidators(3)
+			if err := multisigKeeper.UpdateValidatorSet(ctx, validators); err != nil {
+				return false
+			}
+			msgServer := keeper.NewMsgServerImpl(*multisigKeeper)
+
+			first, err := multisigKeeper.GenerateMintCommand(ctx, "bank-a", "recipient1", math.NewInt(1000))
+			if err != nil {
+				return false
+			}
+			second, err := multisigKeeper.GenerateMintCommand(ctx, "bank-a", "recipient2", math.NewInt(1000))
+			if err != nil {
+				return false
+			}
+
+			// Nonces are sequential per chain and keys are deterministic
+			if first.Nonce != 1 || second.Nonce != 2 ||
+				first.IdempotencyKey != multisigtypes.CommandIdempotencyKey(first.CommandID, 1, "bank-a") {
+				return false
+			}
+			if err := signPendingCommands(ctx, multisigKeeper); err != nil {
+				return false
+			}
+
+			// Only active validators may report executions
+			outsider := sdk.AccAddress([]byte("unregistered-relayer")).String()
+			if _, err := msgServer.ReportExecution(ctx, multisigtypes.NewMsgReportExecution(outsider, first.IdempotencyKey, "0xabc")); !errors.Is(err, multisigtypes.ErrUnauthorized) {
+				return false
+			}
+
+			// Relayers sign with the operator account of their validator
+			reporter := sdk.AccAddress([]byte{1}).String()
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			for i := 0; i < reports; i++ {
+				resp, err := msgServer.ReportExecution(ctx, multisigtypes.NewMsgReportExecution(reporter, first.IdempotencyKey, "0xabc"))
+				if err != nil || resp.CommandID != first.CommandID || resp.Duplicate != (i > 0) {
+					return false
+				}
+			}
+
+			executedEvents := 0
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == multisigtypes.EventTypeCommandExecuted {
+					executedEvents++
+				}
+			}
+
+			command, _ := multisigKeeper.GetCommand(ctx, first.CommandID)
+			other, _ := multisigKeeper.GetCommand(ctx, second.CommandID)
+			return executedEvents == 1 &&
+				command.Status == int32(types.CommandStatusExecuted) &&
+				other.Status == int32(types.CommandStatusSigned) &&
+				multisigKeeper.IsExecutionReported(ctx, first.IdempotencyKey) &&
+				!multisigKeeper.IsExecutionReported(ctx, second.IdempotencyKey)
+		},
+		gen.IntRange(1, 5),
+	))
+
+	properties.TestingRun(t)
+}
+
+func TestReportExecution_UnknownKey(t *testing.T) {
+	ctx, multisigKeeper := setupMultisigTestEnvironment(t)
+	validators := generateValidators(3)
+	require.NoError(t, multisigKeeper.UpdateValidatorSet(ctx, validators))
+
+	_, _, err := multisigKeeper.ReportExecution(ctx, validators[0].Address, "unknown", "0xabc", 0, math.ZeroInt())
+	require.ErrorIs(t, err, multisigtypes.ErrUnknownIdempotencyKey)
+}
+
+// **Unit Test: 명령 실행 비용 집계**
+func TestReportExecution_RecordsAndSummarizesCosts(t *testing.T) {
+	ctx, multisigKeeper := setupMultisigTestEnvironment(t)
+	validators := generateValidators(3)
+	require.NoError(t, multisigKeeper.UpdateValidatorSet(ctx, validators))
+	reporter := validators[0].Address
+
+	var commands []types.MintCommand
+	for _, chain := range []string{"bank-a", "bank-a", "bank-b"} {
+		command, err := multisigKeeper.GenerateMintCommand(ctx, chain, "recipient1", math.NewInt(int64(1000+len(commands))))
+		require.NoError(t, err)
+		commands = append(commands, command)
+	}
+	require.NoError(t, signPendingCommands(ctx, multisigKeeper))
+
+	// Executions reported at 1000, 2000 and 3000
+	for i, command := range commands {
+		reportCtx := ctx.WithBlockTime(time.Unix(int64(1000*(i+1)), 0))
+		_, _, err := multisigKeeper.ReportExecution(reportCtx, reporter, command.IdempotencyKey, "0xabc", 21000, math.NewInt(int64(100*(i+1))))
+		require.NoError(t, err)
+	}
+
+	// A duplicate report is not charged again
+	_, duplicate, err := multisigKeeper.ReportExecution(ctx, reporter, commands[0].IdempotencyKey, "0xabc", 99999, math.NewInt(99999))
+	require.NoError(t, err)
+	require.True(t, duplicate)
+
+	cost, found := multisigKeeper.GetExecutionCost(ctx, commands[0].CommandID)
+	require.True(t, found)
+	require.Equal(t, "bank-a", cost.TargetChain)
+	require.Equal(t, uint64(21000), cost.GasUsed)
+	require.Equal(t, math.NewInt(100), cost.CostWei)
+
+	summaries := multisigKeeper.SummarizeExecutionCosts(ctx, "", 0, 0)
+	require.Len(t, summaries, 2)
+	require.Equal(t, "bank-a", summaries[0].TargetChain)
+	require.Equal(t, uint64(2), summaries[0].CommandCount)
+	require.Equal(t, uint64(42000), summaries[0].GasUsed)
+	require.Equal(t, math.NewInt(300), summaries[0].CostWei)
+	require.Equal(t, "bank-b", summaries[1].TargetChain)
+	require.Equal(t, math.NewInt(300), summaries[1].CostWei)
+
+	// Periods bound the execution time inclusively
+	summaries = multisigKeeper.SummarizeExecutionCosts(ctx, "bank-a", 1500, 2000)
+	require.Len(t, summaries, 1)
+	require.Equal(t, uint64(1), summaries[0].CommandCount)
+	require.Equal(t, math.NewInt(200), summaries[0].CostWei)
+
+	require.Empty(t, multisigKeeper.SummarizeExecutionCosts(ctx, "bank-b", 0, 2999))
+}
+
+// **Feature: interbank-netting-engine, Property 25: 서명 지연 에스컬레이션**
+// **검증: 요구사항 5.2 - 서명 제한 시간의 일정 비율이 지나면 미서명 검증자가 한 번 에스컬레이션되는지 검증**
+func TestProperty_EscalateLateSigners_AfterTimeoutFraction(t *testing.T) {
+	properties := gopter.NewProperties(gopter.DefaultTestParameters())
+
+	properties.Property("late signers are escalated once the escalation age passes", prop.ForAll(
+		func(validatorCount int, percent int32) bool {
+			ctx, multisigKeeper := setupMultisigTestEnvironment(t)
+			ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+			validators := generateValidators(validatorCount)
+			if err := multisigKeeper.UpdateValidatorSet(ctx, validators); err != nil {
+				return false
+			}
+			params := multisigtypes.DefaultParams()
+			params.EscalationPercent = percent
+			multisigKeeper.SetParams(ctx, params)
+
+			command, err := multisigKeeper.GenerateMintCommand(ctx, "bank-a", "recipient1", math.NewInt(1000))
+			if err != nil {
+				return false
+			}
+
+			// One signature short of the threshold keeps the command pending
+			signers := int(multisigKeeper.GetValidatorSet(ctx).Threshold) - 1
+			for _, validator := range validators[:signers] {
+				signature, err := multisigKeeper.SignData(ctx, validator.Address, []byte(command.CommandID))
+				if err != nil || multisigKeeper.AddSignatureToCommand(ctx, command.CommandID, signature) != nil {
+					return false
+				}
+			}
+
+			escalations := func(ctx sdk.Context) []sdk.Event {
+				var events []sdk.Event
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type == multisigtypes.EventTypeSigningEscalated {
+						events = append(events, event)
+					}
+				}
+				return events
+			}
+
+			// Nothing is escalated before the escalation age
+			early := ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(params.EscalationAge()-1) * time.Second)).
+				WithEventManager(sdk.NewEventManager())
+			if multisigKeeper.EscalateLateSigners(early) != nil || len(escalations(early)) != 0 {
+				return false
+			}
+
+			due := ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(params.EscalationAge()) * time.Second)).
+				WithEventManager(sdk.NewEventManager())
+			if multisigKeeper.EscalateLateSigners(due) != nil || multisigKeeper.EscalateLateSigners(due) != nil {
+				return false
+			}
+			events := escalations(due)
+			if len(events) != 1 {
+				return false
+			}
+
+			var expected []string
+			for _, validator := range validators[signers:] {
+				expected = append(expected, validator.Address)
+			}
+			lateAttr, _ := events[0].GetAttribute(multisigtypes.AttributeKeyLateSigners)
+			if lateAttr.Value != strings.Join(expected, ",") {
+				return false
+			}
+
+			resp, err := keeper.NewQueryServerImpl(*multisigKeeper).LateSigners(due, &multisigtypes.QueryLateSignersRequest{CommandID: command.CommandID})
+			return err == nil && resp.Escalated &&
+				strings.Join(resp.LateSigners, ",") == strings.Join(expected, ",") &&
+				resp.EscalateAt == command.CreatedAt+params.EscalationAge() &&
+				resp.Deadline == command.CreatedAt+params.SigningTimeout
+		},
+		gen.IntRange(3, 10),
+		gen.Int32Range(1, 100),
+	))
+
+	properties.TestingRun(t)
+}
+
+func TestLateSigners_SignedCommand(t *testing.T) {
+	ctx, multisigKeeper := setupMultisigTestEnvironment(t)
+	require.NoError(t, multisigKeeper.UpdateValidatorSet(ctx, generateValidators(3)))
+
+	command, err := multisigKeeper.GenerateMintCommand(ctx, "bank-a", "recipient1", math.NewInt(1000))
+	require.NoError(t, err)
+	require.NoError(t, signPendingCommands(ctx, multisigKeeper))
+
+	_, err = keeper.NewQueryServerImpl(*multisigKeeper).LateSigners(ctx, &multisigtypes.QueryLateSignersRequest{CommandID: command.CommandID})
+	require.ErrorIs(t, err, multisigtypes.ErrInvalidCommandStatus)
+}
+
+// **Unit Test: 서명 시간 초과 시 명령 만료**
+func TestExpirePendingCommands_AfterSigningTimeout(t *testing.T) {
+	ctx, multisigKeeper := setupMultisigTestEnvironment(t)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	// One of three validators online stays below the threshold of two
+	validators := generateValidators(3)
+	validators[1].Active = false
+	validators[2].Active = false
+	require.NoError(t, multisigKeeper.UpdateValidatorSet(ctx, validators))
+
+	command, err := multisigKeeper.GenerateMintCommand(ctx, "bank-a", "recipient1", math.NewInt(1000))
+	require.NoError(t, err)
+	require.NoError(t, signPendingCommands(ctx, multisigKeeper))
+
+	// Not expired one second before the deadline
+	timeout := multisigKeeper.GetParams(ctx).SigningTimeout
+	ctx = ctx.WithBlockTime(time.Unix(command.CreatedAt+timeout-1, 0))
+	require.NoError(t, multisigKeeper.ExpirePendingCommands(ctx))
+	pending, _ := multisigKeeper.GetCommand(ctx, command.CommandID)
+	require.Equal(t, int32(types.CommandStatusPending), pending.Status)
+
+	ctx = ctx.WithBlockTime(time.Unix(command.CreatedAt+timeout, 0))
+	require.NoError(t, multisigKeeper.ExpirePendingCommands(ctx))
+	expired, _ := multisigKeeper.GetCommand(ctx, command.CommandID)
+	require.Equal(t, int32(types.CommandStatusFailed), expired.Status)
+	require.Len(t, expired.Signatures, 1)
+
+	// Validators coming back online cannot revive the command
+	signature, err := multisigKeeper.SignData(ctx, validators[1].Address, []byte("command"))
+	require.NoError(t, err)
+	require.ErrorIs(t, multisigKeeper.AddSignatureToCommand(ctx, command.CommandID, signature), multisigtypes.ErrCommandExpired)
+	require.Empty(t, multisigKeeper.GetAllPendingCommands(ctx))
+}
+
+// **Unit Test: 오류 코드 분류**
+func TestErrorClassification(t *testing.T) {
+	// Codes are part of the client contract and must never change
+	codespace, code, _ := errorsmod.ABCIInfo(multisigtypes.ErrUnknownIdempotencyKey, false)
+	require.Equal(t, multisigtypes.ModuleName, codespace)
+	require.Equal(t, uint32(19), code)
+
+	// Classification survives wrapping
+	wrapped := errorsmod.Wrapf(multisigtypes.ErrInsufficientSignatures, "command %s", "cmd-1")
+	require.True(t, types.IsRetryable(wrapped))
+	require.False(t, types.IsUserError(wrapped))
+
+	wrapped = errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "recipient cannot be empty")
+	require.True(t, types.IsUserError(wrapped))
+	require.False(t, types.IsRetryable(wrapped))
+
+	// Unregistered errors are internal failures, neither retryable nor the user's
+	plain := errors.New("unexpected")
+	require.False(t, types.IsRetryable(plain))
+	require.False(t, types.IsUserError(plain))
+	require.False(t, types.IsRetryable(nil))
+}
+
 // **Unit Test: 명령 쿼리 메서드 검증**
 func TestGetCommandsByStatus(t *testing.T) {
 	ctx, multisigKeeper := setupMultisigTestEnvironment(t)
@@ -833,3 +1536,791 @@ func TestGetCommandsByStatus(t *testing.T) {
 	require.True(t, pendingIDs[cmd2.CommandID])
 	require.True(t, pendingIDs[cmd3.CommandID])
 }
+
+// **Feature: interbank-netting-engine, Property 21: 명령 Merkle 배치**
+// **검증: 요구사항 5.2 - 블록당 체인별 서명된 명령을 하나의 Merkle 루트로 묶고 명령별 증명을 제공**
+func TestProperty_BatchSignedCommands_ProofsVerifyAgainstRoot(t *testing.T) {
+	properties := gopter.NewProperties(gopter.DefaultTestParameters())
+
+	properties.Property("every batched command has a proof leading to the signed root", prop.ForAll(
+		func(commandCount int, chainCount int) bool {
+			ctx, multisigKeeper := setupMultisigTestEnvironment(t)
+			validators := generateValidators(4)
+			if err := multisigKeeper.UpdateValidatorSet(ctx, validators); err != nil {
+				return false
+			}
+
+			chains := []string{"bank-a", "bank-b", "bank-c"}[:chainCount]
+			commandIDs := make([]string, 0, commandCount)
+			for i := 0; i < commandCount; i++ {
+				command, err := multisigKeeper.GenerateMintCommand(ctx, chains[i%chainCount], "recipient", math.NewInt(int64(1000+i)))
+				if err != nil {
+					return false
+				}
+				commandIDs = append(commandIDs, command.CommandID)
+			}
+
+			// Simulates EndBlock
+			if err := signPendingCommands(ctx, multisigKeeper); err != nil {
+				return false
+			}
+			if err := multisigKeeper.BatchSignedCommands(ctx); err != nil {
+				return false
+			}
+			if err := signPendingBatches(ctx, multisigKeeper); err != nil {
+				return false
+			}
+
+			// One batch per target chain, each root signed once per validator
+			batches := multisigKeeper.GetAllCommandBatches(ctx)
+			if len(batches) != chainCount {
+				return false
+			}
+			validatorSet := multisigKeeper.GetValidatorSet(ctx)
+			for _, batch := range batches {
+				if batch.Status != multisigtypes.BatchStatusSigned || int32(len(batch.Signatures)) != validatorSet.Threshold {
+					return false
+				}
+			}
+
+			for _, commandID := range commandIDs {
+				proof, err := multisigKeeper.GetCommandBatchProof(ctx, commandID)
+				if err != nil || !proof.Verify() {
+					return false
+				}
+
+				// A proof must not verify for a different leaf
+				tampered := proof
+				tampered.LeafHash = multisigtypes.MerkleLeafHash(types.HashAlgorithmSHA256, []byte("forged"))
+				if tampered.Verify() {
+					return false
+				}
+			}
+
+			// Already batched commands are not batched again
+			if err := multisigKeeper.BatchSignedCommands(ctx); err != nil {
+				return false
+			}
+			return len(multisigKeeper.GetAllCommandBatches(ctx)) == chainCount
+		},
+		gen.IntRange(3, 20),
+		gen.IntRange(1, 3),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Unit Test: 배치 루트 서명 도용 방지 및 만료**
+func TestSignBatch_ValidatorsOnlyClaimTheirOwnSignature(t *testing.T) {
+	ctx, multisigKeeper := setupMultisigTestEnvironment(t)
+	msgServer := keeper.NewMsgServerImpl(*multisigKeeper)
+
+	validators := generateValidators(4)
+	validators[3].Active = false
+	require.NoError(t, multisigKeeper.UpdateValidatorSet(ctx, validators))
+
+	_, err := multisigKeeper.GenerateMintCommand(ctx, "bank-a", "recipient1", math.NewInt(1000))
+	require.NoError(t, err)
+	require.NoError(t, signPendingCommands(ctx, multisigKeeper))
+	require.NoError(t, multisigKeeper.BatchSignedCommands(ctx))
+
+	// EndBlock does not sign batch roots on behalf of validators
+	require.NoError(t, multisigKeeper.EvaluatePendingBatches(ctx))
+	batch := multisigKeeper.GetAllCommandBatches(ctx)[0]
+	require.Empty(t, batch.Signatures)
+	require.Equal(t, multisigtypes.BatchStatusPending, batch.Status)
+
+	// A validator cannot submit another validator's signature
+	first, err := multisigKeeper.SignData(ctx, validators[0].Address, batch.Root)
+	require.NoError(t, err)
+	_, err = msgServer.SignBatch(ctx, multisigtypes.NewMsgSignBatch(validatorAccount(validators[1]), batch.BatchID, first))
+	require.ErrorIs(t, err, multisigtypes.ErrUnauthorized)
+
+	// Inactive validators and other accounts cannot sign at all
+	inactive, err := multisigKeeper.SignData(ctx, validators[3].Address, batch.Root)
+	require.NoError(t, err)
+	_, err = msgServer.SignBatch(ctx, multisigtypes.NewMsgSignBatch(validatorAccount(validators[3]), batch.BatchID, inactive))
+	require.ErrorIs(t, err, multisigtypes.ErrUnauthorized)
+	_, err = msgServer.SignBatch(ctx, multisigtypes.NewMsgSignBatch(sdk.AccAddress("outsider____________").String(), batch.BatchID, first))
+	require.ErrorIs(t, err, multisigtypes.ErrUnauthorized)
+
+	// The validator's own account claims its signature once
+	resp, err := msgServer.SignBatch(ctx, multisigtypes.NewMsgSignBatch(validatorAccount(validators[0]), batch.BatchID, first))
+	require.NoError(t, err)
+	require.Equal(t, 1, resp.SignatureCount)
+	require.False(t, resp.ThresholdMet)
+	_, err = msgServer.SignBatch(ctx, multisigtypes.NewMsgSignBatch(validatorAccount(validators[0]), batch.BatchID, first))
+	require.ErrorIs(t, err, multisigtypes.ErrDuplicateSignature)
+
+	// The threshold of three is met by the third active validator
+	for _, validator := range validators[1:3] {
+		signature, err := multisigKeeper.SignData(ctx, validator.Address, batch.Root)
+		require.NoError(t, err)
+		resp, err = msgServer.SignBatch(ctx, multisigtypes.NewMsgSignBatch(validatorAccount(validator), batch.BatchID, signature))
+		require.NoError(t, err)
+	}
+	require.True(t, resp.ThresholdMet)
+	signed, _ := multisigKeeper.GetCommandBatch(ctx, batch.BatchID)
+	require.Equal(t, multisigtypes.BatchStatusSigned, signed.Status)
+
+	// A signed batch takes no further signatures
+	extra, err := multisigKeeper.SignData(ctx, validators[3].Address, batch.Root)
+	require.NoError(t, err)
+	require.ErrorIs(t, multisigKeeper.AddSignatureToBatch(ctx, batch.BatchID, extra), multisigtypes.ErrInvalidCommandStatus)
+	signed, _ = multisigKeeper.GetCommandBatch(ctx, batch.BatchID)
+	require.Len(t, signed.Signatures, 3)
+
+	// A batch short of the threshold after the signing timeout expires, and
+	// its commands are committed to a new root
+	command, err := multisigKeeper.GenerateMintCommand(ctx, "bank-b", "recipient1", math.NewInt(2000))
+	require.NoError(t, err)
+	require.NoError(t, signPendingCommands(ctx, multisigKeeper))
+	require.NoError(t, multisigKeeper.BatchSignedCommands(ctx))
+	proof, err := multisigKeeper.GetCommandBatchProof(ctx, command.CommandID)
+	require.NoError(t, err)
+
+	timeout := time.Duration(multisigKeeper.GetParams(ctx).SigningTimeout) * time.Second
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(timeout))
+	require.NoError(t, multisigKeeper.ExpirePendingBatches(ctx))
+	expired, _ := multisigKeeper.GetCommandBatch(ctx, proof.BatchID)
+	require.Equal(t, multisigtypes.BatchStatusExpired, expired.Status)
+	late, err := multisigKeeper.SignData(ctx, validators[0].Address, expired.Root)
+	require.NoError(t, err)
+	_, err = msgServer.SignBatch(ctx, multisigtypes.NewMsgSignBatch(validatorAccount(validators[0]), expired.BatchID, late))
+	require.ErrorIs(t, err, multisigtypes.ErrCommandExpired)
+
+	require.NoError(t, multisigKeeper.BatchSignedCommands(ctx))
+	rebatched, err := multisigKeeper.GetCommandBatchProof(ctx, command.CommandID)
+	require.NoError(t, err)
+	require.NotEqual(t, proof.BatchID, rebatched.BatchID)
+	require.True(t, rebatched.Verify())
+}
+
+// **Unit Test: 제거된 검증자의 배치 서명 제외**
+func TestEvaluatePendingBatches_IgnoresRemovedValidators(t *testing.T) {
+	ctx, multisigKeeper := setupMultisigTestEnvironment(t)
+	validators := generateValidators(4)
+	require.NoError(t, multisigKeeper.UpdateValidatorSet(ctx, validators))
+
+	_, err := multisigKeeper.GenerateMintCommand(ctx, "bank-a", "recipient1", math.NewInt(1000))
+	require.NoError(t, err)
+	require.NoError(t, signPendingCommands(ctx, multisigKeeper))
+	require.NoError(t, multisigKeeper.BatchSignedCommands(ctx))
+	batch := multisigKeeper.GetAllCommandBatches(ctx)[0]
+
+	for _, validator := range validators[:2] {
+		signature, err := multisigKeeper.SignData(ctx, validator.Address, batch.Root)
+		require.NoError(t, err)
+		require.NoError(t, multisigKeeper.AddSignatureToBatch(ctx, batch.BatchID, signature))
+	}
+
+	// The threshold drops to two, but the removed validator's signature no
+	// longer counts toward it
+	require.NoError(t, multisigKeeper.RemoveValidator(ctx, validators[1].Address))
+	require.NoError(t, multisigKeeper.EvaluatePendingBatches(ctx))
+	pending, _ := multisigKeeper.GetCommandBatch(ctx, batch.BatchID)
+	require.Equal(t, multisigtypes.BatchStatusPending, pending.Status)
+
+	signature, err := multisigKeeper.SignData(ctx, validators[2].Address, batch.Root)
+	require.NoError(t, err)
+	require.NoError(t, multisigKeeper.AddSignatureToBatch(ctx, batch.BatchID, signature))
+	signed, _ := multisigKeeper.GetCommandBatch(ctx, batch.BatchID)
+	require.Equal(t, multisigtypes.BatchStatusSigned, signed.Status)
+}
+
+// **Unit Test: RFC 6962 Merkle 증명 검증**
+func TestMerkleAuditPath_AllSizes(t *testing.T) {
+	for _, algorithm := range []types.HashAlgorithm{types.HashAlgorithmSHA256, types.HashAlgorithmKeccak256} {
+		other := types.HashAlgorithmKeccak256
+		if algorithm == other {
+			other = types.HashAlgorithmSHA256
+		}
+
+		for size := 1; size <= 17; size++ {
+			leaves := make([][]byte, size)
+			for i := range leaves {
+				leaves[i] = multisigtypes.MerkleLeafHash(algorithm, []byte{byte(i)})
+			}
+			root := multisigtypes.MerkleRoot(algorithm, leaves)
+
+			for index := 0; index < size; index++ {
+				path := multisigtypes.MerkleAuditPath(algorithm, leaves, index)
+				require.True(t, multisigtypes.VerifyMerkleProof(algorithm, leaves[index], uint32(index), uint32(size), path, root))
+				require.False(t, multisigtypes.VerifyMerkleProof(algorithm, leaves[index], uint32(size), uint32(size), path, root))
+				if size > 1 {
+					require.False(t, multisigtypes.VerifyMerkleProof(algorithm, leaves[(index+1)%size], uint32(index), uint32(size), path, root))
+					// A tree of one algorithm does not verify with the other
+					require.False(t, multisigtypes.VerifyMerkleProof(other, leaves[index], uint32(index), uint32(size), path, root))
+				}
+			}
+		}
+	}
+}
+
+// **Unit Test: 배치 증명 검증 한도**
+func TestVerifyCommandBatchProof_EnforcesLimitsBeforeVerification(t *testing.T) {
+	ctx, multisigKeeper := setupMultisigTestEnvironment(t)
+	require.NoError(t, multisigKeeper.UpdateValidatorSet(ctx, generateValidators(3)))
+
+	for i := 0; i < 5; i++ {
+		_, err := multisigKeeper.GenerateMintCommand(ctx, "bank-a", "recipient", math.NewInt(int64(1000+i)))
+		require.NoError(t, err)
+	}
+	require.NoError(t, signPendingCommands(ctx, multisigKeeper))
+	require.NoError(t, multisigKeeper.BatchSignedCommands(ctx))
+
+	batch := multisigKeeper.GetAllCommandBatches(ctx)[0]
+	proof, err := multisigKeeper.GetCommandBatchProof(ctx, batch.CommandIDs[2])
+	require.NoError(t, err)
+	require.NoError(t, multisigKeeper.VerifyCommandBatchProof(ctx, proof))
+
+	// More hashes than a tree of five leaves has levels
+	padded := proof
+	padded.Path = append(append([][]byte{}, proof.Path...), proof.Root)
+	require.ErrorIs(t, multisigKeeper.VerifyCommandBatchProof(ctx, padded), multisigtypes.ErrProofTooLarge)
+
+	// Oversized sibling hashes are rejected without hashing them
+	oversized := proof
+	oversized.Path = [][]byte{make([]byte, 1<<20)}
+	require.ErrorIs(t, multisigKeeper.VerifyCommandBatchProof(ctx, oversized), multisigtypes.ErrProofTooLarge)
+
+	tampered := proof
+	tampered.LeafHash = multisigtypes.MerkleLeafHash(types.HashAlgorithmSHA256, []byte("forged"))
+	require.ErrorIs(t, multisigKeeper.VerifyCommandBatchProof(ctx, tampered), multisigtypes.ErrInvalidProof)
+
+	// The depth limit is a param
+	params := multisigKeeper.GetParams(ctx)
+	params.MaxProofDepth = int32(len(proof.Path)) - 1
+	multisigKeeper.SetParams(ctx, params)
+	require.ErrorIs(t, multisigKeeper.VerifyCommandBatchProof(ctx, proof), multisigtypes.ErrProofTooLarge)
+}
+
+func TestGetCommandBatchProof_UnbatchedCommand(t *testing.T) {
+	ctx, multisigKeeper := setupMultisigTestEnvironment(t)
+	require.NoError(t, multisigKeeper.UpdateValidatorSet(ctx, generateValidators(3)))
+
+	command, err := multisigKeeper.GenerateMintCommand(ctx, "bank-a", "recipient1", math.NewInt(1000))
+	require.NoError(t, err)
+
+	_, err = multisigKeeper.GetCommandBatchProof(ctx, command.CommandID)
+	require.ErrorIs(t, err, multisigtypes.ErrCommandNotBatched)
+}
+
+// **Unit Test: 컬렉션 키 집합 마커 마이그레이션**
+func TestMigrate2to3_RewritesKeySetMarkers(t *testing.T) {
+	ctx, multisigKeeper := setupMultisigTestEnvironment(t)
+
+	// Version 2 stored {0x01} markers, which collection key sets cannot walk
+	store := ctx.KVStore(multisigKeeper.GetStoreKey())
+	store.Set(append([]byte{0x0A}, "key-1"...), []byte{0x01})
+	store.Set(append([]byte{0x0C}, "cmd-1"...), []byte{0x01})
+	walk := func(set collections.KeySet[string]) ([]string, error) {
+		var keys []string
+		err := set.Walk(ctx, nil, func(key string) (bool, error) {
+			keys = append(keys, key)
+			return false, nil
+		})
+		return keys, err
+	}
+	_, err := walk(multisigKeeper.Executed)
+	require.Error(t, err)
+
+	require.NoError(t, keeper.NewMigrator(*multisigKeeper).Migrate2to3(ctx))
+
+	require.True(t, multisigKeeper.IsExecutionReported(ctx, "key-1"))
+	require.True(t, multisigKeeper.IsSigningEscalated(ctx, "cmd-1"))
+	executed, err := walk(multisigKeeper.Executed)
+	require.NoError(t, err)
+	require.Equal(t, []string{"key-1"}, executed)
+	escalated, err := walk(multisigKeeper.SigningEscalations)
+	require.NoError(t, err)
+	require.Equal(t, []string{"cmd-1"}, escalated)
+}
+
+// keySigner is a signer service holding validator keys in memory, as an HSM would
+type keySigner struct {
+	keys     map[string]*ecdsa.PrivateKey
+	commands []string
+	hashes   [][]byte
+}
+
+func (s *keySigner) SignCommandHash(ctx context.Context, req *multisigtypes.SignCommandHashRequest) (*multisigtypes.SignCommandHashResponse, error) {
+	key, found := s.keys[req.Validator]
+	if !found {
+		return nil, errors.New("no key for validator")
+	}
+	signature, err := crypto.Sign(req.Hash, key)
+	if err != nil {
+		return nil, err
+	}
+	s.commands = append(s.commands, req.CommandID)
+	s.hashes = append(s.hashes, req.Hash)
+	return &multisigtypes.SignCommandHashResponse{Signature: signature}, nil
+}
+
+func (s *keySigner) GetPublicKey(ctx context.Context, req *multisigtypes.GetPublicKeyRequest) (*multisigtypes.GetPublicKeyResponse, error) {
+	key, found := s.keys[req.Validator]
+	if !found {
+		return nil, errors.New("no key for validator")
+	}
+	return &multisigtypes.GetPublicKeyResponse{PubKey: crypto.CompressPubkey(&key.PublicKey)}, nil
+}
+
+// **Unit Test: 외부 서명 서비스**
+func TestSignData_SignsThroughSignerService(t *testing.T) {
+	ctx, multisigKeeper := setupMultisigTestEnvironment(t)
+
+	// The signer holds the keys of the first two validators; its key for the
+	// third does not match the registered one, and it has none for the fourth
+	validators := generateValidators(4)
+	signer := &keySigner{keys: make(map[string]*ecdsa.PrivateKey)}
+	for i := range validators {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		validators[i].PubKey = crypto.CompressPubkey(&key.PublicKey)
+		if i < 3 {
+			signer.keys[validators[i].Address] = key
+		}
+	}
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer.keys[validators[2].Address] = other
+	require.NoError(t, multisigKeeper.UpdateValidatorSet(ctx, validators))
+	multisigKeeper.SetSignerService(signer)
+
+	command, err := multisigKeeper.GenerateMintCommand(ctx, "bank-a", "recipient1", math.NewInt(1000))
+	require.NoError(t, err)
+	require.NoError(t, signPendingCommands(ctx, multisigKeeper))
+
+	updated, found := multisigKeeper.GetCommand(ctx, command.CommandID)
+	require.True(t, found)
+	require.Len(t, updated.Signatures, 2)
+	require.Equal(t, []string{command.CommandID, command.CommandID}, signer.commands)
+
+	// Each signature is the validator's own, recoverable from the command hash
+	for i, sig := range updated.Signatures {
+		require.Equal(t, validators[i].Address, sig.Validator)
+		recovered, err := crypto.SigToPub(signer.hashes[i], append(append(append([]byte{}, sig.R...), sig.S...), byte(sig.V-27)))
+		require.NoError(t, err)
+		require.Equal(t, validators[i].PubKey, crypto.CompressPubkey(recovered))
+	}
+
+	// Two of four signatures are below the threshold of three
+	require.Equal(t, int32(types.CommandStatusPending), updated.Status)
+}
+
+// **Unit Test: 대상 체인별 명령 해시 알고리즘**
+func TestCommandHashAlgorithm_PerTargetChainWithoutRehashingStoredCommands(t *testing.T) {
+	ctx, multisigKeeper := setupMultisigTestEnvironment(t)
+
+	before, err := multisigKeeper.GenerateMintCommand(ctx, "bank-b", "0x1111111111111111111111111111111111111111", math.NewInt(100))
+	require.NoError(t, err)
+	require.Equal(t, types.HashAlgorithmSHA256, before.HashAlgorithm)
+	beforeHash := multisigtypes.CommandHash(before)
+
+	params := multisigKeeper.GetParams(ctx)
+	params.CommandHashAlgorithms = []types.ChainHashAlgorithm{{Chain: "bank-b", Algorithm: types.HashAlgorithmKeccak256}}
+	require.NoError(t, params.Validate())
+	multisigKeeper.SetParams(ctx, params)
+
+	// New commands of the chain use keccak256, other chains keep SHA-256
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	after, err := multisigKeeper.GenerateMintCommand(ctx, "bank-b", "0x1111111111111111111111111111111111111111", math.NewInt(100))
+	require.NoError(t, err)
+	require.Equal(t, types.HashAlgorithmKeccak256, after.HashAlgorithm)
+	other, err := multisigKeeper.GenerateMintCommand(ctx, "bank-c", "0x1111111111111111111111111111111111111111", math.NewInt(100))
+	require.NoError(t, err)
+	require.Equal(t, types.HashAlgorithmSHA256, other.HashAlgorithm)
+
+	asSHA256 := after
+	asSHA256.HashAlgorithm = types.HashAlgorithmSHA256
+	require.NotEqual(t, multisigtypes.CommandHash(asSHA256), multisigtypes.CommandHash(after))
+
+	// The stored command keeps the hash it was signed with
+	stored, found := multisigKeeper.GetCommand(ctx, before.CommandID)
+	require.True(t, found)
+	require.Equal(t, beforeHash, multisigtypes.CommandHash(stored))
+
+	// Commands stored before hash algorithms were configurable hash with SHA-256
+	legacy := stored
+	legacy.HashAlgorithm = ""
+	require.Equal(t, beforeHash, multisigtypes.CommandHash(legacy))
+
+	// Unknown algorithms and duplicate chains are rejected
+	params.CommandHashAlgorithms = []types.ChainHashAlgorithm{{Chain: "bank-b", Algorithm: "md5"}}
+	require.Error(t, params.Validate())
+	params.CommandHashAlgorithms = []types.ChainHashAlgorithm{
+		{Chain: "bank-b", Algorithm: types.HashAlgorithmKeccak256},
+		{Chain: "bank-b", Algorithm: types.HashAlgorithmSHA256},
+	}
+	require.Error(t, params.Validate())
+}
+
+// **Unit Test: 게이트웨이 테스트 벡터**
+func TestGenerateTestVectors_MatchKeeperEncodings(t *testing.T) {
+	ctx, multisigKeeper := setupMultisigTestEnvironment(t)
+
+	// The keeper signs through a signer service holding the test vector keys;
+	// signing is deterministic, so its signatures must match the vectors
+	input := cli.DefaultTestVectorInput()
+	validators := make([]types.Validator, len(input.Validators))
+	signer := &keySigner{keys: make(map[string]*ecdsa.PrivateKey)}
+	for i, validator := range input.Validators {
+		key, err := crypto.HexToECDSA(validator.PrivateKey)
+		require.NoError(t, err)
+		signer.keys[validator.Address] = key
+		validators[i] = types.Validator{Address: validator.Address, PubKey: crypto.CompressPubkey(&key.PublicKey), Power: 1, Active: true}
+	}
+	require.NoError(t, multisigKeeper.UpdateValidatorSet(ctx, validators))
+	multisigKeeper.SetSignerService(signer)
+
+	// bank-c hashes its commands and batches with keccak256
+	params := multisigKeeper.GetParams(ctx)
+	params.CommandHashAlgorithms = []types.ChainHashAlgorithm{{Chain: "bank-c", Algorithm: types.HashAlgorithmKeccak256}}
+	multisigKeeper.SetParams(ctx, params)
+
+	input.Commands = nil
+	for i, chain := range []string{"bank-b", "bank-c", "bank-b"} {
+		command, err := multisigKeeper.GenerateMintCommand(ctx, chain, "0x1111111111111111111111111111111111111111", math.NewInt(int64(100+i)))
+		require.NoError(t, err)
+		input.Commands = append(input.Commands, command)
+	}
+	require.NoError(t, signPendingCommands(ctx, multisigKeeper))
+	require.NoError(t, multisigKeeper.BatchSignedCommands(ctx))
+	require.NoError(t, signPendingBatches(ctx, multisigKeeper))
+
+	validatorSet := multisigKeeper.GetValidatorSet(ctx)
+	input.Version = validatorSet.Version
+	input.Threshold = validatorSet.Threshold
+	vectors, err := cli.GenerateTestVectors(input)
+	require.NoError(t, err)
+
+	encoded := func(signatures []types.ECDSASignature) map[string]string {
+		bySigner := make(map[string]string)
+		for _, signature := range signatures {
+			bz, err := multisigtypes.EncodeSignature(signature)
+			require.NoError(t, err)
+			bySigner[signature.Validator] = hexutil.Encode(bz)
+		}
+		return bySigner
+	}
+	vectorEncoded := func(signatures []cli.SignatureVector) map[string]string {
+		bySigner := make(map[string]string)
+		for _, signature := range signatures {
+			bySigner[signature.Validator] = signature.Encoded
+		}
+		return bySigner
+	}
+
+	// Commands: same hash and the same signature from every validator
+	for i, vector := range vectors.Commands {
+		command, found := multisigKeeper.GetCommand(ctx, input.Commands[i].CommandID)
+		require.True(t, found)
+		require.Equal(t, hexutil.Encode(multisigtypes.CommandHash(command)), vector.CommandHash)
+		require.Equal(t, encoded(command.Signatures), vectorEncoded(vector.Signatures))
+	}
+
+	// Batches: same root, leaf order and signatures per target chain. Root
+	// signatures stop being accepted once the threshold is met, so the batch
+	// holds a threshold-sized subset of the vector signatures.
+	batches := multisigKeeper.GetAllCommandBatches(ctx)
+	require.Len(t, vectors.Batches, len(batches))
+	for _, vector := range vectors.Batches {
+		var batch *multisigtypes.CommandBatch
+		for i := range batches {
+			if batches[i].TargetChain == vector.TargetChain {
+				batch = &batches[i]
+			}
+		}
+		require.NotNil(t, batch, vector.TargetChain)
+		require.Equal(t, params.CommandHashAlgorithm(vector.TargetChain), batch.HashAlgorithm)
+		require.Equal(t, batch.HashAlgorithm.String(), vector.HashAlgorithm)
+		require.Equal(t, hexutil.Encode(batch.Root), vector.Root)
+		require.Equal(t, batch.CommandIDs, vector.CommandIDs)
+		require.Len(t, batch.Signatures, int(validatorSet.Threshold))
+		expected := vectorEncoded(vector.Signatures)
+		for validator, signature := range encoded(batch.Signatures) {
+			require.Equal(t, expected[validator], signature, validator)
+		}
+	}
+
+	// The validator set hash does not depend on the order of the validators
+	hash, err := multisigtypes.ValidatorSetHash(validatorSet)
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Encode(hash), vectors.ValidatorSet.Hash)
+	reversed := validatorSet
+	reversed.Validators = []types.Validator{validators[2], validators[1], validators[0]}
+	reversedHash, err := multisigtypes.ValidatorSetHash(reversed)
+	require.NoError(t, err)
+	require.Equal(t, hash, reversedHash)
+
+	// The aggregated signatures are the ABI encoding the gateway decodes
+	aggregated, err := hexutil.Decode(vectors.Commands[0].AggregatedSignatures)
+	require.NoError(t, err)
+	require.Equal(t, 64+len(validators)*(32+32+96), len(aggregated))
+}
+
+// **Unit Test: 검증자 집합 크기 제한**
+func TestUpdateValidatorSet_EnforcesSizeLimits(t *testing.T) {
+	ctx, k := setupMultisigTestEnvironment(t)
+	updater := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	msg := multisigtypes.NewMsgUpdateValidatorSet(updater, generateValidators(3))
+	require.NoError(t, msg.ValidateBasic())
+
+	// Oversized sets and fields are rejected before they reach the keeper
+	msg = multisigtypes.NewMsgUpdateValidatorSet(updater, generateValidators(multisigtypes.MaxValidatorSetSize+1))
+	require.ErrorIs(t, msg.ValidateBasic(), multisigtypes.ErrValidatorSetTooLarge)
+
+	validators := generateValidators(3)
+	validators[1].Address = strings.Repeat("a", types.MaxFieldLength+1)
+	require.ErrorIs(t, multisigtypes.NewMsgUpdateValidatorSet(updater, validators).ValidateBasic(), multisigtypes.ErrValidatorSetTooLarge)
+
+	validators = generateValidators(3)
+	validators[2].PubKey = make([]byte, multisigtypes.MaxPubKeyLength+1)
+	require.ErrorIs(t, multisigtypes.NewMsgUpdateValidatorSet(updater, validators).ValidateBasic(), multisigtypes.ErrValidatorSetTooLarge)
+
+	// The keeper holds sets to the MaxValidatorCount param
+	params := multisigtypes.DefaultParams()
+	params.MaxValidatorCount = 3
+	k.SetParams(ctx, params)
+	require.ErrorIs(t, k.UpdateValidatorSet(ctx, generateValidators(4)), multisigtypes.ErrValidatorSetTooLarge)
+	require.NoError(t, k.UpdateValidatorSet(ctx, generateValidators(3)))
+
+	extra := generateValidators(4)[3]
+	require.ErrorIs(t, k.AddValidator(ctx, extra), multisigtypes.ErrValidatorSetTooLarge)
+	require.Len(t, k.GetValidatorSet(ctx).Validators, 3)
+}
+
+// **Unit Test: 게이트웨이 논스 공백 감지**
+func TestDetectNonceGaps_PausesChainUntilResolved(t *testing.T) {
+	ctx, multisigKeeper := setupMultisigTestEnvironment(t)
+	validators := generateValidators(3)
+	require.NoError(t, multisigKeeper.UpdateValidatorSet(ctx, validators))
+	reporter := validators[0].Address
+	msgServer := keeper.NewMsgServerImpl(*multisigKeeper)
+	querier := keeper.NewQueryServerImpl(*multisigKeeper)
+
+	generate := func(chain string, count int) []types.MintCommand {
+		var commands []types.MintCommand
+		for i := 0; i < count; i++ {
+			command, err := multisigKeeper.GenerateMintCommand(ctx, chain, "recipient1", math.NewInt(int64(1000+i)))
+			require.NoError(t, err)
+			commands = append(commands, command)
+		}
+		require.NoError(t, signPendingCommands(ctx, multisigKeeper))
+		return commands
+	}
+	report := func(command types.MintCommand) {
+		_, _, err := multisigKeeper.ReportExecution(ctx, reporter, command.IdempotencyKey, "0xabc", 21000, math.NewInt(100))
+		require.NoError(t, err)
+	}
+	hasEvent := func(eventType string) bool {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == eventType {
+				return true
+			}
+		}
+		return false
+	}
+
+	commands := generate("bank-a", 5)
+	generate("bank-b", 1)
+
+	// Executions of one block may be reported out of order
+	report(commands[1])
+	report(commands[0])
+	require.NoError(t, multisigKeeper.DetectNonceGaps(ctx))
+	require.Empty(t, multisigKeeper.GetAllNonceGaps(ctx))
+
+	// Nonce 3 is skipped when nonce 4 executes
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	report(commands[3])
+	require.NoError(t, multisigKeeper.DetectNonceGaps(ctx))
+	require.True(t, hasEvent(multisigtypes.EventTypeNonceGapDetected))
+	gap, found := multisigKeeper.GetNonceGap(ctx, "bank-a")
+	require.True(t, found)
+	require.Equal(t, []uint64{3}, gap.MissingNonces)
+	require.Equal(t, uint64(4), gap.HighestExecuted)
+
+	resp, err := querier.NonceGaps(ctx, &multisigtypes.QueryNonceGapsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Gaps, 1)
+
+	// Generation is paused for the chain only
+	_, err = multisigKeeper.GenerateMintCommand(ctx, "bank-a", "recipient1", math.NewInt(1))
+	require.ErrorIs(t, err, multisigtypes.ErrNonceGap)
+	require.True(t, types.IsRetryable(err))
+	_, err = multisigKeeper.GenerateMintCommand(ctx, "bank-b", "recipient1", math.NewInt(1))
+	require.NoError(t, err)
+
+	// Reporting the skipped command closes the gap
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	report(commands[2])
+	require.NoError(t, multisigKeeper.DetectNonceGaps(ctx))
+	require.True(t, hasEvent(multisigtypes.EventTypeNonceGapResolved))
+	require.Empty(t, multisigKeeper.GetAllNonceGaps(ctx))
+
+	// A gap whose command will not execute is resolved by governance
+	commands = append(commands, generate("bank-a", 1)...)
+	report(commands[5])
+	require.NoError(t, multisigKeeper.DetectNonceGaps(ctx))
+	gap, found = multisigKeeper.GetNonceGap(ctx, "bank-a")
+	require.True(t, found)
+	require.Equal(t, []uint64{5}, gap.MissingNonces)
+
+	_, err = msgServer.ResolveNonceGap(ctx, multisigtypes.NewMsgResolveNonceGap(reporter, "bank-a"))
+	require.ErrorIs(t, err, multisigtypes.ErrUnauthorized)
+
+	resolved, err := msgServer.ResolveNonceGap(ctx, multisigtypes.NewMsgResolveNonceGap(multisigKeeper.GetAuthority(), "bank-a"))
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5}, resolved.FailedNonces)
+	skipped, _ := multisigKeeper.GetCommand(ctx, commands[4].CommandID)
+	require.Equal(t, int32(types.CommandStatusFailed), skipped.Status)
+
+	require.NoError(t, multisigKeeper.DetectNonceGaps(ctx))
+	require.Empty(t, multisigKeeper.GetAllNonceGaps(ctx))
+	_, err = multisigKeeper.GenerateMintCommand(ctx, "bank-a", "recipient1", math.NewInt(1))
+	require.NoError(t, err)
+
+	_, err = msgServer.ResolveNonceGap(ctx, multisigtypes.NewMsgResolveNonceGap(multisigKeeper.GetAuthority(), "bank-a"))
+	require.ErrorIs(t, err, multisigtypes.ErrNonceGapNotFound)
+}
+
+// **Unit Test: 긴급 검증자 집합 교체**
+func TestOverrideValidatorSet_ReplacesSetAndHandlesInFlightCommands(t *testing.T) {
+	ctx, multisigKeeper := setupMultisigTestEnvironment(t)
+	validators := generateValidators(6)
+	previous, recovery := validators[:3], validators[3:5]
+	require.NoError(t, multisigKeeper.UpdateValidatorSet(ctx, previous))
+	require.NoError(t, multisigKeeper.AdmitValidator(ctx, validators[5], 3))
+	authority := multisigKeeper.GetAuthority()
+	msgServer := keeper.NewMsgServerImpl(*multisigKeeper)
+
+	// One command signed and batched by the previous set, one still pending
+	signed, err := multisigKeeper.GenerateMintCommand(ctx, "bank-a", "recipient1", math.NewInt(1000))
+	require.NoError(t, err)
+	require.NoError(t, signPendingCommands(ctx, multisigKeeper))
+	require.NoError(t, multisigKeeper.BatchSignedCommands(ctx))
+	_, err = multisigKeeper.GetCommandBatchProof(ctx, signed.CommandID)
+	require.NoError(t, err)
+	pending, err := multisigKeeper.GenerateMintCommand(ctx, "bank-a", "recipient1", math.NewInt(2000))
+	require.NoError(t, err)
+
+	duplicate := multisigtypes.NewMsgOverrideValidatorSet(authority, []types.Validator{recovery[0], recovery[0]}, multisigtypes.InFlightResign, "quorum lost")
+	require.Error(t, duplicate.ValidateBasic())
+	require.Error(t, multisigtypes.NewMsgOverrideValidatorSet(authority, recovery, "keep", "quorum lost").ValidateBasic())
+
+	msg := multisigtypes.NewMsgOverrideValidatorSet(previous[0].Address, recovery, multisigtypes.InFlightResign, "quorum lost")
+	_, err = msgServer.OverrideValidatorSet(ctx, msg)
+	require.ErrorIs(t, err, multisigtypes.ErrUnauthorized)
+
+	msg.Authority = authority
+	require.NoError(t, msg.ValidateBasic())
+	resp, err := msgServer.OverrideValidatorSet(ctx, msg)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{signed.CommandID, pending.CommandID}, resp.CommandIDs)
+
+	// The set is replaced wholesale, without probations
+	validatorSet := multisigKeeper.GetValidatorSet(ctx)
+	require.Equal(t, recovery, validatorSet.Validators)
+	require.Equal(t, resp.Version, validatorSet.Version)
+	require.Equal(t, types.ConsensusThreshold(2), validatorSet.Threshold)
+	require.Empty(t, multisigKeeper.GetAllProbations(ctx))
+
+	// In-flight commands wait for the new set to sign them again
+	for _, commandID := range resp.CommandIDs {
+		command, found := multisigKeeper.GetCommand(ctx, commandID)
+		require.True(t, found)
+		require.Equal(t, int32(types.CommandStatusPending), command.Status)
+		require.Empty(t, command.Signatures)
+	}
+	require.NoError(t, signPendingCommands(ctx, multisigKeeper))
+
+	// They carry signatures of the new set only and are batched again
+	for _, commandID := range resp.CommandIDs {
+		command, found := multisigKeeper.GetCommand(ctx, commandID)
+		require.True(t, found)
+		require.Equal(t, int32(types.CommandStatusSigned), command.Status)
+		require.Len(t, command.Signatures, 2)
+		for _, signature := range command.Signatures {
+			require.Contains(t, []string{recovery[0].Address, recovery[1].Address}, signature.Validator)
+		}
+		require.True(t, multisigKeeper.VerifyCommand(ctx, command))
+		_, err = multisigKeeper.GetCommandBatchProof(ctx, commandID)
+		require.ErrorIs(t, err, multisigtypes.ErrCommandNotBatched)
+	}
+	require.NoError(t, multisigKeeper.BatchSignedCommands(ctx))
+	_, err = multisigKeeper.GetCommandBatchProof(ctx, signed.CommandID)
+	require.NoError(t, err)
+
+	// Validators of the replaced set can no longer sign
+	_, err = multisigKeeper.SignData(ctx, previous[0].Address, []byte("data"))
+	require.ErrorIs(t, err, multisigtypes.ErrValidatorNotFound)
+
+	// Invalidating fails the in-flight commands instead
+	stale, err := multisigKeeper.GenerateMintCommand(ctx, "bank-b", "recipient1", math.NewInt(3000))
+	require.NoError(t, err)
+	resp, err = msgServer.OverrideValidatorSet(ctx, multisigtypes.NewMsgOverrideValidatorSet(authority, previous, multisigtypes.InFlightInvalidate, "recovery set compromised"))
+	require.NoError(t, err)
+	require.Len(t, resp.CommandIDs, 3)
+	for _, commandID := range resp.CommandIDs {
+		command, _ := multisigKeeper.GetCommand(ctx, commandID)
+		require.Equal(t, int32(types.CommandStatusFailed), command.Status)
+	}
+	require.Contains(t, resp.CommandIDs, stale.CommandID)
+}
+
+// **Unit Test: 긴급 교체 시 진행 중인 배치 만료**
+func TestOverrideValidatorSet_ExpiresBatchesOfTheReplacedSet(t *testing.T) {
+	ctx, multisigKeeper := setupMultisigTestEnvironment(t)
+	validators := generateValidators(6)
+	previous, recovery := validators[:4], validators[4:]
+	require.NoError(t, multisigKeeper.UpdateValidatorSet(ctx, previous))
+	authority := multisigKeeper.GetAuthority()
+
+	// A batch holding root signatures of two replaced validators, which
+	// would meet the threshold of the two-validator recovery set
+	command, err := multisigKeeper.GenerateMintCommand(ctx, "bank-a", "recipient1", math.NewInt(1000))
+	require.NoError(t, err)
+	require.NoError(t, signPendingCommands(ctx, multisigKeeper))
+	require.NoError(t, multisigKeeper.BatchSignedCommands(ctx))
+	proof, err := multisigKeeper.GetCommandBatchProof(ctx, command.CommandID)
+	require.NoError(t, err)
+	batch, _ := multisigKeeper.GetCommandBatch(ctx, proof.BatchID)
+	for _, validator := range previous[:2] {
+		signature, err := multisigKeeper.SignData(ctx, validator.Address, batch.Root)
+		require.NoError(t, err)
+		require.NoError(t, multisigKeeper.AddSignatureToBatch(ctx, batch.BatchID, signature))
+	}
+
+	_, _, err = multisigKeeper.OverrideValidatorSet(ctx, recovery, multisigtypes.InFlightResign, "quorum lost", authority)
+	require.NoError(t, err)
+	require.Equal(t, types.ConsensusThreshold(2), multisigKeeper.GetValidatorSet(ctx).Threshold)
+
+	// The batch is expired without its signatures and its command released
+	require.NoError(t, multisigKeeper.EvaluatePendingBatches(ctx))
+	expired, _ := multisigKeeper.GetCommandBatch(ctx, batch.BatchID)
+	require.Equal(t, multisigtypes.BatchStatusExpired, expired.Status)
+	require.Empty(t, expired.Signatures)
+	_, err = multisigKeeper.GetCommandBatchProof(ctx, command.CommandID)
+	require.ErrorIs(t, err, multisigtypes.ErrCommandNotBatched)
+
+	// The recovery set signs the command and, in a later block, its new batch
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, signPendingCommands(ctx, multisigKeeper))
+	require.NoError(t, multisigKeeper.BatchSignedCommands(ctx))
+	require.NoError(t, signPendingBatches(ctx, multisigKeeper))
+	proof, err = multisigKeeper.GetCommandBatchProof(ctx, command.CommandID)
+	require.NoError(t, err)
+	require.NotEqual(t, batch.BatchID, proof.BatchID)
+
+	// Invalidating fails the command, so its signed batch no longer serves a
+	// proof for it
+	_, _, err = multisigKeeper.OverrideValidatorSet(ctx, previous, multisigtypes.InFlightInvalidate, "recovery set compromised", authority)
+	require.NoError(t, err)
+	failed, _ := multisigKeeper.GetCommand(ctx, command.CommandID)
+	require.Equal(t, int32(types.CommandStatusFailed), failed.Status)
+	_, err = multisigKeeper.GetCommandBatchProof(ctx, command.CommandID)
+	require.ErrorIs(t, err, multisigtypes.ErrCommandNotBatched)
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
+)
+
+// Migrator runs the in-place store migrations of the multisig module
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a Migrator for the keeper
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 moves the store to the collections layout. Every key of
+// version 2 is already a single string after its prefix, so only the
+// executed and escalation markers change: version 2 stored them as {0x01}
+// and collection key sets store empty values. Batches still short of the
+// threshold are added to the pending batches awaiting root signatures.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+
+	for _, prefix := range [][]byte{multisigtypes.ExecutedKeyPrefix, multisigtypes.SigningEscalationKeyPrefix} {
+		iterator := storetypes.KVStorePrefixIterator(store, prefix)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Set(key, []byte{})
+		}
+	}
+
+	for _, batch := range m.keeper.GetAllCommandBatches(ctx) {
+		if batch.Status != multisigtypes.BatchStatusPending {
+			continue
+		}
+		if err := m.keeper.PendingBatches.Set(ctx, batch.BatchID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
 )
@@ -24,7 +25,7 @@ func (k msgServer) GenerateMintCommand(goCtx context.Context, msg *multisigtypes
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// Generate mint command
-	command, err := k.Keeper.GenerateMintCommand(ctx, msg.TargetChain, msg.Recipient, msg.Amount)
+	command, err := k.Keeper.GenerateTokenMintCommand(ctx, msg.TargetChain, msg.Recipient, msg.TokenID, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -39,8 +40,8 @@ func (k msgServer) GenerateMintCommand(goCtx context.Context, msg *multisigtypes
 func (k msgServer) SignCommand(goCtx context.Context, msg *multisigtypes.MsgSignCommand) (*multisigtypes.MsgSignCommandResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// Add signature to command
-	err := k.Keeper.AddSignatureToCommand(ctx, msg.CommandID, msg.Signature)
+	// Add the signer's own signature to command
+	err := k.Keeper.SignCommand(ctx, msg.Signer, msg.CommandID, msg.Signature)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +53,7 @@ func (k msgServer) SignCommand(goCtx context.Context, msg *multisigtypes.MsgSign
 	}
 
 	validatorSet := k.Keeper.GetValidatorSet(ctx)
-	thresholdMet := int32(len(command.Signatures)) >= validatorSet.Threshold
+	thresholdMet := int32(len(k.Keeper.countedSignatures(ctx, command.Signatures))) >= validatorSet.Threshold
 
 	return &multisigtypes.MsgSignCommandResponse{
 		Success:        true,
@@ -61,6 +62,27 @@ func (k msgServer) SignCommand(goCtx context.Context, msg *multisigtypes.MsgSign
 	}, nil
 }
 
+// SignBatch handles MsgSignBatch messages
+func (k msgServer) SignBatch(goCtx context.Context, msg *multisigtypes.MsgSignBatch) (*multisigtypes.MsgSignBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Add the signer's own signature to the batch root
+	if err := k.Keeper.SignBatch(ctx, msg.Signer, msg.BatchID, msg.Signature); err != nil {
+		return nil, err
+	}
+
+	batch, found := k.Keeper.GetCommandBatch(ctx, msg.BatchID)
+	if !found {
+		return nil, multisigtypes.ErrBatchNotFound
+	}
+
+	return &multisigtypes.MsgSignBatchResponse{
+		Success:        true,
+		SignatureCount: len(batch.Signatures),
+		ThresholdMet:   batch.Status == multisigtypes.BatchStatusSigned,
+	}, nil
+}
+
 // UpdateValidatorSet handles MsgUpdateValidatorSet messages
 func (k msgServer) UpdateValidatorSet(goCtx context.Context, msg *multisigtypes.MsgUpdateValidatorSet) (*multisigtypes.MsgUpdateValidatorSetResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -109,4 +131,75 @@ func (k msgServer) RemoveValidator(goCtx context.Context, msg *multisigtypes.Msg
 	return &multisigtypes.MsgRemoveValidatorResponse{
 		Success: true,
 	}, nil
-}
\ No newline at end of file
+}
+
+// AdmitValidator handles MsgAdmitValidator messages
+func (k msgServer) AdmitValidator(goCtx context.Context, msg *multisigtypes.MsgAdmitValidator) (*multisigtypes.MsgAdmitValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.Keeper.GetAuthority() {
+		return nil, errorsmod.Wrapf(multisigtypes.ErrUnauthorized, "expected %s, got %s", k.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	if err := k.Keeper.AdmitValidator(ctx, msg.Validator, msg.ProbationCycles); err != nil {
+		return nil, err
+	}
+
+	return &multisigtypes.MsgAdmitValidatorResponse{
+		Probation: msg.ProbationCycles > 0,
+		Threshold: k.Keeper.GetValidatorSet(ctx).Threshold,
+	}, nil
+}
+
+// ReportExecution handles MsgReportExecution messages
+func (k msgServer) ReportExecution(goCtx context.Context, msg *multisigtypes.MsgReportExecution) (*multisigtypes.MsgReportExecutionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	commandID, duplicate, err := k.Keeper.ReportExecution(ctx, msg.Reporter, msg.IdempotencyKey, msg.TxHash, msg.GasUsed, msg.CostWei)
+	if err != nil {
+		return nil, err
+	}
+
+	return &multisigtypes.MsgReportExecutionResponse{
+		CommandID: commandID,
+		Duplicate: duplicate,
+	}, nil
+}
+
+// ResolveNonceGap handles MsgResolveNonceGap messages
+func (k msgServer) ResolveNonceGap(goCtx context.Context, msg *multisigtypes.MsgResolveNonceGap) (*multisigtypes.MsgResolveNonceGapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.Keeper.GetAuthority() {
+		return nil, errorsmod.Wrapf(multisigtypes.ErrUnauthorized, "expected %s, got %s", k.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	gap, err := k.Keeper.ResolveNonceGap(ctx, msg.TargetChain, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	return &multisigtypes.MsgResolveNonceGapResponse{
+		FailedNonces: gap.MissingNonces,
+	}, nil
+}
+
+// OverrideValidatorSet handles MsgOverrideValidatorSet messages
+func (k msgServer) OverrideValidatorSet(goCtx context.Context, msg *multisigtypes.MsgOverrideValidatorSet) (*multisigtypes.MsgOverrideValidatorSetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.Keeper.GetAuthority() {
+		return nil, errorsmod.Wrapf(multisigtypes.ErrUnauthorized, "expected %s, got %s", k.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	validatorSet, commandIDs, err := k.Keeper.OverrideValidatorSet(ctx, msg.Validators, msg.InFlight, msg.Reason, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	return &multisigtypes.MsgOverrideValidatorSetResponse{
+		Version:    validatorSet.Version,
+		Threshold:  validatorSet.Threshold,
+		CommandIDs: commandIDs,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testhelpers "github.com/interbank-netting/cosmos/testutil"
+	"github.com/interbank-netting/cosmos/x/multisig/keeper"
+	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
+)
+
+// **Unit Test: 버전 2 저장소 픽스처 업그레이드 호환성**
+func TestUpgradeFromVersion2Store_KeepsQueryResults(t *testing.T) {
+	ctx, multisigKeeper := setupMultisigTestEnvironment(t)
+	fixture := testhelpers.LoadStoreFixture(t, "testdata/store_v2.json")
+	fixture.Write(t, ctx.KVStore(multisigKeeper.GetStoreKey()))
+
+	require.NoError(t, keeper.NewMigrator(*multisigKeeper).Migrate2to3(ctx))
+
+	resp, err := keeper.NewQueryServerImpl(*multisigKeeper).LateSigners(ctx, &multisigtypes.QueryLateSignersRequest{CommandID: "cmd-bank-b-8"})
+	require.NoError(t, err)
+	require.True(t, fixture.MatchesQuery(t, "LateSigners", resp))
+
+	// Commands are still found by their idempotency keys
+	for key, commandID := range map[string]string{"9f2c": "cmd-bank-b-8", "41d7": "cmd-bank-a-3"} {
+		found, ok := multisigKeeper.GetCommandIDByIdempotencyKey(ctx, key)
+		require.True(t, ok)
+		require.Equal(t, commandID, found)
+	}
+
+	// Markers are walkable key sets once rewritten
+	var executed []string
+	require.NoError(t, multisigKeeper.Executed.Walk(ctx, nil, func(key string) (bool, error) {
+		executed = append(executed, key)
+		return false, nil
+	}))
+	require.Equal(t, []string{"41d7"}, executed)
+	require.False(t, multisigKeeper.IsExecutionReported(ctx, "9f2c"))
+	require.True(t, multisigKeeper.IsSigningEscalated(ctx, "cmd-bank-b-8"))
+
+	// Only the batch still short of the threshold awaits root signatures
+	var pending []string
+	require.NoError(t, multisigKeeper.PendingBatches.Walk(ctx, nil, func(batchID string) (bool, error) {
+		pending = append(pending, batchID)
+		return false, nil
+	}))
+	require.Equal(t, []string{"batch-bank-b-41"}, pending)
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/interbank-netting/cosmos/types"
+	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
+)
+
+// ViewKeeper exposes the read-only methods of the multisig keeper. Query
+// servers hold a ViewKeeper instead of the Keeper, so a query path cannot
+// write state.
+type ViewKeeper interface {
+	GetParams(ctx sdk.Context) multisigtypes.Params
+	GetValidatorSet(ctx sdk.Context) types.ValidatorSet
+	CheckValidatorSetConsistency(ctx sdk.Context) (multisigtypes.ValidatorSetConsistency, error)
+	GetAllProbations(ctx sdk.Context) []multisigtypes.ValidatorProbation
+	GetAllNonceGaps(ctx sdk.Context) []multisigtypes.NonceGap
+
+	GetCommand(ctx sdk.Context, commandID string) (types.MintCommand, bool)
+	GetCommandIDByIdempotencyKey(ctx sdk.Context, idempotencyKey string) (string, bool)
+	IsExecutionReported(ctx sdk.Context, idempotencyKey string) bool
+	LateSigners(ctx sdk.Context, command types.MintCommand) []string
+	IsSigningEscalated(ctx sdk.Context, commandID string) bool
+	GetExecutionCost(ctx sdk.Context, commandID string) (multisigtypes.ExecutionCost, bool)
+	SummarizeExecutionCosts(ctx sdk.Context, targetChain string, startTime, endTime int64) []multisigtypes.ExecutionCostSummary
+
+	GetCommandBatch(ctx sdk.Context, batchID string) (multisigtypes.CommandBatch, bool)
+	GetCommandBatchProof(ctx sdk.Context, commandID string) (multisigtypes.CommandBatchProof, error)
+	VerifyCommandBatchProof(ctx sdk.Context, proof multisigtypes.CommandBatchProof) error
+}
+
+var _ ViewKeeper = Keeper{}
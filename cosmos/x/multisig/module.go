@@ -113,10 +113,18 @@ func (am AppModule) Name() string {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	// TODO: Register msg server when protobuf is generated
 	// multisigtypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+	// multisigtypes.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(multisigtypes.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", multisigtypes.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the multisig module's invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the multisig module's genesis initialization It returns
 // no validator updates.
@@ -134,16 +142,49 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the multisig module.
 func (am AppModule) BeginBlock(ctx context.Context) error {
 	return nil
 }
 
+// reconcileValidatorSet syncs the validator set from staking every
+// ReconcileInterval blocks and otherwise only reports drift
+func (am AppModule) reconcileValidatorSet(ctx sdk.Context) error {
+	if am.keeper.GetParams(ctx).ReconcileDue(ctx.BlockHeight()) {
+		_, err := am.keeper.ReconcileValidatorSet(ctx)
+		return err
+	}
+	return am.keeper.ReportValidatorSetDrift(ctx)
+}
+
 // EndBlock executes all ABCI EndBlock logic respective to the multisig module.
-// Requirement 5.2: Collect ECDSA signatures from active validators
+// Validators sign commands with MsgSignCommand and batch roots with
+// MsgSignBatch; EndBlock only evaluates the threshold of pending commands and
+// batches and expires them.
 func (am AppModule) EndBlock(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	return am.keeper.ProcessPendingCommands(sdkCtx)
+	if err := am.keeper.EvaluatePendingCommands(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.ExpirePendingCommands(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.EscalateLateSigners(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.reconcileValidatorSet(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.DetectNonceGaps(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.EvaluatePendingBatches(sdkCtx); err != nil {
+		return err
+	}
+	if err := am.keeper.ExpirePendingBatches(sdkCtx); err != nil {
+		return err
+	}
+	return am.keeper.BatchSignedCommands(sdkCtx)
 }
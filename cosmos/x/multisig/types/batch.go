@@ -0,0 +1,101 @@
+package types
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/interbank-netting/cosmos/types"
+)
+
+// Command batch statuses
+const (
+	BatchStatusPending int32 = 0 // Root awaiting validator signatures
+	BatchStatusSigned  int32 = 1 // Root signed by 2/3+ of the validator set
+	BatchStatusExpired int32 = 2 // Root short of the threshold SigningTimeout seconds after creation
+)
+
+// CommandBatch groups the signed commands of one target chain created in a
+// block under a single Merkle root. Validators sign the root once with
+// MsgSignBatch, and the gateway accepts any command of the batch with the root
+// signatures and the command's Merkle proof.
+type CommandBatch struct {
+	BatchID       string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id"`
+	TargetChain   string                 `protobuf:"bytes,2,opt,name=target_chain,json=targetChain,proto3" json:"target_chain"`
+	BlockHeight   int64                  `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height"`
+	CommandIDs    []string               `protobuf:"bytes,4,rep,name=command_ids,json=commandIds,proto3" json:"command_ids"` // Leaf order
+	Root          []byte                 `protobuf:"bytes,5,opt,name=root,proto3" json:"root"`
+	Signatures    []types.ECDSASignature `protobuf:"bytes,6,rep,name=signatures,proto3" json:"signatures"` // Signatures over Root
+	Status        int32                  `protobuf:"varint,7,opt,name=status,proto3" json:"status"`
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	HashAlgorithm types.HashAlgorithm    `protobuf:"bytes,9,opt,name=hash_algorithm,json=hashAlgorithm,proto3" json:"hash_algorithm,omitempty"` // Algorithm of the Merkle tree, empty for SHA-256
+}
+
+// ProtoMessage implements proto.Message
+func (b *CommandBatch) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (b *CommandBatch) Reset() { *b = CommandBatch{} }
+
+// String implements proto.Message
+func (b *CommandBatch) String() string {
+	return fmt.Sprintf("CommandBatch{BatchID: %s, TargetChain: %s, Commands: %d}", b.BatchID, b.TargetChain, len(b.CommandIDs))
+}
+
+// CommandBatchProof proves that a command is part of a signed batch
+type CommandBatchProof struct {
+	BatchID       string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id"`
+	CommandID     string                 `protobuf:"bytes,2,opt,name=command_id,json=commandId,proto3" json:"command_id"`
+	Root          []byte                 `protobuf:"bytes,3,opt,name=root,proto3" json:"root"`
+	LeafHash      []byte                 `protobuf:"bytes,4,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash"`
+	Index         uint32                 `protobuf:"varint,5,opt,name=index,proto3" json:"index"`
+	LeafCount     uint32                 `protobuf:"varint,6,opt,name=leaf_count,json=leafCount,proto3" json:"leaf_count"`
+	Path          [][]byte               `protobuf:"bytes,7,rep,name=path,proto3" json:"path"`
+	Signatures    []types.ECDSASignature `protobuf:"bytes,8,rep,name=signatures,proto3" json:"signatures"`                                      // Signatures over Root
+	HashAlgorithm types.HashAlgorithm    `protobuf:"bytes,9,opt,name=hash_algorithm,json=hashAlgorithm,proto3" json:"hash_algorithm,omitempty"` // Algorithm of the Merkle tree, empty for SHA-256
+}
+
+// ProtoMessage implements proto.Message
+func (p *CommandBatchProof) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (p *CommandBatchProof) Reset() { *p = CommandBatchProof{} }
+
+// String implements proto.Message
+func (p *CommandBatchProof) String() string {
+	return fmt.Sprintf("CommandBatchProof{BatchID: %s, CommandID: %s, Index: %d}", p.BatchID, p.CommandID, p.Index)
+}
+
+// Verify checks that the proof's audit path leads from the leaf to the root.
+// The root signatures are checked separately against the validator set.
+func (p CommandBatchProof) Verify() bool {
+	return VerifyMerkleProof(p.HashAlgorithm, p.LeafHash, p.Index, p.LeafCount, p.Path, p.Root)
+}
+
+// CheckLimits rejects a proof whose audit path is longer than maxDepth or than
+// its leaf count allows, with an unknown hash algorithm or hashes not of its
+// size, or with more than maxSignatures root signatures. It runs before Verify and any signature check
+// on proofs from untrusted sources.
+func (p CommandBatchProof) CheckLimits(maxDepth, maxSignatures int32) error {
+	if int64(len(p.Path)) > int64(maxDepth) {
+		return fmt.Errorf("audit path of %d hashes, limit %d", len(p.Path), maxDepth)
+	}
+	if p.LeafCount > 0 && len(p.Path) > bits.Len32(p.LeafCount-1) {
+		return fmt.Errorf("audit path of %d hashes for %d leaves", len(p.Path), p.LeafCount)
+	}
+	if int64(len(p.Signatures)) > int64(maxSignatures) {
+		return fmt.Errorf("%d signatures, limit %d", len(p.Signatures), maxSignatures)
+	}
+
+	if !p.HashAlgorithm.IsValid() {
+		return fmt.Errorf("unknown hash algorithm %q", p.HashAlgorithm)
+	}
+	if len(p.LeafHash) != types.HashSize || len(p.Root) != types.HashSize {
+		return fmt.Errorf("leaf hash and root must be %d bytes", types.HashSize)
+	}
+	for i, sibling := range p.Path {
+		if len(sibling) != types.HashSize {
+			return fmt.Errorf("audit path hash %d: %d bytes, expected %d", i, len(sibling), types.HashSize)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	"github.com/interbank-netting/cosmos/types"
+)
+
+// Korean messages of the x/multisig errors in the message catalog
+func init() {
+	types.RegisterErrorMessages(types.LocaleKorean, map[*errors.Error]string{
+		ErrInvalidValidator:       "잘못된 검증자",
+		ErrValidatorNotFound:      "검증자를 찾을 수 없음",
+		ErrValidatorAlreadyExists: "이미 존재하는 검증자",
+		ErrInvalidSignature:       "잘못된 서명",
+		ErrDuplicateSignature:     "중복 서명",
+		ErrCommandNotFound:        "명령을 찾을 수 없음",
+		ErrCommandAlreadySigned:   "검증자가 이미 서명한 명령",
+		ErrInsufficientSignatures: "서명 부족",
+		ErrInvalidThreshold:       "잘못된 임계값",
+		ErrValidatorSetEmpty:      "검증자 집합은 비어 있을 수 없음",
+		ErrInvalidCommandID:       "잘못된 명령 ID",
+		ErrCommandExpired:         "만료된 명령",
+		ErrUnauthorized:           "권한 없는 작업",
+		ErrInvalidECDSASignature:  "잘못된 ECDSA 서명",
+		ErrSignatureVerification:  "서명 검증 실패",
+		ErrInvalidCommandStatus:   "잘못된 명령 상태",
+		ErrBatchNotFound:          "명령 배치를 찾을 수 없음",
+		ErrCommandNotBatched:      "배치에 포함되지 않은 명령",
+		ErrUnknownIdempotencyKey:  "알 수 없는 멱등성 키",
+		ErrProofTooLarge:          "증명이 검증 한도를 초과함",
+		ErrInvalidProof:           "잘못된 증명",
+		ErrInvalidTokenID:         "잘못된 토큰 ID",
+		ErrUnboundRecipient:       "수신자가 등록된 지급 주소가 아님",
+		ErrValidatorSetTooLarge:   "검증자 집합이 크기 한도를 초과함",
+		ErrNonceGap:               "논스 공백으로 명령 생성이 중단됨",
+		ErrNonceGapNotFound:       "논스 공백을 찾을 수 없음",
+	})
+}
@@ -12,9 +12,14 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgGenerateMintCommand{}, "multisig/MsgGenerateMintCommand", nil)
 	cdc.RegisterConcrete(&MsgSignCommand{}, "multisig/MsgSignCommand", nil)
+	cdc.RegisterConcrete(&MsgSignBatch{}, "multisig/MsgSignBatch", nil)
 	cdc.RegisterConcrete(&MsgUpdateValidatorSet{}, "multisig/MsgUpdateValidatorSet", nil)
 	cdc.RegisterConcrete(&MsgAddValidator{}, "multisig/MsgAddValidator", nil)
 	cdc.RegisterConcrete(&MsgRemoveValidator{}, "multisig/MsgRemoveValidator", nil)
+	cdc.RegisterConcrete(&MsgAdmitValidator{}, "multisig/MsgAdmitValidator", nil)
+	cdc.RegisterConcrete(&MsgReportExecution{}, "multisig/MsgReportExecution", nil)
+	cdc.RegisterConcrete(&MsgResolveNonceGap{}, "multisig/MsgResolveNonceGap", nil)
+	cdc.RegisterConcrete(&MsgOverrideValidatorSet{}, "multisig/MsgOverrideValidatorSet", nil)
 }
 
 // RegisterInterfaces registers the x/multisig interfaces types with the interface registry
@@ -22,9 +27,14 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgGenerateMintCommand{},
 		&MsgSignCommand{},
+		&MsgSignBatch{},
 		&MsgUpdateValidatorSet{},
 		&MsgAddValidator{},
 		&MsgRemoveValidator{},
+		&MsgAdmitValidator{},
+		&MsgReportExecution{},
+		&MsgResolveNonceGap{},
+		&MsgOverrideValidatorSet{},
 	)
 
 	// Note: MsgServiceDesc registration requires protobuf generation
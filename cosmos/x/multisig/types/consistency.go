@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ValidatorSetConsistency compares the multisig validator set with the
+// active staking set the oracle derives its threshold from
+type ValidatorSetConsistency struct {
+	StakingValidators   []string `protobuf:"bytes,1,rep,name=staking_validators,json=stakingValidators,proto3" json:"staking_validators"`         // Bonded, unjailed operators
+	MultisigValidators  []string `protobuf:"bytes,2,rep,name=multisig_validators,json=multisigValidators,proto3" json:"multisig_validators"`      // Active multisig validators
+	MissingFromMultisig []string `protobuf:"bytes,3,rep,name=missing_from_multisig,json=missingFromMultisig,proto3" json:"missing_from_multisig"` // Active in staking only
+	ExtraInMultisig     []string `protobuf:"bytes,4,rep,name=extra_in_multisig,json=extraInMultisig,proto3" json:"extra_in_multisig"`             // Active in multisig only
+	StakingThreshold    int32    `protobuf:"varint,5,opt,name=staking_threshold,json=stakingThreshold,proto3" json:"staking_threshold"`
+	MultisigThreshold   int32    `protobuf:"varint,6,opt,name=multisig_threshold,json=multisigThreshold,proto3" json:"multisig_threshold"`
+	Consistent          bool     `protobuf:"varint,7,opt,name=consistent,proto3" json:"consistent"`
+}
+
+// ProtoMessage implements proto.Message
+func (c *ValidatorSetConsistency) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (c *ValidatorSetConsistency) Reset() { *c = ValidatorSetConsistency{} }
+
+// String implements proto.Message
+func (c *ValidatorSetConsistency) String() string {
+	return fmt.Sprintf("ValidatorSetConsistency{Missing: %v, Extra: %v, StakingThreshold: %d, MultisigThreshold: %d}",
+		c.MissingFromMultisig, c.ExtraInMultisig, c.StakingThreshold, c.MultisigThreshold)
+}
+
+// NewValidatorSetConsistency compares both active sets and thresholds. The
+// sets are sorted so the result is deterministic.
+func NewValidatorSetConsistency(stakingValidators, multisigValidators []string, stakingThreshold, multisigThreshold int32) ValidatorSetConsistency {
+	staking := sortedCopy(stakingValidators)
+	multisig := sortedCopy(multisigValidators)
+
+	c := ValidatorSetConsistency{
+		StakingValidators:   staking,
+		MultisigValidators:  multisig,
+		MissingFromMultisig: difference(staking, multisig),
+		ExtraInMultisig:     difference(multisig, staking),
+		StakingThreshold:    stakingThreshold,
+		MultisigThreshold:   multisigThreshold,
+	}
+	c.Consistent = len(c.MissingFromMultisig) == 0 && len(c.ExtraInMultisig) == 0 &&
+		stakingThreshold == multisigThreshold
+	return c
+}
+
+func sortedCopy(values []string) []string {
+	sorted := append([]string{}, values...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+// difference returns the values of a missing from b
+func difference(a, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, value := range b {
+		in[value] = true
+	}
+
+	out := []string{}
+	for _, value := range a {
+		if !in[value] {
+			out = append(out, value)
+		}
+	}
+	return out
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// ExecutionCost is the relaying cost the relayer reported for executing a
+// command on its target chain. It is recorded with the first execution report
+// of the command.
+type ExecutionCost struct {
+	CommandID      string   `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3" json:"command_id"`
+	TargetChain    string   `protobuf:"bytes,2,opt,name=target_chain,json=targetChain,proto3" json:"target_chain"`
+	TxHash         string   `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash"` // Besu transaction that executed the command
+	Reporter       string   `protobuf:"bytes,4,opt,name=reporter,proto3" json:"reporter"`
+	GasUsed        uint64   `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used"`
+	CostWei        math.Int `protobuf:"bytes,6,opt,name=cost_wei,json=costWei,proto3,customtype=cosmossdk.io/math.Int" json:"cost_wei"` // Gas used times effective gas price
+	ExecutedAt     int64    `protobuf:"varint,7,opt,name=executed_at,json=executedAt,proto3" json:"executed_at"`                        // Block time of the first execution report
+	ExecutedHeight int64    `protobuf:"varint,8,opt,name=executed_height,json=executedHeight,proto3" json:"executed_height"`
+}
+
+// ProtoMessage implements proto.Message
+func (c *ExecutionCost) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (c *ExecutionCost) Reset() { *c = ExecutionCost{} }
+
+// String implements proto.Message
+func (c *ExecutionCost) String() string {
+	return fmt.Sprintf("ExecutionCost{CommandID: %s, GasUsed: %d, CostWei: %s}", c.CommandID, c.GasUsed, c.CostWei)
+}
+
+// ExecutionCostSummary totals the execution costs of a target chain over a
+// period, so relaying costs can be charged back to the bank of the chain
+type ExecutionCostSummary struct {
+	TargetChain  string   `protobuf:"bytes,1,opt,name=target_chain,json=targetChain,proto3" json:"target_chain"`
+	StartTime    int64    `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time"` // Inclusive, zero if unbounded
+	EndTime      int64    `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time"`       // Inclusive, zero if unbounded
+	CommandCount uint64   `protobuf:"varint,4,opt,name=command_count,json=commandCount,proto3" json:"command_count"`
+	GasUsed      uint64   `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used"`
+	CostWei      math.Int `protobuf:"bytes,6,opt,name=cost_wei,json=costWei,proto3,customtype=cosmossdk.io/math.Int" json:"cost_wei"`
+}
+
+// ProtoMessage implements proto.Message
+func (s *ExecutionCostSummary) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (s *ExecutionCostSummary) Reset() { *s = ExecutionCostSummary{} }
+
+// String implements proto.Message
+func (s *ExecutionCostSummary) String() string {
+	return fmt.Sprintf("ExecutionCostSummary{TargetChain: %s, Commands: %d, CostWei: %s}", s.TargetChain, s.CommandCount, s.CostWei)
+}
+
+// Add counts an execution cost into the summary
+func (s *ExecutionCostSummary) Add(cost ExecutionCost) {
+	s.CommandCount++
+	s.GasUsed += cost.GasUsed
+	s.CostWei = s.CostWei.Add(cost.CostWei)
+}
@@ -0,0 +1,157 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/interbank-netting/cosmos/types"
+)
+
+// ABI types of the gateway call arguments
+var (
+	abiBytesArray, _   = abi.NewType("bytes[]", "", nil)
+	abiUint256, _      = abi.NewType("uint256", "", nil)
+	abiAddressArray, _ = abi.NewType("address[]", "", nil)
+)
+
+// CommandHash returns the hash validators sign for a mint command: the hash,
+// with the command's hash algorithm, of the dash separated command ID, target
+// chain, recipient, amount and idempotency key. Commands with a token ID
+// append it, so commands of the default token keep the layout they had before
+// token IDs.
+func CommandHash(command types.MintCommand) []byte {
+	data := fmt.Sprintf("%s-%s-%s-%s-%s", command.CommandID, command.TargetChain, command.Recipient, command.Amount.String(), command.IdempotencyKey)
+	if command.TokenID != "" {
+		data += "-" + command.TokenID
+	}
+	return command.HashAlgorithm.Sum([]byte(data))
+}
+
+// EncodeSignature returns the 65 byte R || S || V form of a signature the
+// gateway recovers signers from, with V normalized to 27 or 28
+func EncodeSignature(signature types.ECDSASignature) ([]byte, error) {
+	if len(signature.R) > 32 || len(signature.S) > 32 {
+		return nil, fmt.Errorf("signature of %s: R and S must be at most 32 bytes", signature.Validator)
+	}
+
+	v := signature.V
+	if v < 27 {
+		v += 27
+	}
+	if v != 27 && v != 28 {
+		return nil, fmt.Errorf("signature of %s: invalid V %d", signature.Validator, signature.V)
+	}
+
+	encoded := make([]byte, 65)
+	copy(encoded[32-len(signature.R):32], signature.R)
+	copy(encoded[64-len(signature.S):64], signature.S)
+	encoded[64] = byte(v)
+	return encoded, nil
+}
+
+// DecodeSignature returns a validator's signature from its 65 byte
+// R || S || V form, as returned by a SignerService. V may be 0 or 1 and is
+// normalized to 27 or 28.
+func DecodeSignature(validator string, encoded []byte, timestamp int64) (types.ECDSASignature, error) {
+	if len(encoded) != 65 {
+		return types.ECDSASignature{}, fmt.Errorf("signature of %s: length %d, want 65", validator, len(encoded))
+	}
+
+	v := uint32(encoded[64])
+	if v < 27 {
+		v += 27
+	}
+	if v != 27 && v != 28 {
+		return types.ECDSASignature{}, fmt.Errorf("signature of %s: invalid V %d", validator, encoded[64])
+	}
+
+	return types.ECDSASignature{
+		Validator: validator,
+		R:         append([]byte(nil), encoded[:32]...),
+		S:         append([]byte(nil), encoded[32:64]...),
+		V:         v,
+		Timestamp: timestamp,
+	}, nil
+}
+
+// EncodeSignatures returns the ABI encoding of the signatures as the bytes[]
+// argument of the gateway, in the given order
+func EncodeSignatures(signatures []types.ECDSASignature) ([]byte, error) {
+	encoded := make([][]byte, len(signatures))
+	for i, signature := range signatures {
+		bz, err := EncodeSignature(signature)
+		if err != nil {
+			return nil, err
+		}
+		encoded[i] = bz
+	}
+	return abi.Arguments{{Type: abiBytesArray}}.Pack(encoded)
+}
+
+// EthereumAddress returns the address the gateway knows a validator by,
+// derived from its compressed or uncompressed secp256k1 public key
+func EthereumAddress(pubKey []byte) (ethcommon.Address, error) {
+	switch len(pubKey) {
+	case 33:
+		key, err := crypto.DecompressPubkey(pubKey)
+		if err != nil {
+			return ethcommon.Address{}, err
+		}
+		return crypto.PubkeyToAddress(*key), nil
+	case 65:
+		key, err := crypto.UnmarshalPubkey(pubKey)
+		if err != nil {
+			return ethcommon.Address{}, err
+		}
+		return crypto.PubkeyToAddress(*key), nil
+	default:
+		return ethcommon.Address{}, fmt.Errorf("unsupported public key length %d", len(pubKey))
+	}
+}
+
+// ValidatorSetHash returns keccak256(abi.encode(version, threshold,
+// addresses)) over the Ethereum addresses of the active validators in
+// ascending order, so a gateway can compare its validator set with the
+// multisig set regardless of the order validators were added in
+func ValidatorSetHash(set types.ValidatorSet) ([]byte, error) {
+	addresses, err := ActiveEthereumAddresses(set)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := abi.Arguments{{Type: abiUint256}, {Type: abiUint256}, {Type: abiAddressArray}}.Pack(
+		new(big.Int).SetUint64(set.Version),
+		big.NewInt(int64(set.Threshold)),
+		addresses,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Keccak256(bz), nil
+}
+
+// ActiveEthereumAddresses returns the Ethereum addresses of the active
+// validators of a set in ascending order
+func ActiveEthereumAddresses(set types.ValidatorSet) ([]ethcommon.Address, error) {
+	addresses := make([]ethcommon.Address, 0, len(set.Validators))
+	for _, validator := range set.Validators {
+		if !validator.Active {
+			continue
+		}
+		address, err := EthereumAddress(validator.PubKey)
+		if err != nil {
+			return nil, fmt.Errorf("validator %s: %w", validator.Address, err)
+		}
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i][:], addresses[j][:]) < 0
+	})
+	return addresses, nil
+}
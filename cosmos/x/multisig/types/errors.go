@@ -2,6 +2,8 @@ package types
 
 import (
 	"cosmossdk.io/errors"
+
+	"github.com/interbank-netting/cosmos/types"
 )
 
 // x/multisig module sentinel errors
@@ -22,4 +24,47 @@ var (
 	ErrInvalidECDSASignature  = errors.Register(ModuleName, 14, "invalid ECDSA signature")
 	ErrSignatureVerification  = errors.Register(ModuleName, 15, "signature verification failed")
 	ErrInvalidCommandStatus   = errors.Register(ModuleName, 16, "invalid command status")
-)
\ No newline at end of file
+	ErrBatchNotFound          = errors.Register(ModuleName, 17, "command batch not found")
+	ErrCommandNotBatched      = errors.Register(ModuleName, 18, "command is not part of a batch")
+	ErrUnknownIdempotencyKey  = errors.Register(ModuleName, 19, "unknown idempotency key")
+	ErrProofTooLarge          = errors.Register(ModuleName, 20, "proof exceeds verification limits")
+	ErrInvalidProof           = errors.Register(ModuleName, 21, "invalid proof")
+	ErrInvalidTokenID         = errors.Register(ModuleName, 22, "invalid token ID")
+	ErrUnboundRecipient       = errors.Register(ModuleName, 23, "recipient is not a bound payout address")
+	ErrValidatorSetTooLarge   = errors.Register(ModuleName, 24, "validator set exceeds size limits")
+	ErrNonceGap               = errors.Register(ModuleName, 25, "command generation paused by a nonce gap")
+	ErrNonceGapNotFound       = errors.Register(ModuleName, 26, "nonce gap not found")
+)
+
+func init() {
+	types.RegisterUserErrors(
+		ErrInvalidValidator,
+		ErrValidatorNotFound,
+		ErrValidatorAlreadyExists,
+		ErrInvalidSignature,
+		ErrDuplicateSignature,
+		ErrCommandNotFound,
+		ErrCommandAlreadySigned,
+		ErrInvalidThreshold,
+		ErrValidatorSetEmpty,
+		ErrInvalidCommandID,
+		ErrCommandExpired,
+		ErrUnauthorized,
+		ErrInvalidECDSASignature,
+		ErrSignatureVerification,
+		ErrInvalidCommandStatus,
+		ErrBatchNotFound,
+		ErrUnknownIdempotencyKey,
+		ErrProofTooLarge,
+		ErrInvalidProof,
+		ErrInvalidTokenID,
+		ErrUnboundRecipient,
+		ErrValidatorSetTooLarge,
+		ErrNonceGapNotFound,
+	)
+	types.RegisterRetryableErrors(
+		ErrInsufficientSignatures,
+		ErrCommandNotBatched,
+		ErrNonceGap,
+	)
+}
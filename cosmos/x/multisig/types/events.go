@@ -1,32 +1,81 @@
 package types
 
+import "github.com/interbank-netting/cosmos/types"
+
 // Multisig module event types
 const (
-	EventTypeMintCommandGenerated = "mint_command_generated"
-	EventTypeCommandSigned        = "command_signed"
-	EventTypeThresholdReached     = "threshold_reached"
-	EventTypeValidatorSetUpdated  = "validator_set_updated"
-	EventTypeValidatorAdded       = "validator_added"
-	EventTypeValidatorRemoved     = "validator_removed"
-	EventTypeSignatureVerified    = "signature_verified"
-	EventTypeSignatureRejected    = "signature_rejected"
-	EventTypeCommandExecuted      = "command_executed"
+	EventTypeMintCommandGenerated   = "mint_command_generated"
+	EventTypeCommandSigned          = "command_signed"
+	EventTypeThresholdReached       = "threshold_reached"
+	EventTypeValidatorSetUpdated    = "validator_set_updated"
+	EventTypeValidatorAdded         = "validator_added"
+	EventTypeValidatorRemoved       = "validator_removed"
+	EventTypeSignatureVerified      = "signature_verified"
+	EventTypeSignatureRejected      = "signature_rejected"
+	EventTypeCommandExecuted        = "command_executed"
+	EventTypeCommandBatchCreated    = "command_batch_created"
+	EventTypeBatchRootSigned        = "batch_root_signed"
+	EventTypeCommandBatchSigned     = "command_batch_signed"
+	EventTypeCommandBatchExpired    = "command_batch_expired"
+	EventTypeDuplicateExecution     = "duplicate_execution_report"
+	EventTypeSigningEscalated       = "signing_escalated"
+	EventTypeCommandExpired         = "command_expired"
+	EventTypeValidatorSetDrift      = "validator_set_drift"
+	EventTypeValidatorSetReconciled = "validator_set_reconciled"
+	EventTypeExecutionCostRecorded  = "execution_cost_recorded"
+	EventTypeValidatorProbation     = "validator_probation"
+	EventTypeValidatorActivated     = "validator_activated"
+	EventTypeNonceGapDetected       = "nonce_gap_detected"
+	EventTypeNonceGapResolved       = "nonce_gap_resolved"
+	EventTypeValidatorSetOverridden = "validator_set_overridden"
 )
 
 // Multisig module event attribute keys
 const (
 	AttributeKeyCommandID        = "command_id"
 	AttributeKeyTargetChain      = "target_chain"
-	AttributeKeyRecipient        = "recipient"
-	AttributeKeyAmount           = "amount"
-	AttributeKeyValidator        = "validator"
 	AttributeKeySignatureCount   = "signature_count"
-	AttributeKeyThreshold        = "threshold"
 	AttributeKeyValidatorCount   = "validator_count"
 	AttributeKeyValidatorAddress = "validator_address"
 	AttributeKeyValidatorPubKey  = "validator_pub_key"
 	AttributeKeyValidatorPower   = "validator_power"
 	AttributeKeyVersion          = "version"
 	AttributeKeyUpdateHeight     = "update_height"
-	AttributeKeyReason           = "reason"
-)
\ No newline at end of file
+	AttributeKeyBatchID          = "batch_id"
+	AttributeKeyMerkleRoot       = "merkle_root"
+	AttributeKeyCommandCount     = "command_count"
+	AttributeKeyIdempotencyKey   = "idempotency_key"
+	AttributeKeyLateSigners      = "late_signers"
+	AttributeKeyDeadline         = "deadline"
+	AttributeKeyMissing          = "missing"
+	AttributeKeyExtra            = "extra"
+	AttributeKeyGasUsed          = "gas_used"
+	AttributeKeyCostWei          = "cost_wei"
+	AttributeKeyRequiredCycles   = "required_cycles"
+	AttributeKeyMissedCycles     = "missed_cycles"
+	AttributeKeyMissingNonces    = "missing_nonces"
+	AttributeKeyHighestExecuted  = "highest_executed"
+	AttributeKeyResolvedBy       = "resolved_by"
+	AttributeKeyInFlight         = "in_flight"
+	AttributeKeyAuthority        = "authority"
+)
+
+// Attribute keys shared with other modules, kept for existing importers
+const (
+	// Deprecated: use types.AttributeKeyTxHash
+	AttributeKeyTxHash = types.AttributeKeyTxHash
+	// Deprecated: use types.AttributeKeyAmount
+	AttributeKeyAmount = types.AttributeKeyAmount
+	// Deprecated: use types.AttributeKeyReason
+	AttributeKeyReason = types.AttributeKeyReason
+	// Deprecated: use types.AttributeKeyRecipient
+	AttributeKeyRecipient = types.AttributeKeyRecipient
+	// Deprecated: use types.AttributeKeyValidator
+	AttributeKeyValidator = types.AttributeKeyValidator
+	// Deprecated: use types.AttributeKeyThreshold
+	AttributeKeyThreshold = types.AttributeKeyThreshold
+	// Deprecated: use types.AttributeKeyReporter
+	AttributeKeyReporter = types.AttributeKeyReporter
+	// Deprecated: use types.AttributeKeyNonce
+	AttributeKeyNonce = types.AttributeKeyNonce
+)
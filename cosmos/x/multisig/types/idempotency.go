@@ -0,0 +1,17 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// CommandIdempotencyKey returns the deterministic key identifying one
+// execution of a command on its target chain. It is part of the signed
+// command payload, and execution reports are deduplicated by it, so duplicate
+// relayer submissions for the same Besu execution are only counted once.
+func CommandIdempotencyKey(commandID string, nonce uint64, targetChain string) string {
+	data := fmt.Sprintf("%s-%d-%s", commandID, nonce, targetChain)
+	hash := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(hash[:])
+}
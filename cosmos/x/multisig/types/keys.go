@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "multisig"
@@ -14,47 +16,56 @@ const (
 	MemStoreKey = "mem_multisig"
 )
 
-// Store key prefixes
+// Store prefixes of the keeper collections
 var (
-	// ValidatorSetKeyPrefix is the prefix for validator set storage
-	ValidatorSetKeyPrefix = []byte{0x01}
-	
+	// ValidatorSetKey is the key for the current validator set
+	ValidatorSetKey = collections.NewPrefix(1)
+
 	// MintCommandKeyPrefix is the prefix for mint command storage
-	MintCommandKeyPrefix = []byte{0x02}
-	
-	// SignatureKeyPrefix is the prefix for signature storage
-	SignatureKeyPrefix = []byte{0x03}
-	
+	MintCommandKeyPrefix = collections.NewPrefix(2)
+
+	// Prefixes 3 (signatures) and 5 (command statuses) were reserved by
+	// earlier versions but never written; both live on the command
+
 	// ValidatorKeyPrefix is the prefix for individual validator storage
-	ValidatorKeyPrefix = []byte{0x04}
-	
-	// CommandStatusKeyPrefix is the prefix for command status storage
-	CommandStatusKeyPrefix = []byte{0x05}
-)
+	ValidatorKeyPrefix = collections.NewPrefix(4)
+
+	// CommandBatchKeyPrefix is the prefix for command batch storage
+	CommandBatchKeyPrefix = collections.NewPrefix(6)
+
+	// CommandBatchIndexKeyPrefix is the prefix for the command → batch index
+	CommandBatchIndexKeyPrefix = collections.NewPrefix(7)
+
+	// CommandNonceKeyPrefix is the prefix for the last command nonce per target chain
+	CommandNonceKeyPrefix = collections.NewPrefix(8)
+
+	// IdempotencyKeyPrefix is the prefix for the idempotency key → command index
+	IdempotencyKeyPrefix = collections.NewPrefix(9)
 
-// GetValidatorSetKey returns the store key for the current validator set
-func GetValidatorSetKey() []byte {
-	return ValidatorSetKeyPrefix
-}
-
-// GetMintCommandKey returns the store key for a mint command
-func GetMintCommandKey(commandID string) []byte {
-	return append(MintCommandKeyPrefix, []byte(commandID)...)
-}
-
-// GetSignatureKey returns the store key for a signature
-func GetSignatureKey(commandID, validator string) []byte {
-	key := append(SignatureKeyPrefix, []byte(commandID)...)
-	key = append(key, []byte("/")...)
-	return append(key, []byte(validator)...)
-}
-
-// GetValidatorKey returns the store key for a validator
-func GetValidatorKey(address string) []byte {
-	return append(ValidatorKeyPrefix, []byte(address)...)
-}
-
-// GetCommandStatusKey returns the store key for command status
-func GetCommandStatusKey(commandID string) []byte {
-	return append(CommandStatusKeyPrefix, []byte(commandID)...)
-}
\ No newline at end of file
+	// ExecutedKeyPrefix is the prefix for idempotency keys reported executed
+	ExecutedKeyPrefix = collections.NewPrefix(10)
+
+	// ParamsKey is the key for the module parameters
+	ParamsKey = collections.NewPrefix(11)
+
+	// SigningEscalationKeyPrefix is the prefix for pending commands whose late signers were escalated
+	SigningEscalationKeyPrefix = collections.NewPrefix(12)
+
+	// ExecutionCostKeyPrefix is the prefix for the reported execution costs of commands
+	ExecutionCostKeyPrefix = collections.NewPrefix(13)
+
+	// ExecutionCostIndexKeyPrefix is the prefix for the (target chain, executed at, command ID) cost index
+	ExecutionCostIndexKeyPrefix = collections.NewPrefix(14)
+
+	// ProbationKeyPrefix is the prefix for validators admitted on probation
+	ProbationKeyPrefix = collections.NewPrefix(15)
+
+	// ExecutedNonceKeyPrefix is the prefix for the highest nonce reported executed per target chain
+	ExecutedNonceKeyPrefix = collections.NewPrefix(16)
+
+	// NonceGapKeyPrefix is the prefix for the open nonce gaps per target chain
+	NonceGapKeyPrefix = collections.NewPrefix(17)
+
+	// PendingBatchKeyPrefix is the prefix for the IDs of batches awaiting root signatures
+	PendingBatchKeyPrefix = collections.NewPrefix(18)
+)
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/interbank-netting/cosmos/types"
+)
+
+// Merkle trees follow RFC 6962 with the hash algorithm H of their batch:
+// leaves are hashed as H(0x00 || data) and inner nodes as
+// H(0x01 || left || right), so a leaf can never be passed off as an inner
+// node. An unbalanced tree splits at the largest power of two below its size
+// instead of duplicating the last leaf.
+const (
+	merkleLeafPrefix byte = 0x00
+	merkleNodePrefix byte = 0x01
+)
+
+// MerkleLeafHash returns the leaf hash of data
+func MerkleLeafHash(algorithm types.HashAlgorithm, data []byte) []byte {
+	return algorithm.Sum([]byte{merkleLeafPrefix}, data)
+}
+
+func merkleNodeHash(algorithm types.HashAlgorithm, left, right []byte) []byte {
+	return algorithm.Sum([]byte{merkleNodePrefix}, left, right)
+}
+
+// splitPoint returns the largest power of two smaller than n (n > 1)
+func splitPoint(n int) int {
+	k := 1
+	for k*2 < n {
+		k *= 2
+	}
+	return k
+}
+
+// MerkleRoot returns the root of the tree over the given leaf hashes, or nil
+// for no leaves
+func MerkleRoot(algorithm types.HashAlgorithm, leaves [][]byte) []byte {
+	switch len(leaves) {
+	case 0:
+		return nil
+	case 1:
+		return leaves[0]
+	}
+
+	k := splitPoint(len(leaves))
+	return merkleNodeHash(algorithm, MerkleRoot(algorithm, leaves[:k]), MerkleRoot(algorithm, leaves[k:]))
+}
+
+// MerkleAuditPath returns the sibling hashes from the leaf at index up to the
+// root, leaf side first
+func MerkleAuditPath(algorithm types.HashAlgorithm, leaves [][]byte, index int) [][]byte {
+	if len(leaves) <= 1 || index < 0 || index >= len(leaves) {
+		return [][]byte{}
+	}
+
+	k := splitPoint(len(leaves))
+	if index < k {
+		return append(MerkleAuditPath(algorithm, leaves[:k], index), MerkleRoot(algorithm, leaves[k:]))
+	}
+	return append(MerkleAuditPath(algorithm, leaves[k:], index-k), MerkleRoot(algorithm, leaves[:k]))
+}
+
+// VerifyMerkleProof checks that leaf is the leaf at index of a tree with
+// leafCount leaves and the given root (RFC 9162 section 2.1.3.2)
+func VerifyMerkleProof(algorithm types.HashAlgorithm, leaf []byte, index, leafCount uint32, path [][]byte, root []byte) bool {
+	if index >= leafCount {
+		return false
+	}
+
+	fn, sn := index, leafCount-1
+	hash := leaf
+	for _, sibling := range path {
+		if sn == 0 {
+			return false
+		}
+
+		if fn&1 == 1 || fn == sn {
+			hash = merkleNodeHash(algorithm, sibling, hash)
+			// Skip levels where this node has no right sibling
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			hash = merkleNodeHash(algorithm, hash, sibling)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+
+	return sn == 0 && bytes.Equal(hash, root)
+}
@@ -12,19 +12,43 @@ import (
 
 
 const (
-	TypeMsgGenerateMintCommand = "generate_mint_command"
-	TypeMsgSignCommand         = "sign_command"
-	TypeMsgUpdateValidatorSet  = "update_validator_set"
-	TypeMsgAddValidator        = "add_validator"
-	TypeMsgRemoveValidator     = "remove_validator"
+	TypeMsgGenerateMintCommand  = "generate_mint_command"
+	TypeMsgSignCommand          = "sign_command"
+	TypeMsgSignBatch            = "sign_batch"
+	TypeMsgUpdateValidatorSet   = "update_validator_set"
+	TypeMsgAddValidator         = "add_validator"
+	TypeMsgRemoveValidator      = "remove_validator"
+	TypeMsgAdmitValidator       = "admit_validator"
+	TypeMsgReportExecution      = "report_execution"
+	TypeMsgResolveNonceGap      = "resolve_nonce_gap"
+	TypeMsgOverrideValidatorSet = "override_validator_set"
+)
+
+// How a MsgOverrideValidatorSet handles the in-flight commands, pending or
+// signed but not executed, of the replaced set
+const (
+	InFlightResign     = "resign"     // Drop their signatures and sign them again with the new set
+	InFlightInvalidate = "invalidate" // Mark them failed
+)
+
+// Size limits of a MsgUpdateValidatorSet. The set is checked against the
+// MaxValidatorCount param once it reaches the keeper.
+const (
+	MaxValidatorSetSize = 256
+	MaxPubKeyLength     = 65 // Uncompressed secp256k1
 )
 
 var (
 	_ sdk.Msg = &MsgGenerateMintCommand{}
 	_ sdk.Msg = &MsgSignCommand{}
+	_ sdk.Msg = &MsgSignBatch{}
 	_ sdk.Msg = &MsgUpdateValidatorSet{}
 	_ sdk.Msg = &MsgAddValidator{}
 	_ sdk.Msg = &MsgRemoveValidator{}
+	_ sdk.Msg = &MsgAdmitValidator{}
+	_ sdk.Msg = &MsgReportExecution{}
+	_ sdk.Msg = &MsgResolveNonceGap{}
+	_ sdk.Msg = &MsgOverrideValidatorSet{}
 )
 
 // MsgGenerateMintCommand defines a message for generating mint commands
@@ -33,6 +57,7 @@ type MsgGenerateMintCommand struct {
 	TargetChain string    `json:"target_chain"`
 	Recipient   string    `json:"recipient"`
 	Amount      math.Int  `json:"amount"`
+	TokenID     string    `json:"token_id,omitempty"` // Wrapped asset to mint, empty for the default token
 }
 
 // ProtoMessage implements proto.Message
@@ -103,6 +128,10 @@ func (msg MsgGenerateMintCommand) ValidateBasic() error {
 	if msg.Amount.IsNil() || msg.Amount.LTE(math.ZeroInt()) {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
 	}
+
+	if err := types.ValidateTokenID(msg.TokenID); err != nil {
+		return errorsmod.Wrap(ErrInvalidTokenID, err.Error())
+	}
 	
 	return nil
 }
@@ -185,6 +214,79 @@ func (msg MsgSignCommand) ValidateBasic() error {
 	return nil
 }
 
+// MsgSignBatch defines a message for signing the Merkle root of a command batch
+type MsgSignBatch struct {
+	Signer    string               `json:"signer"`
+	BatchID   string               `json:"batch_id"`
+	Signature types.ECDSASignature `json:"signature"`
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgSignBatch) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgSignBatch) Reset() { *msg = MsgSignBatch{} }
+
+// String implements proto.Message
+func (msg *MsgSignBatch) String() string {
+	return fmt.Sprintf("MsgSignBatch{Signer: %s, BatchID: %s}", msg.Signer, msg.BatchID)
+}
+
+// NewMsgSignBatch creates a new MsgSignBatch instance
+func NewMsgSignBatch(signer, batchID string, signature types.ECDSASignature) *MsgSignBatch {
+	return &MsgSignBatch{
+		Signer:    signer,
+		BatchID:   batchID,
+		Signature: signature,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgSignBatch) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgSignBatch) Type() string {
+	return TypeMsgSignBatch
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgSignBatch) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgSignBatch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgSignBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address: %s", err)
+	}
+
+	if msg.BatchID == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "batch ID cannot be empty")
+	}
+
+	if msg.Signature.Validator == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "signature validator cannot be empty")
+	}
+
+	if len(msg.Signature.R) == 0 || len(msg.Signature.S) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "signature R and S cannot be empty")
+	}
+
+	return nil
+}
+
 // MsgUpdateValidatorSet defines a message for updating the validator set
 type MsgUpdateValidatorSet struct {
 	Updater    string              `json:"updater"`
@@ -249,15 +351,25 @@ func (msg MsgUpdateValidatorSet) ValidateBasic() error {
 	if len(msg.Validators) == 0 {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "validators cannot be empty")
 	}
+
+	if len(msg.Validators) > MaxValidatorSetSize {
+		return errorsmod.Wrapf(ErrValidatorSetTooLarge, "%d validators, limit %d", len(msg.Validators), MaxValidatorSetSize)
+	}
 	
 	// Validate each validator
 	for i, validator := range msg.Validators {
 		if validator.Address == "" {
 			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "validator %d: address cannot be empty", i)
 		}
+		if err := types.ValidateFieldLength("address", validator.Address, types.MaxFieldLength); err != nil {
+			return errorsmod.Wrapf(ErrValidatorSetTooLarge, "validator %d: %s", i, err)
+		}
 		if len(validator.PubKey) == 0 {
 			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "validator %d: public key cannot be empty", i)
 		}
+		if len(validator.PubKey) > MaxPubKeyLength {
+			return errorsmod.Wrapf(ErrValidatorSetTooLarge, "validator %d: public key longer than %d bytes: %d", i, MaxPubKeyLength, len(validator.PubKey))
+		}
 		if validator.Power <= 0 {
 			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "validator %d: power must be positive", i)
 		}
@@ -408,4 +520,327 @@ func (msg MsgRemoveValidator) ValidateBasic() error {
 	}
 	
 	return nil
-}
\ No newline at end of file
+}
+
+// MsgAdmitValidator defines a governance message admitting a validator to
+// the set. With a positive ProbationCycles the validator starts on probation
+// and its signatures only count after that many signing cycles in a row.
+type MsgAdmitValidator struct {
+	Authority       string          `json:"authority"`
+	Validator       types.Validator `json:"validator"`
+	ProbationCycles uint32          `json:"probation_cycles"` // Zero admits the validator fully active
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgAdmitValidator) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgAdmitValidator) Reset() { *msg = MsgAdmitValidator{} }
+
+// String implements proto.Message
+func (msg *MsgAdmitValidator) String() string {
+	return fmt.Sprintf("MsgAdmitValidator{Authority: %s, Validator: %s, ProbationCycles: %d}", msg.Authority, msg.Validator.Address, msg.ProbationCycles)
+}
+
+// NewMsgAdmitValidator creates a new MsgAdmitValidator instance
+func NewMsgAdmitValidator(authority string, validator types.Validator, probationCycles uint32) *MsgAdmitValidator {
+	return &MsgAdmitValidator{
+		Authority:       authority,
+		Validator:       validator,
+		ProbationCycles: probationCycles,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgAdmitValidator) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgAdmitValidator) Type() string {
+	return TypeMsgAdmitValidator
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgAdmitValidator) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgAdmitValidator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgAdmitValidator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if msg.Validator.Address == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "validator address cannot be empty")
+	}
+
+	if len(msg.Validator.PubKey) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "validator public key cannot be empty")
+	}
+
+	if msg.Validator.Power <= 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "validator power must be positive")
+	}
+
+	return nil
+}
+
+// MsgReportExecution defines a message for reporting that a command was
+// executed on its target chain
+type MsgReportExecution struct {
+	Reporter       string   `json:"reporter"`
+	IdempotencyKey string   `json:"idempotency_key"`
+	TxHash         string   `json:"tx_hash"`            // Besu transaction that executed the command
+	GasUsed        uint64   `json:"gas_used,omitempty"` // Gas used by the transaction
+	CostWei        math.Int `json:"cost_wei,omitempty"` // Gas used times effective gas price
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgReportExecution) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgReportExecution) Reset() { *msg = MsgReportExecution{} }
+
+// String implements proto.Message
+func (msg *MsgReportExecution) String() string {
+	return fmt.Sprintf("MsgReportExecution{Reporter: %s, IdempotencyKey: %s}", msg.Reporter, msg.IdempotencyKey)
+}
+
+// NewMsgReportExecution creates a new MsgReportExecution instance
+func NewMsgReportExecution(reporter, idempotencyKey, txHash string) *MsgReportExecution {
+	return &MsgReportExecution{
+		Reporter:       reporter,
+		IdempotencyKey: idempotencyKey,
+		TxHash:         txHash,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgReportExecution) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgReportExecution) Type() string {
+	return TypeMsgReportExecution
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgReportExecution) GetSigners() []sdk.AccAddress {
+	reporter, err := sdk.AccAddressFromBech32(msg.Reporter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{reporter}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgReportExecution) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgReportExecution) ValidateBasic() error {
+	if msg.Reporter == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "reporter cannot be empty")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Reporter)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid reporter address: %s", err)
+	}
+
+	if msg.IdempotencyKey == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "idempotency key cannot be empty")
+	}
+
+	if msg.TxHash == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "tx hash cannot be empty")
+	}
+
+	if !msg.CostWei.IsNil() && msg.CostWei.IsNegative() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cost cannot be negative")
+	}
+
+	return nil
+}
+
+// MsgResolveNonceGap defines a governance message closing the nonce gap of a
+// target chain whose skipped commands will not be executed. The skipped
+// commands are marked failed and command generation for the chain resumes.
+type MsgResolveNonceGap struct {
+	Authority   string `json:"authority"`
+	TargetChain string `json:"target_chain"`
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgResolveNonceGap) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgResolveNonceGap) Reset() { *msg = MsgResolveNonceGap{} }
+
+// String implements proto.Message
+func (msg *MsgResolveNonceGap) String() string {
+	return fmt.Sprintf("MsgResolveNonceGap{Authority: %s, TargetChain: %s}", msg.Authority, msg.TargetChain)
+}
+
+// NewMsgResolveNonceGap creates a new MsgResolveNonceGap instance
+func NewMsgResolveNonceGap(authority, targetChain string) *MsgResolveNonceGap {
+	return &MsgResolveNonceGap{
+		Authority:   authority,
+		TargetChain: targetChain,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgResolveNonceGap) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgResolveNonceGap) Type() string {
+	return TypeMsgResolveNonceGap
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgResolveNonceGap) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgResolveNonceGap) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgResolveNonceGap) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if msg.TargetChain == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "target chain cannot be empty")
+	}
+
+	return types.ValidateFieldLength("target chain", msg.TargetChain, types.MaxFieldLength)
+}
+
+// MsgOverrideValidatorSet defines a break-glass governance message replacing
+// the validator set wholesale, for recovery after the set lost its signing
+// quorum. It bypasses the add, remove and probation flow: every validator of
+// the new set is fully active. In-flight commands are re-signed by the new set
+// or invalidated, as InFlight selects.
+type MsgOverrideValidatorSet struct {
+	Authority  string            `json:"authority"`
+	Validators []types.Validator `json:"validators"`
+	InFlight   string            `json:"in_flight"` // InFlightResign or InFlightInvalidate
+	Reason     string            `json:"reason"`
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgOverrideValidatorSet) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgOverrideValidatorSet) Reset() { *msg = MsgOverrideValidatorSet{} }
+
+// String implements proto.Message
+func (msg *MsgOverrideValidatorSet) String() string {
+	return fmt.Sprintf("MsgOverrideValidatorSet{Authority: %s, ValidatorCount: %d, InFlight: %s}", msg.Authority, len(msg.Validators), msg.InFlight)
+}
+
+// NewMsgOverrideValidatorSet creates a new MsgOverrideValidatorSet instance
+func NewMsgOverrideValidatorSet(authority string, validators []types.Validator, inFlight, reason string) *MsgOverrideValidatorSet {
+	return &MsgOverrideValidatorSet{
+		Authority:  authority,
+		Validators: validators,
+		InFlight:   inFlight,
+		Reason:     reason,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgOverrideValidatorSet) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgOverrideValidatorSet) Type() string {
+	return TypeMsgOverrideValidatorSet
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgOverrideValidatorSet) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgOverrideValidatorSet) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgOverrideValidatorSet) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if err := ValidateInFlight(msg.InFlight); err != nil {
+		return err
+	}
+
+	if msg.Reason == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "reason cannot be empty")
+	}
+	if err := types.ValidateFieldLength("reason", msg.Reason, types.MaxReasonLength); err != nil {
+		return err
+	}
+
+	// The same checks as an update, and no validator twice since the set
+	// is not merged with the current one
+	update := MsgUpdateValidatorSet{Updater: msg.Authority, Validators: msg.Validators}
+	if err := update.ValidateBasic(); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(msg.Validators))
+	for i, validator := range msg.Validators {
+		if seen[validator.Address] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "validator %d: duplicate address %s", i, validator.Address)
+		}
+		seen[validator.Address] = true
+	}
+
+	return nil
+}
+
+// ValidateInFlight checks the in-flight mode of a validator set override
+func ValidateInFlight(inFlight string) error {
+	switch inFlight {
+	case InFlightResign, InFlightInvalidate:
+		return nil
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "in-flight mode must be %s or %s, got %q", InFlightResign, InFlightInvalidate, inFlight)
+	}
+}
@@ -0,0 +1,27 @@
+package types
+
+import "fmt"
+
+// NonceGap records signed commands of a target chain that were skipped on
+// Besu: a command with a higher nonce was reported executed while they were
+// not. Command generation for the chain is paused while the gap is open. It
+// closes once the skipped commands are reported executed, or when governance
+// resolves it with MsgResolveNonceGap, which fails the skipped commands.
+type NonceGap struct {
+	TargetChain     string   `protobuf:"bytes,1,opt,name=target_chain,json=targetChain,proto3" json:"target_chain"`
+	MissingNonces   []uint64 `protobuf:"varint,2,rep,packed,name=missing_nonces,json=missingNonces,proto3" json:"missing_nonces"` // Ascending
+	HighestExecuted uint64   `protobuf:"varint,3,opt,name=highest_executed,json=highestExecuted,proto3" json:"highest_executed"`  // Highest nonce reported executed
+	DetectedAt      int64    `protobuf:"varint,4,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at"`
+	DetectedHeight  int64    `protobuf:"varint,5,opt,name=detected_height,json=detectedHeight,proto3" json:"detected_height"`
+}
+
+// ProtoMessage implements proto.Message
+func (g *NonceGap) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (g *NonceGap) Reset() { *g = NonceGap{} }
+
+// String implements proto.Message
+func (g *NonceGap) String() string {
+	return fmt.Sprintf("NonceGap{TargetChain: %s, MissingNonces: %v, HighestExecuted: %d}", g.TargetChain, g.MissingNonces, g.HighestExecuted)
+}
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/interbank-netting/cosmos/types"
+)
+
+// Params defines the parameters for the multisig module.
+type Params struct {
+	SigningTimeout        int64                      `protobuf:"varint,1,opt,name=signing_timeout,json=signingTimeout,proto3" json:"signing_timeout"`                       // Signing timeout in seconds
+	MaxCommandAge         int64                      `protobuf:"varint,2,opt,name=max_command_age,json=maxCommandAge,proto3" json:"max_command_age"`                        // Maximum command age in seconds
+	MinValidatorCount     int32                      `protobuf:"varint,3,opt,name=min_validator_count,json=minValidatorCount,proto3" json:"min_validator_count"`            // Minimum validator count
+	MaxValidatorCount     int32                      `protobuf:"varint,4,opt,name=max_validator_count,json=maxValidatorCount,proto3" json:"max_validator_count"`            // Maximum validator count
+	EscalationPercent     int32                      `protobuf:"varint,5,opt,name=escalation_percent,json=escalationPercent,proto3" json:"escalation_percent"`              // Share of SigningTimeout after which late signers are escalated
+	MaxProofDepth         int32                      `protobuf:"varint,6,opt,name=max_proof_depth,json=maxProofDepth,proto3" json:"max_proof_depth"`                        // Longest batch proof audit path accepted for verification
+	ReconcileInterval     int64                      `protobuf:"varint,7,opt,name=reconcile_interval,json=reconcileInterval,proto3" json:"reconcile_interval"`              // Blocks between validator set syncs from staking, zero to only report drift
+	PayoutAddresses       []PayoutAddresses          `protobuf:"bytes,8,rep,name=payout_addresses,json=payoutAddresses,proto3" json:"payout_addresses"`                     // Verified recipients per target chain, empty to allow any recipient
+	CommandHashAlgorithms []types.ChainHashAlgorithm `protobuf:"bytes,9,rep,name=command_hash_algorithms,json=commandHashAlgorithms,proto3" json:"command_hash_algorithms"` // Hash algorithm of the commands and batches per target chain, SHA-256 for chains not listed
+}
+
+// ProtoMessage implements proto.Message
+func (p *Params) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (p *Params) Reset() { *p = Params{} }
+
+// String implements proto.Message
+func (p *Params) String() string {
+	return fmt.Sprintf("Params{SigningTimeout: %d, MaxCommandAge: %d, EscalationPercent: %d}",
+		p.SigningTimeout, p.MaxCommandAge, p.EscalationPercent)
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return Params{
+		SigningTimeout:        3600,                         // 1 hour
+		MaxCommandAge:         7200,                         // 2 hours
+		MinValidatorCount:     1,                            // Minimum 1 validator
+		MaxValidatorCount:     100,                          // Maximum 100 validators
+		EscalationPercent:     50,                           // Escalate halfway to the signing timeout
+		MaxProofDepth:         32,                           // Enough for any uint32 leaf count
+		ReconcileInterval:     0,                            // Report drift without reconciling
+		PayoutAddresses:       []PayoutAddresses{},          // Recipients are not restricted
+		CommandHashAlgorithms: []types.ChainHashAlgorithm{}, // Every chain uses SHA-256
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if p.SigningTimeout <= 0 {
+		return fmt.Errorf("signing timeout must be positive: %d", p.SigningTimeout)
+	}
+
+	if p.MaxCommandAge <= 0 {
+		return fmt.Errorf("max command age must be positive: %d", p.MaxCommandAge)
+	}
+
+	if p.MinValidatorCount <= 0 {
+		return fmt.Errorf("minimum validator count must be positive: %d", p.MinValidatorCount)
+	}
+
+	if p.MaxValidatorCount <= 0 {
+		return fmt.Errorf("maximum validator count must be positive: %d", p.MaxValidatorCount)
+	}
+
+	if p.MinValidatorCount > p.MaxValidatorCount {
+		return fmt.Errorf("minimum validator count cannot be greater than maximum: %d > %d",
+			p.MinValidatorCount, p.MaxValidatorCount)
+	}
+
+	if p.EscalationPercent <= 0 || p.EscalationPercent > 100 {
+		return fmt.Errorf("escalation percent must be in (0, 100]: %d", p.EscalationPercent)
+	}
+
+	if p.MaxProofDepth <= 0 || p.MaxProofDepth > 32 {
+		return fmt.Errorf("max proof depth must be in (0, 32]: %d", p.MaxProofDepth)
+	}
+
+	if p.ReconcileInterval < 0 {
+		return fmt.Errorf("reconcile interval cannot be negative: %d", p.ReconcileInterval)
+	}
+
+	chains := make(map[string]bool, len(p.PayoutAddresses))
+	for i, payout := range p.PayoutAddresses {
+		if err := payout.Validate(); err != nil {
+			return fmt.Errorf("payout addresses %d: %w", i, err)
+		}
+		if chains[payout.Chain] {
+			return fmt.Errorf("payout addresses %d: duplicate chain %s", i, payout.Chain)
+		}
+		chains[payout.Chain] = true
+	}
+
+	if err := types.ValidateChainHashAlgorithms(p.CommandHashAlgorithms); err != nil {
+		return fmt.Errorf("command %w", err)
+	}
+
+	return nil
+}
+
+// CommandHashAlgorithm returns the hash algorithm of the new commands and
+// batches of a target chain
+func (p Params) CommandHashAlgorithm(targetChain string) types.HashAlgorithm {
+	return types.ChainHashAlgorithmOf(p.CommandHashAlgorithms, targetChain)
+}
+
+// EscalationAge returns how long a command may stay pending, in seconds,
+// before its late signers are escalated
+func (p Params) EscalationAge() int64 {
+	return p.SigningTimeout * int64(p.EscalationPercent) / 100
+}
+
+// ReconcileDue returns true if the validator set is synced from staking at
+// the height
+func (p Params) ReconcileDue(height int64) bool {
+	return p.ReconcileInterval > 0 && height%p.ReconcileInterval == 0
+}
+
+// CheckPayoutAddress returns an error unless the recipient is bound to the
+// target chain. Without any bindings every recipient is allowed; once a
+// binding exists, chains without one accept no recipient.
+func (p Params) CheckPayoutAddress(targetChain, recipient string) error {
+	if len(p.PayoutAddresses) == 0 {
+		return nil
+	}
+
+	for _, payout := range p.PayoutAddresses {
+		if payout.Chain != targetChain {
+			continue
+		}
+		if !payout.Contains(recipient) {
+			return fmt.Errorf("recipient %s is not a payout address of chain %s", recipient, targetChain)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("chain %s has no payout addresses", targetChain)
+}
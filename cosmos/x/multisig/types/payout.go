@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PayoutAddresses binds a bank to the verified addresses on its Besu chain
+// that mint commands may pay out to
+type PayoutAddresses struct {
+	Chain     string   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain"` // Target chain of the bank's mint commands
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses"`
+}
+
+// ProtoMessage implements proto.Message
+func (p *PayoutAddresses) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (p *PayoutAddresses) Reset() { *p = PayoutAddresses{} }
+
+// String implements proto.Message
+func (p *PayoutAddresses) String() string {
+	return fmt.Sprintf("PayoutAddresses{Chain: %s, Addresses: %v}", p.Chain, p.Addresses)
+}
+
+// Validate checks the chain and that each address is a distinct EVM address
+func (p PayoutAddresses) Validate() error {
+	if p.Chain == "" {
+		return fmt.Errorf("chain cannot be empty")
+	}
+
+	if len(p.Addresses) == 0 {
+		return fmt.Errorf("chain %s has no payout addresses", p.Chain)
+	}
+
+	seen := make(map[string]bool, len(p.Addresses))
+	for _, address := range p.Addresses {
+		if !common.IsHexAddress(address) {
+			return fmt.Errorf("chain %s: invalid payout address %s", p.Chain, address)
+		}
+		key := strings.ToLower(address)
+		if seen[key] {
+			return fmt.Errorf("chain %s: duplicate payout address %s", p.Chain, address)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// Contains returns true if the address is bound. EVM addresses compare
+// case-insensitively, so checksummed and lower-case forms are the same.
+func (p PayoutAddresses) Contains(address string) bool {
+	for _, bound := range p.Addresses {
+		if strings.EqualFold(bound, address) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package types
+
+import "fmt"
+
+// ValidatorProbation tracks a validator admitted on probation. A validator on
+// probation signs commands like any other, but its signatures don't count
+// toward the threshold until it completes RequiredCycles signing cycles in a
+// row. A signing cycle is a command that was created while the validator was
+// in the set and has since been executed or expired; a cycle the validator did
+// not sign is missed and restarts the count.
+type ValidatorProbation struct {
+	Address         string `protobuf:"bytes,1,opt,name=address,proto3" json:"address"`
+	RequiredCycles  uint32 `protobuf:"varint,2,opt,name=required_cycles,json=requiredCycles,proto3" json:"required_cycles"`
+	CompletedCycles uint32 `protobuf:"varint,3,opt,name=completed_cycles,json=completedCycles,proto3" json:"completed_cycles"` // Signed in a row since the last missed cycle
+	MissedCycles    uint32 `protobuf:"varint,4,opt,name=missed_cycles,json=missedCycles,proto3" json:"missed_cycles"`          // Missed since admission
+	AdmittedAt      int64  `protobuf:"varint,5,opt,name=admitted_at,json=admittedAt,proto3" json:"admitted_at"`
+	AdmittedHeight  int64  `protobuf:"varint,6,opt,name=admitted_height,json=admittedHeight,proto3" json:"admitted_height"`
+}
+
+// ProtoMessage implements proto.Message
+func (p *ValidatorProbation) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (p *ValidatorProbation) Reset() { *p = ValidatorProbation{} }
+
+// String implements proto.Message
+func (p *ValidatorProbation) String() string {
+	return fmt.Sprintf("ValidatorProbation{Address: %s, Completed: %d/%d}", p.Address, p.CompletedCycles, p.RequiredCycles)
+}
+
+// RecordCycle counts a finished signing cycle and returns true once the
+// probation is complete
+func (p *ValidatorProbation) RecordCycle(signed bool) bool {
+	if signed {
+		p.CompletedCycles++
+	} else {
+		p.CompletedCycles = 0
+		p.MissedCycles++
+	}
+	return p.CompletedCycles >= p.RequiredCycles
+}
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"context"
+)
+
+// QueryCommandBatchRequest is the request type for Query/CommandBatch
+type QueryCommandBatchRequest struct {
+	BatchID string `json:"batch_id"`
+}
+
+// QueryCommandBatchResponse is the response type for Query/CommandBatch
+type QueryCommandBatchResponse struct {
+	Batch CommandBatch `json:"batch"`
+}
+
+// QueryCommandProofRequest is the request type for Query/CommandProof
+type QueryCommandProofRequest struct {
+	CommandID string `json:"command_id"`
+}
+
+// QueryCommandProofResponse is the response type for Query/CommandProof
+type QueryCommandProofResponse struct {
+	Proof CommandBatchProof `json:"proof"`
+}
+
+// QueryLateSignersRequest is the request type for Query/LateSigners
+type QueryLateSignersRequest struct {
+	CommandID string `json:"command_id"`
+}
+
+// QueryLateSignersResponse is the response type for Query/LateSigners
+type QueryLateSignersResponse struct {
+	CommandID   string   `json:"command_id"`
+	LateSigners []string `json:"late_signers"` // Active validators that have not signed
+	EscalateAt  int64    `json:"escalate_at"`  // Block time at which late signers are escalated
+	Deadline    int64    `json:"deadline"`     // CreatedAt + SigningTimeout
+	Escalated   bool     `json:"escalated"`
+}
+
+// QueryValidatorSetConsistencyRequest is the request type for
+// Query/ValidatorSetConsistency
+type QueryValidatorSetConsistencyRequest struct{}
+
+// QueryValidatorSetConsistencyResponse is the response type for
+// Query/ValidatorSetConsistency
+type QueryValidatorSetConsistencyResponse struct {
+	Consistency         ValidatorSetConsistency `json:"consistency"`
+	ValidatorSetVersion uint64                  `json:"validator_set_version"`
+	ReconcileInterval   int64                   `json:"reconcile_interval"` // Zero if drift is only reported
+}
+
+// QueryExecutionCostRequest is the request type for Query/ExecutionCost
+type QueryExecutionCostRequest struct {
+	CommandID string `json:"command_id"`
+}
+
+// QueryExecutionCostResponse is the response type for Query/ExecutionCost
+type QueryExecutionCostResponse struct {
+	Cost ExecutionCost `json:"cost"`
+}
+
+// QueryExecutionCostsRequest is the request type for Query/ExecutionCosts
+type QueryExecutionCostsRequest struct {
+	TargetChain string `json:"target_chain,omitempty"` // Every chain if empty
+	StartTime   int64  `json:"start_time,omitempty"`   // Inclusive, unix seconds
+	EndTime     int64  `json:"end_time,omitempty"`     // Inclusive, unix seconds
+}
+
+// QueryExecutionCostsResponse is the response type for Query/ExecutionCosts
+type QueryExecutionCostsResponse struct {
+	Summaries []ExecutionCostSummary `json:"summaries"` // One per target chain
+}
+
+// QueryProbationsRequest is the request type for Query/Probations
+type QueryProbationsRequest struct{}
+
+// QueryProbationsResponse is the response type for Query/Probations
+type QueryProbationsResponse struct {
+	Probations []ValidatorProbation `json:"probations"`
+}
+
+// QueryNonceGapsRequest is the request type for Query/NonceGaps
+type QueryNonceGapsRequest struct{}
+
+// QueryNonceGapsResponse is the response type for Query/NonceGaps
+type QueryNonceGapsResponse struct {
+	Gaps []NonceGap `json:"gaps"` // One per paused target chain
+}
+
+// QueryServer defines the query service for the multisig module
+type QueryServer interface {
+	CommandBatch(ctx context.Context, req *QueryCommandBatchRequest) (*QueryCommandBatchResponse, error)
+	CommandProof(ctx context.Context, req *QueryCommandProofRequest) (*QueryCommandProofResponse, error)
+	LateSigners(ctx context.Context, req *QueryLateSignersRequest) (*QueryLateSignersResponse, error)
+	ValidatorSetConsistency(ctx context.Context, req *QueryValidatorSetConsistencyRequest) (*QueryValidatorSetConsistencyResponse, error)
+	ExecutionCost(ctx context.Context, req *QueryExecutionCostRequest) (*QueryExecutionCostResponse, error)
+	ExecutionCosts(ctx context.Context, req *QueryExecutionCostsRequest) (*QueryExecutionCostsResponse, error)
+	Probations(ctx context.Context, req *QueryProbationsRequest) (*QueryProbationsResponse, error)
+	NonceGaps(ctx context.Context, req *QueryNonceGapsRequest) (*QueryNonceGapsResponse, error)
+}
+
+// Placeholder for protobuf service descriptor
+// In a real implementation, this would be generated from .proto files
+var _Query_serviceDesc = struct{}{}
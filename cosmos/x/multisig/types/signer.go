@@ -0,0 +1,86 @@
+package types
+
+import (
+	"context"
+	"fmt"
+)
+
+// SignerServiceName is the full gRPC name of the signer service
+const SignerServiceName = "interbank.multisig.v1.SignerService"
+
+// SignerService signs mint command hashes with validator keys held outside
+// the node, e.g. in an HSM or a cloud KMS. Validator operators implement it
+// next to the node; their signing sidecar calls it for every command and the
+// node for every batch root, so neither holds an ECDSA key.
+type SignerService interface {
+	// SignCommandHash signs the hash of a mint command with the validator's key
+	SignCommandHash(ctx context.Context, req *SignCommandHashRequest) (*SignCommandHashResponse, error)
+	// GetPublicKey returns the public key the service signs with for a validator
+	GetPublicKey(ctx context.Context, req *GetPublicKeyRequest) (*GetPublicKeyResponse, error)
+}
+
+// SignCommandHashRequest is the request type for SignerService/SignCommandHash
+type SignCommandHashRequest struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator"`
+	CommandID string `protobuf:"bytes,2,opt,name=command_id,json=commandId,proto3" json:"command_id"`
+	Hash      []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash"` // 32-byte command hash, signed as is
+}
+
+// ProtoMessage implements proto.Message
+func (r *SignCommandHashRequest) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (r *SignCommandHashRequest) Reset() { *r = SignCommandHashRequest{} }
+
+// String implements proto.Message
+func (r *SignCommandHashRequest) String() string {
+	return fmt.Sprintf("SignCommandHashRequest{Validator: %s, CommandID: %s}", r.Validator, r.CommandID)
+}
+
+// SignCommandHashResponse is the response type for SignerService/SignCommandHash
+type SignCommandHashResponse struct {
+	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature"` // 65 bytes: r, s and a v of 0/1 or 27/28
+}
+
+// ProtoMessage implements proto.Message
+func (r *SignCommandHashResponse) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (r *SignCommandHashResponse) Reset() { *r = SignCommandHashResponse{} }
+
+// String implements proto.Message
+func (r *SignCommandHashResponse) String() string {
+	return fmt.Sprintf("SignCommandHashResponse{Signature: %x}", r.Signature)
+}
+
+// GetPublicKeyRequest is the request type for SignerService/GetPublicKey
+type GetPublicKeyRequest struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator"`
+}
+
+// ProtoMessage implements proto.Message
+func (r *GetPublicKeyRequest) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (r *GetPublicKeyRequest) Reset() { *r = GetPublicKeyRequest{} }
+
+// String implements proto.Message
+func (r *GetPublicKeyRequest) String() string {
+	return fmt.Sprintf("GetPublicKeyRequest{Validator: %s}", r.Validator)
+}
+
+// GetPublicKeyResponse is the response type for SignerService/GetPublicKey
+type GetPublicKeyResponse struct {
+	PubKey []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key"` // Compressed or uncompressed secp256k1 key
+}
+
+// ProtoMessage implements proto.Message
+func (r *GetPublicKeyResponse) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (r *GetPublicKeyResponse) Reset() { *r = GetPublicKeyResponse{} }
+
+// String implements proto.Message
+func (r *GetPublicKeyResponse) String() string {
+	return fmt.Sprintf("GetPublicKeyResponse{PubKey: %x}", r.PubKey)
+}
@@ -15,6 +15,13 @@ type MsgSignCommandResponse struct {
 	ThresholdMet   bool `json:"threshold_met"`
 }
 
+// MsgSignBatchResponse defines the response for MsgSignBatch
+type MsgSignBatchResponse struct {
+	Success        bool `json:"success"`
+	SignatureCount int  `json:"signature_count"`
+	ThresholdMet   bool `json:"threshold_met"`
+}
+
 // MsgUpdateValidatorSetResponse defines the response for MsgUpdateValidatorSet
 type MsgUpdateValidatorSetResponse struct {
 	Success   bool   `json:"success"`
@@ -32,13 +39,42 @@ type MsgRemoveValidatorResponse struct {
 	Success bool `json:"success"`
 }
 
+// MsgAdmitValidatorResponse defines the response for MsgAdmitValidator
+type MsgAdmitValidatorResponse struct {
+	Probation bool  `json:"probation"` // The validator's signatures don't count yet
+	Threshold int32 `json:"threshold"`
+}
+
+// MsgReportExecutionResponse defines the response for MsgReportExecution
+type MsgReportExecutionResponse struct {
+	CommandID string `json:"command_id"`
+	Duplicate bool   `json:"duplicate"` // The key was already reported executed; nothing changed
+}
+
+// MsgResolveNonceGapResponse defines the response for MsgResolveNonceGap
+type MsgResolveNonceGapResponse struct {
+	FailedNonces []uint64 `json:"failed_nonces"` // Nonces of the skipped commands marked failed
+}
+
+// MsgOverrideValidatorSetResponse defines the response for MsgOverrideValidatorSet
+type MsgOverrideValidatorSetResponse struct {
+	Version    uint64   `json:"version"`
+	Threshold  int32    `json:"threshold"`
+	CommandIDs []string `json:"command_ids"` // In-flight commands re-signed or invalidated
+}
+
 // MsgServer defines the msg service for the multisig module
 type MsgServer interface {
 	GenerateMintCommand(ctx context.Context, msg *MsgGenerateMintCommand) (*MsgGenerateMintCommandResponse, error)
 	SignCommand(ctx context.Context, msg *MsgSignCommand) (*MsgSignCommandResponse, error)
+	SignBatch(ctx context.Context, msg *MsgSignBatch) (*MsgSignBatchResponse, error)
 	UpdateValidatorSet(ctx context.Context, msg *MsgUpdateValidatorSet) (*MsgUpdateValidatorSetResponse, error)
 	AddValidator(ctx context.Context, msg *MsgAddValidator) (*MsgAddValidatorResponse, error)
 	RemoveValidator(ctx context.Context, msg *MsgRemoveValidator) (*MsgRemoveValidatorResponse, error)
+	AdmitValidator(ctx context.Context, msg *MsgAdmitValidator) (*MsgAdmitValidatorResponse, error)
+	ReportExecution(ctx context.Context, msg *MsgReportExecution) (*MsgReportExecutionResponse, error)
+	ResolveNonceGap(ctx context.Context, msg *MsgResolveNonceGap) (*MsgResolveNonceGapResponse, error)
+	OverrideValidatorSet(ctx context.Context, msg *MsgOverrideValidatorSet) (*MsgOverrideValidatorSetResponse, error)
 }
 
 // Placeholder for protobuf service descriptor
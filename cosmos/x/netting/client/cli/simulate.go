@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"cosmossdk.io/math"
+	"github.com/spf13/cobra"
+
+	"github.com/interbank-netting/cosmos/types"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
+)
+
+const (
+	// FlagFile is the flag for the obligations input file
+	FlagFile = "file"
+)
+
+// SimulationResult is the output of an offline netting simulation
+type SimulationResult struct {
+	Pairs        []types.BankPair              `json:"pairs"`
+	NetPositions map[string]math.Int           `json:"net_positions"`
+	Stats        nettingtypes.CompressionStats `json:"stats"`
+}
+
+// GetNettingCmd returns the offline netting tooling commands
+func GetNettingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   nettingtypes.ModuleName,
+		Short: "Offline netting engine tools",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(NewSimulateCmd())
+
+	return cmd
+}
+
+// NewSimulateCmd returns a command that runs the netting algorithms over a
+// list of bilateral obligations without a running node
+func NewSimulateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Simulate a netting cycle from offline obligations",
+		Long: `Load a JSON list of bilateral obligations and run the bilateral and
+multilateral netting algorithms used on-chain, printing the resulting pairs,
+net positions and compression statistics. An obligation may set "priority"
+to 1 for urgent customer payments; it defaults to 0 for interbank positions.
+
+Example obligations.json:
+[
+  {"debtor": "bank-a", "creditor": "bank-b", "amount": "100", "priority": 1},
+  {"debtor": "bank-b", "creditor": "bank-a", "amount": "30"}
+]`,
+		Example: fmt.Sprintf("interbank-nettingd %s simulate --file obligations.json", nettingtypes.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, err := cmd.Flags().GetString(FlagFile)
+			if err != nil {
+				return err
+			}
+			if path == "" {
+				return fmt.Errorf("--%s is required", FlagFile)
+			}
+
+			obligations, err := readObligations(path)
+			if err != nil {
+				return err
+			}
+
+			result := Simulate(obligations)
+
+			bz, err := json.MarshalIndent(result, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+
+	cmd.Flags().String(FlagFile, "", "Path to a JSON file containing the obligations")
+
+	return cmd
+}
+
+// Simulate runs the netting algorithms over the given obligations
+func Simulate(obligations []nettingtypes.Obligation) SimulationResult {
+	pairs := nettingtypes.CalculateBilateralPairs(obligations)
+	if pairs == nil {
+		pairs = []types.BankPair{}
+	}
+
+	return SimulationResult{
+		Pairs:        pairs,
+		NetPositions: nettingtypes.CalculateNetPositions(obligations),
+		Stats:        nettingtypes.CalculateCompressionStats(obligations),
+	}
+}
+
+func readObligations(path string) ([]nettingtypes.Obligation, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read obligations file: %w", err)
+	}
+
+	var obligations []nettingtypes.Obligation
+	if err := json.Unmarshal(bz, &obligations); err != nil {
+		return nil, fmt.Errorf("failed to parse obligations file: %w", err)
+	}
+
+	if err := nettingtypes.ValidateObligations(obligations); err != nil {
+		return nil, err
+	}
+
+	return obligations, nil
+}
@@ -7,14 +7,19 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/interbank-netting/cosmos/types"
 	"github.com/interbank-netting/cosmos/x/netting/keeper"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
 )
 
 // GenesisState defines the netting module's genesis state.
 type GenesisState struct {
-	CreditTokens    []types.CreditToken    `json:"credit_tokens"`
-	NettingCycles   []types.NettingCycle   `json:"netting_cycles"`
-	LastNettingBlock int64                 `json:"last_netting_block"`
-	Params          Params                 `json:"params"`
+	CreditTokens     []types.CreditToken        `protobuf:"bytes,1,rep,name=credit_tokens,json=creditTokens,proto3" json:"credit_tokens"`
+	NettingCycles    []types.NettingCycle       `protobuf:"bytes,2,rep,name=netting_cycles,json=nettingCycles,proto3" json:"netting_cycles"`
+	LastNettingBlock int64                      `protobuf:"varint,3,opt,name=last_netting_block,json=lastNettingBlock,proto3" json:"last_netting_block"`
+	Params           nettingtypes.Params        `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	BankAccounts     []nettingtypes.BankAccount `protobuf:"bytes,5,rep,name=bank_accounts,json=bankAccounts,proto3" json:"bank_accounts"`
+	DustBalances     []nettingtypes.DustBalance `protobuf:"bytes,6,rep,name=dust_balances,json=dustBalances,proto3" json:"dust_balances"`
+
+	PendingDenomMigration *nettingtypes.DenomMigration `protobuf:"bytes,7,opt,name=pending_denom_migration,json=pendingDenomMigration,proto3" json:"pending_denom_migration,omitempty"`
 }
 
 // ProtoMessage implements proto.Message
@@ -28,44 +33,22 @@ func (gs *GenesisState) String() string {
 	return fmt.Sprintf("GenesisState{CreditTokens: %d, NettingCycles: %d}", len(gs.CreditTokens), len(gs.NettingCycles))
 }
 
-// Params defines the parameters for the netting module.
-type Params struct {
-	NettingInterval   int64 `json:"netting_interval"`    // Netting interval in blocks
-	MinNettingAmount  int64 `json:"min_netting_amount"`  // Minimum amount for netting
-	MaxNettingPairs   int   `json:"max_netting_pairs"`   // Maximum pairs per netting cycle
-}
-
-// DefaultParams returns a default set of parameters
-func DefaultParams() Params {
-	return Params{
-		NettingInterval:  10,     // Every 10 blocks
-		MinNettingAmount: 1,      // Minimum 1 unit
-		MaxNettingPairs:  100,    // Maximum 100 pairs per cycle
-	}
-}
-
 // DefaultGenesisState returns the default genesis state
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		CreditTokens:     []types.CreditToken{},
 		NettingCycles:    []types.NettingCycle{},
 		LastNettingBlock: 0,
-		Params:           DefaultParams(),
+		Params:           nettingtypes.DefaultParams(),
+		BankAccounts:     []nettingtypes.BankAccount{},
+		DustBalances:     []nettingtypes.DustBalance{},
 	}
 }
 
 // ValidateGenesis validates the netting genesis parameters
 func ValidateGenesis(data *GenesisState) error {
-	if data.Params.NettingInterval <= 0 {
-		return fmt.Errorf("netting interval must be positive: %d", data.Params.NettingInterval)
-	}
-	
-	if data.Params.MinNettingAmount <= 0 {
-		return fmt.Errorf("minimum netting amount must be positive: %d", data.Params.MinNettingAmount)
-	}
-	
-	if data.Params.MaxNettingPairs <= 0 {
-		return fmt.Errorf("maximum netting pairs must be positive: %d", data.Params.MaxNettingPairs)
+	if err := data.Params.Validate(); err != nil {
+		return err
 	}
 	
 	if data.LastNettingBlock < 0 {
@@ -98,26 +81,79 @@ func ValidateGenesis(data *GenesisState) error {
 		}
 	}
 	
+	// Validate bank accounts
+	seenAccounts := make(map[string]bool)
+	for i, account := range data.BankAccounts {
+		if err := account.Validate(); err != nil {
+			return fmt.Errorf("bank account %d: %w", i, err)
+		}
+		if seenAccounts[account.Address] {
+			return fmt.Errorf("bank account %d: duplicate address %s", i, account.Address)
+		}
+		seenAccounts[account.Address] = true
+	}
+
+	// Validate dust balances
+	seenDust := make(map[string]bool)
+	for i, balance := range data.DustBalances {
+		if balance.Bank == "" {
+			return fmt.Errorf("dust balance %d: bank cannot be empty", i)
+		}
+		if balance.Amount.IsNil() || balance.Amount.IsNegative() {
+			return fmt.Errorf("dust balance %d: amount cannot be negative", i)
+		}
+		if seenDust[balance.Bank] {
+			return fmt.Errorf("dust balance %d: duplicate bank %s", i, balance.Bank)
+		}
+		seenDust[balance.Bank] = true
+	}
+
+	// Validate the pending denom migration
+	if data.PendingDenomMigration != nil {
+		if err := data.PendingDenomMigration.Validate(); err != nil {
+			return fmt.Errorf("pending denom migration: %w", err)
+		}
+	}
+	
 	return nil
 }
 
 // InitGenesis initializes the netting module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState *GenesisState) {
 	// Initialize credit tokens
-	for _, token := range genState.CreditTokens {
-		// Issue credit token (keeper method would need to be implemented)
-		_ = keeper.IssueCreditToken(ctx, token)
+	for i, token := range genState.CreditTokens {
+		if err := keeper.InitCreditToken(ctx, token); err != nil {
+			panic(fmt.Sprintf("failed to initialize credit token %d: %v", i, err))
+		}
 	}
 	
 	// Initialize netting cycles
-	// TODO: Implement when keeper methods are available
-	_ = genState.NettingCycles
+	for _, cycle := range genState.NettingCycles {
+		keeper.SetNettingCycle(ctx, cycle)
+	}
 	
 	// Set last netting block
-	// keeper.SetLastNettingBlock(ctx, genState.LastNettingBlock)
+	keeper.SetLastNettingBlock(ctx, genState.LastNettingBlock)
 	
-	// Set parameters (would need parameter store implementation)
-	// keeper.SetParams(ctx, genState.Params)
+	// Set parameters
+	keeper.SetParams(ctx, genState.Params)
+	
+	// Initialize bank accounts
+	for i, account := range genState.BankAccounts {
+		if err := keeper.SetBankAccount(ctx, account); err != nil {
+			panic(fmt.Sprintf("failed to initialize bank account %d: %v", i, err))
+		}
+	}
+
+	// Initialize dust accounts
+	for _, balance := range genState.DustBalances {
+		keeper.SetDustBalance(ctx, balance)
+	}
+
+	// Initialize the pending denom migration
+	if genState.PendingDenomMigration != nil {
+		keeper.SetPendingDenomMigration(ctx, *genState.PendingDenomMigration)
+	}
 }
 
 // ExportGenesis returns the netting module's exported genesis.
@@ -133,8 +169,23 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *GenesisState {
 	// Export last netting block (would need keeper methods)
 	// genesis.LastNettingBlock = keeper.GetLastNettingBlock(ctx)
 	
-	// Export parameters (would need parameter store)
-	// genesis.Params = keeper.GetParams(ctx)
+	// Export parameters
+	genesis.Params = keeper.GetParams(ctx)
+	
+	// Export bank accounts
+	if accounts := keeper.GetAllBankAccounts(ctx); accounts != nil {
+		genesis.BankAccounts = accounts
+	}
+
+	// Export dust accounts
+	if balances := keeper.GetAllDustBalances(ctx); balances != nil {
+		genesis.DustBalances = balances
+	}
+
+	// Export the pending denom migration
+	if migration, found := keeper.GetPendingDenomMigration(ctx); found {
+		genesis.PendingDenomMigration = &migration
+	}
 	
 	return genesis
 }
\ No newline at end of file
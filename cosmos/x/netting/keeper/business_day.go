@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/interbank-netting/cosmos/types"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
+)
+
+// CloseBusinessDay runs the end-of-day cycle once the business day has
+// closed. It runs in EndBlock before the periodic cycle. The end-of-day cycle
+// nets the netting sets in order, one cycle per block and regardless of
+// MinNettingAmount and MaxNettingPairs, and generates their settlement
+// commands. Once every set has been netted the closing report is stored and
+// the business date rolls forward. A block that already has a cycle, or a
+// cycle in progress, postpones the closing to a later block.
+func (k Keeper) CloseBusinessDay(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if params.BusinessDayClose == 0 {
+		return nil
+	}
+
+	day, found := k.GetBusinessDay(ctx)
+	if !found {
+		k.setBusinessDay(ctx, nettingtypes.NewBusinessDay(ctx.BlockTime(), params.BusinessDayClose))
+		return nil
+	}
+	if ctx.BlockTime().Before(day.CloseTime(params.BusinessDayClose)) {
+		return nil
+	}
+
+	cycleID := uint64(ctx.BlockHeight())
+	if k.getLastNettingBlock(ctx) == ctx.BlockHeight() {
+		return nil
+	}
+	if _, inProgress := k.getInProgressCycleID(ctx); inProgress {
+		return nil
+	}
+
+	sets := params.GetNettingSets()
+	for int(day.ClosedSets) < len(sets) {
+		set := sets[day.ClosedSets]
+		setCtx := nettingtypes.WithNettingSet(types.WithCorrelationID(ctx, types.CycleCorrelationID(cycleID)), set)
+		day.ClosedSets++
+
+		pairs, err := k.CalculateNetting(setCtx)
+		if err != nil {
+			k.setBusinessDay(ctx, day)
+			return err
+		}
+		if len(pairs) == 0 && len(k.obligationLoops(setCtx, params)) == 0 {
+			continue
+		}
+
+		// The set is not netted again if its cycle fails
+		day.Cycles = append(day.Cycles, cycleID)
+		k.setBusinessDay(ctx, day)
+		if err := k.executeNetting(setCtx, pairs, "", 0, true); err != nil {
+			return err
+		}
+		k.setLastNettingBlock(ctx, ctx.BlockHeight())
+		k.setLastNettingSet(ctx, set.ID)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				nettingtypes.EventTypeNettingTriggered,
+				sdk.NewAttribute(nettingtypes.AttributeKeyBlockHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+				sdk.NewAttribute(nettingtypes.AttributeKeyNettingSet, set.ID),
+				sdk.NewAttribute(nettingtypes.AttributeKeyPairCount, strconv.Itoa(len(pairs))),
+				sdk.NewAttribute(nettingtypes.AttributeKeyDeferredCount, "0"),
+				sdk.NewAttribute(nettingtypes.AttributeKeyTriggeredBy, ""),
+				sdk.NewAttribute(nettingtypes.AttributeKeyEndOfDay, "true"),
+			),
+		)
+
+		// The next sets are netted in the following blocks
+		if int(day.ClosedSets) < len(sets) {
+			return nil
+		}
+	}
+
+	k.closeBusinessDay(ctx, day, params)
+	return nil
+}
+
+// closeBusinessDay stores the closing report of a business day whose sets
+// have all been netted and opens the next business day
+func (k Keeper) closeBusinessDay(ctx sdk.Context, day nettingtypes.BusinessDay, params nettingtypes.Params) {
+	next := nettingtypes.NewBusinessDay(ctx.BlockTime(), params.BusinessDayClose)
+	next.OpenedAt++
+
+	closedAt := ctx.BlockTime().Unix()
+	closing := nettingtypes.ClosingReport{
+		BusinessDate:     day.Date,
+		NextBusinessDate: next.Date,
+		ClosedHeight:     ctx.BlockHeight(),
+		ClosedAt:         closedAt,
+		Cycles:           append([]uint64{}, day.Cycles...),
+		Report:           k.aggregateReport(ctx, nettingtypes.NewPeriodReport(day.Date, day.OpenedAt, closedAt)),
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(nettingtypes.GetClosingReportKey(closing.BusinessDate), k.cdc.MustMarshal(&closing))
+	k.setBusinessDay(ctx, next)
+
+	k.Logger(ctx).Info("business day closed",
+		"business_date", closing.BusinessDate,
+		"next_business_date", closing.NextBusinessDate,
+		"cycle_count", len(closing.Cycles),
+	)
+
+	if k.oracleKeeper != nil {
+		auditLog := types.AuditLog{
+			EventType: types.EventTypeBusinessDayClosed,
+			Timestamp: closedAt,
+			Details: map[string]string{
+				"business_date":      closing.BusinessDate,
+				"next_business_date": closing.NextBusinessDate,
+				"cycle_count":        strconv.Itoa(len(closing.Cycles)),
+				"netted_amount":      closing.Report.NettedAmount.String(),
+				"settled_amount":     closing.Report.SettledAmount.String(),
+			},
+		}
+		if _, err := k.oracleKeeper.SaveAuditLog(ctx, auditLog); err != nil {
+			k.Logger(ctx).Error("failed to log business day close", "error", err)
+			// Don't fail for logging errors
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			nettingtypes.EventTypeBusinessDayClosed,
+			sdk.NewAttribute(nettingtypes.AttributeKeyBusinessDate, closing.BusinessDate),
+			sdk.NewAttribute(nettingtypes.AttributeKeyNextDate, closing.NextBusinessDate),
+			sdk.NewAttribute(nettingtypes.AttributeKeyCycleCount, strconv.Itoa(len(closing.Cycles))),
+			sdk.NewAttribute(nettingtypes.AttributeKeyBlockHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
+}
+
+// GetBusinessDay returns the business day currently open, if end-of-day
+// cycles have been enabled
+func (k Keeper) GetBusinessDay(ctx sdk.Context) (nettingtypes.BusinessDay, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nettingtypes.BusinessDayKey)
+	if bz == nil {
+		return nettingtypes.BusinessDay{}, false
+	}
+
+	var day nettingtypes.BusinessDay
+	k.cdc.MustUnmarshal(bz, &day)
+	return day, true
+}
+
+// setBusinessDay stores the business day currently open
+func (k Keeper) setBusinessDay(ctx sdk.Context, day nettingtypes.BusinessDay) {
+	ctx.KVStore(k.storeKey).Set(nettingtypes.BusinessDayKey, k.cdc.MustMarshal(&day))
+}
+
+// GetClosingReport returns the closing report of a business date
+func (k Keeper) GetClosingReport(ctx sdk.Context, date string) (nettingtypes.ClosingReport, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nettingtypes.GetClosingReportKey(date))
+	if bz == nil {
+		return nettingtypes.ClosingReport{}, false
+	}
+
+	var closing nettingtypes.ClosingReport
+	k.cdc.MustUnmarshal(bz, &closing)
+	return closing, true
+}
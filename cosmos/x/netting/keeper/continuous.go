@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/interbank-netting/cosmos/types"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
+)
+
+// offsetContinuousCorridor offsets credit just issued between the banks of a
+// continuous corridor against the reciprocal balance, if any, and records the
+// offset as a micro-cycle. Whole settlement units are burned from both banks
+// and the residual is carried. Corridors are not offset while a periodic
+// cycle is in progress, so the cycle's snapshot stays valid.
+func (k Keeper) offsetContinuousCorridor(ctx sdk.Context, token types.CreditToken) error {
+	params := k.GetParams(ctx)
+	if !params.IsContinuousCorridor(token.IssuerBank, token.HolderBank) {
+		return nil
+	}
+	if _, inProgress := k.getInProgressCycleID(ctx); inProgress {
+		return nil
+	}
+
+	// Credit is offset within the netting set of its currency
+	set, found := params.GetNettingSetOf(creditCurrency(token.Denom))
+	if !found {
+		return nil
+	}
+	ctx = nettingtypes.WithNettingSet(ctx, set)
+
+	// The holder's credit on the issuer against the issuer's on the holder
+	offset := math.MinInt(
+		k.nettedBalance(ctx, token.HolderBank, token.IssuerBank, k.GetAvailableCreditBalance),
+		k.nettedBalance(ctx, token.IssuerBank, token.HolderBank, k.GetAvailableCreditBalance),
+	)
+	netted, dust := nettingtypes.SplitDust(offset, params.SettlementUnit)
+	if !netted.IsPositive() {
+		return nil
+	}
+
+	holderBurns, err := k.burnNettedCredit(ctx, token.HolderBank, token.IssuerBank, netted)
+	if err != nil {
+		return err
+	}
+	issuerBurns, err := k.burnNettedCredit(ctx, token.IssuerBank, token.HolderBank, netted)
+	if err != nil {
+		return err
+	}
+
+	// Carried residuals keep their priority, as in periodic cycles
+	pair := types.NewBankPair(token.IssuerBank, token.HolderBank, netted, netted, types.PriorityNormal)
+	if dust.IsZero() {
+		k.clearSettledPriorities(ctx, pair)
+	}
+	k.recordVelocity(ctx, token.IssuerBank, token.HolderBank, math.ZeroInt(), netted)
+	k.recordVelocity(ctx, token.HolderBank, token.IssuerBank, math.ZeroInt(), netted)
+
+	micro := k.addMicroCycle(ctx, nettingtypes.MicroCycle{
+		OriginTx:   token.OriginTx,
+		BankA:      pair.BankA,
+		BankB:      pair.BankB,
+		Netted:     netted,
+		Dust:       dust,
+		NettingSet: set.ID,
+	})
+	reference := strconv.FormatUint(micro.ID, 10)
+	k.recordBurns(ctx, withReference(append(holderBurns, issuerBurns...), reference), nettingtypes.LineageKindMicroCycle)
+
+	k.Logger(ctx).Info("continuous corridor offset",
+		"micro_cycle_id", micro.ID,
+		"bank_a", micro.BankA,
+		"bank_b", micro.BankB,
+		"netted", netted.String(),
+	)
+
+	if k.oracleKeeper != nil {
+		auditLog := types.AuditLog{
+			EventType: types.EventTypeMicroCycleCompleted,
+			TxHash:    token.OriginTx,
+			Timestamp: ctx.BlockTime().Unix(),
+			Details: map[string]string{
+				"micro_cycle_id": reference,
+				"bank_a":         micro.BankA,
+				"bank_b":         micro.BankB,
+				"netted":         netted.String(),
+				"dust":           dust.String(),
+			},
+		}
+		if _, err := k.oracleKeeper.SaveAuditLog(ctx, auditLog); err != nil {
+			k.Logger(ctx).Error("failed to log micro-cycle", "error", err)
+			// Don't fail for logging errors
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			nettingtypes.EventTypeMicroCycleCompleted,
+			sdk.NewAttribute(nettingtypes.AttributeKeyMicroCycleID, reference),
+			sdk.NewAttribute(nettingtypes.AttributeKeyBankA, micro.BankA),
+			sdk.NewAttribute(nettingtypes.AttributeKeyBankB, micro.BankB),
+			sdk.NewAttribute(types.AttributeKeyAmount, netted.String()),
+			sdk.NewAttribute(nettingtypes.AttributeKeyDust, dust.String()),
+			sdk.NewAttribute(nettingtypes.AttributeKeyOriginTx, token.OriginTx),
+		),
+	)
+
+	return nil
+}
+
+// GetMicroCycle retrieves a micro-cycle by ID
+func (k Keeper) GetMicroCycle(ctx sdk.Context, id uint64) (nettingtypes.MicroCycle, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nettingtypes.GetMicroCycleKey(id))
+	if bz == nil {
+		return nettingtypes.MicroCycle{}, false
+	}
+
+	var micro nettingtypes.MicroCycle
+	k.cdc.MustUnmarshal(bz, &micro)
+	return micro, true
+}
+
+// addMicroCycle stores a micro-cycle under the next ID
+func (k Keeper) addMicroCycle(ctx sdk.Context, micro nettingtypes.MicroCycle) nettingtypes.MicroCycle {
+	store := ctx.KVStore(k.storeKey)
+	var last uint64
+	if bz := store.Get(nettingtypes.LastMicroCycleKey); len(bz) == 8 {
+		last = binary.BigEndian.Uint64(bz)
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, last+1)
+	store.Set(nettingtypes.LastMicroCycleKey, bz)
+
+	micro.ID = last + 1
+	micro.BlockHeight = ctx.BlockHeight()
+	micro.Time = ctx.BlockTime().Unix()
+	store.Set(nettingtypes.GetMicroCycleKey(micro.ID), k.cdc.MustMarshal(&micro))
+	return micro
+}
@@ -0,0 +1,276 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
+)
+
+type querier struct {
+	keeper ViewKeeper
+}
+
+// NewQueryServerImpl returns an implementation of the QueryServer interface
+// for the provided Keeper. The querier only holds the keeper's ViewKeeper.
+func NewQueryServerImpl(keeper Keeper) nettingtypes.QueryServer {
+	return &querier{keeper: keeper}
+}
+
+var _ nettingtypes.QueryServer = querier{}
+
+// CreditBalance returns the credit a bank holds in a denom, served from the
+// query cache when it is enabled
+func (q querier) CreditBalance(goCtx context.Context, req *nettingtypes.QueryCreditBalanceRequest) (*nettingtypes.QueryCreditBalanceResponse, error) {
+	if req == nil || req.Bank == "" || req.Denom == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "bank and denom cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &nettingtypes.QueryCreditBalanceResponse{
+		Balance: q.keeper.GetCreditBalanceSummary(ctx, req.Bank, req.Denom),
+	}, nil
+}
+
+// CreditVelocity returns the rolling issued and netted volume of an issuer -> holder pair
+func (q querier) CreditVelocity(goCtx context.Context, req *nettingtypes.QueryCreditVelocityRequest) (*nettingtypes.QueryCreditVelocityResponse, error) {
+	if req == nil || req.IssuerBank == "" || req.HolderBank == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "issuer and holder bank cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &nettingtypes.QueryCreditVelocityResponse{
+		Velocity: q.keeper.GetCreditVelocity(ctx, req.IssuerBank, req.HolderBank),
+	}, nil
+}
+
+// CycleSettlement returns the settlement commands of a netting cycle with the
+// amount still outstanding
+func (q querier) CycleSettlement(goCtx context.Context, req *nettingtypes.QueryCycleSettlementRequest) (*nettingtypes.QueryCycleSettlementResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	settlement, found := q.keeper.GetCycleSettlement(ctx, req.CycleID)
+	if !found {
+		return nil, errorsmod.Wrapf(nettingtypes.ErrSettlementNotFound, "cycle %d", req.CycleID)
+	}
+
+	return &nettingtypes.QueryCycleSettlementResponse{
+		Settlement:  settlement,
+		Executed:    int32(settlement.ExecutedCount()),
+		Outstanding: settlement.Outstanding(),
+	}, nil
+}
+
+// OpenSettlements returns the netting cycles that are not fully settled
+func (q querier) OpenSettlements(goCtx context.Context, req *nettingtypes.QueryOpenSettlementsRequest) (*nettingtypes.QueryOpenSettlementsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	settlements := q.keeper.GetOpenSettlements(ctx)
+	outstanding := math.ZeroInt()
+	for _, settlement := range settlements {
+		outstanding = outstanding.Add(settlement.Outstanding())
+	}
+
+	return &nettingtypes.QueryOpenSettlementsResponse{
+		Settlements: settlements,
+		Outstanding: outstanding,
+	}, nil
+}
+
+// ParamsHistory returns the params changes made by governance
+func (q querier) ParamsHistory(goCtx context.Context, req *nettingtypes.QueryParamsHistoryRequest) (*nettingtypes.QueryParamsHistoryResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &nettingtypes.QueryParamsHistoryResponse{Changes: q.keeper.GetParamsHistory(ctx)}, nil
+}
+
+// CreditLineage returns the provenance graph of a credit lineage node
+func (q querier) CreditLineage(goCtx context.Context, req *nettingtypes.QueryCreditLineageRequest) (*nettingtypes.QueryCreditLineageResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lineage, found := q.keeper.GetCreditLineage(ctx, req.TokenID)
+	if !found {
+		return nil, errorsmod.Wrapf(nettingtypes.ErrLineageNodeNotFound, "node %d", req.TokenID)
+	}
+
+	return &nettingtypes.QueryCreditLineageResponse{
+		Lineage: lineage,
+		Origins: lineage.Origins(),
+	}, nil
+}
+
+// CreditLots returns the unspent credit lots making up a bank's balance of a denom
+func (q querier) CreditLots(goCtx context.Context, req *nettingtypes.QueryCreditLotsRequest) (*nettingtypes.QueryCreditLotsResponse, error) {
+	if req == nil || req.Bank == "" || req.Denom == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "bank and denom cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &nettingtypes.QueryCreditLotsResponse{
+		Lots:    q.keeper.GetCreditLots(ctx, req.Bank, req.Denom),
+		Balance: q.keeper.GetCreditBalance(ctx, req.Bank, req.Denom),
+	}, nil
+}
+
+// Banks returns the banks known to the netting module with their outstanding credit
+func (q querier) Banks(goCtx context.Context, req *nettingtypes.QueryBanksRequest) (*nettingtypes.QueryBanksResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &nettingtypes.QueryBanksResponse{Banks: q.keeper.GetBanks(ctx)}, nil
+}
+
+// DenomMigration returns the scheduled denom migration and the report of the last one applied
+func (q querier) DenomMigration(goCtx context.Context, req *nettingtypes.QueryDenomMigrationRequest) (*nettingtypes.QueryDenomMigrationResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	response := &nettingtypes.QueryDenomMigrationResponse{}
+	if pending, found := q.keeper.GetPendingDenomMigration(ctx); found {
+		response.Pending = &pending
+	}
+	if last, found := q.keeper.GetLastDenomMigration(ctx); found {
+		response.Last = &last
+	}
+	return response, nil
+}
+
+// DenomMigrationDryRun returns what renaming credit denoms would change at the current height
+func (q querier) DenomMigrationDryRun(goCtx context.Context, req *nettingtypes.QueryDenomMigrationDryRunRequest) (*nettingtypes.QueryDenomMigrationDryRunResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	migration := nettingtypes.DenomMigration{Height: ctx.BlockHeight(), Renames: req.Renames}
+	report, err := q.keeper.DryRunDenomMigration(ctx, migration)
+	if err != nil {
+		return nil, err
+	}
+	return &nettingtypes.QueryDenomMigrationDryRunResponse{Report: report}, nil
+}
+
+// DailyReport returns the settlement activity of a calendar day for end-of-day reconciliation
+func (q querier) DailyReport(goCtx context.Context, req *nettingtypes.QueryDailyReportRequest) (*nettingtypes.QueryDailyReportResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	day, err := nettingtypes.ParseReportDate(req.Date)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &nettingtypes.QueryDailyReportResponse{Report: q.keeper.GetDailyReport(ctx, day)}, nil
+}
+
+// MicroCycle returns the offset of a continuous corridor
+func (q querier) MicroCycle(goCtx context.Context, req *nettingtypes.QueryMicroCycleRequest) (*nettingtypes.QueryMicroCycleResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	micro, found := q.keeper.GetMicroCycle(ctx, req.ID)
+	if !found {
+		return nil, errorsmod.Wrapf(nettingtypes.ErrMicroCycleNotFound, "micro-cycle %d", req.ID)
+	}
+
+	return &nettingtypes.QueryMicroCycleResponse{MicroCycle: micro}, nil
+}
+
+// ReserveAttestation returns a proof-of-reserves attestation of a bank
+func (q querier) ReserveAttestation(goCtx context.Context, req *nettingtypes.QueryReserveAttestationRequest) (*nettingtypes.QueryReserveAttestationResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var attestation nettingtypes.ReserveAttestation
+	var found bool
+	if req.ID == 0 {
+		attestation, found = q.keeper.GetLatestReserveAttestation(ctx, req.Bank)
+	} else {
+		attestation, found = q.keeper.GetReserveAttestation(ctx, req.Bank, req.ID)
+	}
+	if !found {
+		return nil, errorsmod.Wrapf(nettingtypes.ErrAttestationNotFound, "bank %s, attestation %d", req.Bank, req.ID)
+	}
+
+	report, _ := q.keeper.GetReserveReport(ctx, req.Bank)
+	return &nettingtypes.QueryReserveAttestationResponse{Attestation: attestation, Report: report}, nil
+}
+
+// BusinessDay returns the business day currently open and when it closes
+func (q querier) BusinessDay(goCtx context.Context, req *nettingtypes.QueryBusinessDayRequest) (*nettingtypes.QueryBusinessDayResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := q.keeper.GetParams(ctx)
+	if params.BusinessDayClose == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "end-of-day cycles are disabled")
+	}
+
+	day, found := q.keeper.GetBusinessDay(ctx)
+	if !found {
+		day = nettingtypes.NewBusinessDay(ctx.BlockTime(), params.BusinessDayClose)
+	}
+	return &nettingtypes.QueryBusinessDayResponse{
+		BusinessDay: day,
+		CloseTime:   day.CloseTime(params.BusinessDayClose).Unix(),
+	}, nil
+}
+
+// ClosingReport returns the closing report of a business date
+func (q querier) ClosingReport(goCtx context.Context, req *nettingtypes.QueryClosingReportRequest) (*nettingtypes.QueryClosingReportResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	if _, err := nettingtypes.ParseReportDate(req.BusinessDate); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	closing, found := q.keeper.GetClosingReport(ctx, req.BusinessDate)
+	if !found {
+		return nil, errorsmod.Wrapf(nettingtypes.ErrClosingReportNotFound, "business date %s", req.BusinessDate)
+	}
+	return &nettingtypes.QueryClosingReportResponse{Report: closing}, nil
+}
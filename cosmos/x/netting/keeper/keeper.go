@@ -1,9 +1,14 @@
 package keeper
 
 import (
-	"fmt"
+	"encoding/binary"
+	"errors"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
@@ -22,9 +27,21 @@ type Keeper struct {
 	memKey     storetypes.StoreKey
 	paramstore paramtypes.Subspace
 
-	bankKeeper    types.BankKeeper
-	accountKeeper types.AccountKeeper
-	oracleKeeper  nettingtypes.OracleKeeper
+	bankKeeper     types.BankKeeper
+	accountKeeper  types.AccountKeeper
+	oracleKeeper   nettingtypes.OracleKeeper
+	multisigKeeper nettingtypes.MultisigKeeper
+
+	// strategies are the netting strategies registered besides the built-in
+	// bilateral one, by name
+	strategies map[string]nettingtypes.NettingStrategy
+
+	// queryCache serves hot queries from the memory store when enabled
+	queryCache types.QueryCache
+
+	// authority is the address allowed to update params and trigger netting
+	// besides the registered operators, usually the gov module account
+	authority string
 }
 
 // NewKeeper creates a new netting Keeper instance
@@ -35,6 +52,7 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	bankKeeper types.BankKeeper,
 	accountKeeper types.AccountKeeper,
+	authority string,
 ) *Keeper {
 	return &Keeper{
 		cdc:           cdc,
@@ -43,12 +61,24 @@ func NewKeeper(
 		paramstore:    ps,
 		bankKeeper:    bankKeeper,
 		accountKeeper: accountKeeper,
+		strategies:    make(map[string]nettingtypes.NettingStrategy),
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the module's authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetStoreKey returns the store key
+func (k Keeper) GetStoreKey() storetypes.StoreKey {
+	return k.storeKey
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
-	return ctx.Logger().With("module", fmt.Sprintf("x/%s", nettingtypes.ModuleName))
+	return types.ModuleLogger(ctx, nettingtypes.ModuleName)
 }
 
 // SetOracleKeeper sets the oracle keeper (to avoid circular dependency)
@@ -56,8 +86,18 @@ func (k *Keeper) SetOracleKeeper(oracleKeeper nettingtypes.OracleKeeper) {
 	k.oracleKeeper = oracleKeeper
 }
 
+// SetMultisigKeeper sets the multisig keeper generating settlement commands
+func (k *Keeper) SetMultisigKeeper(multisigKeeper nettingtypes.MultisigKeeper) {
+	k.multisigKeeper = multisigKeeper
+}
+
 // IssueCreditToken issues a new credit token
 func (k Keeper) IssueCreditToken(ctx sdk.Context, token types.CreditToken) error {
+	// Correlate with the originating transfer unless called within its processing
+	if types.GetCorrelationID(ctx) == "" {
+		ctx = types.WithCorrelationID(ctx, token.OriginTx)
+	}
+
 	// Validate credit token
 	if err := k.validateCreditToken(token); err != nil {
 		return err
@@ -73,6 +113,15 @@ func (k Keeper) IssueCreditToken(ctx sdk.Context, token types.CreditToken) error
 
 	// Update credit balance for holder bank
 	k.addCreditBalance(ctx, token.HolderBank, token.Denom, token.Amount)
+	k.raiseObligationPriority(ctx, token.IssuerBank, token.HolderBank, token.Priority)
+	k.recordVelocity(ctx, token.IssuerBank, token.HolderBank, token.Amount, math.ZeroInt())
+	lot := k.recordIssuance(ctx, token)
+
+	k.Logger(ctx).Info("credit token issued",
+		"denom", token.Denom,
+		"holder_bank", token.HolderBank,
+		"amount", token.Amount.String(),
+	)
 
 	// Log credit issuance (Requirement 7.1)
 	if k.oracleKeeper != nil {
@@ -86,19 +135,48 @@ func (k Keeper) IssueCreditToken(ctx sdk.Context, token types.CreditToken) error
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			nettingtypes.EventTypeCreditIssued,
-			sdk.NewAttribute(nettingtypes.AttributeKeyDenom, token.Denom),
-			sdk.NewAttribute(nettingtypes.AttributeKeyAmount, token.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, token.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, token.Amount.String()),
 			sdk.NewAttribute(nettingtypes.AttributeKeyIssuerBank, token.IssuerBank),
-			sdk.NewAttribute(nettingtypes.AttributeKeyHolderBank, token.HolderBank),
+			sdk.NewAttribute(types.AttributeKeyHolderBank, token.HolderBank),
 			sdk.NewAttribute(nettingtypes.AttributeKeyOriginTx, token.OriginTx),
+			sdk.NewAttribute(nettingtypes.AttributeKeyLineageNode, strconv.FormatUint(lot.ID, 10)),
 		),
 	)
 
-	return nil
+	// Continuous corridors offset the new credit right away
+	return k.offsetContinuousCorridor(ctx, token)
 }
 
 // BurnCreditToken burns credit tokens
 func (k Keeper) BurnCreditToken(ctx sdk.Context, denom string, amount math.Int) error {
+	if err := k.burnCredit(ctx, denom, amount); err != nil {
+		return err
+	}
+	k.recordBurn(ctx, denom, amount, nettingtypes.LineageKindBurn, "")
+	return nil
+}
+
+// BurnHeldCreditToken burns credit of a denom held by a given bank, e.g. the
+// credit a dispute froze at its holder after the token's credit moved on
+func (k Keeper) BurnHeldCreditToken(ctx sdk.Context, holder, denom string, amount math.Int) error {
+	if amount.IsNil() || amount.LTE(math.ZeroInt()) {
+		return nettingtypes.ErrInvalidAmount
+	}
+	if !k.creditTokenExists(ctx, denom) {
+		return nettingtypes.ErrCreditTokenNotFound
+	}
+
+	if err := k.burnHeldCredit(ctx, holder, denom, amount); err != nil {
+		return err
+	}
+	k.recordBurns(ctx, []creditBurn{{holder: holder, denom: denom, amount: amount}}, nettingtypes.LineageKindBurn)
+	return nil
+}
+
+// burnCredit burns credit tokens without recording their lineage, for
+// netting cycles that record it once complete
+func (k Keeper) burnCredit(ctx sdk.Context, denom string, amount math.Int) error {
 	// Validate amount
 	if amount.IsNil() || amount.LTE(math.ZeroInt()) {
 		return nettingtypes.ErrInvalidAmount
@@ -110,22 +188,28 @@ func (k Keeper) BurnCreditToken(ctx sdk.Context, denom string, amount math.Int)
 		return nettingtypes.ErrCreditTokenNotFound
 	}
 
-	// Check if holder bank has sufficient balance
-	balance := k.GetCreditBalance(ctx, token.HolderBank, denom)
+	return k.burnHeldCredit(ctx, token.HolderBank, denom, amount)
+}
+
+// burnHeldCredit burns credit of a denom held by a bank, which for loops
+// compressed by multilateral netting need not be the token's holder bank
+func (k Keeper) burnHeldCredit(ctx sdk.Context, holder, denom string, amount math.Int) error {
+	// Check if holder bank has sufficient unfrozen balance
+	balance := k.GetAvailableCreditBalance(ctx, holder, denom)
 	if balance.LT(amount) {
 		return nettingtypes.ErrInsufficientBalance
 	}
 
 	// Subtract from credit balance
-	k.subtractCreditBalance(ctx, token.HolderBank, denom, amount)
+	k.subtractCreditBalance(ctx, holder, denom, amount)
 
 	// Emit credit burned event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			nettingtypes.EventTypeCreditBurned,
-			sdk.NewAttribute(nettingtypes.AttributeKeyDenom, denom),
-			sdk.NewAttribute(nettingtypes.AttributeKeyAmount, amount.String()),
-			sdk.NewAttribute(nettingtypes.AttributeKeyHolderBank, token.HolderBank),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyHolderBank, holder),
 		),
 	)
 
@@ -139,8 +223,8 @@ func (k Keeper) TransferCreditToken(ctx sdk.Context, from, to, denom string, amo
 		return nettingtypes.ErrInvalidAmount
 	}
 
-	// Check if from bank has sufficient balance
-	balance := k.GetCreditBalance(ctx, from, denom)
+	// Check if from bank has sufficient unfrozen balance
+	balance := k.GetAvailableCreditBalance(ctx, from, denom)
 	if balance.LT(amount) {
 		return nettingtypes.ErrInsufficientBalance
 	}
@@ -148,15 +232,23 @@ func (k Keeper) TransferCreditToken(ctx sdk.Context, from, to, denom string, amo
 	// Transfer credit balance
 	k.subtractCreditBalance(ctx, from, denom, amount)
 	k.addCreditBalance(ctx, to, denom, amount)
+	lot := k.passOnCredit(ctx, from, nettingtypes.CreditLineageNode{
+		Kind:      nettingtypes.LineageKindAssignment,
+		Denom:     denom,
+		Bank:      to,
+		Amount:    amount,
+		Reference: from,
+	})
 
 	// Emit credit transferred event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			nettingtypes.EventTypeCreditTransferred,
-			sdk.NewAttribute(nettingtypes.AttributeKeyDenom, denom),
-			sdk.NewAttribute(nettingtypes.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
 			sdk.NewAttribute(nettingtypes.AttributeKeyFromBank, from),
 			sdk.NewAttribute(nettingtypes.AttributeKeyToBank, to),
+			sdk.NewAttribute(nettingtypes.AttributeKeyLineageNode, strconv.FormatUint(lot.ID, 10)),
 		),
 	)
 
@@ -183,7 +275,8 @@ func (k Keeper) GetCreditBalance(ctx sdk.Context, bank, denom string) math.Int {
 // GetAllCreditBalances returns all credit balances for a bank
 func (k Keeper) GetAllCreditBalances(ctx sdk.Context, bank string) map[string]math.Int {
 	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, append(nettingtypes.CreditBalanceKeyPrefix, []byte(bank)...))
+	// Include the separator so bank-a does not also match bank-ab
+	iterator := storetypes.KVStorePrefixIterator(store, append(nettingtypes.CreditBalanceKeyPrefix, []byte(bank+"/")...))
 	defer iterator.Close()
 
 	balances := make(map[string]math.Int)
@@ -216,19 +309,96 @@ func lastIndexByte(s string, c byte) int {
 	return -1
 }
 
-// GetDebtPosition returns the debt position between two banks
-func (k Keeper) GetDebtPosition(ctx sdk.Context, bankA, bankB string) (math.Int, math.Int) {
-	// Get credit tokens that bankA holds from bankB (bankB owes bankA)
-	credAFromB := k.GetCreditBalance(ctx, bankA, "cred-"+bankB)
-	
-	// Get credit tokens that bankB holds from bankA (bankA owes bankB)
-	credBFromA := k.GetCreditBalance(ctx, bankB, "cred-"+bankA)
-	
-	return credAFromB, credBFromA
+// GetDebtPosition returns the mutual credit of two banks across every denom
+// each of them issued, grouped by currency. The issuer of a denom comes from
+// its credit token, falling back to the denom itself for credit restored
+// without one.
+func (k Keeper) GetDebtPosition(ctx sdk.Context, bankA, bankB string) types.DebtPosition {
+	byCurrency := make(map[string]*types.CurrencyPosition)
+	position := func(currency string) *types.CurrencyPosition {
+		if _, ok := byCurrency[currency]; !ok {
+			byCurrency[currency] = &types.CurrencyPosition{Currency: currency, AFromB: math.ZeroInt(), BFromA: math.ZeroInt()}
+		}
+		return byCurrency[currency]
+	}
+
+	// Credit bankA holds from bankB (bankB owes bankA)
+	for denom, balance := range k.GetAllCreditBalances(ctx, bankA) {
+		if issuer, currency, ok := k.creditDenomIssuer(ctx, denom); ok && issuer == bankB {
+			position(currency).AFromB = position(currency).AFromB.Add(balance)
+		}
+	}
+
+	// Credit bankB holds from bankA (bankA owes bankB)
+	for denom, balance := range k.GetAllCreditBalances(ctx, bankB) {
+		if issuer, currency, ok := k.creditDenomIssuer(ctx, denom); ok && issuer == bankA {
+			position(currency).BFromA = position(currency).BFromA.Add(balance)
+		}
+	}
+
+	debtPosition := types.DebtPosition{
+		BankA:       bankA,
+		BankB:       bankB,
+		Currencies:  make([]types.CurrencyPosition, 0, len(byCurrency)),
+		TotalAFromB: math.ZeroInt(),
+		TotalBFromA: math.ZeroInt(),
+	}
+	for _, currencyPosition := range byCurrency {
+		debtPosition.Currencies = append(debtPosition.Currencies, *currencyPosition)
+		debtPosition.TotalAFromB = debtPosition.TotalAFromB.Add(currencyPosition.AFromB)
+		debtPosition.TotalBFromA = debtPosition.TotalBFromA.Add(currencyPosition.BFromA)
+	}
+	sort.Slice(debtPosition.Currencies, func(i, j int) bool {
+		return debtPosition.Currencies[i].Currency < debtPosition.Currencies[j].Currency
+	})
+
+	return debtPosition
+}
+
+// creditDenomIssuer returns the issuing bank and currency of a credit denom
+func (k Keeper) creditDenomIssuer(ctx sdk.Context, denom string) (string, string, bool) {
+	issuer, currency, ok := types.ParseCreditDenom(denom)
+	if token, found := k.getCreditToken(ctx, denom); found {
+		if !ok {
+			currency = denom
+		}
+		return token.IssuerBank, currency, true
+	}
+	return issuer, currency, ok
 }
 
 // TriggerNetting triggers the netting process
 func (k Keeper) TriggerNetting(ctx sdk.Context) error {
+	return k.triggerNetting(ctx, "")
+}
+
+// TriggerNettingBy triggers the netting process on behalf of an operator or
+// the authority, enforcing the manual trigger cooldown
+func (k Keeper) TriggerNettingBy(ctx sdk.Context, triggerer string) error {
+	params := k.GetParams(ctx)
+
+	if triggerer != k.authority && !params.IsOperator(triggerer) {
+		return errorsmod.Wrapf(nettingtypes.ErrUnauthorized, "%s is not a netting operator", triggerer)
+	}
+
+	// Manual triggers are rate limited independently of the EndBlock schedule
+	lastTrigger, triggered := k.getLastManualTrigger(ctx)
+	if triggered && ctx.BlockHeight()-lastTrigger < params.ManualTriggerCooldown {
+		return errorsmod.Wrapf(nettingtypes.ErrTriggerCooldown,
+			"last manual trigger at block %d, cooldown %d blocks", lastTrigger, params.ManualTriggerCooldown)
+	}
+
+	if err := k.triggerNetting(ctx, triggerer); err != nil {
+		return err
+	}
+
+	k.setLastManualTrigger(ctx, ctx.BlockHeight())
+	return nil
+}
+
+func (k Keeper) triggerNetting(ctx sdk.Context, triggerer string) error {
+	ctx = types.WithCorrelationID(ctx, types.CycleCorrelationID(uint64(ctx.BlockHeight())))
+
 	// Check if enough blocks have passed since last netting
 	lastNettingBlock := k.getLastNettingBlock(ctx)
 	currentBlock := ctx.BlockHeight()
@@ -237,94 +407,189 @@ func (k Keeper) TriggerNetting(ctx sdk.Context) error {
 		return nettingtypes.ErrNettingNotRequired
 	}
 
-	// Calculate netting pairs
-	pairs, err := k.CalculateNetting(ctx)
-	if err != nil {
+	if err := k.checkNoCycleInProgress(ctx); err != nil {
 		return err
 	}
 
-	if len(pairs) == 0 {
+	// A cycle nets the first netting set in turn that needs netting
+	var pairs []types.BankPair
+	var set nettingtypes.NettingSet
+	for _, candidate := range k.nettingSetsInTurn(ctx) {
+		setCtx := nettingtypes.WithNettingSet(ctx, candidate)
+
+		// Calculate netting pairs; offsets below MinNettingAmount wait for a
+		// larger offset or the end-of-day cycle
+		candidatePairs, err := k.CalculateNetting(setCtx)
+		if err != nil {
+			return err
+		}
+		candidatePairs = nettingtypes.FilterMinNetting(candidatePairs, k.GetParams(ctx).MinNettingAmount)
+
+		// Multilateral netting also runs for loops among banks that do not owe
+		// each other both ways
+		if len(candidatePairs) > 0 || len(k.obligationLoops(setCtx, k.GetParams(ctx))) > 0 {
+			ctx, pairs, set = setCtx, candidatePairs, candidate
+			break
+		}
+	}
+	if set.ID == "" {
 		return nettingtypes.ErrNettingNotRequired
 	}
 
+	// Settle high-priority pairs first when the cycle is capped; the rest
+	// stay outstanding for a later cycle
+	pairs, deferred := nettingtypes.PrioritizePairs(pairs, int(k.GetParams(ctx).MaxNettingPairs))
+	if len(deferred) > 0 {
+		k.Logger(ctx).Info("netting pairs deferred to a later cycle",
+			"pair_count", len(pairs),
+			"deferred_count", len(deferred),
+		)
+	}
+
 	// Execute netting
-	if err := k.ExecuteNetting(ctx, pairs); err != nil {
+	if err := k.executeNetting(ctx, pairs, triggerer, len(deferred), false); err != nil {
 		return err
 	}
 
 	// Update last netting block
 	k.setLastNettingBlock(ctx, currentBlock)
+	k.setLastNettingSet(ctx, set.ID)
 
 	// Emit netting triggered event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			nettingtypes.EventTypeNettingTriggered,
 			sdk.NewAttribute(nettingtypes.AttributeKeyBlockHeight, strconv.FormatInt(currentBlock, 10)),
+			sdk.NewAttribute(nettingtypes.AttributeKeyNettingSet, set.ID),
 			sdk.NewAttribute(nettingtypes.AttributeKeyPairCount, strconv.Itoa(len(pairs))),
+			sdk.NewAttribute(nettingtypes.AttributeKeyDeferredCount, strconv.Itoa(len(deferred))),
+			sdk.NewAttribute(nettingtypes.AttributeKeyTriggeredBy, triggerer),
+			sdk.NewAttribute(nettingtypes.AttributeKeyEndOfDay, "false"),
 		),
 	)
 
 	return nil
 }
 
-// CalculateNetting calculates netting pairs
+// CalculateNetting calculates netting pairs with the strategy selected by the
+// NettingStrategy param
 func (k Keeper) CalculateNetting(ctx sdk.Context) ([]types.BankPair, error) {
-	// Get all banks with credit balances
-	banks := k.getAllBanksWithCredits(ctx)
-	var pairs []types.BankPair
+	strategy, err := k.nettingStrategy(k.GetParams(ctx).NettingStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs, err := strategy.CalculatePairs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.ValidateNettingPairs(ctx, pairs); err != nil {
+		return nil, err
+	}
+	return pairs, nil
+}
+
+// obligationLoops returns the obligation loops multilateral netting compresses
+// in a cycle, each rounded down to whole settlement units, or none when
+// multilateral netting is disabled. Bilateral netting of deferred pairs or
+// of carried dust only leaves more credit on a loop than assumed here.
+func (k Keeper) obligationLoops(ctx sdk.Context, params nettingtypes.Params) []types.ObligationLoop {
+	if !params.MultilateralNetting {
+		return nil
+	}
+
+	var loops []types.ObligationLoop
+	for _, loop := range nettingtypes.CalculateObligationLoops(k.GetObligations(ctx)) {
+		if loop.Amount, _ = nettingtypes.SplitDust(loop.Amount, params.SettlementUnit); loop.Amount.IsPositive() {
+			loops = append(loops, loop)
+		}
+	}
+	return loops
+}
+
+// GetObligations returns the outstanding gross obligations between all banks
+// with credit balances, derived from their cred-{BankID} holdings. Each
+// obligation carries the highest priority issued to it since it last settled.
+// Credit frozen by an open dispute is left out until the dispute is resolved.
+func (k Keeper) GetObligations(ctx sdk.Context) []nettingtypes.Obligation {
+	return k.obligationsAmong(ctx, k.getAllBanksWithCredits(ctx))
+}
+
+// obligationsAmong returns the outstanding gross obligations between the banks
+func (k Keeper) obligationsAmong(ctx sdk.Context, banks []string) []nettingtypes.Obligation {
+	var obligations []nettingtypes.Obligation
 
-	// Calculate netting for each bank pair
 	for i := 0; i < len(banks); i++ {
 		for j := i + 1; j < len(banks); j++ {
 			bankA := banks[i]
 			bankB := banks[j]
 
-			// Get mutual credit positions
-			credAFromB, credBFromA := k.GetDebtPosition(ctx, bankA, bankB)
-
-			// Only create pair if both banks have credits from each other
-			if credAFromB.GT(math.ZeroInt()) && credBFromA.GT(math.ZeroInt()) {
-				var netAmount math.Int
-				var netDebtor string
-
-				if credAFromB.GT(credBFromA) {
-					netAmount = credAFromB.Sub(credBFromA)
-					netDebtor = bankB
-				} else {
-					netAmount = credBFromA.Sub(credAFromB)
-					netDebtor = bankA
-				}
-
-				pair := types.BankPair{
-					BankA:     bankA,
-					BankB:     bankB,
-					AmountA:   credBFromA, // Amount A owes to B
-					AmountB:   credAFromB, // Amount B owes to A
-					NetAmount: netAmount,
-					NetDebtor: netDebtor,
-				}
-
-				pairs = append(pairs, pair)
+			// Get mutual credit positions in the netting set
+			credAFromB := k.nettedBalance(ctx, bankA, bankB, k.GetAvailableCreditBalance)
+			credBFromA := k.nettedBalance(ctx, bankB, bankA, k.GetAvailableCreditBalance)
+
+			if credAFromB.IsPositive() {
+				obligations = append(obligations, nettingtypes.Obligation{
+					Debtor:   bankB,
+					Creditor: bankA,
+					Amount:   credAFromB,
+					Priority: k.getObligationPriority(ctx, bankB, bankA),
+				})
+			}
+			if credBFromA.IsPositive() {
+				obligations = append(obligations, nettingtypes.Obligation{
+					Debtor:   bankA,
+					Creditor: bankB,
+					Amount:   credBFromA,
+					Priority: k.getObligationPriority(ctx, bankA, bankB),
+				})
 			}
 		}
 	}
 
-	return pairs, nil
+	return obligations
 }
 
 // ExecuteNetting executes the netting process
 func (k Keeper) ExecuteNetting(ctx sdk.Context, pairs []types.BankPair) error {
+	return k.executeNetting(ctx, pairs, "", 0, false)
+}
+
+func (k Keeper) executeNetting(ctx sdk.Context, pairs []types.BankPair, triggerer string, deferred int, endOfDay bool) error {
 	cycleID := uint64(ctx.BlockHeight())
+	ctx = types.WithCorrelationID(ctx, types.CycleCorrelationID(cycleID))
+	params := k.GetParams(ctx)
+
+	if err := k.checkNoCycleInProgress(ctx); err != nil {
+		return err
+	}
 
 	// Create netting cycle
 	cycle := types.NettingCycle{
-		CycleID:     cycleID,
-		BlockHeight: ctx.BlockHeight(),
-		Pairs:       pairs,
-		NetAmounts:  make(map[string]math.Int),
-		StartTime:   ctx.BlockTime().Unix(),
-		Status:      int32(types.NettingStatusInProgress),
+		CycleID:        cycleID,
+		BlockHeight:    ctx.BlockHeight(),
+		Pairs:          pairs,
+		NetAmounts:     make(map[string]math.Int),
+		StartTime:      ctx.BlockTime().Unix(),
+		Status:         int32(types.NettingStatusInProgress),
+		TriggeredBy:    triggerer,
+		Deferred:       int32(deferred),
+		SettlementUnit: params.SettlementUnit,
+		DustPolicy:     params.DustPolicy,
+		Dust:           math.ZeroInt(),
+		Loops:          k.obligationLoops(ctx, params),
+		NettingSet:     k.nettingSet(ctx).ID,
+		EndOfDay:       endOfDay,
 	}
+	totalNetted := math.ZeroInt()
+	burnedByPair := make([]math.Int, 0, len(pairs))
+	reference := strconv.FormatUint(cycleID, 10)
+	var burns []creditBurn
+
+	// Store the cycle with its pre-cycle snapshot before burning, so a cycle
+	// that fails part way outside a transaction can be cancelled
+	k.setCycleSnapshot(ctx, k.CreateNettingSnapshot(ctx, pairs))
+	k.setNettingCycle(ctx, cycle)
 
 	// Execute netting for each pair
 	for _, pair := range pairs {
@@ -334,14 +599,34 @@ func (k Keeper) ExecuteNetting(ctx sdk.Context, pairs []types.BankPair) error {
 			minAmount = pair.AmountB
 		}
 
+		// Only whole settlement units are netted; the dust policy decides
+		// whether the residual is burned with them
+		netted, dust := nettingtypes.SplitDust(minAmount, params.SettlementUnit)
+		burned := minAmount
+		if params.DustPolicy == nettingtypes.DustPolicyCarry {
+			burned = netted
+		}
+
 		// Burn credit tokens from both banks
-		if err := k.BurnCreditToken(ctx, "cred-"+pair.BankA, minAmount); err != nil {
-			return fmt.Errorf("failed to burn credit from %s: %w", pair.BankA, err)
+		if burned.IsPositive() {
+			burnedA, err := k.burnNettedCredit(ctx, pair.BankB, pair.BankA, burned)
+			if err != nil {
+				return errorsmod.Wrapf(err, "failed to burn credit from %s", pair.BankA)
+			}
+
+			burnedB, err := k.burnNettedCredit(ctx, pair.BankA, pair.BankB, burned)
+			if err != nil {
+				return errorsmod.Wrapf(err, "failed to burn credit from %s", pair.BankB)
+			}
+			burns = append(burns, withReference(append(burnedA, burnedB...), reference)...)
 		}
 
-		if err := k.BurnCreditToken(ctx, "cred-"+pair.BankB, minAmount); err != nil {
-			return fmt.Errorf("failed to burn credit from %s: %w", pair.BankB, err)
+		if dust.IsPositive() && params.DustPolicy == nettingtypes.DustPolicyAccumulate {
+			k.setDustBalance(ctx, pair.BankA, k.GetDustBalance(ctx, pair.BankA).Add(dust))
+			k.setDustBalance(ctx, pair.BankB, k.GetDustBalance(ctx, pair.BankB).Add(dust))
 		}
+		cycle.Dust = cycle.Dust.Add(dust)
+		totalNetted = totalNetted.Add(netted)
 
 		// Update net amounts (initialize to zero if not present)
 		if _, ok := cycle.NetAmounts[pair.BankA]; !ok {
@@ -350,38 +635,97 @@ func (k Keeper) ExecuteNetting(ctx sdk.Context, pairs []types.BankPair) error {
 		if _, ok := cycle.NetAmounts[pair.BankB]; !ok {
 			cycle.NetAmounts[pair.BankB] = math.ZeroInt()
 		}
-		cycle.NetAmounts[pair.BankA] = cycle.NetAmounts[pair.BankA].Add(minAmount)
-		cycle.NetAmounts[pair.BankB] = cycle.NetAmounts[pair.BankB].Add(minAmount)
+		cycle.NetAmounts[pair.BankA] = cycle.NetAmounts[pair.BankA].Add(netted)
+		cycle.NetAmounts[pair.BankB] = cycle.NetAmounts[pair.BankB].Add(netted)
+
+		// Carried residuals keep their priority for the next cycle
+		if burned.Equal(minAmount) {
+			k.clearSettledPriorities(ctx, pair)
+		}
+		burnedByPair = append(burnedByPair, burned)
+	}
+
+	// Every obligation of a loop shrinks by the loop amount, which leaves the
+	// net positions of its banks unchanged
+	for _, loop := range cycle.Loops {
+		for i, debtor := range loop.Banks {
+			creditor := loop.Banks[(i+1)%len(loop.Banks)]
+			burned, err := k.burnNettedCredit(ctx, creditor, debtor, loop.Amount)
+			if err != nil {
+				return errorsmod.Wrapf(err, "failed to burn loop credit of %s held by %s", debtor, creditor)
+			}
+			burns = append(burns, withReference(burned, reference)...)
+
+			if _, ok := cycle.NetAmounts[debtor]; !ok {
+				cycle.NetAmounts[debtor] = math.ZeroInt()
+			}
+			cycle.NetAmounts[debtor] = cycle.NetAmounts[debtor].Add(loop.Amount)
+		}
+		totalNetted = totalNetted.Add(loop.Amount)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				nettingtypes.EventTypeObligationLoopCompressed,
+				sdk.NewAttribute(nettingtypes.AttributeKeyCycleID, strconv.FormatUint(cycleID, 10)),
+				sdk.NewAttribute(nettingtypes.AttributeKeyBanks, strings.Join(loop.Banks, ",")),
+				sdk.NewAttribute(types.AttributeKeyAmount, loop.Amount.String()),
+			),
+		)
+	}
+
+	// Velocity is recorded once every burn succeeded, so a cycle cancelled
+	// after a partial failure does not count as netted volume
+	for i, pair := range pairs {
+		k.recordVelocity(ctx, pair.BankA, pair.BankB, math.ZeroInt(), burnedByPair[i])
+		k.recordVelocity(ctx, pair.BankB, pair.BankA, math.ZeroInt(), burnedByPair[i])
+	}
+	for _, loop := range cycle.Loops {
+		for i, debtor := range loop.Banks {
+			k.recordVelocity(ctx, debtor, loop.Banks[(i+1)%len(loop.Banks)], math.ZeroInt(), loop.Amount)
+		}
+	}
+
+	settled, err := k.generateSettlementCommands(ctx, cycleID, pairs, params)
+	if err != nil {
+		return err
 	}
 
+	// Netted credit is passed on first, then the settled residuals
+	k.recordBurns(ctx, burns, nettingtypes.LineageKindNetting)
+	k.recordBurns(ctx, settled, nettingtypes.LineageKindSettlement)
+
 	// Mark cycle as completed
 	cycle.EndTime = ctx.BlockTime().Unix()
 	cycle.Status = int32(types.NettingStatusCompleted)
 
 	// Store netting cycle
 	k.setNettingCycle(ctx, cycle)
+	k.deleteCycleSnapshot(ctx, cycleID)
+
+	k.Logger(ctx).Info("netting cycle completed",
+		"cycle_id", cycleID,
+		"netting_set", cycle.NettingSet,
+		"pair_count", len(pairs),
+		"loop_count", len(cycle.Loops),
+		"dust", cycle.Dust.String(),
+		"dust_policy", cycle.DustPolicy,
+	)
 
 	// Log netting completion (Requirement 7.2)
 	if k.oracleKeeper != nil {
-		// Calculate total netted amount
-		totalNetted := math.ZeroInt()
-		for _, pair := range pairs {
-			minAmount := pair.AmountA
-			if pair.AmountB.LT(minAmount) {
-				minAmount = pair.AmountB
-			}
-			totalNetted = totalNetted.Add(minAmount)
-		}
-
 		auditLog := types.AuditLog{
 			EventType: types.EventTypeNettingCompleted,
 			Timestamp: ctx.BlockTime().Unix(),
 			Details: map[string]string{
 				"cycle_id":      strconv.FormatUint(cycleID, 10),
+				"netting_set":   cycle.NettingSet,
 				"pair_count":    strconv.Itoa(len(pairs)),
+				"loop_count":    strconv.Itoa(len(cycle.Loops)),
 				"total_netted":  totalNetted.String(),
 				"start_time":    strconv.FormatInt(cycle.StartTime, 10),
 				"end_time":      strconv.FormatInt(cycle.EndTime, 10),
+				"dust":          cycle.Dust.String(),
+				"dust_policy":   strconv.FormatInt(int64(cycle.DustPolicy), 10),
 			},
 		}
 		if _, err := k.oracleKeeper.SaveAuditLog(ctx, auditLog); err != nil {
@@ -396,7 +740,11 @@ func (k Keeper) ExecuteNetting(ctx sdk.Context, pairs []types.BankPair) error {
 			nettingtypes.EventTypeNettingCompleted,
 			sdk.NewAttribute(nettingtypes.AttributeKeyCycleID, strconv.FormatUint(cycleID, 10)),
 			sdk.NewAttribute(nettingtypes.AttributeKeyBlockHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+			sdk.NewAttribute(nettingtypes.AttributeKeyNettingSet, cycle.NettingSet),
 			sdk.NewAttribute(nettingtypes.AttributeKeyPairCount, strconv.Itoa(len(pairs))),
+			sdk.NewAttribute(nettingtypes.AttributeKeyLoopCount, strconv.Itoa(len(cycle.Loops))),
+			sdk.NewAttribute(nettingtypes.AttributeKeyDust, cycle.Dust.String()),
+			sdk.NewAttribute(nettingtypes.AttributeKeyDustPolicy, strconv.FormatInt(int64(cycle.DustPolicy), 10)),
 		),
 	)
 
@@ -418,6 +766,226 @@ func (k Keeper) GetNettingCycle(ctx sdk.Context, cycleID uint64) (types.NettingC
 	return cycle, true
 }
 
+// =============================================================================
+// Cycle Settlement
+// =============================================================================
+
+// generateSettlementCommands settles the residual each pair leaves owed to a
+// bank with a settlement account: the residual credit is burned and a mint
+// command pays it to the account on the bank's chain. The commands are
+// generated together or not at all, so a failure leaves the cycle in progress
+// without orphaned commands. It returns the settled credit burned.
+func (k Keeper) generateSettlementCommands(ctx sdk.Context, cycleID uint64, pairs []types.BankPair, params nettingtypes.Params) ([]creditBurn, error) {
+	if k.multisigKeeper == nil || len(params.SettlementAccounts) == 0 {
+		return nil, nil
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	settlement := nettingtypes.CycleSettlement{CycleID: cycleID}
+	outstanding := math.ZeroInt()
+	var settled []creditBurn
+
+	for _, pair := range pairs {
+		creditor := pair.BankA
+		if creditor == pair.NetDebtor {
+			creditor = pair.BankB
+		}
+
+		address, found := params.GetSettlementAccount(creditor)
+		if !found {
+			continue
+		}
+
+		// Only whole settlement units are paid out; the rest stays as credit
+		amount, _ := nettingtypes.SplitDust(pair.NetAmount, params.SettlementUnit)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		burned, err := k.burnNettedCredit(cacheCtx, creditor, pair.NetDebtor, amount)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to burn settled credit of %s", pair.NetDebtor)
+		}
+
+		command, err := k.multisigKeeper.GenerateMintCommand(cacheCtx, creditor, address, amount)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to generate settlement command for %s", creditor)
+		}
+		settled = append(settled, withReference(burned, command.CommandID)...)
+
+		settlement.Commands = append(settlement.Commands, nettingtypes.SettlementCommand{
+			CommandID: command.CommandID,
+			Creditor:  creditor,
+			Debtor:    pair.NetDebtor,
+			Amount:    amount,
+			Status:    command.Status,
+		})
+		outstanding = outstanding.Add(amount)
+	}
+
+	if len(settlement.Commands) == 0 {
+		return nil, nil
+	}
+
+	write()
+	k.setCycleSettlement(ctx, settlement)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			nettingtypes.EventTypeSettlementCreated,
+			sdk.NewAttribute(nettingtypes.AttributeKeyCycleID, strconv.FormatUint(cycleID, 10)),
+			sdk.NewAttribute(nettingtypes.AttributeKeyCommandCount, strconv.Itoa(len(settlement.Commands))),
+			sdk.NewAttribute(nettingtypes.AttributeKeyOutstanding, outstanding.String()),
+		),
+	)
+
+	return settled, nil
+}
+
+// GetCycleSettlement returns the settlement commands of a netting cycle
+func (k Keeper) GetCycleSettlement(ctx sdk.Context, cycleID uint64) (nettingtypes.CycleSettlement, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nettingtypes.GetCycleSettlementKey(cycleID))
+	if bz == nil {
+		return nettingtypes.CycleSettlement{}, false
+	}
+
+	var settlement nettingtypes.CycleSettlement
+	k.cdc.MustUnmarshal(bz, &settlement)
+	return settlement, true
+}
+
+// GetOpenSettlements returns the settlements of the cycles that are not fully
+// settled, in cycle order
+func (k Keeper) GetOpenSettlements(ctx sdk.Context) []nettingtypes.CycleSettlement {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, nettingtypes.OpenSettlementKeyPrefix)
+	defer iterator.Close()
+
+	settlements := make([]nettingtypes.CycleSettlement, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		cycleID := binary.BigEndian.Uint64(iterator.Key()[len(nettingtypes.OpenSettlementKeyPrefix):])
+		if settlement, found := k.GetCycleSettlement(ctx, cycleID); found {
+			settlements = append(settlements, settlement)
+		}
+	}
+	return settlements
+}
+
+// UpdateSettlements refreshes the command statuses of the open settlements
+// and marks a cycle fully settled once all its commands were executed. It runs
+// every EndBlock after the block's execution reports.
+func (k Keeper) UpdateSettlements(ctx sdk.Context) {
+	if k.multisigKeeper == nil {
+		return
+	}
+
+	for _, settlement := range k.GetOpenSettlements(ctx) {
+		changed := false
+		for i, command := range settlement.Commands {
+			current, found := k.multisigKeeper.GetCommand(ctx, command.CommandID)
+			if found && current.Status != command.Status {
+				settlement.Commands[i].Status = current.Status
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+
+		if settlement.ExecutedCount() == len(settlement.Commands) {
+			settlement.FullySettled = true
+			settlement.SettledAt = ctx.BlockTime().Unix()
+			settlement.SettledHeight = ctx.BlockHeight()
+
+			k.Logger(ctx).Info("netting cycle fully settled",
+				"cycle_id", settlement.CycleID,
+				"command_count", len(settlement.Commands),
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					nettingtypes.EventTypeCycleSettled,
+					sdk.NewAttribute(nettingtypes.AttributeKeyCycleID, strconv.FormatUint(settlement.CycleID, 10)),
+					sdk.NewAttribute(nettingtypes.AttributeKeyCommandCount, strconv.Itoa(len(settlement.Commands))),
+				),
+			)
+		}
+		k.setCycleSettlement(ctx, settlement)
+	}
+}
+
+// GetDailyReport aggregates the transfers, credit and netting cycles of a
+// calendar day from the audit log's time index. Without an oracle keeper
+// there is no audit log and the report is empty.
+func (k Keeper) GetDailyReport(ctx sdk.Context, day time.Time) nettingtypes.DailyReport {
+	return k.aggregateReport(ctx, nettingtypes.NewDailyReport(day))
+}
+
+// aggregateReport adds the activity between the start and end time of an
+// empty report to it
+func (k Keeper) aggregateReport(ctx sdk.Context, report nettingtypes.DailyReport) nettingtypes.DailyReport {
+	if k.oracleKeeper == nil {
+		return report
+	}
+
+	for _, log := range k.oracleKeeper.GetAuditLogsByTimeRange(ctx, report.StartTime, report.EndTime) {
+		amount, ok := math.NewIntFromString(log.Details["amount"])
+		if !ok {
+			amount = math.ZeroInt()
+		}
+
+		switch log.EventType {
+		case types.EventTypeTransferConfirmed:
+			report.TransfersConfirmed++
+			report.TransferVolume = report.TransferVolume.Add(amount)
+		case types.EventTypeCreditIssued:
+			report.CreditsIssued++
+			report.IssuedAmount = report.IssuedAmount.Add(amount)
+		case types.EventTypeNettingCancelled:
+			report.CyclesCancelled++
+		case types.EventTypeNettingCompleted:
+			cycleID, err := strconv.ParseUint(log.Details["cycle_id"], 10, 64)
+			if err != nil {
+				continue
+			}
+			cycle, found := k.GetNettingCycle(ctx, cycleID)
+			if !found {
+				continue
+			}
+			netted, ok := math.NewIntFromString(log.Details["total_netted"])
+			if !ok {
+				netted = math.ZeroInt()
+			}
+			settlement, _ := k.GetCycleSettlement(ctx, cycleID)
+			report.AddCycle(cycle, settlement, netted)
+		case types.EventTypeMicroCycleCompleted:
+			id, err := strconv.ParseUint(log.Details["micro_cycle_id"], 10, 64)
+			if err != nil {
+				continue
+			}
+			if micro, found := k.GetMicroCycle(ctx, id); found {
+				report.AddMicroCycle(micro)
+			}
+		}
+	}
+
+	return report
+}
+
+// setCycleSettlement stores a settlement and keeps its open marker while it is
+// not fully settled
+func (k Keeper) setCycleSettlement(ctx sdk.Context, settlement nettingtypes.CycleSettlement) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(nettingtypes.GetCycleSettlementKey(settlement.CycleID), k.cdc.MustMarshal(&settlement))
+
+	if settlement.FullySettled {
+		store.Delete(nettingtypes.GetOpenSettlementKey(settlement.CycleID))
+	} else {
+		store.Set(nettingtypes.GetOpenSettlementKey(settlement.CycleID), []byte{0x01})
+	}
+}
+
 // Private helper methods
 
 func (k Keeper) validateCreditToken(token types.CreditToken) error {
@@ -427,12 +995,19 @@ func (k Keeper) validateCreditToken(token types.CreditToken) error {
 	if token.IssuerBank == "" {
 		return nettingtypes.ErrInvalidBankID
 	}
+	// The denom names the issuer, so a bank cannot take another bank's denom
+	if issuer, _, ok := types.ParseCreditDenom(token.Denom); !ok || issuer != token.IssuerBank {
+		return errorsmod.Wrapf(nettingtypes.ErrInvalidCreditToken, "denom %s is not issued by %s", token.Denom, token.IssuerBank)
+	}
 	if token.HolderBank == "" {
 		return nettingtypes.ErrInvalidBankID
 	}
 	if token.Amount.IsNil() || token.Amount.LTE(math.ZeroInt()) {
 		return nettingtypes.ErrInvalidAmount
 	}
+	if !types.IsValidPriority(token.Priority) {
+		return nettingtypes.ErrInvalidPriority
+	}
 	return nil
 }
 
@@ -512,6 +1087,7 @@ func (k Keeper) getAllBanksWithCredits(ctx sdk.Context) []string {
 	for bank := range bankSet {
 		banks = append(banks, bank)
 	}
+	sort.Strings(banks)
 
 	return banks
 }
@@ -560,6 +1136,56 @@ func (k Keeper) setLastNettingBlock(ctx sdk.Context, blockHeight int64) {
 	store.Set(key, bz)
 }
 
+func (k Keeper) getLastManualTrigger(ctx sdk.Context) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nettingtypes.GetLastManualTriggerKey())
+	if len(bz) != 8 {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+func (k Keeper) setLastManualTrigger(ctx sdk.Context, blockHeight int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(blockHeight))
+	store.Set(nettingtypes.GetLastManualTriggerKey(), bz)
+}
+
+// raiseObligationPriority records that debtor owes creditor at least priority
+func (k Keeper) raiseObligationPriority(ctx sdk.Context, debtor, creditor string, priority int32) {
+	if priority <= k.getObligationPriority(ctx, debtor, creditor) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, uint32(priority))
+	store.Set(nettingtypes.GetObligationPriorityKey(debtor, creditor), bz)
+}
+
+func (k Keeper) getObligationPriority(ctx sdk.Context, debtor, creditor string) int32 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nettingtypes.GetObligationPriorityKey(debtor, creditor))
+	if len(bz) != 4 {
+		return types.PriorityNormal
+	}
+	return int32(binary.BigEndian.Uint32(bz))
+}
+
+// clearSettledPriorities drops the priority of either direction of the pair
+// once netting has fully settled it
+func (k Keeper) clearSettledPriorities(ctx sdk.Context, pair types.BankPair) {
+	store := ctx.KVStore(k.storeKey)
+	position := k.GetDebtPosition(ctx, pair.BankA, pair.BankB)
+	if !position.TotalAFromB.IsPositive() {
+		store.Delete(nettingtypes.GetObligationPriorityKey(pair.BankB, pair.BankA))
+	}
+	if !position.TotalBFromA.IsPositive() {
+		store.Delete(nettingtypes.GetObligationPriorityKey(pair.BankA, pair.BankB))
+	}
+}
+
 func (k Keeper) setNettingCycle(ctx sdk.Context, cycle types.NettingCycle) {
 	store := ctx.KVStore(k.storeKey)
 	key := nettingtypes.GetNettingCycleKey(cycle.CycleID)
@@ -568,27 +1194,996 @@ func (k Keeper) setNettingCycle(ctx sdk.Context, cycle types.NettingCycle) {
 }
 
 // =============================================================================
-// Error Handling and Recovery (Task 12.3)
+// Params
 // =============================================================================
 
-// NettingSnapshot stores balances before netting for potential rollback
-type NettingSnapshot struct {
-	CycleID  uint64
-	Balances map[string]map[string]math.Int // bank -> denom -> amount
+// GetParams returns the module parameters, or the defaults if none are stored
+func (k Keeper) GetParams(ctx sdk.Context) nettingtypes.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nettingtypes.ParamsKey)
+	if bz == nil {
+		return nettingtypes.DefaultParams()
+	}
+
+	var params nettingtypes.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
 }
 
-// CreateNettingSnapshot creates a snapshot of current credit balances for rollback
-func (k Keeper) CreateNettingSnapshot(ctx sdk.Context, pairs []types.BankPair) NettingSnapshot {
-	snapshot := NettingSnapshot{
-		CycleID:  uint64(ctx.BlockHeight()),
-		Balances: make(map[string]map[string]math.Int),
+// SetParams stores the module parameters
+func (k Keeper) SetParams(ctx sdk.Context, params nettingtypes.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(nettingtypes.ParamsKey, k.cdc.MustMarshal(&params))
+}
+
+// UpdateParams stores params set by the authority and records the change in
+// the params history
+func (k Keeper) UpdateParams(ctx sdk.Context, authority string, params nettingtypes.Params, proposalID uint64) (nettingtypes.ParamsChange, error) {
+	if err := params.Validate(); err != nil {
+		return nettingtypes.ParamsChange{}, errorsmod.Wrap(nettingtypes.ErrInvalidParams, err.Error())
+	}
+	if _, err := k.nettingStrategy(params.NettingStrategy); err != nil {
+		return nettingtypes.ParamsChange{}, err
 	}
 
-	// Collect all affected banks
-	affectedBanks := make(map[string]bool)
-	for _, pair := range pairs {
-		affectedBanks[pair.BankA] = true
-		affectedBanks[pair.BankB] = true
+	k.SetParams(ctx, params)
+
+	change := nettingtypes.ParamsChange{
+		Version:    k.getLastParamsVersion(ctx) + 1,
+		Height:     ctx.BlockHeight(),
+		Time:       ctx.BlockTime().Unix(),
+		ProposalID: proposalID,
+		Authority:  authority,
+		Params:     params,
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(nettingtypes.GetParamsHistoryKey(change.Version), k.cdc.MustMarshal(&change))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			nettingtypes.EventTypeParamsUpdated,
+			sdk.NewAttribute(types.AttributeKeyVersion, strconv.FormatUint(change.Version, 10)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposalID, 10)),
+		),
+	)
+
+	return change, nil
+}
+
+// GetParamsHistory returns the recorded params changes, oldest first
+func (k Keeper) GetParamsHistory(ctx sdk.Context) []nettingtypes.ParamsChange {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, nettingtypes.ParamsHistoryKeyPrefix)
+	defer iterator.Close()
+
+	changes := make([]nettingtypes.ParamsChange, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var change nettingtypes.ParamsChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+func (k Keeper) getLastParamsVersion(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, nettingtypes.ParamsHistoryKeyPrefix)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0
+	}
+	return binary.BigEndian.Uint64(iterator.Key()[len(nettingtypes.ParamsHistoryKeyPrefix):])
+}
+
+// =============================================================================
+// Credit Freezes
+// =============================================================================
+
+// GetFrozenCredit returns the credit of a denom a bank holds that is frozen
+func (k Keeper) GetFrozenCredit(ctx sdk.Context, bank, denom string) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nettingtypes.GetFrozenCreditKey(bank, denom))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	var frozen math.Int
+	if err := frozen.Unmarshal(bz); err != nil {
+		return math.ZeroInt()
+	}
+	return frozen
+}
+
+// GetAvailableCreditBalance returns the credit balance that is not frozen
+func (k Keeper) GetAvailableCreditBalance(ctx sdk.Context, bank, denom string) math.Int {
+	available := k.GetCreditBalance(ctx, bank, denom).Sub(k.GetFrozenCredit(ctx, bank, denom))
+	if available.IsNegative() {
+		return math.ZeroInt()
+	}
+	return available
+}
+
+// FreezeCredit freezes up to amount of a bank's unfrozen credit so that netting,
+// burns and transfers cannot consume it. It returns the amount actually frozen,
+// which is less than requested when part of the credit was already netted.
+func (k Keeper) FreezeCredit(ctx sdk.Context, bank, denom string, amount math.Int) (math.Int, error) {
+	if amount.IsNil() || !amount.IsPositive() {
+		return math.ZeroInt(), nettingtypes.ErrInvalidAmount
+	}
+
+	frozen := math.MinInt(amount, k.GetAvailableCreditBalance(ctx, bank, denom))
+	if !frozen.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+
+	k.setFrozenCredit(ctx, bank, denom, k.GetFrozenCredit(ctx, bank, denom).Add(frozen))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			nettingtypes.EventTypeCreditFrozen,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, frozen.String()),
+			sdk.NewAttribute(types.AttributeKeyHolderBank, bank),
+		),
+	)
+
+	return frozen, nil
+}
+
+// UnfreezeCredit releases previously frozen credit
+func (k Keeper) UnfreezeCredit(ctx sdk.Context, bank, denom string, amount math.Int) error {
+	if amount.IsNil() || !amount.IsPositive() {
+		return nettingtypes.ErrInvalidAmount
+	}
+
+	frozen := k.GetFrozenCredit(ctx, bank, denom)
+	if frozen.LT(amount) {
+		return errorsmod.Wrapf(nettingtypes.ErrInsufficientBalance, "only %s %s frozen for %s", frozen, denom, bank)
+	}
+
+	k.setFrozenCredit(ctx, bank, denom, frozen.Sub(amount))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			nettingtypes.EventTypeCreditUnfrozen,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyHolderBank, bank),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) setFrozenCredit(ctx sdk.Context, bank, denom string, amount math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	key := nettingtypes.GetFrozenCreditKey(bank, denom)
+	if amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	bz, _ := amount.Marshal()
+	store.Set(key, bz)
+}
+
+// =============================================================================
+// Bank Accounts
+// =============================================================================
+
+// GetBankForAddress returns the bank an address is authorized to act for
+func (k Keeper) GetBankForAddress(ctx sdk.Context, address string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nettingtypes.GetBankAccountKey(address))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// GetAllBankAccounts returns all registered bank accounts ordered by address
+func (k Keeper) GetAllBankAccounts(ctx sdk.Context) []nettingtypes.BankAccount {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, nettingtypes.BankAccountKeyPrefix)
+	defer iterator.Close()
+
+	var accounts []nettingtypes.BankAccount
+	for ; iterator.Valid(); iterator.Next() {
+		accounts = append(accounts, nettingtypes.BankAccount{
+			Address: string(iterator.Key()[len(nettingtypes.BankAccountKeyPrefix):]),
+			BankID:  string(iterator.Value()),
+		})
+	}
+	return accounts
+}
+
+// GetBanks returns every bank known to the module, ordered by ID: the banks in
+// the bank account registry and, for deployments without one, the banks
+// found in the credit balance index as holders or issuers of outstanding
+// credit
+func (k Keeper) GetBanks(ctx sdk.Context) []nettingtypes.BankSummary {
+	summaries := make(map[string]*nettingtypes.BankSummary)
+	summary := func(bank string) *nettingtypes.BankSummary {
+		if _, ok := summaries[bank]; !ok {
+			summaries[bank] = &nettingtypes.BankSummary{BankID: bank}
+		}
+		return summaries[bank]
+	}
+
+	for _, account := range k.GetAllBankAccounts(ctx) {
+		summary(account.BankID).Accounts++
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, nettingtypes.CreditBalanceKeyPrefix)
+	defer iterator.Close()
+
+	prefixLen := len(nettingtypes.CreditBalanceKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var balance math.Int
+		if err := balance.Unmarshal(iterator.Value()); err != nil || !balance.IsPositive() {
+			continue
+		}
+
+		// Key format: prefix + bank + "/" + denom
+		withoutPrefix := string(iterator.Key()[prefixLen:])
+		idx := indexByte(withoutPrefix, '/')
+		if idx <= 0 {
+			continue
+		}
+		summary(withoutPrefix[:idx]).HeldCredits++
+		if issuer, _, ok := types.ParseCreditDenom(withoutPrefix[idx+1:]); ok {
+			summary(issuer).IssuedCredits++
+		}
+	}
+
+	banks := make([]nettingtypes.BankSummary, 0, len(summaries))
+	for _, bank := range summaries {
+		banks = append(banks, *bank)
+	}
+	sort.Slice(banks, func(i, j int) bool { return banks[i].BankID < banks[j].BankID })
+	return banks
+}
+
+// SetBankAccount authorizes an address to act for a bank, replacing any bank
+// the address was mapped to before
+func (k Keeper) SetBankAccount(ctx sdk.Context, account nettingtypes.BankAccount) error {
+	if err := account.Validate(); err != nil {
+		return errorsmod.Wrap(nettingtypes.ErrInvalidBankID, err.Error())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(nettingtypes.GetBankAccountKey(account.Address), []byte(account.BankID))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			nettingtypes.EventTypeBankAccountSet,
+			sdk.NewAttribute(nettingtypes.AttributeKeyAddress, account.Address),
+			sdk.NewAttribute(nettingtypes.AttributeKeyBankID, account.BankID),
+		),
+	)
+
+	return nil
+}
+
+// RemoveBankAccount revokes an address's authorization to act for its bank
+func (k Keeper) RemoveBankAccount(ctx sdk.Context, address string) error {
+	bankID, found := k.GetBankForAddress(ctx, address)
+	if !found {
+		return errorsmod.Wrapf(nettingtypes.ErrBankAccountNotFound, "address %s", address)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(nettingtypes.GetBankAccountKey(address))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			nettingtypes.EventTypeBankAccountRemove,
+			sdk.NewAttribute(nettingtypes.AttributeKeyAddress, address),
+			sdk.NewAttribute(nettingtypes.AttributeKeyBankID, bankID),
+		),
+	)
+
+	return nil
+}
+
+// AuthorizeBankSigner checks that signer may act for bankID. The authority
+// may act for any bank; any other signer must be registered for exactly that
+// bank.
+func (k Keeper) AuthorizeBankSigner(ctx sdk.Context, signer, bankID string) error {
+	if signer == k.authority {
+		return nil
+	}
+
+	signerBank, found := k.GetBankForAddress(ctx, signer)
+	if !found {
+		return errorsmod.Wrapf(nettingtypes.ErrUnauthorized, "%s is not registered for any bank", signer)
+	}
+	if signerBank != bankID {
+		return errorsmod.Wrapf(nettingtypes.ErrUnauthorized, "%s acts for %s, not %s", signer, signerBank, bankID)
+	}
+
+	return nil
+}
+
+// =============================================================================
+// Dust Accounts
+// =============================================================================
+
+// GetDustBalance returns the residual accumulated in a bank's dust account
+func (k Keeper) GetDustBalance(ctx sdk.Context, bank string) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nettingtypes.GetDustBalanceKey(bank))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	var dust math.Int
+	if err := dust.Unmarshal(bz); err != nil {
+		return math.ZeroInt()
+	}
+	return dust
+}
+
+// GetAllDustBalances returns every non-empty dust account ordered by bank
+func (k Keeper) GetAllDustBalances(ctx sdk.Context) []nettingtypes.DustBalance {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, nettingtypes.DustBalanceKeyPrefix)
+	defer iterator.Close()
+
+	var balances []nettingtypes.DustBalance
+	for ; iterator.Valid(); iterator.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			continue
+		}
+		balances = append(balances, nettingtypes.DustBalance{
+			Bank:   string(iterator.Key()[len(nettingtypes.DustBalanceKeyPrefix):]),
+			Amount: amount,
+		})
+	}
+	return balances
+}
+
+// SetDustBalance restores a dust account from genesis
+func (k Keeper) SetDustBalance(ctx sdk.Context, balance nettingtypes.DustBalance) {
+	k.setDustBalance(ctx, balance.Bank, balance.Amount)
+}
+
+func (k Keeper) setDustBalance(ctx sdk.Context, bank string, amount math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	key := nettingtypes.GetDustBalanceKey(bank)
+	if amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	bz, _ := amount.Marshal()
+	store.Set(key, bz)
+}
+
+// =============================================================================
+// Credit Velocity
+// =============================================================================
+
+// GetCreditVelocity returns the credit issuer extended to holder and the
+// credit netted between them over the last 24 hours and 7 days, at hourly
+// bucket granularity
+func (k Keeper) GetCreditVelocity(ctx sdk.Context, issuer, holder string) nettingtypes.CreditVelocity {
+	velocity := nettingtypes.CreditVelocity{
+		IssuerBank: issuer,
+		HolderBank: holder,
+		Issued24h:  math.ZeroInt(),
+		Netted24h:  math.ZeroInt(),
+		Issued7d:   math.ZeroInt(),
+		Netted7d:   math.ZeroInt(),
+	}
+	hour := ctx.BlockTime().Unix() / nettingtypes.VelocityBucketSeconds
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, nettingtypes.GetVelocityPairPrefix(issuer, holder))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var bucket nettingtypes.VelocityBucket
+		k.cdc.MustUnmarshal(iterator.Value(), &bucket)
+
+		// Slots not written for a week hold buckets older than every window
+		age := hour - bucket.Hour
+		if age < 0 || age >= nettingtypes.VelocityBucketCount {
+			continue
+		}
+
+		velocity.Issued7d = velocity.Issued7d.Add(bucket.Issued)
+		velocity.Netted7d = velocity.Netted7d.Add(bucket.Netted)
+		if age < nettingtypes.VelocityDayBuckets {
+			velocity.Issued24h = velocity.Issued24h.Add(bucket.Issued)
+			velocity.Netted24h = velocity.Netted24h.Add(bucket.Netted)
+		}
+	}
+
+	return velocity
+}
+
+// recordVelocity adds issued and netted volume to the current hour's bucket
+// of the issuer -> holder ring, reusing the slot of the same hour a week ago
+func (k Keeper) recordVelocity(ctx sdk.Context, issuer, holder string, issued, netted math.Int) {
+	if !issued.IsPositive() && !netted.IsPositive() {
+		return
+	}
+
+	hour := ctx.BlockTime().Unix() / nettingtypes.VelocityBucketSeconds
+	slot := hour % nettingtypes.VelocityBucketCount
+	if slot < 0 {
+		slot += nettingtypes.VelocityBucketCount
+	}
+	key := nettingtypes.GetVelocityBucketKey(issuer, holder, uint32(slot))
+
+	store := ctx.KVStore(k.storeKey)
+	bucket := nettingtypes.VelocityBucket{Hour: hour, Issued: math.ZeroInt(), Netted: math.ZeroInt()}
+	if bz := store.Get(key); bz != nil {
+		var stored nettingtypes.VelocityBucket
+		k.cdc.MustUnmarshal(bz, &stored)
+		if stored.Hour == hour {
+			bucket = stored
+		}
+	}
+
+	bucket.Issued = bucket.Issued.Add(issued)
+	bucket.Netted = bucket.Netted.Add(netted)
+	store.Set(key, k.cdc.MustMarshal(&bucket))
+}
+
+// =============================================================================
+// Credit Lineage
+// =============================================================================
+
+// Credit lineage links every balance to the Besu transfers it was issued for.
+// Credit is held in lots, and a holder's lots of a denom are passed on oldest
+// first, so the unspent lots add up to the holder's balance. Credit held
+// before lineage was tracked has no lots, so nodes passing it on have fewer
+// parents than their amount.
+
+// GetCreditLineage returns the provenance graph of a lineage node: the node,
+// the nodes it descends from back to the Besu transfers, and the nodes
+// descending from it down to the burns
+func (k Keeper) GetCreditLineage(ctx sdk.Context, id uint64) (nettingtypes.CreditLineage, bool) {
+	if _, found := k.getLineageNode(ctx, id); !found {
+		return nettingtypes.CreditLineage{}, false
+	}
+
+	seen := map[uint64]bool{id: true}
+	edges := make(map[[2]uint64]nettingtypes.CreditLineageEdge)
+	k.walkLineage(ctx, id, true, seen, edges)
+	k.walkLineage(ctx, id, false, seen, edges)
+
+	lineage := nettingtypes.CreditLineage{Root: id}
+	for nodeID := range seen {
+		node, _ := k.getLineageNode(ctx, nodeID)
+		lineage.Nodes = append(lineage.Nodes, node)
+	}
+	for _, edge := range edges {
+		lineage.Edges = append(lineage.Edges, edge)
+	}
+	sort.Slice(lineage.Nodes, func(i, j int) bool { return lineage.Nodes[i].ID < lineage.Nodes[j].ID })
+	sort.Slice(lineage.Edges, func(i, j int) bool {
+		if lineage.Edges[i].From != lineage.Edges[j].From {
+			return lineage.Edges[i].From < lineage.Edges[j].From
+		}
+		return lineage.Edges[i].To < lineage.Edges[j].To
+	})
+
+	return lineage, true
+}
+
+// GetCreditLots returns the unspent credit lots of a holder and denom, oldest
+// first
+func (k Keeper) GetCreditLots(ctx sdk.Context, holder, denom string) []nettingtypes.CreditLineageNode {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, nettingtypes.GetCreditLotPrefix(holder, denom))
+	defer iterator.Close()
+
+	var lots []nettingtypes.CreditLineageNode
+	for ; iterator.Valid(); iterator.Next() {
+		if lot, found := k.getLineageNode(ctx, binary.BigEndian.Uint64(iterator.Value())); found {
+			lots = append(lots, lot)
+		}
+	}
+	return lots
+}
+
+// walkLineage adds the nodes and edges reachable from id, towards the parents
+// if up and towards the children otherwise
+func (k Keeper) walkLineage(ctx sdk.Context, id uint64, up bool, seen map[uint64]bool, edges map[[2]uint64]nettingtypes.CreditLineageEdge) {
+	queue := []uint64{id}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, edge := range k.getLineageEdges(ctx, current, up) {
+			edges[[2]uint64{edge.From, edge.To}] = edge
+			next := edge.To
+			if up {
+				next = edge.From
+			}
+			if !seen[next] {
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+}
+
+// getLineageEdges returns the edges into a node if up and the edges from it
+// otherwise
+func (k Keeper) getLineageEdges(ctx sdk.Context, id uint64, up bool) []nettingtypes.CreditLineageEdge {
+	store := ctx.KVStore(k.storeKey)
+	prefix := nettingtypes.GetLineageEdgePrefix(id)
+	if up {
+		prefix = nettingtypes.GetLineageParentPrefix(id)
+	}
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var edges []nettingtypes.CreditLineageEdge
+	for ; iterator.Valid(); iterator.Next() {
+		bz := iterator.Value()
+		if up {
+			// Key format: prefix + child + parent
+			from := binary.BigEndian.Uint64(iterator.Key()[len(prefix):])
+			bz = store.Get(nettingtypes.GetLineageEdgeKey(from, id))
+		}
+
+		var edge nettingtypes.CreditLineageEdge
+		k.cdc.MustUnmarshal(bz, &edge)
+		edges = append(edges, edge)
+	}
+	return edges
+}
+
+// recordIssuance records the Besu transfer of issued credit and the lot the
+// holder received for it, returning the lot
+func (k Keeper) recordIssuance(ctx sdk.Context, token types.CreditToken) nettingtypes.CreditLineageNode {
+	transfer := k.addLineageNode(ctx, nettingtypes.CreditLineageNode{
+		Kind:      nettingtypes.LineageKindTransfer,
+		Denom:     token.Denom,
+		Bank:      token.IssuerBank,
+		Amount:    token.Amount,
+		Reference: token.OriginTx,
+	})
+	lot := k.addLineageNode(ctx, nettingtypes.CreditLineageNode{
+		Kind:   nettingtypes.LineageKindCredit,
+		Denom:  token.Denom,
+		Bank:   token.HolderBank,
+		Amount: token.Amount,
+	})
+	k.addLineageEdge(ctx, transfer.ID, lot.ID, token.Amount)
+	k.setCreditLot(ctx, lot, lot.ID)
+	return lot
+}
+
+// recordBurn records credit of a denom burned from its holder
+func (k Keeper) recordBurn(ctx sdk.Context, denom string, amount math.Int, kind, reference string) {
+	token, found := k.getCreditToken(ctx, denom)
+	if !found {
+		return
+	}
+	k.passOnCredit(ctx, token.HolderBank, nettingtypes.CreditLineageNode{
+		Kind:      kind,
+		Denom:     denom,
+		Bank:      token.HolderBank,
+		Amount:    amount,
+		Reference: reference,
+	})
+}
+
+// passOnCredit stores node and passes it node.Amount of the holder's lots of
+// node.Denom, oldest first. A lot passed on in part leaves a split lot with the
+// rest in its place. A node that is a lot itself joins the lots of node.Bank.
+func (k Keeper) passOnCredit(ctx sdk.Context, holder string, node nettingtypes.CreditLineageNode) nettingtypes.CreditLineageNode {
+	node = k.addLineageNode(ctx, node)
+
+	// Collect the lots first, the store is not written while iterating
+	type spentLot struct {
+		position uint64
+		lot      nettingtypes.CreditLineageNode
+	}
+	var spent []spentLot
+	covered := math.ZeroInt()
+
+	store := ctx.KVStore(k.storeKey)
+	prefix := nettingtypes.GetCreditLotPrefix(holder, node.Denom)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	for ; iterator.Valid() && covered.LT(node.Amount); iterator.Next() {
+		lot, found := k.getLineageNode(ctx, binary.BigEndian.Uint64(iterator.Value()))
+		if !found {
+			continue
+		}
+		spent = append(spent, spentLot{binary.BigEndian.Uint64(iterator.Key()[len(prefix):]), lot})
+		covered = covered.Add(lot.Amount)
+	}
+	iterator.Close()
+
+	remaining := node.Amount
+	for _, s := range spent {
+		taken := math.MinInt(s.lot.Amount, remaining)
+		remaining = remaining.Sub(taken)
+
+		s.lot.Spent = true
+		k.setLineageNode(ctx, s.lot)
+		store.Delete(nettingtypes.GetCreditLotKey(holder, node.Denom, s.position))
+		k.addLineageEdge(ctx, s.lot.ID, node.ID, taken)
+
+		if rest := s.lot.Amount.Sub(taken); rest.IsPositive() {
+			split := k.addLineageNode(ctx, nettingtypes.CreditLineageNode{
+				Kind:   nettingtypes.LineageKindSplit,
+				Denom:  node.Denom,
+				Bank:   holder,
+				Amount: rest,
+			})
+			k.addLineageEdge(ctx, s.lot.ID, split.ID, rest)
+			k.setCreditLot(ctx, split, s.position)
+		}
+	}
+
+	if nettingtypes.IsCreditLot(node.Kind) {
+		k.setCreditLot(ctx, node, node.ID)
+	}
+	return node
+}
+
+// addLineageNode stores a new lineage node with the next ID at the current
+// block
+func (k Keeper) addLineageNode(ctx sdk.Context, node nettingtypes.CreditLineageNode) nettingtypes.CreditLineageNode {
+	store := ctx.KVStore(k.storeKey)
+	var last uint64
+	if bz := store.Get(nettingtypes.LastLineageNodeKey); len(bz) == 8 {
+		last = binary.BigEndian.Uint64(bz)
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, last+1)
+	store.Set(nettingtypes.LastLineageNodeKey, bz)
+
+	node.ID = last + 1
+	node.Height = ctx.BlockHeight()
+	node.Time = ctx.BlockTime().Unix()
+	k.setLineageNode(ctx, node)
+	return node
+}
+
+func (k Keeper) setLineageNode(ctx sdk.Context, node nettingtypes.CreditLineageNode) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(nettingtypes.GetLineageNodeKey(node.ID), k.cdc.MustMarshal(&node))
+}
+
+func (k Keeper) getLineageNode(ctx sdk.Context, id uint64) (nettingtypes.CreditLineageNode, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nettingtypes.GetLineageNodeKey(id))
+	if bz == nil {
+		return nettingtypes.CreditLineageNode{}, false
+	}
+
+	var node nettingtypes.CreditLineageNode
+	k.cdc.MustUnmarshal(bz, &node)
+	return node, true
+}
+
+func (k Keeper) addLineageEdge(ctx sdk.Context, from, to uint64, amount math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	edge := nettingtypes.CreditLineageEdge{From: from, To: to, Amount: amount}
+	store.Set(nettingtypes.GetLineageEdgeKey(from, to), k.cdc.MustMarshal(&edge))
+	store.Set(nettingtypes.GetLineageParentKey(to, from), []byte{0x01})
+}
+
+// setCreditLot puts a lot at a position in its holder's lots
+func (k Keeper) setCreditLot(ctx sdk.Context, lot nettingtypes.CreditLineageNode, position uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, lot.ID)
+	store.Set(nettingtypes.GetCreditLotKey(lot.Bank, lot.Denom, position), bz)
+}
+
+// =============================================================================
+// Denom Migration
+// =============================================================================
+
+// ScheduleDenomMigration stores a denom migration to apply at the start of
+// its height, replacing any migration scheduled before. The migration is dry
+// run against the current state first, and its report is returned.
+func (k Keeper) ScheduleDenomMigration(ctx sdk.Context, migration nettingtypes.DenomMigration) (nettingtypes.DenomMigrationReport, error) {
+	if err := migration.Validate(); err != nil {
+		return nettingtypes.DenomMigrationReport{}, errorsmod.Wrap(nettingtypes.ErrInvalidDenomMigration, err.Error())
+	}
+	if migration.Height <= ctx.BlockHeight() {
+		return nettingtypes.DenomMigrationReport{}, errorsmod.Wrapf(nettingtypes.ErrInvalidDenomMigration, "height %d is not after the current height %d", migration.Height, ctx.BlockHeight())
+	}
+
+	report, err := k.DryRunDenomMigration(ctx, migration)
+	if err != nil {
+		return nettingtypes.DenomMigrationReport{}, err
+	}
+
+	k.SetPendingDenomMigration(ctx, migration)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			nettingtypes.EventTypeDenomMigrationScheduled,
+			sdk.NewAttribute(nettingtypes.AttributeKeyBlockHeight, strconv.FormatInt(migration.Height, 10)),
+			sdk.NewAttribute(nettingtypes.AttributeKeyRenameCount, strconv.Itoa(len(migration.Renames))),
+		),
+	)
+
+	return report, nil
+}
+
+// DryRunDenomMigration returns what a denom migration would change if it
+// were applied now, without changing state
+func (k Keeper) DryRunDenomMigration(ctx sdk.Context, migration nettingtypes.DenomMigration) (nettingtypes.DenomMigrationReport, error) {
+	if err := migration.Validate(); err != nil {
+		return nettingtypes.DenomMigrationReport{}, errorsmod.Wrap(nettingtypes.ErrInvalidDenomMigration, err.Error())
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	report, err := k.migrateDenoms(cacheCtx, migration)
+	report.DryRun = true
+	return report, err
+}
+
+// ApplyDenomMigration applies the pending denom migration once its height is
+// reached. A migration that fails leaves the state unchanged and is dropped.
+func (k Keeper) ApplyDenomMigration(ctx sdk.Context) {
+	migration, found := k.GetPendingDenomMigration(ctx)
+	if !found || migration.Height > ctx.BlockHeight() {
+		return
+	}
+	ctx.KVStore(k.storeKey).Delete(nettingtypes.PendingDenomMigrationKey)
+
+	cacheCtx, write := ctx.CacheContext()
+	report, err := k.migrateDenoms(cacheCtx, migration)
+	if err != nil {
+		k.Logger(ctx).Error("denom migration failed", "height", migration.Height, "error", err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				nettingtypes.EventTypeDenomMigrationFailed,
+				sdk.NewAttribute(nettingtypes.AttributeKeyBlockHeight, strconv.FormatInt(migration.Height, 10)),
+				sdk.NewAttribute(types.AttributeKeyReasonCode, nettingtypes.FailureReason(err).String()),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			),
+		)
+		return
+	}
+	write()
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(nettingtypes.LastDenomMigrationKey, k.cdc.MustMarshal(&report))
+
+	k.Logger(ctx).Info("denoms migrated", "height", migration.Height, "renames", len(report.Renames))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			nettingtypes.EventTypeDenomsMigrated,
+			sdk.NewAttribute(nettingtypes.AttributeKeyBlockHeight, strconv.FormatInt(migration.Height, 10)),
+			sdk.NewAttribute(nettingtypes.AttributeKeyRenameCount, strconv.Itoa(len(report.Renames))),
+		),
+	)
+}
+
+// GetPendingDenomMigration returns the denom migration scheduled by governance
+func (k Keeper) GetPendingDenomMigration(ctx sdk.Context) (nettingtypes.DenomMigration, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nettingtypes.PendingDenomMigrationKey)
+	if bz == nil {
+		return nettingtypes.DenomMigration{}, false
+	}
+
+	var migration nettingtypes.DenomMigration
+	k.cdc.MustUnmarshal(bz, &migration)
+	return migration, true
+}
+
+// GetLastDenomMigration returns the report of the last applied denom migration
+func (k Keeper) GetLastDenomMigration(ctx sdk.Context) (nettingtypes.DenomMigrationReport, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nettingtypes.LastDenomMigrationKey)
+	if bz == nil {
+		return nettingtypes.DenomMigrationReport{}, false
+	}
+
+	var report nettingtypes.DenomMigrationReport
+	k.cdc.MustUnmarshal(bz, &report)
+	return report, true
+}
+
+// SetPendingDenomMigration stores a denom migration to apply at its height
+func (k Keeper) SetPendingDenomMigration(ctx sdk.Context, migration nettingtypes.DenomMigration) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(nettingtypes.PendingDenomMigrationKey, k.cdc.MustMarshal(&migration))
+}
+
+// migrateDenoms renames the credit denoms of a migration in the credit
+// tokens, balances, credit lots and cycle snapshots. Credit frozen by an open
+// dispute cannot be renamed, since the dispute refers to its denom.
+func (k Keeper) migrateDenoms(ctx sdk.Context, migration nettingtypes.DenomMigration) (nettingtypes.DenomMigrationReport, error) {
+	report := nettingtypes.DenomMigrationReport{Height: migration.Height}
+	renames := make(map[string]string, len(migration.Renames))
+	index := make(map[string]int, len(migration.Renames))
+	for i, rename := range migration.Renames {
+		if !k.creditTokenExists(ctx, rename.From) {
+			return report, errorsmod.Wrapf(nettingtypes.ErrCreditTokenNotFound, "denom %s", rename.From)
+		}
+		if k.creditTokenExists(ctx, rename.To) {
+			return report, errorsmod.Wrapf(nettingtypes.ErrDuplicateCreditToken, "denom %s", rename.To)
+		}
+		renames[rename.From] = rename.To
+		index[rename.From] = i
+		report.Renames = append(report.Renames, nettingtypes.DenomRenameReport{From: rename.From, To: rename.To, Amount: math.ZeroInt()})
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if frozen := k.collectDenomKeys(ctx, nettingtypes.FrozenCreditKeyPrefix, renames); len(frozen) > 0 {
+		return report, errorsmod.Wrapf(nettingtypes.ErrInvalidDenomMigration, "credit of %s held by %s is frozen by an open dispute", frozen[0].denom, frozen[0].bank)
+	}
+
+	for _, rename := range migration.Renames {
+		token, _ := k.getCreditToken(ctx, rename.From)
+		token.Denom = rename.To
+		store.Delete(nettingtypes.GetCreditTokenKey(rename.From))
+		k.setCreditToken(ctx, token)
+	}
+
+	for _, entry := range k.collectDenomKeys(ctx, nettingtypes.CreditBalanceKeyPrefix, renames) {
+		var balance math.Int
+		if err := balance.Unmarshal(store.Get(entry.key)); err != nil {
+			continue
+		}
+		store.Delete(entry.key)
+		k.addCreditBalance(ctx, entry.bank, renames[entry.denom], balance)
+
+		renamed := &report.Renames[index[entry.denom]]
+		renamed.Balances++
+		renamed.Amount = renamed.Amount.Add(balance)
+	}
+
+	// Lots keep their position, so they are still passed on oldest first
+	for _, entry := range k.collectDenomKeys(ctx, nettingtypes.CreditLotKeyPrefix, renames) {
+		lot, found := k.getLineageNode(ctx, binary.BigEndian.Uint64(store.Get(entry.key)))
+		store.Delete(entry.key)
+		if !found {
+			continue
+		}
+		lot.Denom = renames[entry.denom]
+		k.setLineageNode(ctx, lot)
+		k.setCreditLot(ctx, lot, binary.BigEndian.Uint64(entry.key[len(entry.key)-8:]))
+		report.Renames[index[entry.denom]].Lots++
+	}
+
+	iterator := storetypes.KVStorePrefixIterator(store, nettingtypes.CycleSnapshotKeyPrefix)
+	var snapshots []nettingtypes.CycleSnapshot
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot nettingtypes.CycleSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		snapshots = append(snapshots, snapshot)
+	}
+	iterator.Close()
+	for _, snapshot := range snapshots {
+		changed := false
+		for i, balance := range snapshot.Balances {
+			if to, ok := renames[balance.Denom]; ok {
+				report.Renames[index[balance.Denom]].Snapshots++
+				snapshot.Balances[i].Denom = to
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		sort.Slice(snapshot.Balances, func(i, j int) bool {
+			if snapshot.Balances[i].Bank != snapshot.Balances[j].Bank {
+				return snapshot.Balances[i].Bank < snapshot.Balances[j].Bank
+			}
+			return snapshot.Balances[i].Denom < snapshot.Balances[j].Denom
+		})
+		store.Set(nettingtypes.GetCycleSnapshotKey(snapshot.CycleID), k.cdc.MustMarshal(&snapshot))
+	}
+
+	return report, nil
+}
+
+// denomKey is a store key of a bank and denom, optionally followed by a
+// suffix
+type denomKey struct {
+	key   []byte
+	bank  string
+	denom string
+}
+
+// collectDenomKeys returns the keys under prefix, laid out as bank + "/" +
+// denom with an optional "/" + suffix, whose denom is renamed
+func (k Keeper) collectDenomKeys(ctx sdk.Context, prefix []byte, renames map[string]string) []denomKey {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var keys []denomKey
+	for ; iterator.Valid(); iterator.Next() {
+		withoutPrefix := string(iterator.Key()[len(prefix):])
+		idx := indexByte(withoutPrefix, '/')
+		if idx == -1 {
+			continue
+		}
+		denom := withoutPrefix[idx+1:]
+		if end := indexByte(denom, '/'); end != -1 {
+			denom = denom[:end]
+		}
+		if _, ok := renames[denom]; ok {
+			keys = append(keys, denomKey{key: iterator.Key(), bank: withoutPrefix[:idx], denom: denom})
+		}
+	}
+	return keys
+}
+
+// =============================================================================
+// Genesis
+// =============================================================================
+
+// InitCreditToken restores a credit token position from genesis.
+// Unlike IssueCreditToken it allows several holders of the same denom,
+// since a bank's credit is usually held by more than one counterparty.
+func (k Keeper) InitCreditToken(ctx sdk.Context, token types.CreditToken) error {
+	if err := k.validateCreditToken(token); err != nil {
+		return err
+	}
+
+	if !k.creditTokenExists(ctx, token.Denom) {
+		k.setCreditToken(ctx, token)
+	}
+	k.addCreditBalance(ctx, token.HolderBank, token.Denom, token.Amount)
+	k.raiseObligationPriority(ctx, token.IssuerBank, token.HolderBank, token.Priority)
+	k.recordIssuance(ctx, token)
+
+	return nil
+}
+
+// SetNettingCycle stores a netting cycle
+func (k Keeper) SetNettingCycle(ctx sdk.Context, cycle types.NettingCycle) {
+	k.setNettingCycle(ctx, cycle)
+}
+
+// SetLastNettingBlock sets the block height of the last netting
+func (k Keeper) SetLastNettingBlock(ctx sdk.Context, blockHeight int64) {
+	k.setLastNettingBlock(ctx, blockHeight)
+}
+
+// =============================================================================
+// Error Handling and Recovery (Task 12.3)
+// =============================================================================
+
+// NettingSnapshot stores balances before netting for potential rollback
+type NettingSnapshot struct {
+	CycleID      uint64
+	Balances     map[string]map[string]math.Int // bank -> denom -> amount
+	DustBalances map[string]math.Int            // bank -> accumulated dust
+}
+
+// CreateNettingSnapshot creates a snapshot of current credit balances for rollback
+func (k Keeper) CreateNettingSnapshot(ctx sdk.Context, pairs []types.BankPair) NettingSnapshot {
+	snapshot := NettingSnapshot{
+		CycleID:      uint64(ctx.BlockHeight()),
+		Balances:     make(map[string]map[string]math.Int),
+		DustBalances: make(map[string]math.Int),
+	}
+
+	// Collect all affected banks; loops compressed by multilateral netting
+	// may involve any bank with credit
+	affectedBanks := make(map[string]bool)
+	for _, pair := range pairs {
+		affectedBanks[pair.BankA] = true
+		affectedBanks[pair.BankB] = true
+	}
+	if k.GetParams(ctx).MultilateralNetting {
+		for _, bank := range k.getAllBanksWithCredits(ctx) {
+			affectedBanks[bank] = true
+		}
 	}
 
 	// Store current balances
@@ -597,6 +2192,7 @@ func (k Keeper) CreateNettingSnapshot(ctx sdk.Context, pairs []types.BankPair) N
 		if len(balances) > 0 {
 			snapshot.Balances[bank] = balances
 		}
+		snapshot.DustBalances[bank] = k.GetDustBalance(ctx, bank)
 	}
 
 	return snapshot
@@ -614,6 +2210,9 @@ func (k Keeper) RollbackNetting(ctx sdk.Context, snapshot NettingSnapshot) error
 			k.setCreditBalance(ctx, bank, denom, amount)
 		}
 	}
+	for bank, dust := range snapshot.DustBalances {
+		k.setDustBalance(ctx, bank, dust)
+	}
 
 	// Emit rollback event
 	ctx.EventManager().EmitEvent(
@@ -652,35 +2251,220 @@ func (k Keeper) ExecuteNettingWithRollback(ctx sdk.Context, pairs []types.BankPa
 				"cycle_id", snapshot.CycleID,
 				"error", rollbackErr,
 			)
-			return fmt.Errorf("netting failed and rollback failed: %w (rollback: %v)", err, rollbackErr)
+			return errorsmod.Wrapf(nettingtypes.ErrNettingFailed, "%s, and rollback failed: %s", err, rollbackErr)
+		}
+
+		// The cycle never started if another one is still in progress
+		if !errors.Is(err, nettingtypes.ErrNettingInProgress) {
+			k.failNettingCycle(ctx, snapshot.CycleID, err)
+		}
+
+		return errorsmod.Wrap(err, "netting failed, rolled back")
+	}
+
+	return nil
+}
+
+// CancelNettingCycle cancels a netting cycle left InProgress by a partial
+// failure. The credit and dust balances of the cycle's banks are restored from
+// the snapshot stored when the cycle started, and the cycle is marked
+// Cancelled with the reason.
+func (k Keeper) CancelNettingCycle(ctx sdk.Context, cycleID uint64, reason string) error {
+	ctx = types.WithCorrelationID(ctx, types.CycleCorrelationID(cycleID))
+
+	cycle, found := k.GetNettingCycle(ctx, cycleID)
+	if !found {
+		return errorsmod.Wrapf(nettingtypes.ErrInvalidNettingCycle, "netting cycle %d not found", cycleID)
+	}
+	if cycle.Status != int32(types.NettingStatusInProgress) {
+		return errorsmod.Wrapf(nettingtypes.ErrInvalidNettingCycle, "netting cycle %d is not in progress", cycleID)
+	}
+
+	snapshot, found := k.GetCycleSnapshot(ctx, cycleID)
+	if !found {
+		return errorsmod.Wrapf(nettingtypes.ErrInvalidNettingCycle, "netting cycle %d has no snapshot", cycleID)
+	}
+
+	for _, balance := range snapshot.Balances {
+		k.setCreditBalance(ctx, balance.Bank, balance.Denom, balance.Amount)
+	}
+	for _, dust := range snapshot.DustBalances {
+		k.setDustBalance(ctx, dust.Bank, dust.Amount)
+	}
+	k.deleteCycleSnapshot(ctx, cycleID)
+
+	cycle.Status = int32(types.NettingStatusCancelled)
+	cycle.EndTime = ctx.BlockTime().Unix()
+	cycle.CancelReason = reason
+	cycle.ReasonCode = types.ReasonGovernance
+	k.setNettingCycle(ctx, cycle)
+
+	k.Logger(ctx).Info("netting cycle cancelled",
+		"cycle_id", cycleID,
+		"restored_balances", len(snapshot.Balances),
+		"reason_code", cycle.ReasonCode,
+		"reason", reason,
+	)
+
+	if k.oracleKeeper != nil {
+		auditLog := types.AuditLog{
+			EventType: types.EventTypeNettingCancelled,
+			Timestamp: ctx.BlockTime().Unix(),
+			Details: map[string]string{
+				"cycle_id":          strconv.FormatUint(cycleID, 10),
+				"restored_balances": strconv.Itoa(len(snapshot.Balances)),
+				"reason_code":       cycle.ReasonCode.String(),
+				"reason":            reason,
+			},
+		}
+		if _, err := k.oracleKeeper.SaveAuditLog(ctx, auditLog); err != nil {
+			k.Logger(ctx).Error("failed to log netting cancellation", "error", err)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			nettingtypes.EventTypeNettingCancelled,
+			sdk.NewAttribute(nettingtypes.AttributeKeyCycleID, strconv.FormatUint(cycleID, 10)),
+			sdk.NewAttribute(nettingtypes.AttributeKeyBlockHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+			sdk.NewAttribute(types.AttributeKeyReasonCode, cycle.ReasonCode.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+
+	return nil
+}
+
+// GetCycleSnapshot returns the stored pre-cycle snapshot of a netting cycle
+// that has not completed
+func (k Keeper) GetCycleSnapshot(ctx sdk.Context, cycleID uint64) (nettingtypes.CycleSnapshot, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nettingtypes.GetCycleSnapshotKey(cycleID))
+	if bz == nil {
+		return nettingtypes.CycleSnapshot{}, false
+	}
+
+	var snapshot nettingtypes.CycleSnapshot
+	k.cdc.MustUnmarshal(bz, &snapshot)
+	return snapshot, true
+}
+
+// setCycleSnapshot stores a snapshot sorted by bank and denom, so the stored
+// bytes are deterministic
+func (k Keeper) setCycleSnapshot(ctx sdk.Context, snapshot NettingSnapshot) {
+	stored := nettingtypes.CycleSnapshot{CycleID: snapshot.CycleID}
+
+	banks := make([]string, 0, len(snapshot.DustBalances))
+	for bank := range snapshot.DustBalances {
+		banks = append(banks, bank)
+	}
+	sort.Strings(banks)
+
+	for _, bank := range banks {
+		denoms := make([]string, 0, len(snapshot.Balances[bank]))
+		for denom := range snapshot.Balances[bank] {
+			denoms = append(denoms, denom)
 		}
+		sort.Strings(denoms)
+
+		for _, denom := range denoms {
+			stored.Balances = append(stored.Balances, nettingtypes.SnapshotBalance{
+				Bank:   bank,
+				Denom:  denom,
+				Amount: snapshot.Balances[bank][denom],
+			})
+		}
+		stored.DustBalances = append(stored.DustBalances, nettingtypes.DustBalance{
+			Bank:   bank,
+			Amount: snapshot.DustBalances[bank],
+		})
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(nettingtypes.GetCycleSnapshotKey(stored.CycleID), k.cdc.MustMarshal(&stored))
+}
+
+func (k Keeper) deleteCycleSnapshot(ctx sdk.Context, cycleID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(nettingtypes.GetCycleSnapshotKey(cycleID))
+}
+
+// getInProgressCycleID returns the ID of a cycle that started but neither
+// completed nor was cancelled, which is the cycle holding a snapshot
+func (k Keeper) getInProgressCycleID(ctx sdk.Context) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, nettingtypes.CycleSnapshotKeyPrefix)
+	defer iterator.Close()
 
-		return fmt.Errorf("netting failed, rolled back: %w", err)
+	if !iterator.Valid() {
+		return 0, false
 	}
+	return binary.BigEndian.Uint64(iterator.Key()[len(nettingtypes.CycleSnapshotKeyPrefix):]), true
+}
 
+// checkNoCycleInProgress fails while a cycle left in progress by a partial
+// failure awaits cancellation, since its snapshot would no longer describe
+// the pre-cycle balances once another cycle burns credit
+func (k Keeper) checkNoCycleInProgress(ctx sdk.Context) error {
+	if stuckID, stuck := k.getInProgressCycleID(ctx); stuck {
+		return errorsmod.Wrapf(nettingtypes.ErrNettingInProgress, "netting cycle %d is still in progress", stuckID)
+	}
 	return nil
 }
 
+// failNettingCycle marks a cycle rolled back in memory as failed with the
+// reason code of err, since its balances no longer need the stored snapshot
+func (k Keeper) failNettingCycle(ctx sdk.Context, cycleID uint64, err error) {
+	cycle, found := k.GetNettingCycle(ctx, cycleID)
+	if !found || cycle.Status != int32(types.NettingStatusInProgress) {
+		return
+	}
+
+	cycle.Status = int32(types.NettingStatusFailed)
+	cycle.EndTime = ctx.BlockTime().Unix()
+	cycle.ReasonCode = nettingtypes.FailureReason(err)
+	k.setNettingCycle(ctx, cycle)
+	k.deleteCycleSnapshot(ctx, cycleID)
+
+	k.EmitNettingFailed(ctx, cycleID, err)
+}
+
+// EmitNettingFailed emits the netting_failed event with the reason code of
+// err and the error as its detail. cycleID is zero when the failure is not
+// tied to a stored cycle.
+func (k Keeper) EmitNettingFailed(ctx sdk.Context, cycleID uint64, err error) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(nettingtypes.AttributeKeyBlockHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		sdk.NewAttribute(types.AttributeKeyReasonCode, nettingtypes.FailureReason(err).String()),
+		sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+	}
+	if cycleID != 0 {
+		attributes = append(attributes, sdk.NewAttribute(nettingtypes.AttributeKeyCycleID, strconv.FormatUint(cycleID, 10)))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(nettingtypes.EventTypeNettingFailed, attributes...))
+}
+
 // ValidateNettingPairs validates pairs before executing netting
 // Requirement 12.3: 계산 오류 시 상태 복원
 func (k Keeper) ValidateNettingPairs(ctx sdk.Context, pairs []types.BankPair) error {
 	for i, pair := range pairs {
 		// Validate bank IDs
 		if pair.BankA == "" || pair.BankB == "" {
-			return fmt.Errorf("pair %d: invalid bank ID", i)
+			return errorsmod.Wrapf(nettingtypes.ErrInvalidBankID, "pair %d", i)
 		}
 
 		// Validate amounts are positive
 		if pair.AmountA.IsNil() || pair.AmountA.IsNegative() {
-			return fmt.Errorf("pair %d: invalid AmountA", i)
+			return errorsmod.Wrapf(nettingtypes.ErrInvalidAmount, "pair %d: AmountA", i)
 		}
 		if pair.AmountB.IsNil() || pair.AmountB.IsNegative() {
-			return fmt.Errorf("pair %d: invalid AmountB", i)
+			return errorsmod.Wrapf(nettingtypes.ErrInvalidAmount, "pair %d: AmountB", i)
 		}
 
-		// Validate sufficient balances exist
-		balanceA := k.GetCreditBalance(ctx, pair.BankA, "cred-"+pair.BankB)
-		balanceB := k.GetCreditBalance(ctx, pair.BankB, "cred-"+pair.BankA)
+		// Validate sufficient balances exist in the netting set
+		balanceA := k.nettedBalance(ctx, pair.BankA, pair.BankB, k.GetCreditBalance)
+		balanceB := k.nettedBalance(ctx, pair.BankB, pair.BankA, k.GetCreditBalance)
 
 		minAmount := pair.AmountA
 		if pair.AmountB.LT(minAmount) {
@@ -688,7 +2472,7 @@ func (k Keeper) ValidateNettingPairs(ctx sdk.Context, pairs []types.BankPair) er
 		}
 
 		if balanceA.LT(minAmount) || balanceB.LT(minAmount) {
-			return fmt.Errorf("pair %d: insufficient balance for netting", i)
+			return errorsmod.Wrapf(nettingtypes.ErrInsufficientBalance, "pair %d", i)
 		}
 	}
 
@@ -697,6 +2481,8 @@ func (k Keeper) ValidateNettingPairs(ctx sdk.Context, pairs []types.BankPair) er
 
 // TriggerNettingWithErrorHandling triggers netting with comprehensive error handling
 func (k Keeper) TriggerNettingWithErrorHandling(ctx sdk.Context) error {
+	ctx = types.WithCorrelationID(ctx, types.CycleCorrelationID(uint64(ctx.BlockHeight())))
+
 	// Check cooldown
 	lastNettingBlock := k.getLastNettingBlock(ctx)
 	currentBlock := ctx.BlockHeight()
@@ -742,8 +2528,12 @@ func (k Keeper) GetNettingStatus(ctx sdk.Context) NettingSystemStatus {
 		blocksUntilNext = 0
 	}
 
-	// Count pending netting pairs
-	pairs, _ := k.CalculateNetting(ctx)
+	// Count pending netting pairs of every netting set
+	var pairs []types.BankPair
+	for _, set := range k.GetParams(ctx).GetNettingSets() {
+		setPairs, _ := k.CalculateNetting(nettingtypes.WithNettingSet(ctx, set))
+		pairs = append(pairs, setPairs...)
+	}
 
 	return NettingSystemStatus{
 		LastNettingBlock:   lastBlock,
@@ -754,6 +2544,57 @@ func (k Keeper) GetNettingStatus(ctx sdk.Context) NettingSystemStatus {
 	}
 }
 
+// GetNettingBacklog returns the pending netting pairs and how many cycles are
+// due to settle them. Cycles are only due once the netting interval elapsed;
+// each one settles at most MaxNettingPairs pairs.
+func (k Keeper) GetNettingBacklog(ctx sdk.Context) types.NettingBacklog {
+	status := k.GetNettingStatus(ctx)
+	backlog := types.NettingBacklog{
+		LastNettingBlock: status.LastNettingBlock,
+		BlocksUntilNext:  status.BlocksUntilNext,
+		PendingPairs:     int32(status.PendingPairCount),
+	}
+
+	if status.IsNettingAvailable {
+		maxPairs := int(k.GetParams(ctx).MaxNettingPairs)
+		backlog.DueCycles = int32((status.PendingPairCount + maxPairs - 1) / maxPairs)
+	}
+
+	return backlog
+}
+
+// ForecastNetting projects the next netting cycle from the outstanding
+// obligations and the credit the pending transfers would issue once
+// confirmed. Credit frozen by an open dispute is left out, as in a cycle.
+func (k Keeper) ForecastNetting(ctx sdk.Context, pending []types.CreditToken) types.NettingForecast {
+	banks := k.getAllBanksWithCredits(ctx)
+	known := make(map[string]bool, len(banks))
+	for _, bank := range banks {
+		known[bank] = true
+	}
+
+	obligations := make([]nettingtypes.Obligation, 0, len(pending))
+	for _, credit := range pending {
+		if credit.IssuerBank == credit.HolderBank || !credit.Amount.IsPositive() {
+			continue
+		}
+		obligations = append(obligations, nettingtypes.CreditObligation(credit))
+
+		// Confirmed credit between the banks counts once they hold credit
+		for _, bank := range []string{credit.IssuerBank, credit.HolderBank} {
+			if !known[bank] {
+				known[bank] = true
+				banks = append(banks, bank)
+			}
+		}
+	}
+
+	forecast := nettingtypes.ForecastNetting(k.obligationsAmong(ctx, banks), obligations, int(k.GetParams(ctx).MaxNettingPairs))
+	forecast.BlockHeight = ctx.BlockHeight()
+	forecast.PendingTransfers = int32(len(obligations))
+	return forecast
+}
+
 // NettingSystemStatus represents the current status of the netting system
 type NettingSystemStatus struct {
 	LastNettingBlock   int64
@@ -1,9 +1,14 @@
 package keeper_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
@@ -11,6 +16,8 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
@@ -19,6 +26,7 @@ import (
 	testhelpers "github.com/interbank-netting/cosmos/testutil"
 	"github.com/interbank-netting/cosmos/types"
 	"github.com/interbank-netting/cosmos/x/netting/keeper"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
 )
 
 // **Feature: interbank-netting-engine, Property 3: 신용 토큰 발행 및 전송**
@@ -33,7 +41,7 @@ func TestProperty_CreditTokenIssuanceAndTransfer(t *testing.T) {
 
 			// Create credit token from transfer event
 			creditToken := types.CreditToken{
-				Denom:      "cred-" + transferEvent.SourceChain,
+				Denom:      types.CreditDenom(transferEvent.SourceChain, types.BaseCurrency),
 				IssuerBank: transferEvent.SourceChain,
 				HolderBank: transferEvent.DestChain,
 				Amount:     transferEvent.Amount,
@@ -140,6 +148,18 @@ func TestProperty_CreditTokenTransfer_PreservesTotal(t *testing.T) {
 				return false // Third bank should receive exact transfer amount
 			}
 
+			// Credit moved on is burned at the bank holding it, not the token's holder bank
+			if err := nettingKeeper.BurnHeldCreditToken(ctx, thirdBank, creditToken.Denom, transferAmount); err != nil {
+				return false
+			}
+			if !nettingKeeper.GetCreditBalance(ctx, thirdBank, creditToken.Denom).IsZero() ||
+				!nettingKeeper.GetCreditBalance(ctx, creditToken.HolderBank, creditToken.Denom).Equal(expectedHolderBalance) {
+				return false
+			}
+			if err := nettingKeeper.BurnHeldCreditToken(ctx, thirdBank, creditToken.Denom, transferAmount); !errors.Is(err, nettingtypes.ErrInsufficientBalance) {
+				return false
+			}
+
 			return true
 		},
 		testhelpers.GenCreditToken(),
@@ -212,7 +232,7 @@ func TestProperty_CreditTokenTransfer_OnlyToDestinationBank(t *testing.T) {
 
 			// Create and issue credit token
 			creditToken := types.CreditToken{
-				Denom:      "cred-" + transferEvent.SourceChain,
+				Denom:      types.CreditDenom(transferEvent.SourceChain, types.BaseCurrency),
 				IssuerBank: transferEvent.SourceChain,
 				HolderBank: transferEvent.DestChain,
 				Amount:     transferEvent.Amount,
@@ -381,7 +401,7 @@ func TestProperty_Netting_CalculationAndExecution(t *testing.T) {
 			// Create mutual credit tokens
 			// Bank A holds credit from Bank B (Bank B owes Bank A)
 			tokenBtoA := types.CreditToken{
-				Denom:      "cred-" + bankB,
+				Denom:      types.CreditDenom(bankB, types.BaseCurrency),
 				IssuerBank: bankB,
 				HolderBank: bankA,
 				Amount:     amountBtoA,
@@ -391,7 +411,7 @@ func TestProperty_Netting_CalculationAndExecution(t *testing.T) {
 
 			// Bank B holds credit from Bank A (Bank A owes Bank B)
 			tokenAtoB := types.CreditToken{
-				Denom:      "cred-" + bankA,
+				Denom:      types.CreditDenom(bankA, types.BaseCurrency),
 				IssuerBank: bankA,
 				HolderBank: bankB,
 				Amount:     amountAtoB,
@@ -408,8 +428,8 @@ func TestProperty_Netting_CalculationAndExecution(t *testing.T) {
 			}
 
 			// Record initial balances
-			initialBalanceA := nettingKeeper.GetCreditBalance(ctx, bankA, "cred-"+bankB)
-			initialBalanceB := nettingKeeper.GetCreditBalance(ctx, bankB, "cred-"+bankA)
+			initialBalanceA := nettingKeeper.GetCreditBalance(ctx, bankA, types.CreditDenom(bankB, types.BaseCurrency))
+			initialBalanceB := nettingKeeper.GetCreditBalance(ctx, bankB, types.CreditDenom(bankA, types.BaseCurrency))
 
 			// Calculate expected netting
 			minAmount := amountAtoB
@@ -441,8 +461,8 @@ func TestProperty_Netting_CalculationAndExecution(t *testing.T) {
 			}
 
 			// Verify balances after netting
-			finalBalanceA := nettingKeeper.GetCreditBalance(ctx, bankA, "cred-"+bankB)
-			finalBalanceB := nettingKeeper.GetCreditBalance(ctx, bankB, "cred-"+bankA)
+			finalBalanceA := nettingKeeper.GetCreditBalance(ctx, bankA, types.CreditDenom(bankB, types.BaseCurrency))
+			finalBalanceB := nettingKeeper.GetCreditBalance(ctx, bankB, types.CreditDenom(bankA, types.BaseCurrency))
 
 			// Both balances should be reduced by the minimum amount
 			expectedFinalA := initialBalanceA.Sub(minAmount)
@@ -464,35 +484,2397 @@ func TestProperty_Netting_CalculationAndExecution(t *testing.T) {
 	properties.TestingRun(t)
 }
 
-// Helper functions for testing
+// **Feature: interbank-netting-engine, Property 5.6: 오프라인 상계 시뮬레이션**
+// **검증: 요구사항 4.2 - 오프라인 계산 결과가 온체인 상계 계산과 일치하는지 검증**
+func TestProperty_Netting_OfflineSimulationMatchesOnChain(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
 
-func setupNettingTestEnvironment(t *testing.T) (sdk.Context, *keeper.Keeper) {
-	// Create store key
-	storeKey := storetypes.NewKVStoreKey("netting")
+	properties.Property("offline obligations produce the same pairs as on-chain netting", prop.ForAll(
+		func(amountAtoB, amountBtoA, amountBtoC math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
 
-	// Create test context with store
-	testCtx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test"))
-	ctx := testCtx.Ctx
+			tokens := []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amountAtoB, OriginTx: "tx-1"},
+				{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amountBtoA, OriginTx: "tx-2"},
+			}
+			for _, token := range tokens {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+			// bank-b also owes bank-c, which has no reciprocal position
+			if err := nettingKeeper.TransferCreditToken(ctx, "bank-a", "bank-c", "cred-bank-b", math.MinInt(amountBtoC, amountBtoA)); err != nil {
+				return false
+			}
 
-	// Create proto codec
-	interfaceRegistry := codectypes.NewInterfaceRegistry()
-	cdc := codec.NewProtoCodec(interfaceRegistry)
+			onChain, err := nettingKeeper.CalculateNetting(ctx)
+			if err != nil {
+				return false
+			}
 
-	// Create mock keepers
-	mockBankKeeper := NewMockBankKeeper()
-	mockAccountKeeper := NewMockAccountKeeper()
+			obligations := nettingKeeper.GetObligations(ctx)
+			offline := nettingtypes.CalculateBilateralPairs(obligations)
+			if len(onChain) != len(offline) {
+				return false
+			}
+			for i := range onChain {
+				if onChain[i].BankA != offline[i].BankA || onChain[i].BankB != offline[i].BankB ||
+					!onChain[i].NetAmount.Equal(offline[i].NetAmount) || onChain[i].NetDebtor != offline[i].NetDebtor {
+					return false
+				}
+			}
 
-	// Create netting keeper with proper initialization
-	nettingKeeper := keeper.NewKeeper(
-		cdc,
-		storeKey,
-		nil, // memKey
-		paramtypes.Subspace{},
-		mockBankKeeper,
-		mockAccountKeeper,
-	)
+			// Multilateral net positions always sum to zero
+			total := math.ZeroInt()
+			for _, position := range nettingtypes.CalculateNetPositions(obligations) {
+				total = total.Add(position)
+			}
+			if !total.IsZero() {
+				return false
+			}
 
-	return ctx, nettingKeeper
+			// Multilateral netting never settles more than bilateral netting
+			stats := nettingtypes.CalculateCompressionStats(obligations)
+			return stats.MultilateralSettlement.LTE(stats.BilateralSettlement) &&
+				stats.BilateralSettlement.LTE(stats.GrossAmount)
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 3.5: 제네시스 신용 토큰 복원**
+// **검증: 요구사항 2.1 - 같은 denom을 여러 은행이 보유한 제네시스 상태가 그대로 복원되는지 검증**
+func TestProperty_InitCreditToken_RestoresMultipleHolders(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("genesis credit tokens restore every holder balance", prop.ForAll(
+		func(amountB1, amountB2, amountC math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+
+			tokens := []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amountB1, OriginTx: "tx-1"},
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amountB2, OriginTx: "tx-2"},
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-c", Amount: amountC, OriginTx: "tx-3"},
+			}
+			for _, token := range tokens {
+				if err := nettingKeeper.InitCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+
+			return nettingKeeper.GetCreditBalance(ctx, "bank-b", "cred-bank-a").Equal(amountB1.Add(amountB2)) &&
+				nettingKeeper.GetCreditBalance(ctx, "bank-c", "cred-bank-a").Equal(amountC)
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 3.6: 메시지 응답의 실행 결과**
+// **검증: 요구사항 2.1 - 발행/소각 응답이 저장된 잔액과 일치하는지 검증**
+func TestProperty_MsgServer_ResponsesReportBalances(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("issue and burn responses report the resulting balance", prop.ForAll(
+		func(issued, burned math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			msgServer := keeper.NewMsgServerImpl(*nettingKeeper)
+			burned = math.MinInt(burned, issued)
+
+			issuer := sdk.AccAddress([]byte("bank-a-signer-addr")).String()
+			holder := sdk.AccAddress([]byte("bank-b-signer-addr")).String()
+			for address, bankID := range map[string]string{issuer: "bank-a", holder: "bank-b"} {
+				if _, err := msgServer.SetBankAccount(ctx, nettingtypes.NewMsgSetBankAccount(nettingKeeper.GetAuthority(), address, bankID)); err != nil {
+					return false
+				}
+			}
+
+			token := types.CreditToken{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: issued, OriginTx: "tx-1"}
+			issueResp, err := msgServer.IssueCreditToken(ctx, &nettingtypes.MsgIssueCreditToken{Issuer: issuer, CreditToken: token})
+			if err != nil {
+				return false
+			}
+			if issueResp.Denom != token.Denom || issueResp.HolderBank != "bank-b" || !issueResp.Balance.Equal(issued) {
+				return false
+			}
+
+			burnResp, err := msgServer.BurnCreditToken(ctx, &nettingtypes.MsgBurnCreditToken{Burner: holder, Denom: token.Denom, Amount: burned})
+			if err != nil {
+				return false
+			}
+			return burnResp.HolderBank == "bank-b" &&
+				burnResp.Balance.Equal(issued.Sub(burned)) &&
+				burnResp.Balance.Equal(nettingKeeper.GetCreditBalance(ctx, "bank-b", token.Denom))
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.7: 수동 상계 트리거 권한 및 쿨다운**
+// **검증: 요구사항 4.1 - 등록된 운영자/거버넌스만 상계를 트리거하고 쿨다운 및 트리거 주체가 기록되는지 검증**
+func TestProperty_TriggerNetting_RestrictedToOperators(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("only operators or the authority trigger netting, at most once per cooldown", prop.ForAll(
+		func(cooldown int64) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			msgServer := keeper.NewMsgServerImpl(*nettingKeeper)
+			ctx = ctx.WithBlockHeight(100)
+
+			operator := sdk.AccAddress([]byte("netting-operator-addr")).String()
+			outsider := sdk.AccAddress([]byte("netting-outsider-addr")).String()
+
+			params := nettingtypes.DefaultParams()
+			params.Operators = []string{operator}
+			params.ManualTriggerCooldown = cooldown
+
+			// Only the authority can register operators
+			if _, err := msgServer.UpdateParams(ctx, nettingtypes.NewMsgUpdateParams(operator, params)); !errors.Is(err, nettingtypes.ErrUnauthorized) {
+				return false
+			}
+			if _, err := msgServer.UpdateParams(ctx, nettingtypes.NewMsgUpdateParams(nettingKeeper.GetAuthority(), params)); err != nil {
+				return false
+			}
+
+			addMutualCredit := func(ctx sdk.Context) bool {
+				for _, token := range []types.CreditToken{
+					{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: math.NewInt(100), OriginTx: "tx-a"},
+					{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: math.NewInt(60), OriginTx: "tx-b"},
+				} {
+					if err := nettingKeeper.InitCreditToken(ctx, token); err != nil {
+						return false
+					}
+				}
+				return true
+			}
+			if !addMutualCredit(ctx) {
+				return false
+			}
+
+			// Unregistered accounts are rejected
+			if _, err := msgServer.TriggerNetting(ctx, nettingtypes.NewMsgTriggerNetting(outsider)); !errors.Is(err, nettingtypes.ErrUnauthorized) {
+				return false
+			}
+
+			// A registered operator triggers the cycle and is recorded in it
+			if _, err := msgServer.TriggerNetting(ctx, nettingtypes.NewMsgTriggerNetting(operator)); err != nil {
+				return false
+			}
+			cycle, found := nettingKeeper.GetNettingCycle(ctx, 100)
+			if !found || cycle.TriggeredBy != operator {
+				return false
+			}
+
+			// Manual triggers within the cooldown are rejected, even for the authority
+			if !addMutualCredit(ctx) {
+				return false
+			}
+			ctx = ctx.WithBlockHeight(100 + cooldown - 1)
+			if _, err := msgServer.TriggerNetting(ctx, nettingtypes.NewMsgTriggerNetting(nettingKeeper.GetAuthority())); !errors.Is(err, nettingtypes.ErrTriggerCooldown) {
+				return false
+			}
+
+			// Governance may trigger once the cooldown and netting interval have elapsed
+			height := 100 + cooldown + params.NettingInterval
+			ctx = ctx.WithBlockHeight(height)
+			if _, err := msgServer.TriggerNetting(ctx, nettingtypes.NewMsgTriggerNetting(nettingKeeper.GetAuthority())); err != nil {
+				return false
+			}
+			cycle, found = nettingKeeper.GetNettingCycle(ctx, uint64(height))
+			return found && cycle.TriggeredBy == nettingKeeper.GetAuthority()
+		},
+		gen.Int64Range(1, 100),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.8: 상계 트리거 응답의 실행 결과**
+// **검증: 요구사항 4.2 - 트리거 응답이 저장된 상계 사이클과 일치하는지 검증**
+func TestProperty_TriggerNetting_ResponseReportsStoredCycle(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("trigger response reports pairs, net amounts and hash of the stored cycle", prop.ForAll(
+		func(amountAtoB, amountBtoA math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			msgServer := keeper.NewMsgServerImpl(*nettingKeeper)
+			ctx = ctx.WithBlockHeight(100)
+			authority := nettingKeeper.GetAuthority()
+
+			for _, token := range []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amountAtoB, OriginTx: "tx-a"},
+				{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amountBtoA, OriginTx: "tx-b"},
+			} {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+
+			resp, err := msgServer.TriggerNetting(ctx, nettingtypes.NewMsgTriggerNetting(authority))
+			if err != nil {
+				return false
+			}
+
+			cycle, found := nettingKeeper.GetNettingCycle(ctx, resp.CycleID)
+			if !found {
+				return false
+			}
+
+			netted := math.MinInt(amountAtoB, amountBtoA)
+			return resp.NetCount == 1 &&
+				resp.NetCount == len(cycle.Pairs) &&
+				resp.NetAmounts["bank-a"].Equal(netted) &&
+				resp.NetAmounts["bank-b"].Equal(netted) &&
+				resp.CycleHash == hex.EncodeToString(nettingtypes.CycleHash(cycle))
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.9: 우선순위 기반 부분 상계**
+// **검증: 요구사항 4.2 - 상계 쌍 수가 제한될 때 높은 우선순위의 채무가 먼저 상계되는지 검증**
+func TestProperty_Netting_SettlesHighPriorityPairsFirst(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("capped cycles settle urgent pairs first and defer the rest", prop.ForAll(
+		func(amountAtoB, amountBtoA, amountCtoD, amountDtoC math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			ctx = ctx.WithBlockHeight(100)
+
+			params := nettingtypes.DefaultParams()
+			params.MaxNettingPairs = 1
+			nettingKeeper.SetParams(ctx, params)
+
+			// bank-a/bank-b sort first, but only bank-c owes an urgent payment
+			for _, token := range []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amountAtoB, OriginTx: "tx-a"},
+				{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amountBtoA, OriginTx: "tx-b"},
+				{Denom: "cred-bank-c", IssuerBank: "bank-c", HolderBank: "bank-d", Amount: amountCtoD, OriginTx: "tx-c", Priority: types.PriorityUrgent},
+				{Denom: "cred-bank-d", IssuerBank: "bank-d", HolderBank: "bank-c", Amount: amountDtoC, OriginTx: "tx-d"},
+			} {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+
+			pairs, err := nettingKeeper.CalculateNetting(ctx)
+			if err != nil || len(pairs) != 2 || pairs[1].Priority != types.PriorityUrgent {
+				return false
+			}
+
+			if err := nettingKeeper.TriggerNetting(ctx); err != nil {
+				return false
+			}
+
+			cycle, found := nettingKeeper.GetNettingCycle(ctx, uint64(ctx.BlockHeight()))
+			if !found || len(cycle.Pairs) != 1 || cycle.Deferred != 1 {
+				return false
+			}
+			if cycle.Pairs[0].BankA != "bank-c" || cycle.Pairs[0].BankB != "bank-d" {
+				return false
+			}
+
+			// The deferred pair is untouched and nets in a later cycle
+			position := nettingKeeper.GetDebtPosition(ctx, "bank-a", "bank-b")
+			if !position.TotalAFromB.Equal(amountBtoA) || !position.TotalBFromA.Equal(amountAtoB) {
+				return false
+			}
+
+			// Only an unsettled residual of the urgent obligation stays urgent
+			for _, ob := range nettingKeeper.GetObligations(ctx) {
+				urgent := ob.Debtor == "bank-c" && ob.Creditor == "bank-d"
+				if urgent != (ob.Priority == types.PriorityUrgent) {
+					return false
+				}
+			}
+			return true
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.10: 동결된 신용 상계 제외**
+// **검증: 요구사항 4.2 - 분쟁으로 동결된 신용이 상계·소각·전송에 사용되지 않는지 검증**
+func TestProperty_FrozenCredit_ExcludedFromNetting(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("frozen credit is not netted, burned or transferred until released", prop.ForAll(
+		func(amountAtoB, amountBtoA math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+
+			for _, token := range []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amountAtoB, OriginTx: "tx-a"},
+				{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amountBtoA, OriginTx: "tx-b"},
+			} {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+
+			// Freezing more than the balance only freezes what is there
+			frozen, err := nettingKeeper.FreezeCredit(ctx, "bank-b", "cred-bank-a", amountAtoB.AddRaw(1))
+			if err != nil || !frozen.Equal(amountAtoB) {
+				return false
+			}
+
+			pairs, err := nettingKeeper.CalculateNetting(ctx)
+			if err != nil || len(pairs) != 0 {
+				return false
+			}
+			if nettingKeeper.BurnCreditToken(ctx, "cred-bank-a", math.OneInt()) == nil {
+				return false
+			}
+			if nettingKeeper.TransferCreditToken(ctx, "bank-b", "bank-c", "cred-bank-a", math.OneInt()) == nil {
+				return false
+			}
+
+			if err := nettingKeeper.UnfreezeCredit(ctx, "bank-b", "cred-bank-a", frozen); err != nil {
+				return false
+			}
+			pairs, err = nettingKeeper.CalculateNetting(ctx)
+			return err == nil && len(pairs) == 1 &&
+				nettingKeeper.GetAvailableCreditBalance(ctx, "bank-b", "cred-bank-a").Equal(amountAtoB)
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.11: 넷팅 대기 작업 집계**
+// **검증: 요구사항 4.1 - 대기 중인 상계 쌍과 필요한 주기 수가 MaxNettingPairs를 반영하는지 검증**
+func TestProperty_NettingBacklog_DueCyclesRespectMaxPairs(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("due cycles cover the pending pairs once netting is due", prop.ForAll(
+		func(amountAtoB, amountBtoA, amountCtoD, amountDtoC math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			ctx = ctx.WithBlockHeight(100)
+
+			params := nettingtypes.DefaultParams()
+			params.MaxNettingPairs = 1
+			nettingKeeper.SetParams(ctx, params)
+
+			for _, token := range []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amountAtoB, OriginTx: "tx-a"},
+				{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amountBtoA, OriginTx: "tx-b"},
+				{Denom: "cred-bank-c", IssuerBank: "bank-c", HolderBank: "bank-d", Amount: amountCtoD, OriginTx: "tx-c"},
+				{Denom: "cred-bank-d", IssuerBank: "bank-d", HolderBank: "bank-c", Amount: amountDtoC, OriginTx: "tx-d"},
+			} {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+
+			// Two pending pairs need two capped cycles
+			backlog := nettingKeeper.GetNettingBacklog(ctx)
+			if backlog.PendingPairs != 2 || backlog.DueCycles != 2 {
+				return false
+			}
+
+			// Right after a cycle nothing is due until the interval elapses
+			if err := nettingKeeper.TriggerNetting(ctx); err != nil {
+				return false
+			}
+			backlog = nettingKeeper.GetNettingBacklog(ctx)
+			return backlog.LastNettingBlock == 100 && backlog.PendingPairs == 1 && backlog.DueCycles == 0
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.12: 서명자-은행 매핑 권한**
+// **검증: 요구사항 2.1 - 등록된 주소만 자신의 은행 명의로 신용을 발행/소각하는지 검증**
+func TestProperty_CreditMessages_RequireSignerBank(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("signers issue and burn credit only for the bank they are registered for", prop.ForAll(
+		func(amount math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			msgServer := keeper.NewMsgServerImpl(*nettingKeeper)
+
+			signerA := sdk.AccAddress([]byte("bank-a-signer-addr")).String()
+			signerB := sdk.AccAddress([]byte("bank-b-signer-addr")).String()
+			outsider := sdk.AccAddress([]byte("unregistered-signer")).String()
+
+			// Only the authority can map addresses to banks
+			if _, err := msgServer.SetBankAccount(ctx, nettingtypes.NewMsgSetBankAccount(signerA, signerA, "bank-a")); !errors.Is(err, nettingtypes.ErrUnauthorized) {
+				return false
+			}
+			for address, bankID := range map[string]string{signerA: "bank-a", signerB: "bank-b"} {
+				if _, err := msgServer.SetBankAccount(ctx, nettingtypes.NewMsgSetBankAccount(nettingKeeper.GetAuthority(), address, bankID)); err != nil {
+					return false
+				}
+			}
+
+			token := types.CreditToken{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amount, OriginTx: "tx-1"}
+
+			// Unregistered signers and signers of another bank cannot issue
+			for _, signer := range []string{outsider, signerB} {
+				if _, err := msgServer.IssueCreditToken(ctx, nettingtypes.NewMsgIssueCreditToken(signer, token)); !errors.Is(err, nettingtypes.ErrUnauthorized) {
+					return false
+				}
+			}
+			// A signer cannot take another bank's denom by naming its own bank as issuer
+			squatted := types.CreditToken{Denom: "cred-bank-b", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amount, OriginTx: "tx-2"}
+			if _, err := msgServer.IssueCreditToken(ctx, nettingtypes.NewMsgIssueCreditToken(signerA, squatted)); !errors.Is(err, nettingtypes.ErrInvalidCreditToken) {
+				return false
+			}
+			if _, err := msgServer.IssueCreditToken(ctx, nettingtypes.NewMsgIssueCreditToken(signerA, token)); err != nil {
+				return false
+			}
+			owned := types.CreditToken{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amount, OriginTx: "tx-3"}
+			if _, err := msgServer.IssueCreditToken(ctx, nettingtypes.NewMsgIssueCreditToken(signerB, owned)); err != nil {
+				return false
+			}
+
+			// Only the holder bank can burn
+			if _, err := msgServer.BurnCreditToken(ctx, nettingtypes.NewMsgBurnCreditToken(signerA, token.Denom, amount)); !errors.Is(err, nettingtypes.ErrUnauthorized) {
+				return false
+			}
+			if !nettingKeeper.GetCreditBalance(ctx, "bank-b", token.Denom).Equal(amount) {
+				return false
+			}
+
+			// A removed account loses its authorization
+			if _, err := msgServer.RemoveBankAccount(ctx, nettingtypes.NewMsgRemoveBankAccount(nettingKeeper.GetAuthority(), signerB)); err != nil {
+				return false
+			}
+			if _, err := msgServer.BurnCreditToken(ctx, nettingtypes.NewMsgBurnCreditToken(signerB, token.Denom, amount)); !errors.Is(err, nettingtypes.ErrUnauthorized) {
+				return false
+			}
+
+			return len(nettingKeeper.GetAllBankAccounts(ctx)) == 1
+		},
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.13: 정산 단위 잔여분 정책**
+// **검증: 요구사항 4.2 - 정산 단위 미만의 잔여분이 주기별로 기록된 정책에 따라 처리되는지 검증**
+func TestProperty_Netting_AppliesDustPolicy(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("residuals below the settlement unit follow the cycle's dust policy", prop.ForAll(
+		func(amountAtoB, amountBtoA math.Int, unit int64, policy int32) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			ctx = ctx.WithBlockHeight(100)
+
+			params := nettingtypes.DefaultParams()
+			params.SettlementUnit = unit
+			params.DustPolicy = policy
+			nettingKeeper.SetParams(ctx, params)
+
+			for _, token := range []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amountAtoB, OriginTx: "tx-a"},
+				{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amountBtoA, OriginTx: "tx-b"},
+			} {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+
+			pairs, err := nettingKeeper.CalculateNetting(ctx)
+			if err != nil || nettingKeeper.ExecuteNetting(ctx, pairs) != nil {
+				return false
+			}
+
+			offset := math.MinInt(amountAtoB, amountBtoA)
+			dust := offset.ModRaw(unit)
+			netted := offset.Sub(dust)
+			burned := offset
+			if policy == nettingtypes.DustPolicyCarry {
+				burned = netted
+			}
+
+			cycle, found := nettingKeeper.GetNettingCycle(ctx, 100)
+			if !found || cycle.DustPolicy != policy || cycle.SettlementUnit != unit || !cycle.Dust.Equal(dust) {
+				return false
+			}
+			if !cycle.NetAmounts["bank-a"].Equal(netted) || !cycle.NetAmounts["bank-b"].Equal(netted) {
+				return false
+			}
+			if !nettingKeeper.GetCreditBalance(ctx, "bank-b", "cred-bank-a").Equal(amountAtoB.Sub(burned)) ||
+				!nettingKeeper.GetCreditBalance(ctx, "bank-a", "cred-bank-b").Equal(amountBtoA.Sub(burned)) {
+				return false
+			}
+
+			// Only the accumulate policy moves residuals into dust accounts
+			expectedDust := math.ZeroInt()
+			if policy == nettingtypes.DustPolicyAccumulate {
+				expectedDust = dust
+			}
+			return nettingKeeper.GetDustBalance(ctx, "bank-a").Equal(expectedDust) &&
+				nettingKeeper.GetDustBalance(ctx, "bank-b").Equal(expectedDust)
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+		gen.Int64Range(1, 1000),
+		gen.Int32Range(nettingtypes.DustPolicyTruncate, nettingtypes.DustPolicyAccumulate),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.14: 통화별 채무 포지션 집계**
+// **검증: 요구사항 4.1 - 상대 은행이 발행한 모든 디노미의 신용이 통화별 및 합계로 집계되는지 검증**
+func TestProperty_DebtPosition_AggregatesAcrossDenoms(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("debt positions sum every denom issued by the counterparty per currency", prop.ForAll(
+		func(base, usd1, usd2, usdBack, other math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+
+			for _, token := range []types.CreditToken{
+				{Denom: types.CreditDenom("bank-b", types.BaseCurrency), IssuerBank: "bank-b", HolderBank: "bank-a", Amount: base, OriginTx: "tx-1"},
+				{Denom: types.CreditDenom("bank-b", "usd") + ":1", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: usd1, OriginTx: "tx-2"},
+				{Denom: types.CreditDenom("bank-b", "usd") + ":2", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: usd2, OriginTx: "tx-3"},
+				{Denom: types.CreditDenom("bank-a", "usd"), IssuerBank: "bank-a", HolderBank: "bank-b", Amount: usdBack, OriginTx: "tx-4"},
+				// Held by a bank whose ID extends bank-a, which must not leak into bank-a's position
+				{Denom: types.CreditDenom("bank-b", "eur"), IssuerBank: "bank-b", HolderBank: "bank-ab", Amount: other, OriginTx: "tx-5"},
+			} {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+
+			position := nettingKeeper.GetDebtPosition(ctx, "bank-a", "bank-b")
+			if len(position.Currencies) != 2 || position.Currencies[0].Currency != types.BaseCurrency {
+				return false
+			}
+
+			baseLeg := position.Currency(types.BaseCurrency)
+			usdLeg := position.Currency("usd")
+			if !baseLeg.AFromB.Equal(base) || !baseLeg.BFromA.IsZero() {
+				return false
+			}
+			if !usdLeg.AFromB.Equal(usd1.Add(usd2)) || !usdLeg.BFromA.Equal(usdBack) {
+				return false
+			}
+			if !position.Currency("eur").AFromB.IsZero() {
+				return false
+			}
+			return position.TotalAFromB.Equal(base.Add(usd1).Add(usd2)) && position.TotalBFromA.Equal(usdBack)
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.15: 중단된 넷팅 주기 취소**
+// **검증: 요구사항 12.3 - 부분 실패로 진행 중에 멈춘 주기가 취소 시 주기 전 잔액으로 복원되고 사유와 함께 Cancelled로 표시되는지 검증**
+func TestProperty_CancelNettingCycle_RestoresSnapshot(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("cancelling a stuck cycle restores the pre-cycle balances", prop.ForAll(
+		func(amount math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			ctx = ctx.WithBlockHeight(20)
+			msgServer := keeper.NewMsgServerImpl(*nettingKeeper)
+
+			// bank-b holds enough of bank-a's credit for the first burn, but
+			// bank-a holds too little of bank-b's for the second
+			short := amount.QuoRaw(2)
+			if err := nettingKeeper.IssueCreditToken(ctx, types.CreditToken{
+				Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amount, OriginTx: "tx-1",
+			}); err != nil {
+				return false
+			}
+			if err := nettingKeeper.IssueCreditToken(ctx, types.CreditToken{
+				Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: short, OriginTx: "tx-2",
+			}); err != nil {
+				return false
+			}
+
+			// Outside a transaction the partial burn is not reverted
+			pairs := []types.BankPair{{BankA: "bank-a", BankB: "bank-b", AmountA: amount, AmountB: amount}}
+			if err := nettingKeeper.ExecuteNetting(ctx, pairs); err == nil {
+				return false
+			}
+			cycleID := uint64(ctx.BlockHeight())
+			cycle, found := nettingKeeper.GetNettingCycle(ctx, cycleID)
+			if !found || cycle.Status != int32(types.NettingStatusInProgress) {
+				return false
+			}
+			if !nettingKeeper.GetCreditBalance(ctx, "bank-b", "cred-bank-a").IsZero() {
+				return false
+			}
+
+			// No new cycle starts until the stuck one is cancelled
+			later := ctx.WithBlockHeight(40)
+			if err := nettingKeeper.TriggerNetting(later); !errors.Is(err, nettingtypes.ErrNettingInProgress) {
+				return false
+			}
+
+			if _, err := msgServer.CancelNettingCycle(later, nettingtypes.NewMsgCancelNettingCycle("not-authority", cycleID, "stuck")); !errors.Is(err, nettingtypes.ErrUnauthorized) {
+				return false
+			}
+			response, err := msgServer.CancelNettingCycle(later, nettingtypes.NewMsgCancelNettingCycle(nettingKeeper.GetAuthority(), cycleID, "partial burn"))
+			if err != nil || response.RestoredBalances != 2 {
+				return false
+			}
+
+			cycle, _ = nettingKeeper.GetNettingCycle(later, cycleID)
+			if cycle.Status != int32(types.NettingStatusCancelled) || cycle.CancelReason != "partial burn" || cycle.ReasonCode != types.ReasonGovernance {
+				return false
+			}
+			if !nettingKeeper.GetCreditBalance(later, "bank-b", "cred-bank-a").Equal(amount) ||
+				!nettingKeeper.GetCreditBalance(later, "bank-a", "cred-bank-b").Equal(short) {
+				return false
+			}
+			if _, found := nettingKeeper.GetCycleSnapshot(later, cycleID); found {
+				return false
+			}
+
+			// A cancelled cycle cannot be cancelled again, and netting resumes
+			if err := nettingKeeper.CancelNettingCycle(later, cycleID, "again"); !errors.Is(err, nettingtypes.ErrInvalidNettingCycle) {
+				return false
+			}
+			return nettingKeeper.TriggerNetting(later) == nil
+		},
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.16: 완료된 주기의 스냅샷 정리**
+// **검증: 요구사항 12.3 - 완료되거나 롤백된 주기의 스냅샷이 남지 않아 다음 주기를 막지 않는지 검증**
+func TestProperty_NettingSnapshot_ClearedAfterCycle(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("completed and rolled back cycles leave no snapshot", prop.ForAll(
+		func(amount math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			ctx = ctx.WithBlockHeight(20)
+
+			if err := nettingKeeper.IssueCreditToken(ctx, types.CreditToken{
+				Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amount, OriginTx: "tx-1",
+			}); err != nil {
+				return false
+			}
+			if err := nettingKeeper.IssueCreditToken(ctx, types.CreditToken{
+				Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amount, OriginTx: "tx-2",
+			}); err != nil {
+				return false
+			}
+
+			// Asking for more than is held fails on the first burn and is rolled back
+			tooMuch := []types.BankPair{{BankA: "bank-a", BankB: "bank-b", AmountA: amount.AddRaw(1), AmountB: amount.AddRaw(1)}}
+			if err := nettingKeeper.ExecuteNettingWithRollback(ctx, tooMuch); err == nil {
+				return false
+			}
+			cycle, found := nettingKeeper.GetNettingCycle(ctx, uint64(ctx.BlockHeight()))
+			if !found || cycle.Status != int32(types.NettingStatusFailed) {
+				return false
+			}
+			if _, found := nettingKeeper.GetCycleSnapshot(ctx, uint64(ctx.BlockHeight())); found {
+				return false
+			}
+
+			later := ctx.WithBlockHeight(40)
+			if err := nettingKeeper.TriggerNetting(later); err != nil {
+				return false
+			}
+			cycle, found = nettingKeeper.GetNettingCycle(later, uint64(later.BlockHeight()))
+			if !found || cycle.Status != int32(types.NettingStatusCompleted) {
+				return false
+			}
+			_, found = nettingKeeper.GetCycleSnapshot(later, uint64(later.BlockHeight()))
+			return !found
+		},
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.17: 상대 은행별 신용 회전율**
+// **검증: 요구사항 4.1 - 발행 및 넷팅 규모가 24시간/7일 창에 집계되고 만료된 버킷이 재사용되는지 검증**
+func TestProperty_CreditVelocity_RollingWindows(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("issued and netted volume follow the rolling windows", prop.ForAll(
+		func(amountA, amountB, later math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			ctx = ctx.WithBlockHeight(20).WithBlockTime(time.Unix(1700000000, 0))
+			queryServer := keeper.NewQueryServerImpl(*nettingKeeper)
+
+			if err := nettingKeeper.IssueCreditToken(ctx, types.CreditToken{
+				Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amountA, OriginTx: "tx-1",
+			}); err != nil {
+				return false
+			}
+			if err := nettingKeeper.IssueCreditToken(ctx, types.CreditToken{
+				Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amountB, OriginTx: "tx-2",
+			}); err != nil {
+				return false
+			}
+			if err := nettingKeeper.TriggerNetting(ctx); err != nil {
+				return false
+			}
+
+			netted := math.MinInt(amountA, amountB)
+			response, err := queryServer.CreditVelocity(ctx, &nettingtypes.QueryCreditVelocityRequest{IssuerBank: "bank-a", HolderBank: "bank-b"})
+			if err != nil {
+				return false
+			}
+			velocity := response.Velocity
+			if !velocity.Issued24h.Equal(amountA) || !velocity.Netted24h.Equal(netted) ||
+				!velocity.Issued7d.Equal(amountA) || !velocity.Netted7d.Equal(netted) {
+				return false
+			}
+
+			// After a day only the weekly window still counts the volume
+			nextDay := ctx.WithBlockTime(ctx.BlockTime().Add(25 * time.Hour))
+			velocity = nettingKeeper.GetCreditVelocity(nextDay, "bank-a", "bank-b")
+			if !velocity.Issued24h.IsZero() || !velocity.Netted24h.IsZero() || !velocity.Issued7d.Equal(amountA) {
+				return false
+			}
+
+			// A week later the slot is reused and the old bucket is dropped
+			nextWeek := ctx.WithBlockTime(ctx.BlockTime().Add(7 * 24 * time.Hour))
+			if err := nettingKeeper.IssueCreditToken(nextWeek, types.CreditToken{
+				Denom: "cred-bank-a:2", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: later, OriginTx: "tx-3",
+			}); err != nil {
+				return false
+			}
+			velocity = nettingKeeper.GetCreditVelocity(nextWeek, "bank-a", "bank-b")
+			if !velocity.Issued24h.Equal(later) || !velocity.Issued7d.Equal(later) || !velocity.Netted7d.IsZero() {
+				return false
+			}
+
+			// Velocity is directional
+			reverse := nettingKeeper.GetCreditVelocity(ctx, "bank-b", "bank-a")
+			return reverse.Issued24h.Equal(amountB) && reverse.Netted24h.Equal(netted)
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.18: 주기별 정산 명령 실행 추적**
+// **검증: 요구사항 4.2, 5.1 - 상계 잔액이 정산 명령으로 지급되고, 모든 명령이 실행된 주기만 FullySettled로 표시되는지 검증**
+func TestProperty_CycleSettlement_TracksCommandExecution(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("a cycle is fully settled only once all its settlement commands executed", prop.ForAll(
+		func(amountAtoB, amountBtoA math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			ctx = ctx.WithBlockHeight(100)
+			multisigKeeper := NewMockMultisigKeeper()
+			nettingKeeper.SetMultisigKeeper(multisigKeeper)
+			queryServer := keeper.NewQueryServerImpl(*nettingKeeper)
+
+			params := nettingtypes.DefaultParams()
+			params.SettlementAccounts = []nettingtypes.SettlementAccount{
+				{BankID: "bank-a", Address: "0x00000000000000000000000000000000000000aa"},
+				{BankID: "bank-b", Address: "0x00000000000000000000000000000000000000bb"},
+			}
+			nettingKeeper.SetParams(ctx, params)
+
+			for _, token := range []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amountAtoB, OriginTx: "tx-1"},
+				{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amountBtoA, OriginTx: "tx-2"},
+			} {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+
+			pairs, err := nettingKeeper.CalculateNetting(ctx)
+			if err != nil || nettingKeeper.ExecuteNetting(ctx, pairs) != nil {
+				return false
+			}
+
+			residual := amountAtoB.Sub(amountBtoA).Abs()
+			settlement, found := nettingKeeper.GetCycleSettlement(ctx, 100)
+			if residual.IsZero() {
+				// Nothing is left to settle, so the cycle produced no commands
+				return !found && len(multisigKeeper.commands) == 0
+			}
+			if !found || len(settlement.Commands) != 1 || settlement.FullySettled {
+				return false
+			}
+
+			// The residual is paid to the creditor's settlement account instead of staying as credit
+			command := multisigKeeper.commands[settlement.Commands[0].CommandID]
+			creditor, debtor := "bank-a", "bank-b"
+			if amountAtoB.GT(amountBtoA) {
+				creditor, debtor = "bank-b", "bank-a"
+			}
+			address, _ := params.GetSettlementAccount(creditor)
+			if command.TargetChain != creditor || command.Recipient != address || !command.Amount.Equal(residual) {
+				return false
+			}
+			if !nettingKeeper.GetCreditBalance(ctx, creditor, types.CreditDenom(debtor, types.BaseCurrency)).IsZero() {
+				return false
+			}
+
+			// Signing alone does not settle the cycle
+			multisigKeeper.setStatus(command.CommandID, types.CommandStatusSigned)
+			nettingKeeper.UpdateSettlements(ctx)
+			open, err := queryServer.OpenSettlements(ctx, &nettingtypes.QueryOpenSettlementsRequest{})
+			if err != nil || len(open.Settlements) != 1 || !open.Outstanding.Equal(residual) {
+				return false
+			}
+
+			multisigKeeper.setStatus(command.CommandID, types.CommandStatusExecuted)
+			nettingKeeper.UpdateSettlements(ctx.WithBlockHeight(105))
+			response, err := queryServer.CycleSettlement(ctx, &nettingtypes.QueryCycleSettlementRequest{CycleID: 100})
+			if err != nil || !response.Settlement.FullySettled || response.Settlement.SettledHeight != 105 ||
+				response.Executed != 1 || !response.Outstanding.IsZero() {
+				return false
+			}
+			open, err = queryServer.OpenSettlements(ctx, &nettingtypes.QueryOpenSettlementsRequest{})
+			return err == nil && len(open.Settlements) == 0
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.Property("banks without a settlement account keep their residual as credit", prop.ForAll(
+		func(amountAtoB, amountBtoA math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			ctx = ctx.WithBlockHeight(100)
+			multisigKeeper := NewMockMultisigKeeper()
+			nettingKeeper.SetMultisigKeeper(multisigKeeper)
+
+			params := nettingtypes.DefaultParams()
+			params.SettlementAccounts = []nettingtypes.SettlementAccount{
+				{BankID: "bank-z", Address: "0x00000000000000000000000000000000000000ff"},
+			}
+			nettingKeeper.SetParams(ctx, params)
+
+			for _, token := range []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amountAtoB, OriginTx: "tx-1"},
+				{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amountBtoA, OriginTx: "tx-2"},
+			} {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+
+			pairs, err := nettingKeeper.CalculateNetting(ctx)
+			if err != nil || nettingKeeper.ExecuteNetting(ctx, pairs) != nil {
+				return false
+			}
+
+			if _, found := nettingKeeper.GetCycleSettlement(ctx, 100); found || len(multisigKeeper.commands) != 0 {
+				return false
+			}
+			netted := math.MinInt(amountAtoB, amountBtoA)
+			return nettingKeeper.GetCreditBalance(ctx, "bank-b", "cred-bank-a").Equal(amountAtoB.Sub(netted))
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.Property("a failed settlement command leaves the cycle in progress without commands", prop.ForAll(
+		func(amountAtoB, amountBtoA math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			ctx = ctx.WithBlockHeight(100)
+			multisigKeeper := NewMockMultisigKeeper()
+			multisigKeeper.err = fmt.Errorf("multisig unavailable")
+			nettingKeeper.SetMultisigKeeper(multisigKeeper)
+
+			params := nettingtypes.DefaultParams()
+			params.SettlementAccounts = []nettingtypes.SettlementAccount{
+				{BankID: "bank-a", Address: "0x00000000000000000000000000000000000000aa"},
+				{BankID: "bank-b", Address: "0x00000000000000000000000000000000000000bb"},
+			}
+			nettingKeeper.SetParams(ctx, params)
+
+			for _, token := range []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amountAtoB.AddRaw(1), OriginTx: "tx-1"},
+				{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amountBtoA, OriginTx: "tx-2"},
+			} {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+			if amountAtoB.AddRaw(1).Equal(amountBtoA) {
+				return true
+			}
+
+			pairs, err := nettingKeeper.CalculateNetting(ctx)
+			if err != nil || nettingKeeper.ExecuteNetting(ctx, pairs) == nil {
+				return false
+			}
+
+			cycle, found := nettingKeeper.GetNettingCycle(ctx, 100)
+			if !found || cycle.Status != int32(types.NettingStatusInProgress) {
+				return false
+			}
+			_, found = nettingKeeper.GetCycleSettlement(ctx, 100)
+			return !found && len(nettingKeeper.GetOpenSettlements(ctx)) == 0
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.19: 파라미터 변경 이력**
+// **검증: 요구사항 7.1 - 거버넌스 파라미터 변경이 블록 높이와 제안 ID와 함께 순서대로 기록되는지 검증**
+func TestProperty_ParamsHistory_RecordsEachChange(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("each params update is recorded with its height and proposal", prop.ForAll(
+		func(intervals []int64) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			msgServer := keeper.NewMsgServerImpl(*nettingKeeper)
+			queryServer := keeper.NewQueryServerImpl(*nettingKeeper)
+
+			for i, interval := range intervals {
+				params := nettingtypes.DefaultParams()
+				params.NettingInterval = interval
+				msg := nettingtypes.NewMsgUpdateParams(nettingKeeper.GetAuthority(), params)
+				msg.ProposalID = uint64(i + 1)
+				if _, err := msgServer.UpdateParams(ctx.WithBlockHeight(int64(10*(i+1))), msg); err != nil {
+					return false
+				}
+			}
+
+			// Rejected updates are not recorded
+			if _, err := msgServer.UpdateParams(ctx, nettingtypes.NewMsgUpdateParams(nettingKeeper.GetAuthority(), nettingtypes.Params{})); err == nil {
+				return false
+			}
+
+			response, err := queryServer.ParamsHistory(ctx, &nettingtypes.QueryParamsHistoryRequest{})
+			if err != nil || len(response.Changes) != len(intervals) {
+				return false
+			}
+			for i, change := range response.Changes {
+				if change.Version != uint64(i+1) || change.ProposalID != uint64(i+1) || change.Height != int64(10*(i+1)) ||
+					change.Params.NettingInterval != intervals[i] {
+					return false
+				}
+			}
+			return nettingKeeper.GetParams(ctx).NettingInterval == intervals[len(intervals)-1]
+		},
+		gen.SliceOfN(5, gen.Int64Range(1, 1000)),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.20: 넷팅 예측**
+// **검증: 요구사항 4.1 - 대기 중인 이체를 포함한 예측이 확정 후 실제 상계 결과와 일치하는지 검증**
+func TestProperty_ForecastNetting_MatchesNettingAfterConfirmation(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("the forecast equals the netting once pending credit is issued", prop.ForAll(
+		func(amountAtoB, amountBtoA, amountCtoA math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			ctx = ctx.WithBlockHeight(100)
+
+			confirmed := types.CreditToken{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amountAtoB, OriginTx: "tx-a"}
+			if err := nettingKeeper.IssueCreditToken(ctx, confirmed); err != nil {
+				return false
+			}
+			pending := []types.CreditToken{
+				{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amountBtoA, OriginTx: "tx-b"},
+				{Denom: "cred-bank-c", IssuerBank: "bank-c", HolderBank: "bank-a", Amount: amountCtoA, OriginTx: "tx-c"},
+			}
+
+			// Only the pending transfer back to bank-a makes a pair
+			forecast := nettingKeeper.ForecastNetting(ctx, pending)
+			if forecast.BlockHeight != 100 || forecast.PendingTransfers != 2 ||
+				!forecast.PendingAmount.Equal(amountBtoA.Add(amountCtoA)) || len(forecast.Pairs) != 1 {
+				return false
+			}
+			pairs, err := nettingKeeper.CalculateNetting(ctx)
+			if err != nil || len(pairs) != 0 {
+				return false
+			}
+
+			for _, token := range pending {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+			pairs, err = nettingKeeper.CalculateNetting(ctx)
+			if err != nil || len(pairs) != 1 || !pairs[0].NetAmount.Equal(forecast.Pairs[0].NetAmount) ||
+				pairs[0].NetDebtor != forecast.Pairs[0].NetDebtor {
+				return false
+			}
+
+			// Positions are sorted by bank and include the confirmed debt of
+			// bank-a, which only holds credit once the pending transfers confirm
+			if len(forecast.Positions) != 3 {
+				return false
+			}
+			for _, position := range forecast.Positions {
+				if !position.Projected.Equal(position.Confirmed.Add(position.Pending)) {
+					return false
+				}
+			}
+			bankA, bankC := forecast.Positions[0], forecast.Positions[2]
+			return bankA.Bank == "bank-a" && bankA.Confirmed.Equal(amountAtoB.Neg()) &&
+				bankA.Projected.Equal(amountBtoA.Add(amountCtoA).Sub(amountAtoB)) &&
+				bankC.Bank == "bank-c" && bankC.Projected.Equal(amountCtoA.Neg())
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.21: 동일 금액 상계의 결정적 처리**
+// **검증: 요구사항 4.1 - 서로 같은 금액을 빚진 은행 쌍이 입력 순서와 관계없이 사전순으로 앞선 은행을 순채무자로 같은 순서로 상계되는지 검증**
+func TestProperty_Netting_EqualAmountsBreakTiesByBankID(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("equal obligations net the same whatever the input order", prop.ForAll(
+		func(amount math.Int, maxPairs int) bool {
+			banks := []string{"bank-c", "bank-a", "bank-b"}
+			var obligations []nettingtypes.Obligation
+			for _, debtor := range banks {
+				for _, creditor := range banks {
+					if debtor != creditor {
+						obligations = append(obligations, nettingtypes.Obligation{Debtor: debtor, Creditor: creditor, Amount: amount})
+					}
+				}
+			}
+			reversed := make([]nettingtypes.Obligation, len(obligations))
+			for i, ob := range obligations {
+				reversed[len(obligations)-1-i] = ob
+			}
+
+			pairs := nettingtypes.CalculateBilateralPairs(obligations)
+			if len(pairs) != 3 || fmt.Sprint(pairs) != fmt.Sprint(nettingtypes.CalculateBilateralPairs(reversed)) {
+				return false
+			}
+			for _, pair := range pairs {
+				if pair.BankA >= pair.BankB || pair.NetDebtor != pair.BankA || !pair.NetAmount.IsZero() {
+					return false
+				}
+			}
+
+			// The banks may be given in either order
+			if fmt.Sprint(types.NewBankPair("bank-b", "bank-a", amount, amount, types.PriorityNormal)) !=
+				fmt.Sprint(types.NewBankPair("bank-a", "bank-b", amount, amount, types.PriorityNormal)) {
+				return false
+			}
+
+			// Pairs of the same priority are selected by bank ID
+			backwards := []types.BankPair{pairs[2], pairs[1], pairs[0]}
+			selected, deferred := nettingtypes.PrioritizePairs(backwards, maxPairs)
+			if len(selected) != maxPairs || len(selected)+len(deferred) != 3 {
+				return false
+			}
+			for i, pair := range append(selected, deferred...) {
+				if pair.BankA != pairs[i].BankA || pair.BankB != pairs[i].BankB {
+					return false
+				}
+			}
+
+			// On chain, equal mutual credit nets to zero with bank-a as net debtor
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			ctx = ctx.WithBlockHeight(100)
+			for _, token := range []types.CreditToken{
+				{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amount, OriginTx: "tx-b"},
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amount, OriginTx: "tx-a"},
+			} {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+			onChain, err := nettingKeeper.CalculateNetting(ctx)
+			if err != nil || len(onChain) != 1 || onChain[0].NetDebtor != "bank-a" || !onChain[0].NetAmount.IsZero() {
+				return false
+			}
+			if err := nettingKeeper.TriggerNetting(ctx); err != nil {
+				return false
+			}
+			position := nettingKeeper.GetDebtPosition(ctx, "bank-a", "bank-b")
+			return position.TotalAFromB.IsZero() && position.TotalBFromA.IsZero()
+		},
+		testhelpers.GenValidAmount(),
+		gen.IntRange(1, 3),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.22: 크레딧 계보 추적**
+// **검증: 요구사항 7.1 - 이전, 분할, 상계 소각과 정산 명령을 거친 크레딧이 원래의 Besu 거래까지 추적되고 미사용 로트의 합이 잔액과 같은지 검증**
+func TestProperty_CreditLineage_TracesBalancesToBesuTransfers(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("every burn and balance traces back to the transfer the credit was issued for", prop.ForAll(
+		func(amountAtoB, amountBtoA math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			ctx = ctx.WithBlockHeight(100)
+			nettingKeeper.SetMultisigKeeper(NewMockMultisigKeeper())
+			queryServer := keeper.NewQueryServerImpl(*nettingKeeper)
+
+			params := nettingtypes.DefaultParams()
+			params.SettlementAccounts = []nettingtypes.SettlementAccount{
+				{BankID: "bank-a", Address: "0x00000000000000000000000000000000000000aa"},
+				{BankID: "bank-b", Address: "0x00000000000000000000000000000000000000bb"},
+			}
+			nettingKeeper.SetParams(ctx, params)
+
+			for _, token := range []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amountAtoB, OriginTx: "tx-a"},
+				{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amountBtoA, OriginTx: "tx-b"},
+			} {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+			issued := nettingKeeper.GetCreditLots(ctx, "bank-b", "cred-bank-a")
+			if len(issued) != 1 || !issued[0].Amount.Equal(amountAtoB) {
+				return false
+			}
+
+			// Part of the credit is assigned to bank-c and back, splitting the lot
+			if part := amountAtoB.QuoRaw(2); part.IsPositive() {
+				if nettingKeeper.TransferCreditToken(ctx, "bank-b", "bank-c", "cred-bank-a", part) != nil ||
+					nettingKeeper.TransferCreditToken(ctx, "bank-c", "bank-b", "cred-bank-a", part) != nil {
+					return false
+				}
+				if len(nettingKeeper.GetCreditLots(ctx, "bank-b", "cred-bank-a")) != 2 ||
+					len(nettingKeeper.GetCreditLots(ctx, "bank-c", "cred-bank-a")) != 0 {
+					return false
+				}
+			}
+
+			pairs, err := nettingKeeper.CalculateNetting(ctx)
+			if err != nil || nettingKeeper.ExecuteNetting(ctx, pairs) != nil {
+				return false
+			}
+
+			// The unspent lots make up every balance
+			for _, holding := range [][2]string{{"bank-b", "cred-bank-a"}, {"bank-a", "cred-bank-b"}, {"bank-c", "cred-bank-a"}} {
+				response, err := queryServer.CreditLots(ctx, &nettingtypes.QueryCreditLotsRequest{Bank: holding[0], Denom: holding[1]})
+				if err != nil {
+					return false
+				}
+				sum := math.ZeroInt()
+				for _, lot := range response.Lots {
+					sum = sum.Add(lot.Amount)
+				}
+				if !sum.Equal(response.Balance) {
+					return false
+				}
+			}
+
+			// The lineage of the issued lot reaches the netting and settlement
+			// burns, which account for all the credit no longer held
+			response, err := queryServer.CreditLineage(ctx, &nettingtypes.QueryCreditLineageRequest{TokenID: issued[0].ID})
+			if err != nil || len(response.Origins) != 1 || response.Origins[0].Reference != "tx-a" {
+				return false
+			}
+			settlement, settled := nettingKeeper.GetCycleSettlement(ctx, 100)
+			burned := math.ZeroInt()
+			for _, node := range response.Lineage.Nodes {
+				switch node.Kind {
+				case nettingtypes.LineageKindNetting:
+					if node.Reference != "100" {
+						return false
+					}
+				case nettingtypes.LineageKindSettlement:
+					if !settled || node.Reference != settlement.Commands[0].CommandID {
+						return false
+					}
+				default:
+					continue
+				}
+				burned = burned.Add(node.Amount)
+			}
+			if !burned.Equal(amountAtoB.Sub(nettingKeeper.GetCreditBalance(ctx, "bank-b", "cred-bank-a"))) {
+				return false
+			}
+
+			// Every burn traces back to the transfer alone
+			for _, node := range response.Lineage.Nodes {
+				if node.Kind != nettingtypes.LineageKindNetting && node.Kind != nettingtypes.LineageKindSettlement {
+					continue
+				}
+				burn, err := queryServer.CreditLineage(ctx, &nettingtypes.QueryCreditLineageRequest{TokenID: node.ID})
+				if err != nil || len(burn.Origins) != 1 || burn.Origins[0].Reference != "tx-a" {
+					return false
+				}
+			}
+
+			_, err = queryServer.CreditLineage(ctx, &nettingtypes.QueryCreditLineageRequest{TokenID: 1000})
+			return errors.Is(err, nettingtypes.ErrLineageNodeNotFound)
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.23: 은행 목록 조회**
+// **검증: 요구사항 4.1 - 등록된 은행과 미결제 크레딧을 보유하거나 발행한 은행이 모두 미결제 크레딧 수와 함께 조회되는지 검증**
+func TestProperty_Banks_ListsRegisteredAndCreditBanks(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("banks come from the registry and the credit index with their outstanding credit", prop.ForAll(
+		func(amountAtoB, amountBtoA, amountAtoC math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			queryServer := keeper.NewQueryServerImpl(*nettingKeeper)
+
+			// bank-d is registered but holds and issued no credit
+			for _, account := range []nettingtypes.BankAccount{
+				{Address: sdk.AccAddress([]byte("bank-a-signer-addr")).String(), BankID: "bank-a"},
+				{Address: sdk.AccAddress([]byte("bank-d-signer-addr")).String(), BankID: "bank-d"},
+				{Address: sdk.AccAddress([]byte("bank-d-backup-addr")).String(), BankID: "bank-d"},
+			} {
+				if nettingKeeper.SetBankAccount(ctx, account) != nil {
+					return false
+				}
+			}
+			for _, token := range []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amountAtoB, OriginTx: "tx-1"},
+				{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amountBtoA, OriginTx: "tx-2"},
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-c", Amount: amountAtoC, OriginTx: "tx-3"},
+			} {
+				if nettingKeeper.InitCreditToken(ctx, token) != nil {
+					return false
+				}
+			}
+
+			response, err := queryServer.Banks(ctx, &nettingtypes.QueryBanksRequest{})
+			if err != nil || fmt.Sprint(response.Banks) != fmt.Sprint([]nettingtypes.BankSummary{
+				{BankID: "bank-a", Accounts: 1, HeldCredits: 1, IssuedCredits: 2},
+				{BankID: "bank-b", HeldCredits: 1, IssuedCredits: 1},
+				{BankID: "bank-c", HeldCredits: 1},
+				{BankID: "bank-d", Accounts: 2},
+			}) {
+				return false
+			}
+
+			// A bank without accounts is no longer known once its credit is gone
+			if nettingKeeper.TransferCreditToken(ctx, "bank-c", "bank-b", "cred-bank-a", amountAtoC) != nil {
+				return false
+			}
+			response, err = queryServer.Banks(ctx, &nettingtypes.QueryBanksRequest{})
+			return err == nil && fmt.Sprint(response.Banks) == fmt.Sprint([]nettingtypes.BankSummary{
+				{BankID: "bank-a", Accounts: 1, HeldCredits: 1, IssuedCredits: 1},
+				{BankID: "bank-b", HeldCredits: 1, IssuedCredits: 1},
+				{BankID: "bank-d", Accounts: 2},
+			})
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.24: 크레딧 단위 이전**
+// **검증: 요구사항 7.1 - 예행 실행이 상태를 바꾸지 않고, 예정된 높이에서 토큰, 잔액, 로트와 진행 중인 주기의 스냅샷이 함께 새 단위로 바뀌는지 검증**
+func TestProperty_DenomMigration_RenamesAtomicallyAtHeight(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("a scheduled migration renames every use of a denom at its height", prop.ForAll(
+		func(amount, frozenAmount math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			ctx = ctx.WithBlockHeight(20)
+			msgServer := keeper.NewMsgServerImpl(*nettingKeeper)
+			queryServer := keeper.NewQueryServerImpl(*nettingKeeper)
+
+			short := amount.QuoRaw(2)
+			for _, token := range []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amount, OriginTx: "tx-1"},
+				{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: short, OriginTx: "tx-2"},
+				{Denom: "cred-bank-c", IssuerBank: "bank-c", HolderBank: "bank-a", Amount: frozenAmount, OriginTx: "tx-3"},
+			} {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+			if _, err := nettingKeeper.FreezeCredit(ctx, "bank-a", "cred-bank-c", frozenAmount); err != nil {
+				return false
+			}
+
+			// A partial burn leaves the cycle in progress with its snapshot
+			pairs := []types.BankPair{{BankA: "bank-a", BankB: "bank-b", AmountA: amount, AmountB: amount}}
+			if err := nettingKeeper.ExecuteNetting(ctx, pairs); err == nil {
+				return false
+			}
+			cycleID := uint64(ctx.BlockHeight())
+
+			renames := []nettingtypes.DenomRename{{From: "cred-bank-a", To: "cred-bank-a:base"}}
+			dryRun, err := queryServer.DenomMigrationDryRun(ctx, &nettingtypes.QueryDenomMigrationDryRunRequest{Renames: renames})
+			if err != nil || !dryRun.Report.DryRun || len(dryRun.Report.Renames) != 1 {
+				return false
+			}
+			if report := dryRun.Report.Renames[0]; report.Balances != 1 || report.Lots != 1 || report.Snapshots != 1 {
+				return false
+			}
+			if len(nettingKeeper.GetCreditLots(ctx, "bank-b", "cred-bank-a")) != 1 ||
+				len(nettingKeeper.GetCreditLots(ctx, "bank-b", "cred-bank-a:base")) != 0 {
+				return false
+			}
+
+			// Credit frozen by a dispute keeps its denom
+			frozen := nettingtypes.DenomMigration{Height: 30, Renames: []nettingtypes.DenomRename{{From: "cred-bank-c", To: "cred-bank-c:base"}}}
+			if _, err := msgServer.ScheduleDenomMigration(ctx, nettingtypes.NewMsgScheduleDenomMigration(nettingKeeper.GetAuthority(), frozen)); !errors.Is(err, nettingtypes.ErrInvalidDenomMigration) {
+				return false
+			}
+
+			migration := nettingtypes.DenomMigration{Height: 30, Renames: renames}
+			if _, err := msgServer.ScheduleDenomMigration(ctx, nettingtypes.NewMsgScheduleDenomMigration("not-authority", migration)); !errors.Is(err, nettingtypes.ErrUnauthorized) {
+				return false
+			}
+			if _, err := msgServer.ScheduleDenomMigration(ctx, nettingtypes.NewMsgScheduleDenomMigration(nettingKeeper.GetAuthority(), nettingtypes.DenomMigration{Height: 20, Renames: renames})); !errors.Is(err, nettingtypes.ErrInvalidDenomMigration) {
+				return false
+			}
+			if _, err := msgServer.ScheduleDenomMigration(ctx, nettingtypes.NewMsgScheduleDenomMigration(nettingKeeper.GetAuthority(), migration)); err != nil {
+				return false
+			}
+
+			// Nothing changes before the height
+			nettingKeeper.ApplyDenomMigration(ctx.WithBlockHeight(29))
+			if _, found := nettingKeeper.GetPendingDenomMigration(ctx); !found {
+				return false
+			}
+
+			later := ctx.WithBlockHeight(30)
+			nettingKeeper.ApplyDenomMigration(later)
+			response, err := queryServer.DenomMigration(later, &nettingtypes.QueryDenomMigrationRequest{})
+			if err != nil || response.Pending != nil || response.Last == nil || response.Last.DryRun || response.Last.Height != 30 {
+				return false
+			}
+			if len(nettingKeeper.GetCreditLots(later, "bank-b", "cred-bank-a")) != 0 ||
+				len(nettingKeeper.GetCreditLots(later, "bank-b", "cred-bank-a:base")) != 1 {
+				return false
+			}
+
+			// Cancelling the cycle restores the balance under the new denom
+			if _, err := msgServer.CancelNettingCycle(later, nettingtypes.NewMsgCancelNettingCycle(nettingKeeper.GetAuthority(), cycleID, "partial burn")); err != nil {
+				return false
+			}
+			return nettingKeeper.GetCreditBalance(later, "bank-b", "cred-bank-a").IsZero() &&
+				nettingKeeper.GetCreditBalance(later, "bank-b", "cred-bank-a:base").Equal(amount) &&
+				nettingKeeper.GetCreditBalance(later, "bank-a", "cred-bank-c").Equal(frozenAmount)
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.25: 블록 내 메시지 단계 순서**
+// **검증: 요구사항 4.2 - 같은 블록의 신용 발행이 상계 트리거보다 먼저 실행되도록 트랜잭션이 정렬되고, 순서를 어긴 제안이 거부되는지 검증**
+func TestProperty_MsgPhase_ConfirmationsRunBeforeNetting(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("a trigger proposed first nets the credit issued in its block", prop.ForAll(
+		func(amountAtoB, amountBtoA math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			msgServer := keeper.NewMsgServerImpl(*nettingKeeper)
+			ctx = ctx.WithBlockHeight(100)
+			authority := nettingKeeper.GetAuthority()
+
+			signerA := sdk.AccAddress([]byte("bank-a-signer-addr")).String()
+			signerB := sdk.AccAddress([]byte("bank-b-signer-addr")).String()
+			for address, bankID := range map[string]string{signerA: "bank-a", signerB: "bank-b"} {
+				if _, err := msgServer.SetBankAccount(ctx, nettingtypes.NewMsgSetBankAccount(authority, address, bankID)); err != nil {
+					return false
+				}
+			}
+
+			trigger := nettingtypes.NewMsgTriggerNetting(authority)
+			issueAtoB := nettingtypes.NewMsgIssueCreditToken(signerA, types.CreditToken{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amountAtoB, OriginTx: "tx-a"})
+			issueBtoA := nettingtypes.NewMsgIssueCreditToken(signerB, types.CreditToken{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amountBtoA, OriginTx: "tx-b"})
+			proposal := [][]sdk.Msg{{trigger}, {issueAtoB}, {trigger, issueBtoA}, {issueBtoA}}
+
+			// Proposals with a trigger ahead of a confirmation are rejected
+			if types.ValidatePhaseOrder(proposal) == nil {
+				return false
+			}
+
+			// Ordering moves the trigger last and defers the transaction
+			// whose own messages are out of phase order
+			order := types.OrderByPhase(proposal)
+			if len(order) != 3 || order[0] != 1 || order[1] != 3 || order[2] != 0 {
+				return false
+			}
+			block := make([][]sdk.Msg, len(order))
+			for i, index := range order {
+				block[i] = proposal[index]
+			}
+			if types.ValidatePhaseOrder(block) != nil {
+				return false
+			}
+
+			var resp *nettingtypes.MsgTriggerNettingResponse
+			for _, msgs := range block {
+				for _, msg := range msgs {
+					var err error
+					switch msg := msg.(type) {
+					case *nettingtypes.MsgIssueCreditToken:
+						_, err = msgServer.IssueCreditToken(ctx, msg)
+					case *nettingtypes.MsgTriggerNetting:
+						resp, err = msgServer.TriggerNetting(ctx, msg)
+					}
+					if err != nil {
+						return false
+					}
+				}
+			}
+
+			netted := math.MinInt(amountAtoB, amountBtoA)
+			return resp != nil && resp.NetCount == 1 &&
+				resp.NetAmounts["bank-a"].Equal(netted) &&
+				types.MsgPhaseOf(trigger) == types.MsgPhaseNetting &&
+				types.MsgPhaseOf(issueAtoB) == types.MsgPhaseConfirmation
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.26: 다자간 상계 루프 압축**
+// **검증: 요구사항 4.2 - 다자간 상계가 켜지면 쌍방 상계로 남은 채무 루프가 압축되고, 각 은행의 순포지션은 변하지 않는지 검증**
+func TestProperty_MultilateralNetting_CompressesLoops(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("loop compression keeps net positions", prop.ForAll(
+		func(amountAtoB, amountBtoC, amountCtoA math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			ctx = ctx.WithBlockHeight(100)
+
+			for _, token := range []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amountAtoB, OriginTx: "tx-a"},
+				{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-c", Amount: amountBtoC, OriginTx: "tx-b"},
+				{Denom: "cred-bank-c", IssuerBank: "bank-c", HolderBank: "bank-a", Amount: amountCtoA, OriginTx: "tx-c"},
+			} {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+			before := nettingtypes.CalculateNetPositions(nettingKeeper.GetObligations(ctx))
+
+			// Bilateral netting finds nothing to net in a loop
+			if !errors.Is(nettingKeeper.TriggerNetting(ctx), nettingtypes.ErrNettingNotRequired) {
+				return false
+			}
+
+			params := nettingtypes.DefaultParams()
+			params.MultilateralNetting = true
+			nettingKeeper.SetParams(ctx, params)
+			if err := nettingKeeper.TriggerNetting(ctx); err != nil {
+				return false
+			}
+
+			compressed := math.MinInt(amountAtoB, math.MinInt(amountBtoC, amountCtoA))
+			cycle, found := nettingKeeper.GetNettingCycle(ctx, 100)
+			if !found || len(cycle.Loops) != 1 || !cycle.Loops[0].Amount.Equal(compressed) ||
+				strings.Join(cycle.Loops[0].Banks, ",") != "bank-a,bank-b,bank-c" {
+				return false
+			}
+			if !nettingKeeper.GetCreditBalance(ctx, "bank-b", "cred-bank-a").Equal(amountAtoB.Sub(compressed)) ||
+				!nettingKeeper.GetCreditBalance(ctx, "bank-c", "cred-bank-b").Equal(amountBtoC.Sub(compressed)) ||
+				!nettingKeeper.GetCreditBalance(ctx, "bank-a", "cred-bank-c").Equal(amountCtoA.Sub(compressed)) {
+				return false
+			}
+
+			after := nettingtypes.CalculateNetPositions(nettingKeeper.GetObligations(ctx))
+			for _, bank := range []string{"bank-a", "bank-b", "bank-c"} {
+				position, ok := after[bank]
+				if !ok {
+					position = math.ZeroInt() // Fully compressed banks drop out
+				}
+				if !position.Equal(before[bank]) {
+					return false
+				}
+			}
+			return true
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.27: 일일 정산 보고서**
+// **검증: 요구사항 7.5 - 하루 동안의 확정 이체, 신용 발행/소각, 상계 주기, 정산액과 명령 수가 감사 로그 시간 색인에서 집계되는지 검증**
+func TestProperty_DailyReport_AggregatesCalendarDay(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("a daily report sums the day's activity", prop.ForAll(
+		func(amountAtoB, amountBtoA math.Int, secondOfDay int64) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			day := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+			ctx = ctx.WithBlockHeight(100).WithBlockTime(day.Add(time.Duration(secondOfDay) * time.Second))
+			oracleKeeper := NewMockOracleKeeper()
+			nettingKeeper.SetOracleKeeper(oracleKeeper)
+			multisigKeeper := NewMockMultisigKeeper()
+			nettingKeeper.SetMultisigKeeper(multisigKeeper)
+			queryServer := keeper.NewQueryServerImpl(*nettingKeeper)
+
+			params := nettingtypes.DefaultParams()
+			params.SettlementAccounts = []nettingtypes.SettlementAccount{
+				{BankID: "bank-a", Address: "0x00000000000000000000000000000000000000aa"},
+				{BankID: "bank-b", Address: "0x00000000000000000000000000000000000000bb"},
+			}
+			nettingKeeper.SetParams(ctx, params)
+
+			// Transfers of the day and of the next day
+			for _, timestamp := range []int64{ctx.BlockTime().Unix(), day.Add(24 * time.Hour).Unix()} {
+				_, _ = oracleKeeper.SaveAuditLog(ctx, types.AuditLog{
+					EventType: types.EventTypeTransferConfirmed,
+					Timestamp: timestamp,
+					Details:   map[string]string{"amount": amountAtoB.String()},
+				})
+			}
+			for _, token := range []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amountAtoB, OriginTx: "tx-1"},
+				{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amountBtoA, OriginTx: "tx-2"},
+			} {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+			if err := nettingKeeper.TriggerNetting(ctx); err != nil {
+				return false
+			}
+
+			if _, err := queryServer.DailyReport(ctx, &nettingtypes.QueryDailyReportRequest{Date: "10/03/2026"}); err == nil {
+				return false
+			}
+			resp, err := queryServer.DailyReport(ctx, &nettingtypes.QueryDailyReportRequest{Date: "2026-03-10"})
+			if err != nil {
+				return false
+			}
+			report := resp.Report
+
+			netted := math.MinInt(amountAtoB, amountBtoA)
+			residual := amountAtoB.Sub(amountBtoA).Abs()
+			commands := int32(0)
+			if residual.IsPositive() {
+				commands = 1
+			}
+			if report.StartTime != day.Unix() || report.EndTime != day.Unix()+86399 ||
+				report.TransfersConfirmed != 1 || !report.TransferVolume.Equal(amountAtoB) ||
+				report.CreditsIssued != 2 || !report.IssuedAmount.Equal(amountAtoB.Add(amountBtoA)) ||
+				report.CyclesExecuted != 1 || report.CyclesCancelled != 0 {
+				return false
+			}
+			if !report.NettedAmount.Equal(netted) || !report.SettledAmount.Equal(residual) ||
+				!report.BurnedAmount.Equal(netted.MulRaw(2).Add(residual)) ||
+				report.Commands != commands || report.CommandsExecuted != 0 {
+				return false
+			}
+			if len(report.Banks) != 2 || report.Banks[0].Bank != "bank-a" || report.Banks[1].Bank != "bank-b" {
+				return false
+			}
+			paid := report.Banks[0].Paid.Add(report.Banks[1].Paid)
+			received := report.Banks[0].Received.Add(report.Banks[1].Received)
+			if !report.Banks[0].Netted.Equal(netted) || !paid.Equal(residual) || !received.Equal(residual) {
+				return false
+			}
+
+			// The day before saw nothing
+			before := nettingKeeper.GetDailyReport(ctx, day.Add(-24*time.Hour))
+			return before.TransfersConfirmed == 0 && before.CyclesExecuted == 0 && len(before.Banks) == 0
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+		gen.Int64Range(0, 86399),
+	))
+
+	properties.TestingRun(t)
+}
+
+// bankStrategy is a netting strategy settling only the pairs of one bank, as
+// a liquidity-constrained strategy would
+type bankStrategy struct {
+	keeper *keeper.Keeper
+	bank   string
+	extra  []types.BankPair
+}
+
+func (s bankStrategy) CalculatePairs(ctx sdk.Context) ([]types.BankPair, error) {
+	var pairs []types.BankPair
+	for _, pair := range nettingtypes.CalculateBilateralPairs(s.keeper.GetObligations(ctx)) {
+		if pair.BankA == s.bank || pair.BankB == s.bank {
+			pairs = append(pairs, pair)
+		}
+	}
+	return append(pairs, s.extra...), nil
+}
+
+// **Feature: interbank-netting-engine, Property 5.28: 교체 가능한 상계 전략**
+// **검증: 요구사항 4.2 - 파라미터로 선택한 등록 전략이 상계 쌍을 계산하고, 미등록 전략과 잔액을 넘는 쌍은 거부되는지 검증**
+func TestProperty_NettingStrategy_SelectedByParams(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("the selected strategy calculates the pairs of a cycle", prop.ForAll(
+		func(amountAB, amountBA, amountCD, amountDC math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			ctx = ctx.WithBlockHeight(100)
+			authority := nettingKeeper.GetAuthority()
+
+			for _, token := range []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amountAB, OriginTx: "tx-ab"},
+				{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amountBA, OriginTx: "tx-ba"},
+				{Denom: "cred-bank-c", IssuerBank: "bank-c", HolderBank: "bank-d", Amount: amountCD, OriginTx: "tx-cd"},
+				{Denom: "cred-bank-d", IssuerBank: "bank-d", HolderBank: "bank-c", Amount: amountDC, OriginTx: "tx-dc"},
+			} {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+
+			// The default bilateral strategy nets every pair
+			pairs, err := nettingKeeper.CalculateNetting(ctx)
+			if err != nil || len(pairs) != 2 {
+				return false
+			}
+
+			params := nettingtypes.DefaultParams()
+			params.NettingStrategy = "bank-a-only"
+			if _, err := nettingKeeper.UpdateParams(ctx, authority, params, 0); !errors.Is(err, nettingtypes.ErrUnknownStrategy) {
+				return false
+			}
+			nettingKeeper.RegisterNettingStrategy("bank-a-only", bankStrategy{keeper: nettingKeeper, bank: "bank-a"})
+			nettingKeeper.RegisterNettingStrategy("overdrawn", bankStrategy{keeper: nettingKeeper, bank: "bank-a", extra: []types.BankPair{
+				types.NewBankPair("bank-c", "bank-d", amountDC.AddRaw(1), amountCD.AddRaw(1), types.PriorityNormal),
+			}})
+			if _, err := nettingKeeper.UpdateParams(ctx, authority, params, 0); err != nil {
+				return false
+			}
+
+			// Only the pair of bank-a is netted
+			if err := nettingKeeper.TriggerNetting(ctx); err != nil {
+				return false
+			}
+			cycle, found := nettingKeeper.GetNettingCycle(ctx, 100)
+			if !found || len(cycle.Pairs) != 1 || cycle.Pairs[0].BankA != "bank-a" && cycle.Pairs[0].BankB != "bank-a" {
+				return false
+			}
+			if !nettingKeeper.GetCreditBalance(ctx, "bank-d", "cred-bank-c").Equal(amountCD) {
+				return false
+			}
+
+			// Pairs beyond the credit balances are rejected before a cycle runs
+			params.NettingStrategy = "overdrawn"
+			if _, err := nettingKeeper.UpdateParams(ctx, authority, params, 0); err != nil {
+				return false
+			}
+			if _, err := nettingKeeper.CalculateNetting(ctx); !errors.Is(err, nettingtypes.ErrInsufficientBalance) {
+				return false
+			}
+
+			// Names are registered once and bilateral is reserved
+			for _, name := range []string{"bank-a-only", nettingtypes.NettingStrategyBilateral, ""} {
+				if !panics(func() { nettingKeeper.RegisterNettingStrategy(name, bankStrategy{}) }) {
+					return false
+				}
+			}
+			return strings.Join(nettingKeeper.GetNettingStrategies(), ",") == "bilateral,bank-a-only,overdrawn"
+		},
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// panics reports whether f panics
+func panics(f func()) (panicked bool) {
+	defer func() { panicked = recover() != nil }()
+	f()
+	return false
+}
+
+// **Feature: interbank-netting-engine, Property 5.29: 연속 상계 모드**
+// **검증: 요구사항 4.2 - 연속 상계 구간은 신용 발행 시 상호 잔액을 즉시 상계해 마이크로 주기로 기록하고, 다른 은행 쌍은 주기를 기다리는지 검증**
+func TestProperty_ContinuousNetting_OffsetsOnIssuance(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("continuous corridors offset reciprocal credit on issuance", prop.ForAll(
+		func(amountAB, amountBA, unit int64) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			ctx = ctx.WithBlockHeight(100).WithBlockTime(time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC))
+			oracleKeeper := NewMockOracleKeeper()
+			nettingKeeper.SetOracleKeeper(oracleKeeper)
+			queryServer := keeper.NewQueryServerImpl(*nettingKeeper)
+
+			params := nettingtypes.DefaultParams()
+			params.SettlementUnit = unit
+			params.ContinuousCorridors = []nettingtypes.ContinuousCorridor{{BankA: "bank-b", BankB: "bank-a"}}
+			if params.Validate() != nil {
+				return false
+			}
+			nettingKeeper.SetParams(ctx, params)
+
+			issue := func(issuer, holder string, amount int64) error {
+				return nettingKeeper.IssueCreditToken(ctx, types.CreditToken{
+					Denom:      types.CreditDenom(issuer, types.BaseCurrency),
+					IssuerBank: issuer,
+					HolderBank: holder,
+					Amount:     math.NewInt(amount),
+					OriginTx:   "tx-" + issuer,
+				})
+			}
+
+			// Credit without a reciprocal balance is left for the cycle
+			if issue("bank-a", "bank-b", amountAB) != nil || issue("bank-c", "bank-d", amountAB) != nil || issue("bank-d", "bank-c", amountBA) != nil {
+				return false
+			}
+			if _, err := queryServer.MicroCycle(ctx, &nettingtypes.QueryMicroCycleRequest{ID: 1}); !errors.Is(err, nettingtypes.ErrMicroCycleNotFound) {
+				return false
+			}
+
+			// The reciprocal credit is offset in whole settlement units
+			if issue("bank-b", "bank-a", amountBA) != nil {
+				return false
+			}
+			netted, _ := nettingtypes.SplitDust(math.NewInt(min(amountAB, amountBA)), unit)
+			resp, err := queryServer.MicroCycle(ctx, &nettingtypes.QueryMicroCycleRequest{ID: 1})
+			if netted.IsZero() {
+				if !errors.Is(err, nettingtypes.ErrMicroCycleNotFound) {
+					return false
+				}
+			} else {
+				micro := resp.MicroCycle
+				if err != nil || micro.BlockHeight != 100 || micro.OriginTx != "tx-bank-b" || !micro.Netted.Equal(netted) ||
+					micro.BankA == micro.BankB || !params.IsContinuousCorridor(micro.BankA, micro.BankB) {
+					return false
+				}
+			}
+			if !nettingKeeper.GetCreditBalance(ctx, "bank-b", "cred-bank-a").Equal(math.NewInt(amountAB).Sub(netted)) ||
+				!nettingKeeper.GetCreditBalance(ctx, "bank-a", "cred-bank-b").Equal(math.NewInt(amountBA).Sub(netted)) {
+				return false
+			}
+
+			// Other banks still wait for the periodic cycle
+			if !nettingKeeper.GetCreditBalance(ctx, "bank-d", "cred-bank-c").Equal(math.NewInt(amountAB)) {
+				return false
+			}
+
+			report := nettingKeeper.GetDailyReport(ctx, ctx.BlockTime())
+			if netted.IsZero() {
+				return report.MicroCycles == 0
+			}
+			return report.MicroCycles == 1 && report.NettedAmount.Equal(netted) && report.BurnedAmount.Equal(netted.MulRaw(2))
+		},
+		gen.Int64Range(1, 1000000),
+		gen.Int64Range(1, 1000000),
+		gen.OneConstOf(int64(1), int64(10), int64(1000)),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.30: 조회 캐시**
+// **검증: 요구사항 8.1 - 신용 잔액 조회는 캐시가 갱신된 높이에서만 메모리 저장소를 사용하고, 다음 블록에서는 저장소를 다시 읽는지 검증**
+func TestProperty_QueryCache_ServesBalancesOfRefreshedHeight(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("credit balance queries are cached per block", prop.ForAll(
+		func(amount, frozen int64, ibcCurrency bool) bool {
+			if frozen >= amount {
+				frozen = amount - 1
+			}
+			ctx, nettingKeeper := setupNettingTestEnvironmentWithMemStore(t)
+			ctx = ctx.WithBlockHeight(100)
+			nettingKeeper.SetOracleKeeper(NewMockOracleKeeper())
+			queryServer := keeper.NewQueryServerImpl(*nettingKeeper)
+
+			// Denoms of IBC currencies contain '/', split after the bank
+			currency := types.BaseCurrency
+			if ibcCurrency {
+				currency = "ibc/27394FB092D2ECCD"
+			}
+			denom := types.CreditDenom("bank-a", currency)
+			balance := func(ctx sdk.Context) (nettingtypes.CreditBalanceSummary, bool) {
+				res, err := queryServer.CreditBalance(ctx, &nettingtypes.QueryCreditBalanceRequest{Bank: "bank-b", Denom: denom})
+				if err != nil {
+					return nettingtypes.CreditBalanceSummary{}, false
+				}
+				return res.Balance, true
+			}
+
+			if nettingKeeper.IssueCreditToken(ctx, types.CreditToken{
+				Denom:      denom,
+				IssuerBank: "bank-a",
+				HolderBank: "bank-b",
+				Amount:     math.NewInt(amount),
+				OriginTx:   "tx-bank-a",
+			}) != nil {
+				return false
+			}
+			if _, err := nettingKeeper.FreezeCredit(ctx, "bank-b", denom, math.NewInt(frozen)); err != nil {
+				return false
+			}
+
+			// Disabled, the cache is neither filled nor read
+			nettingKeeper.RefreshQueryCache(ctx)
+			if summary, ok := balance(ctx); !ok || !summary.Balance.Equal(math.NewInt(amount)) {
+				return false
+			}
+
+			nettingKeeper.EnableQueryCache()
+			queryServer = keeper.NewQueryServerImpl(*nettingKeeper)
+			nettingKeeper.RefreshQueryCache(ctx)
+			if err := nettingKeeper.TransferCreditToken(ctx, "bank-b", "bank-c", denom, math.NewInt(amount-frozen)); err != nil {
+				return false
+			}
+
+			// Queries of the refreshed height are served from the cache
+			summary, ok := balance(ctx)
+			if !ok || summary.Bank != "bank-b" || summary.Denom != denom || !summary.Balance.Equal(math.NewInt(amount)) ||
+				!summary.Frozen.Equal(math.NewInt(frozen)) || !summary.Available.Equal(math.NewInt(amount-frozen)) {
+				return false
+			}
+
+			// The next block reads the store until it refreshes the cache
+			next := ctx.WithBlockHeight(101)
+			if summary, ok := balance(next); !ok || !summary.Balance.Equal(math.NewInt(frozen)) {
+				return false
+			}
+			nettingKeeper.RefreshQueryCache(next)
+			if summary, ok := balance(next); !ok || !summary.Balance.Equal(math.NewInt(frozen)) {
+				return false
+			}
+
+			_, err := queryServer.CreditBalance(ctx, &nettingtypes.QueryCreditBalanceRequest{Bank: "bank-b"})
+			return err != nil
+		},
+		gen.Int64Range(2, 1000000),
+		gen.Int64Range(1, 1000000),
+		gen.Bool(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.31: 실패 사유 코드**
+// **검증: 요구사항 12.3 - 실패하거나 취소된 주기가 기록과 이벤트에 열거형 사유 코드를 남기는지 검증**
+func TestProperty_ReasonCodes_OnFailedAndCancelledCycles(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("failed and cancelled cycles carry reason codes", prop.ForAll(
+		func(amount math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			ctx = ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+			nettingKeeper.SetOracleKeeper(NewMockOracleKeeper())
+
+			for _, token := range []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amount, OriginTx: "tx-1"},
+				{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amount, OriginTx: "tx-2"},
+			} {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+
+			// A burn of more than is held fails the cycle for insufficient balance
+			tooMuch := []types.BankPair{{BankA: "bank-a", BankB: "bank-b", AmountA: amount.AddRaw(1), AmountB: amount.AddRaw(1)}}
+			if err := nettingKeeper.ExecuteNettingWithRollback(ctx, tooMuch); err == nil {
+				return false
+			}
+			cycle, found := nettingKeeper.GetNettingCycle(ctx, uint64(ctx.BlockHeight()))
+			if !found || cycle.ReasonCode != types.ReasonInsufficientBalance {
+				return false
+			}
+
+			failed := map[string]string{}
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == nettingtypes.EventTypeNettingFailed {
+					for _, attribute := range event.Attributes {
+						failed[attribute.Key] = attribute.Value
+					}
+				}
+			}
+			if failed[types.AttributeKeyReasonCode] != string(types.ReasonInsufficientBalance) ||
+				failed[nettingtypes.AttributeKeyCycleID] != fmt.Sprint(ctx.BlockHeight()) || failed[types.AttributeKeyReason] == "" {
+				return false
+			}
+
+			// Errors map to codes by their registered sentinel
+			return nettingtypes.FailureReason(nettingtypes.ErrNettingInProgress) == types.ReasonInvalidState &&
+				nettingtypes.FailureReason(errors.New("boom")) == types.ReasonInternal &&
+				types.ReasonGovernance.IsValid() && !types.ReasonCode("partial burn").IsValid()
+		},
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.32: 지급준비금 증명**
+// **검증: 요구사항 12.4 - 은행별 발행 신용 잔액과 보고된 준비금 비교 증명이 저장되고 조회되는지 검증**
+func TestProperty_ReserveAttestation_ComparesOutstandingCreditWithReserves(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("reserve attestations compare outstanding credit with reserves", prop.ForAll(
+		func(amount math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			ctx = ctx.WithBlockHeight(30).WithEventManager(sdk.NewEventManager())
+			oracleKeeper := NewMockOracleKeeper()
+			nettingKeeper.SetOracleKeeper(oracleKeeper)
+
+			for _, token := range []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amount, OriginTx: "tx-1"},
+				{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amount, OriginTx: "tx-2"},
+			} {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+
+			// Reserves short of the credit held by bank-b are not covered, and
+			// bank-b is attested without reserves
+			reserves := []nettingtypes.ReserveAmount{{Currency: types.BaseCurrency, Amount: amount.SubRaw(1)}}
+			if _, err := nettingKeeper.SetReserveReport(ctx, "reporter", "bank-a", reserves); err != nil {
+				return false
+			}
+			attestations := nettingKeeper.AttestReserves(ctx)
+			if len(attestations) != 2 || attestations[0].Bank != "bank-a" || attestations[0].Covered ||
+				len(attestations[0].Lines) != 1 || !attestations[0].Lines[0].Outstanding.Equal(amount) ||
+				attestations[1].Bank != "bank-b" || attestations[1].Covered || attestations[1].ReportHeight != 0 {
+				return false
+			}
+			if !bytes.Equal(attestations[0].StatementHash, attestations[0].ComputeStatementHash()) {
+				return false
+			}
+
+			// A later report covering the credit is attested under the next ID
+			reserves[0].Amount = amount
+			if _, err := nettingKeeper.SetReserveReport(ctx.WithBlockHeight(31), "reporter", "bank-a", reserves); err != nil {
+				return false
+			}
+			latest := nettingKeeper.AttestBankReserves(ctx.WithBlockHeight(32), "bank-a")
+			if latest.ID != 3 || !latest.Covered || latest.ReportHeight != 31 {
+				return false
+			}
+			stored, found := nettingKeeper.GetLatestReserveAttestation(ctx, "bank-a")
+			if !found || stored.ID != latest.ID || !bytes.Equal(stored.StatementHash, latest.StatementHash) {
+				return false
+			}
+			if first, found := nettingKeeper.GetReserveAttestation(ctx, "bank-a", 1); !found || first.Covered {
+				return false
+			}
+
+			// Each attestation is audited for the attested bank
+			var audited []string
+			for _, log := range oracleKeeper.logs {
+				if log.EventType == types.EventTypeReservesAttested {
+					audited = append(audited, log.Details["attested_bank"])
+				}
+			}
+			if len(audited) != 3 || audited[2] != "bank-a" {
+				return false
+			}
+
+			// Negative and duplicate reserves are rejected
+			if _, err := nettingKeeper.SetReserveReport(ctx, "reporter", "bank-a", []nettingtypes.ReserveAmount{
+				{Currency: types.BaseCurrency, Amount: math.NewInt(-1)},
+			}); err == nil {
+				return false
+			}
+			_, err := nettingKeeper.SetReserveReport(ctx, "reporter", "bank-a", []nettingtypes.ReserveAmount{reserves[0], reserves[0]})
+			return err != nil
+		},
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.33: 네팅 세트**
+// **검증: 요구사항 4.2 - 네팅 세트별로 주기가 실행되고 다른 세트의 통화는 상계되지 않는지 검증**
+func TestProperty_NettingSets_CyclesNetOneSetEach(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("each cycle nets the currencies of one netting set", prop.ForAll(
+		func(amount math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			ctx = ctx.WithBlockHeight(20)
+
+			params := nettingtypes.DefaultParams()
+			params.NettingSets = []nettingtypes.NettingSet{
+				{ID: "usd-agreement", Currencies: []string{types.BaseCurrency, "usd"}},
+				{ID: "eur-agreement", Currencies: []string{"eur"}},
+			}
+			nettingKeeper.SetParams(ctx, params)
+
+			// Base credit of bank-a nets against usd credit of bank-b at par
+			for _, token := range []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amount, OriginTx: "tx-1"},
+				{Denom: "cred-bank-b:usd", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amount, OriginTx: "tx-2"},
+				{Denom: "cred-bank-a:eur", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amount, OriginTx: "tx-3"},
+				{Denom: "cred-bank-b:eur", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amount.MulRaw(2), OriginTx: "tx-4"},
+			} {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+
+			if err := nettingKeeper.TriggerNetting(ctx); err != nil {
+				return false
+			}
+			cycle, found := nettingKeeper.GetNettingCycle(ctx, 20)
+			if !found || cycle.NettingSet != "usd-agreement" || len(cycle.Pairs) != 1 {
+				return false
+			}
+			if !nettingKeeper.GetCreditBalance(ctx, "bank-b", "cred-bank-a").IsZero() ||
+				!nettingKeeper.GetCreditBalance(ctx, "bank-a", "cred-bank-b:usd").IsZero() ||
+				!nettingKeeper.GetCreditBalance(ctx, "bank-b", "cred-bank-a:eur").Equal(amount) {
+				return false
+			}
+
+			// The next cycle takes the other set's turn
+			ctx = ctx.WithBlockHeight(30)
+			if err := nettingKeeper.TriggerNetting(ctx); err != nil {
+				return false
+			}
+			cycle, found = nettingKeeper.GetNettingCycle(ctx, 30)
+			if !found || cycle.NettingSet != "eur-agreement" ||
+				!nettingKeeper.GetCreditBalance(ctx, "bank-a", "cred-bank-b:eur").Equal(amount) {
+				return false
+			}
+
+			// A currency belongs to at most one netting set
+			params.NettingSets = append(params.NettingSets, nettingtypes.NettingSet{ID: "other", Currencies: []string{"usd"}})
+			return params.Validate() != nil
+		},
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.34: 영업일 마감 주기**
+// **검증: 요구사항 4.1, 7.4 - 영업일 마감 시 최소 상계 금액과 무관하게 모든 네팅 세트가 상계되고 마감 보고서와 함께 영업일이 넘어가는지 검증**
+func TestProperty_CloseBusinessDay_NetsEverythingAndRollsDate(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("the end-of-day cycle nets every set and rolls the business date", prop.ForAll(
+		func(amount math.Int) bool {
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			open := time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC)
+			ctx = ctx.WithBlockHeight(20).WithBlockTime(open)
+			nettingKeeper.SetOracleKeeper(NewMockOracleKeeper())
+			nettingKeeper.SetMultisigKeeper(NewMockMultisigKeeper())
+			queryServer := keeper.NewQueryServerImpl(*nettingKeeper)
+
+			params := nettingtypes.DefaultParams()
+			params.MinNettingAmount = amount.Int64() + 1
+			params.BusinessDayClose = 17 * 60 * 60
+			params.NettingSets = []nettingtypes.NettingSet{
+				{ID: "usd-agreement", Currencies: []string{types.BaseCurrency, "usd"}},
+				{ID: "eur-agreement", Currencies: []string{"eur"}},
+			}
+			params.SettlementAccounts = []nettingtypes.SettlementAccount{
+				{BankID: "bank-a", Address: "0x00000000000000000000000000000000000000aa"},
+				{BankID: "bank-b", Address: "0x00000000000000000000000000000000000000bb"},
+			}
+			nettingKeeper.SetParams(ctx, params)
+
+			for _, token := range []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amount, OriginTx: "tx-1"},
+				{Denom: "cred-bank-b:usd", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amount, OriginTx: "tx-2"},
+				{Denom: "cred-bank-a:eur", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: amount, OriginTx: "tx-3"},
+				{Denom: "cred-bank-b:eur", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: amount.MulRaw(2), OriginTx: "tx-4"},
+			} {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+
+			// The first block opens the business day; periodic cycles skip
+			// offsets below MinNettingAmount
+			if err := nettingKeeper.CloseBusinessDay(ctx); err != nil {
+				return false
+			}
+			if err := nettingKeeper.TriggerNetting(ctx); !errors.Is(err, nettingtypes.ErrNettingNotRequired) {
+				return false
+			}
+			resp, err := queryServer.BusinessDay(ctx, &nettingtypes.QueryBusinessDayRequest{})
+			if err != nil || resp.BusinessDay.Date != "2026-03-10" || resp.CloseTime != open.Add(7*time.Hour).Unix() {
+				return false
+			}
+
+			// Nothing happens before the close
+			ctx = ctx.WithBlockHeight(21).WithBlockTime(open.Add(7*time.Hour - time.Second))
+			if err := nettingKeeper.CloseBusinessDay(ctx); err != nil {
+				return false
+			}
+			if _, found := nettingKeeper.GetNettingCycle(ctx, 21); found {
+				return false
+			}
+
+			// One end-of-day cycle per netting set, in consecutive blocks
+			for height := int64(22); height <= 23; height++ {
+				ctx = ctx.WithBlockHeight(height).WithBlockTime(open.Add(7*time.Hour + time.Duration(height-22)*time.Second))
+				if err := nettingKeeper.CloseBusinessDay(ctx); err != nil {
+					return false
+				}
+				cycle, found := nettingKeeper.GetNettingCycle(ctx, uint64(height))
+				if !found || !cycle.EndOfDay || cycle.Status != int32(types.NettingStatusCompleted) {
+					return false
+				}
+			}
+			if !nettingKeeper.GetCreditBalance(ctx, "bank-b", "cred-bank-a").IsZero() ||
+				!nettingKeeper.GetCreditBalance(ctx, "bank-b", "cred-bank-a:eur").IsZero() {
+				return false
+			}
+
+			// The periodic cycle of a closing block does not take its ID
+			if err := nettingKeeper.TriggerNetting(ctx); !errors.Is(err, nettingtypes.ErrNettingNotRequired) {
+				return false
+			}
+
+			if _, err := queryServer.ClosingReport(ctx, &nettingtypes.QueryClosingReportRequest{BusinessDate: "2026-03-11"}); !errors.Is(err, nettingtypes.ErrClosingReportNotFound) {
+				return false
+			}
+			closing, err := queryServer.ClosingReport(ctx, &nettingtypes.QueryClosingReportRequest{BusinessDate: "2026-03-10"})
+			if err != nil {
+				return false
+			}
+			report := closing.Report
+			if report.NextBusinessDate != "2026-03-11" || report.ClosedHeight != 23 || len(report.Cycles) != 2 ||
+				report.Report.CyclesExecuted != 2 || report.Report.Commands != 1 || report.Report.StartTime != open.Unix() {
+				return false
+			}
+
+			resp, err = queryServer.BusinessDay(ctx, &nettingtypes.QueryBusinessDayRequest{})
+			return err == nil && resp.BusinessDay.Date == "2026-03-11" && resp.BusinessDay.ClosedSets == 0 &&
+				resp.CloseTime == open.Add(31*time.Hour).Unix()
+		},
+		testhelpers.GenValidAmount(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 5.35: 주기적 상계의 최소 상계 금액**
+// **검증: 요구사항 4.2, 4.3 - 주기적 상계는 상계액이 최소 상계 금액 미만인 은행 쌍을 건너뛰고 나머지 쌍만 상계하는지 검증**
+func TestProperty_PeriodicNetting_SkipsOffsetsBelowMinNettingAmount(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("periodic cycles leave pairs below MinNettingAmount outstanding", prop.ForAll(
+		func(minAmount int64, shortfall int64) bool {
+			if shortfall >= minAmount {
+				shortfall = minAmount - 1
+			}
+			ctx, nettingKeeper := setupNettingTestEnvironment(t)
+			ctx = ctx.WithBlockHeight(20)
+			nettingKeeper.SetOracleKeeper(NewMockOracleKeeper())
+			nettingKeeper.SetMultisigKeeper(NewMockMultisigKeeper())
+
+			params := nettingtypes.DefaultParams()
+			params.MinNettingAmount = minAmount
+			nettingKeeper.SetParams(ctx, params)
+
+			// bank-a and bank-b offset MinNettingAmount, bank-a and bank-c less
+			small := math.NewInt(minAmount - shortfall)
+			for _, token := range []types.CreditToken{
+				{Denom: "cred-bank-a", IssuerBank: "bank-a", HolderBank: "bank-b", Amount: math.NewInt(minAmount), OriginTx: "tx-1"},
+				{Denom: "cred-bank-b", IssuerBank: "bank-b", HolderBank: "bank-a", Amount: math.NewInt(minAmount), OriginTx: "tx-2"},
+				{Denom: "cred-bank-c", IssuerBank: "bank-c", HolderBank: "bank-a", Amount: small, OriginTx: "tx-3"},
+				{Denom: "cred-bank-a:usd", IssuerBank: "bank-a", HolderBank: "bank-c", Amount: small, OriginTx: "tx-4"},
+			} {
+				if err := nettingKeeper.IssueCreditToken(ctx, token); err != nil {
+					return false
+				}
+			}
+
+			if err := nettingKeeper.TriggerNetting(ctx); err != nil {
+				return false
+			}
+			cycle, found := nettingKeeper.GetNettingCycle(ctx, 20)
+			if !found || len(cycle.Pairs) != 1 || cycle.Pairs[0].BankA != "bank-a" || cycle.Pairs[0].BankB != "bank-b" {
+				return false
+			}
+			if !nettingKeeper.GetCreditBalance(ctx, "bank-b", "cred-bank-a").IsZero() {
+				return false
+			}
+			return nettingKeeper.GetCreditBalance(ctx, "bank-a", "cred-bank-c").Equal(small) &&
+				nettingKeeper.GetCreditBalance(ctx, "bank-c", "cred-bank-a:usd").Equal(small)
+		},
+		gen.Int64Range(2, 1000000),
+		gen.Int64Range(1, 1000000),
+	))
+
+	properties.TestingRun(t)
+}
+
+// Helper functions for testing
+
+func setupNettingTestEnvironment(t *testing.T) (sdk.Context, *keeper.Keeper) {
+	// Create store key
+	storeKey := storetypes.NewKVStoreKey("netting")
+
+	// Create test context with store
+	testCtx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx
+
+	// Create proto codec
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	// Create mock keepers
+	mockBankKeeper := NewMockBankKeeper()
+	mockAccountKeeper := NewMockAccountKeeper()
+
+	// Create netting keeper with proper initialization
+	nettingKeeper := keeper.NewKeeper(
+		cdc,
+		storeKey,
+		nil, // memKey
+		paramtypes.Subspace{},
+		mockBankKeeper,
+		mockAccountKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	return ctx, nettingKeeper
+}
+
+// setupNettingTestEnvironmentWithMemStore returns a netting keeper with a
+// mounted memory store, for the query cache
+func setupNettingTestEnvironmentWithMemStore(t *testing.T) (sdk.Context, *keeper.Keeper) {
+	storeKey := storetypes.NewKVStoreKey("netting")
+	memKey := storetypes.NewMemoryStoreKey("mem_netting")
+	ctx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{"netting": storeKey},
+		nil,
+		map[string]*storetypes.MemoryStoreKey{"mem_netting": memKey},
+	)
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	nettingKeeper := keeper.NewKeeper(
+		cdc,
+		storeKey,
+		memKey,
+		paramtypes.Subspace{},
+		NewMockBankKeeper(),
+		NewMockAccountKeeper(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	return ctx, nettingKeeper
+}
+
+// MockMultisigKeeper for testing
+type MockMultisigKeeper struct {
+	commands map[string]types.MintCommand
+	err      error // Returned by GenerateMintCommand when set
+}
+
+func NewMockMultisigKeeper() *MockMultisigKeeper {
+	return &MockMultisigKeeper{commands: make(map[string]types.MintCommand)}
+}
+
+func (m *MockMultisigKeeper) GenerateMintCommand(ctx sdk.Context, targetChain, recipient string, amount math.Int) (types.MintCommand, error) {
+	if m.err != nil {
+		return types.MintCommand{}, m.err
+	}
+	command := types.MintCommand{
+		CommandID:   fmt.Sprintf("cmd-%d", len(m.commands)),
+		TargetChain: targetChain,
+		Recipient:   recipient,
+		Amount:      amount,
+		Status:      int32(types.CommandStatusPending),
+	}
+	m.commands[command.CommandID] = command
+	return command, nil
+}
+
+func (m *MockMultisigKeeper) GetCommand(ctx sdk.Context, commandID string) (types.MintCommand, bool) {
+	command, found := m.commands[commandID]
+	return command, found
+}
+
+func (m *MockMultisigKeeper) setStatus(commandID string, status types.CommandStatus) {
+	command := m.commands[commandID]
+	command.Status = int32(status)
+	m.commands[commandID] = command
+}
+
+// MockOracleKeeper for testing
+type MockOracleKeeper struct {
+	logs []types.AuditLog
+}
+
+func NewMockOracleKeeper() *MockOracleKeeper {
+	return &MockOracleKeeper{}
+}
+
+func (m *MockOracleKeeper) SaveAuditLog(ctx sdk.Context, log types.AuditLog) (uint64, error) {
+	log.ID = uint64(len(m.logs)) + 1
+	m.logs = append(m.logs, log)
+	return log.ID, nil
+}
+
+func (m *MockOracleKeeper) LogCreditIssued(ctx sdk.Context, credit types.CreditToken) error {
+	_, err := m.SaveAuditLog(ctx, types.AuditLog{
+		EventType: types.EventTypeCreditIssued,
+		TxHash:    credit.OriginTx,
+		Timestamp: ctx.BlockTime().Unix(),
+		Details:   map[string]string{"amount": credit.Amount.String()},
+	})
+	return err
+}
+
+func (m *MockOracleKeeper) GetAuditLogsByTimeRange(ctx sdk.Context, startTime, endTime int64) []types.AuditLog {
+	var logs []types.AuditLog
+	for _, log := range m.logs {
+		if log.Timestamp >= startTime && log.Timestamp <= endTime {
+			logs = append(logs, log)
+		}
+	}
+	return logs
 }
 
 // MockBankKeeper for testing
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
 )
@@ -23,14 +25,21 @@ var _ nettingtypes.MsgServer = msgServer{}
 func (k msgServer) IssueCreditToken(goCtx context.Context, msg *nettingtypes.MsgIssueCreditToken) (*nettingtypes.MsgIssueCreditTokenResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// The issuer must be registered for the bank issuing the credit
+	if err := k.Keeper.AuthorizeBankSigner(ctx, msg.Issuer, msg.CreditToken.IssuerBank); err != nil {
+		return nil, err
+	}
+
 	// Issue the credit token
 	if err := k.Keeper.IssueCreditToken(ctx, msg.CreditToken); err != nil {
 		return nil, err
 	}
 
 	return &nettingtypes.MsgIssueCreditTokenResponse{
-		Success: true,
-		Denom:   msg.CreditToken.Denom,
+		Success:    true,
+		Denom:      msg.CreditToken.Denom,
+		HolderBank: msg.CreditToken.HolderBank,
+		Balance:    k.Keeper.GetCreditBalance(ctx, msg.CreditToken.HolderBank, msg.CreditToken.Denom),
 	}, nil
 }
 
@@ -38,13 +47,26 @@ func (k msgServer) IssueCreditToken(goCtx context.Context, msg *nettingtypes.Msg
 func (k msgServer) BurnCreditToken(goCtx context.Context, msg *nettingtypes.MsgBurnCreditToken) (*nettingtypes.MsgBurnCreditTokenResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	token, found := k.Keeper.getCreditToken(ctx, msg.Denom)
+	if !found {
+		return nil, nettingtypes.ErrCreditTokenNotFound
+	}
+
+	// Only the bank holding the credit may burn it
+	if err := k.Keeper.AuthorizeBankSigner(ctx, msg.Burner, token.HolderBank); err != nil {
+		return nil, err
+	}
+
 	// Burn the credit token
 	if err := k.Keeper.BurnCreditToken(ctx, msg.Denom, msg.Amount); err != nil {
 		return nil, err
 	}
 
 	return &nettingtypes.MsgBurnCreditTokenResponse{
-		Success: true,
+		Success:    true,
+		Denom:      msg.Denom,
+		HolderBank: token.HolderBank,
+		Balance:    k.Keeper.GetCreditBalance(ctx, token.HolderBank, msg.Denom),
 	}, nil
 }
 
@@ -52,23 +74,119 @@ func (k msgServer) BurnCreditToken(goCtx context.Context, msg *nettingtypes.MsgB
 func (k msgServer) TriggerNetting(goCtx context.Context, msg *nettingtypes.MsgTriggerNetting) (*nettingtypes.MsgTriggerNettingResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// Trigger netting process
-	if err := k.Keeper.TriggerNetting(ctx); err != nil {
+	// Only registered operators or the authority may trigger netting
+	if err := k.Keeper.TriggerNettingBy(ctx, msg.Triggerer); err != nil {
 		return nil, err
 	}
 
-	// Get the latest netting cycle ID (current block height)
+	// Report the executed cycle (cycle ID is the current block height)
 	cycleID := uint64(ctx.BlockHeight())
-	
-	// Calculate number of pairs that were netted
-	pairs, err := k.Keeper.CalculateNetting(ctx)
-	if err != nil {
-		return nil, err
+	cycle, found := k.Keeper.GetNettingCycle(ctx, cycleID)
+	if !found {
+		return nil, errorsmod.Wrapf(nettingtypes.ErrInvalidNettingCycle, "netting cycle %d not stored", cycleID)
 	}
 
 	return &nettingtypes.MsgTriggerNettingResponse{
-		Success:  true,
-		CycleID:  cycleID,
-		NetCount: len(pairs),
+		Success:    true,
+		CycleID:    cycle.CycleID,
+		NetCount:   len(cycle.Pairs),
+		NetAmounts: cycle.NetAmounts,
+		CycleHash:  hex.EncodeToString(nettingtypes.CycleHash(cycle)),
 	}, nil
-}
\ No newline at end of file
+}
+
+// UpdateParams handles MsgUpdateParams messages
+func (k msgServer) UpdateParams(goCtx context.Context, msg *nettingtypes.MsgUpdateParams) (*nettingtypes.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.Keeper.GetAuthority() {
+		return nil, errorsmod.Wrapf(nettingtypes.ErrUnauthorized, "expected %s, got %s", k.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	if _, err := k.Keeper.UpdateParams(ctx, msg.Authority, msg.Params, msg.ProposalID); err != nil {
+		return nil, err
+	}
+
+	return &nettingtypes.MsgUpdateParamsResponse{}, nil
+}
+
+// SetBankAccount handles MsgSetBankAccount messages
+func (k msgServer) SetBankAccount(goCtx context.Context, msg *nettingtypes.MsgSetBankAccount) (*nettingtypes.MsgSetBankAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.Keeper.GetAuthority() {
+		return nil, errorsmod.Wrapf(nettingtypes.ErrUnauthorized, "expected %s, got %s", k.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	if err := k.Keeper.SetBankAccount(ctx, msg.Account); err != nil {
+		return nil, err
+	}
+
+	return &nettingtypes.MsgSetBankAccountResponse{}, nil
+}
+
+// RemoveBankAccount handles MsgRemoveBankAccount messages
+func (k msgServer) RemoveBankAccount(goCtx context.Context, msg *nettingtypes.MsgRemoveBankAccount) (*nettingtypes.MsgRemoveBankAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.Keeper.GetAuthority() {
+		return nil, errorsmod.Wrapf(nettingtypes.ErrUnauthorized, "expected %s, got %s", k.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	if err := k.Keeper.RemoveBankAccount(ctx, msg.Address); err != nil {
+		return nil, err
+	}
+
+	return &nettingtypes.MsgRemoveBankAccountResponse{}, nil
+}
+
+// CancelNettingCycle handles MsgCancelNettingCycle messages
+func (k msgServer) CancelNettingCycle(goCtx context.Context, msg *nettingtypes.MsgCancelNettingCycle) (*nettingtypes.MsgCancelNettingCycleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.Keeper.GetAuthority() {
+		return nil, errorsmod.Wrapf(nettingtypes.ErrUnauthorized, "expected %s, got %s", k.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	snapshot, found := k.Keeper.GetCycleSnapshot(ctx, msg.CycleID)
+	if err := k.Keeper.CancelNettingCycle(ctx, msg.CycleID, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	response := &nettingtypes.MsgCancelNettingCycleResponse{}
+	if found {
+		response.RestoredBalances = int32(len(snapshot.Balances))
+	}
+	return response, nil
+}
+
+// ScheduleDenomMigration handles MsgScheduleDenomMigration messages
+func (k msgServer) ScheduleDenomMigration(goCtx context.Context, msg *nettingtypes.MsgScheduleDenomMigration) (*nettingtypes.MsgScheduleDenomMigrationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.Keeper.GetAuthority() {
+		return nil, errorsmod.Wrapf(nettingtypes.ErrUnauthorized, "expected %s, got %s", k.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	report, err := k.Keeper.ScheduleDenomMigration(ctx, msg.Migration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nettingtypes.MsgScheduleDenomMigrationResponse{Report: report}, nil
+}
+
+// ReportReserves handles MsgReportReserves messages
+func (k msgServer) ReportReserves(goCtx context.Context, msg *nettingtypes.MsgReportReserves) (*nettingtypes.MsgReportReservesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.AuthorizeBankSigner(ctx, msg.Reporter, msg.Bank); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.Keeper.SetReserveReport(ctx, msg.Reporter, msg.Bank, msg.Reserves); err != nil {
+		return nil, errorsmod.Wrap(nettingtypes.ErrInvalidReserves, err.Error())
+	}
+
+	return &nettingtypes.MsgReportReservesResponse{}, nil
+}
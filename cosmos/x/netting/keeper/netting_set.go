@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/interbank-netting/cosmos/types"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
+)
+
+// creditBurn is credit of a denom a netting burned from a holder bank
+type creditBurn struct {
+	holder    string
+	denom     string
+	amount    math.Int
+	reference string // Cycle, micro-cycle or settlement command of the burn
+}
+
+// nettingSet returns the netting set a context is scoped to, the first
+// netting set for contexts outside a cycle
+func (k Keeper) nettingSet(ctx sdk.Context) nettingtypes.NettingSet {
+	if set, ok := nettingtypes.GetNettingSet(ctx); ok {
+		return set
+	}
+	return k.GetParams(ctx).GetNettingSets()[0]
+}
+
+// nettingSetsInTurn returns the netting sets starting with the one after the
+// set of the last cycle, so every set gets its turn when cycles only net the
+// first set that needs netting
+func (k Keeper) nettingSetsInTurn(ctx sdk.Context) []nettingtypes.NettingSet {
+	sets := k.GetParams(ctx).GetNettingSets()
+	last := string(ctx.KVStore(k.storeKey).Get(nettingtypes.LastNettingSetKey))
+	for i, set := range sets {
+		if set.ID == last {
+			return append(append([]nettingtypes.NettingSet{}, sets[i+1:]...), sets[:i+1]...)
+		}
+	}
+	return sets
+}
+
+// setLastNettingSet stores the netting set of the last cycle
+func (k Keeper) setLastNettingSet(ctx sdk.Context, id string) {
+	ctx.KVStore(k.storeKey).Set(nettingtypes.LastNettingSetKey, []byte(id))
+}
+
+// nettedBalance sums a holder's balance of credit issued by a bank in the
+// currencies of the context's netting set
+func (k Keeper) nettedBalance(ctx sdk.Context, holder, issuer string, balanceOf func(ctx sdk.Context, bank, denom string) math.Int) math.Int {
+	total := math.ZeroInt()
+	for _, denom := range k.nettingSet(ctx).Denoms(issuer) {
+		total = total.Add(balanceOf(ctx, holder, denom))
+	}
+	return total
+}
+
+// burnNettedCredit burns an amount of credit issued by a bank from a holder
+// across the currencies of the context's netting set, in the set's order
+func (k Keeper) burnNettedCredit(ctx sdk.Context, holder, issuer string, amount math.Int) ([]creditBurn, error) {
+	if k.nettedBalance(ctx, holder, issuer, k.GetAvailableCreditBalance).LT(amount) {
+		return nil, nettingtypes.ErrInsufficientBalance
+	}
+
+	var burns []creditBurn
+	remaining := amount
+	for _, denom := range k.nettingSet(ctx).Denoms(issuer) {
+		burned := math.MinInt(remaining, k.GetAvailableCreditBalance(ctx, holder, denom))
+		if !burned.IsPositive() {
+			continue
+		}
+		if err := k.burnHeldCredit(ctx, holder, denom, burned); err != nil {
+			return nil, err
+		}
+		burns = append(burns, creditBurn{holder: holder, denom: denom, amount: burned})
+		if remaining = remaining.Sub(burned); remaining.IsZero() {
+			break
+		}
+	}
+	return burns, nil
+}
+
+// recordBurns records the lineage of credit burned by a netting
+func (k Keeper) recordBurns(ctx sdk.Context, burns []creditBurn, kind string) {
+	for _, burn := range burns {
+		k.passOnCredit(ctx, burn.holder, nettingtypes.CreditLineageNode{
+			Kind:      kind,
+			Denom:     burn.denom,
+			Bank:      burn.holder,
+			Amount:    burn.amount,
+			Reference: burn.reference,
+		})
+	}
+}
+
+// withReference sets the reference of burns
+func withReference(burns []creditBurn, reference string) []creditBurn {
+	for i := range burns {
+		burns[i].reference = reference
+	}
+	return burns
+}
+
+// creditCurrency returns the currency of a credit denom, the base currency for
+// denoms that are not credit denoms
+func creditCurrency(denom string) string {
+	if _, currency, ok := types.ParseCreditDenom(denom); ok {
+		return currency
+	}
+	return types.BaseCurrency
+}
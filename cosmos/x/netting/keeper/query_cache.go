@@ -0,0 +1,63 @@
+package keeper
+
+import (
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/interbank-netting/cosmos/types"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
+)
+
+// EnableQueryCache serves the credit balance query from the module's memory
+// store, refreshed every EndBlock. It has no effect without a memory store key.
+func (k *Keeper) EnableQueryCache() {
+	k.queryCache = types.NewQueryCache(k.memKey)
+}
+
+// RefreshQueryCache replaces the cached credit balances with the balances at
+// the end of the block. It runs last in EndBlock and does nothing while the
+// cache is disabled.
+func (k Keeper) RefreshQueryCache(ctx sdk.Context) {
+	if !k.queryCache.Enabled() {
+		return
+	}
+	k.queryCache.Reset(ctx)
+
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), nettingtypes.CreditBalanceKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// Key format is prefix + bank + "/" + denom
+		key := string(iterator.Key()[len(nettingtypes.CreditBalanceKeyPrefix):])
+		idx := indexByte(key, '/')
+		if idx == -1 {
+			continue
+		}
+
+		summary := k.creditBalanceSummary(ctx, key[:idx], key[idx+1:])
+		k.queryCache.Set(ctx, []byte(key), k.cdc.MustMarshal(&summary))
+	}
+}
+
+// GetCreditBalanceSummary returns the balance, frozen and available credit of
+// a bank in a denom. It is served from the query cache when the cache was
+// refreshed at the height of ctx.
+func (k Keeper) GetCreditBalanceSummary(ctx sdk.Context, bank, denom string) nettingtypes.CreditBalanceSummary {
+	if bz, found := k.queryCache.Get(ctx, []byte(bank+"/"+denom)); found {
+		var summary nettingtypes.CreditBalanceSummary
+		k.cdc.MustUnmarshal(bz, &summary)
+		return summary
+	}
+	return k.creditBalanceSummary(ctx, bank, denom)
+}
+
+func (k Keeper) creditBalanceSummary(ctx sdk.Context, bank, denom string) nettingtypes.CreditBalanceSummary {
+	return nettingtypes.CreditBalanceSummary{
+		Bank:      bank,
+		Denom:     denom,
+		Balance:   k.GetCreditBalance(ctx, bank, denom),
+		Frozen:    k.GetFrozenCredit(ctx, bank, denom),
+		Available: k.GetAvailableCreditBalance(ctx, bank, denom),
+	}
+}
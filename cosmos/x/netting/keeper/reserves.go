@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"sort"
+	"strconv"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/interbank-netting/cosmos/types"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
+)
+
+// SetReserveReport replaces the reserves a bank reported, in currency order
+func (k Keeper) SetReserveReport(ctx sdk.Context, reporter, bank string, reserves []nettingtypes.ReserveAmount) (nettingtypes.ReserveReport, error) {
+	if err := nettingtypes.ValidateReserves(reserves); err != nil {
+		return nettingtypes.ReserveReport{}, err
+	}
+
+	sorted := append([]nettingtypes.ReserveAmount(nil), reserves...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Currency < sorted[j].Currency })
+	report := nettingtypes.ReserveReport{
+		Bank:     bank,
+		Reporter: reporter,
+		Reserves: sorted,
+		Height:   ctx.BlockHeight(),
+		Time:     ctx.BlockTime().Unix(),
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(nettingtypes.GetReserveReportKey(bank), k.cdc.MustMarshal(&report))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			nettingtypes.EventTypeReservesReported,
+			sdk.NewAttribute(nettingtypes.AttributeKeyBankID, bank),
+			sdk.NewAttribute(nettingtypes.AttributeKeyReporter, reporter),
+			sdk.NewAttribute(nettingtypes.AttributeKeyCurrencyCount, strconv.Itoa(len(sorted))),
+		),
+	)
+
+	return report, nil
+}
+
+// GetReserveReport returns the latest reserve report of a bank
+func (k Keeper) GetReserveReport(ctx sdk.Context, bank string) (nettingtypes.ReserveReport, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nettingtypes.GetReserveReportKey(bank))
+	if bz == nil {
+		return nettingtypes.ReserveReport{}, false
+	}
+
+	var report nettingtypes.ReserveReport
+	k.cdc.MustUnmarshal(bz, &report)
+	return report, true
+}
+
+// GetOutstandingCredit returns the credit issued by each bank and held by
+// other banks, per issuer and currency. Instances of a currency aggregate
+// together and frozen credit is still outstanding.
+func (k Keeper) GetOutstandingCredit(ctx sdk.Context) map[string]map[string]math.Int {
+	outstanding := make(map[string]map[string]math.Int)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, nettingtypes.CreditBalanceKeyPrefix)
+	defer iterator.Close()
+
+	prefixLen := len(nettingtypes.CreditBalanceKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var balance math.Int
+		if err := balance.Unmarshal(iterator.Value()); err != nil || !balance.IsPositive() {
+			continue
+		}
+
+		// Key format: prefix + bank + "/" + denom
+		withoutPrefix := string(iterator.Key()[prefixLen:])
+		idx := indexByte(withoutPrefix, '/')
+		if idx <= 0 {
+			continue
+		}
+		issuer, currency, ok := types.ParseCreditDenom(withoutPrefix[idx+1:])
+		if !ok || issuer == withoutPrefix[:idx] {
+			continue
+		}
+
+		if outstanding[issuer] == nil {
+			outstanding[issuer] = make(map[string]math.Int)
+		}
+		if current, found := outstanding[issuer][currency]; found {
+			balance = current.Add(balance)
+		}
+		outstanding[issuer][currency] = balance
+	}
+	return outstanding
+}
+
+// AttestReserves stores a proof-of-reserves attestation of every bank with
+// outstanding credit or reported reserves, in bank order. It runs in
+// EndBlock every ReserveAttestationInterval blocks.
+func (k Keeper) AttestReserves(ctx sdk.Context) []nettingtypes.ReserveAttestation {
+	outstanding := k.GetOutstandingCredit(ctx)
+	reports := k.getAllReserveReports(ctx)
+
+	banks := make([]string, 0, len(outstanding)+len(reports))
+	for bank := range outstanding {
+		banks = append(banks, bank)
+	}
+	for bank := range reports {
+		if _, found := outstanding[bank]; !found {
+			banks = append(banks, bank)
+		}
+	}
+	sort.Strings(banks)
+
+	attestations := make([]nettingtypes.ReserveAttestation, 0, len(banks))
+	for _, bank := range banks {
+		attestation := nettingtypes.NewReserveAttestation(bank, outstanding[bank], reports[bank])
+		if len(attestation.Lines) == 0 {
+			continue
+		}
+		attestations = append(attestations, k.addReserveAttestation(ctx, attestation))
+	}
+	return attestations
+}
+
+// AttestBankReserves stores a proof-of-reserves attestation of one bank
+func (k Keeper) AttestBankReserves(ctx sdk.Context, bank string) nettingtypes.ReserveAttestation {
+	report, _ := k.GetReserveReport(ctx, bank)
+	attestation := nettingtypes.NewReserveAttestation(bank, k.GetOutstandingCredit(ctx)[bank], report)
+	return k.addReserveAttestation(ctx, attestation)
+}
+
+// addReserveAttestation stores an attestation under the next ID with its
+// statement hash, and logs and emits it
+func (k Keeper) addReserveAttestation(ctx sdk.Context, attestation nettingtypes.ReserveAttestation) nettingtypes.ReserveAttestation {
+	store := ctx.KVStore(k.storeKey)
+	var last uint64
+	if bz := store.Get(nettingtypes.LastReserveAttestationKey); len(bz) == 8 {
+		last = binary.BigEndian.Uint64(bz)
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, last+1)
+	store.Set(nettingtypes.LastReserveAttestationKey, bz)
+
+	attestation.ID = last + 1
+	attestation.Height = ctx.BlockHeight()
+	attestation.Time = ctx.BlockTime().Unix()
+	attestation.StatementHash = attestation.ComputeStatementHash()
+	store.Set(nettingtypes.GetReserveAttestationKey(attestation.Bank, attestation.ID), k.cdc.MustMarshal(&attestation))
+
+	id := strconv.FormatUint(attestation.ID, 10)
+	covered := strconv.FormatBool(attestation.Covered)
+	statementHash := hex.EncodeToString(attestation.StatementHash)
+
+	if !attestation.Covered {
+		k.Logger(ctx).Info("bank reserves do not cover its outstanding credit",
+			"bank", attestation.Bank,
+			"attestation_id", attestation.ID,
+		)
+	}
+
+	if k.oracleKeeper != nil {
+		auditLog := types.AuditLog{
+			EventType: types.EventTypeReservesAttested,
+			Timestamp: attestation.Time,
+			Details: map[string]string{
+				"attestation_id": id,
+				"attested_bank":  attestation.Bank,
+				"covered":        covered,
+				"statement_hash": statementHash,
+				"report_height":  strconv.FormatInt(attestation.ReportHeight, 10),
+			},
+		}
+		if _, err := k.oracleKeeper.SaveAuditLog(ctx, auditLog); err != nil {
+			k.Logger(ctx).Error("failed to log reserve attestation", "error", err)
+			// Don't fail for logging errors
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			nettingtypes.EventTypeReservesAttested,
+			sdk.NewAttribute(nettingtypes.AttributeKeyAttestationID, id),
+			sdk.NewAttribute(nettingtypes.AttributeKeyBankID, attestation.Bank),
+			sdk.NewAttribute(nettingtypes.AttributeKeyCovered, covered),
+			sdk.NewAttribute(nettingtypes.AttributeKeyStatementHash, statementHash),
+		),
+	)
+
+	return attestation
+}
+
+// GetReserveAttestation returns a reserve attestation of a bank
+func (k Keeper) GetReserveAttestation(ctx sdk.Context, bank string, id uint64) (nettingtypes.ReserveAttestation, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(nettingtypes.GetReserveAttestationKey(bank, id))
+	if bz == nil {
+		return nettingtypes.ReserveAttestation{}, false
+	}
+
+	var attestation nettingtypes.ReserveAttestation
+	k.cdc.MustUnmarshal(bz, &attestation)
+	return attestation, true
+}
+
+// GetLatestReserveAttestation returns the last reserve attestation of a bank
+func (k Keeper) GetLatestReserveAttestation(ctx sdk.Context, bank string) (nettingtypes.ReserveAttestation, bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, nettingtypes.GetReserveAttestationPrefix(bank))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nettingtypes.ReserveAttestation{}, false
+	}
+
+	var attestation nettingtypes.ReserveAttestation
+	k.cdc.MustUnmarshal(iterator.Value(), &attestation)
+	return attestation, true
+}
+
+func (k Keeper) getAllReserveReports(ctx sdk.Context) map[string]nettingtypes.ReserveReport {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, nettingtypes.ReserveReportKeyPrefix)
+	defer iterator.Close()
+
+	reports := make(map[string]nettingtypes.ReserveReport)
+	for ; iterator.Valid(); iterator.Next() {
+		var report nettingtypes.ReserveReport
+		k.cdc.MustUnmarshal(iterator.Value(), &report)
+		reports[report.Bank] = report
+	}
+	return reports
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/interbank-netting/cosmos/types"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
+)
+
+// bilateralStrategy is the built-in netting strategy, netting the outstanding
+// obligations pairwise
+type bilateralStrategy struct {
+	keeper Keeper
+}
+
+// CalculatePairs implements nettingtypes.NettingStrategy
+func (s bilateralStrategy) CalculatePairs(ctx sdk.Context) ([]types.BankPair, error) {
+	return nettingtypes.CalculateBilateralPairs(s.keeper.GetObligations(ctx)), nil
+}
+
+// RegisterNettingStrategy registers a strategy the NettingStrategy param can
+// select. It panics if the name is empty or already taken, including by the
+// built-in bilateral strategy, so must be called while wiring the app.
+func (k *Keeper) RegisterNettingStrategy(name string, strategy nettingtypes.NettingStrategy) {
+	if name == "" || name == nettingtypes.NettingStrategyBilateral {
+		panic("invalid netting strategy name: " + name)
+	}
+	if _, ok := k.strategies[name]; ok {
+		panic("netting strategy already registered: " + name)
+	}
+	k.strategies[name] = strategy
+}
+
+// GetNettingStrategies returns the names of the strategies the NettingStrategy
+// param can select, the built-in bilateral one first
+func (k Keeper) GetNettingStrategies() []string {
+	names := make([]string, 0, len(k.strategies)+1)
+	for name := range k.strategies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return append([]string{nettingtypes.NettingStrategyBilateral}, names...)
+}
+
+// nettingStrategy returns the strategy registered under a name, the built-in
+// bilateral one for an empty name
+func (k Keeper) nettingStrategy(name string) (nettingtypes.NettingStrategy, error) {
+	if name == "" || name == nettingtypes.NettingStrategyBilateral {
+		return bilateralStrategy{keeper: k}, nil
+	}
+	strategy, ok := k.strategies[name]
+	if !ok {
+		return nil, errorsmod.Wrapf(nettingtypes.ErrUnknownStrategy, "%q, registered: %v", name, k.GetNettingStrategies())
+	}
+	return strategy, nil
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/interbank-netting/cosmos/types"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
+)
+
+// ViewKeeper exposes the read-only methods of the netting keeper. Query
+// servers hold a ViewKeeper instead of the Keeper, so a query path cannot
+// write state.
+type ViewKeeper interface {
+	GetAuthority() string
+	GetParams(ctx sdk.Context) nettingtypes.Params
+	GetParamsHistory(ctx sdk.Context) []nettingtypes.ParamsChange
+
+	types.BalanceReader
+	GetCreditBalanceSummary(ctx sdk.Context, bank, denom string) nettingtypes.CreditBalanceSummary
+	GetCreditVelocity(ctx sdk.Context, issuer, holder string) nettingtypes.CreditVelocity
+	GetBankForAddress(ctx sdk.Context, address string) (string, bool)
+	GetBanks(ctx sdk.Context) []nettingtypes.BankSummary
+	GetCreditLineage(ctx sdk.Context, id uint64) (nettingtypes.CreditLineage, bool)
+	GetCreditLots(ctx sdk.Context, holder, denom string) []nettingtypes.CreditLineageNode
+	GetPendingDenomMigration(ctx sdk.Context) (nettingtypes.DenomMigration, bool)
+	GetLastDenomMigration(ctx sdk.Context) (nettingtypes.DenomMigrationReport, bool)
+	DryRunDenomMigration(ctx sdk.Context, migration nettingtypes.DenomMigration) (nettingtypes.DenomMigrationReport, error)
+
+	GetNettingCycle(ctx sdk.Context, cycleID uint64) (types.NettingCycle, bool)
+	GetCycleSnapshot(ctx sdk.Context, cycleID uint64) (nettingtypes.CycleSnapshot, bool)
+	GetCycleSettlement(ctx sdk.Context, cycleID uint64) (nettingtypes.CycleSettlement, bool)
+	GetOpenSettlements(ctx sdk.Context) []nettingtypes.CycleSettlement
+	GetNettingBacklog(ctx sdk.Context) types.NettingBacklog
+	GetDailyReport(ctx sdk.Context, day time.Time) nettingtypes.DailyReport
+	GetMicroCycle(ctx sdk.Context, id uint64) (nettingtypes.MicroCycle, bool)
+	GetReserveReport(ctx sdk.Context, bank string) (nettingtypes.ReserveReport, bool)
+	GetReserveAttestation(ctx sdk.Context, bank string, id uint64) (nettingtypes.ReserveAttestation, bool)
+	GetLatestReserveAttestation(ctx sdk.Context, bank string) (nettingtypes.ReserveAttestation, bool)
+	GetBusinessDay(ctx sdk.Context) (nettingtypes.BusinessDay, bool)
+	GetClosingReport(ctx sdk.Context, date string) (nettingtypes.ClosingReport, bool)
+}
+
+var (
+	_ ViewKeeper          = Keeper{}
+	_ types.NettingKeeper = Keeper{}
+)
@@ -3,6 +3,7 @@ package netting
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -138,16 +139,38 @@ func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the netting module.
 func (am AppModule) BeginBlock(ctx context.Context) error {
+	// Apply a scheduled denom migration before the block's transactions
+	am.keeper.ApplyDenomMigration(sdk.UnwrapSDKContext(ctx))
 	return nil
 }
 
 // EndBlock executes all ABCI EndBlock logic respective to the netting module.
 func (am AppModule) EndBlock(ctx context.Context) error {
-	// Trigger netting every 10 blocks
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Close the business day first, so the periodic cycle of the block does
+	// not take the end-of-day cycle's ID
+	if err := am.keeper.CloseBusinessDay(sdkCtx); err != nil {
+		am.keeper.EmitNettingFailed(sdkCtx, 0, err)
+	}
+
+	// Trigger netting every 10 blocks
 	if sdkCtx.BlockHeight()%10 == 0 {
-		// Attempt to trigger netting (ignore errors in EndBlock)
-		_ = am.keeper.TriggerNetting(sdkCtx)
+		// Attempt to trigger netting; a failure is reported but does not halt the chain
+		if err := am.keeper.TriggerNetting(sdkCtx); err != nil && !errors.Is(err, nettingtypes.ErrNettingNotRequired) {
+			am.keeper.EmitNettingFailed(sdkCtx, 0, err)
+		}
 	}
+
+	// Track the execution of the settlement commands of earlier cycles
+	am.keeper.UpdateSettlements(sdkCtx)
+
+	// Attest the reserves of every bank after the block's credit changes
+	if interval := am.keeper.GetParams(sdkCtx).ReserveAttestationInterval; interval > 0 && sdkCtx.BlockHeight()%interval == 0 {
+		am.keeper.AttestReserves(sdkCtx)
+	}
+
+	// Cache the balances of the block for the queries polling them
+	am.keeper.RefreshQueryCache(sdkCtx)
 	return nil
 }
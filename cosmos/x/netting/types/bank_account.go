@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankAccount authorizes a Cosmos address to act for a bank in credit
+// messages. A bank may have several accounts; an account acts for one bank.
+type BankAccount struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address"`
+	BankID  string `protobuf:"bytes,2,opt,name=bank_id,json=bankId,proto3" json:"bank_id"`
+}
+
+// ProtoMessage implements proto.Message
+func (a *BankAccount) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (a *BankAccount) Reset() { *a = BankAccount{} }
+
+// String implements proto.Message
+func (a *BankAccount) String() string {
+	return fmt.Sprintf("BankAccount{Address: %s, BankID: %s}", a.Address, a.BankID)
+}
+
+// Validate checks the account address and bank ID
+func (a BankAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+		return fmt.Errorf("invalid bank account address: %w", err)
+	}
+
+	if a.BankID == "" {
+		return fmt.Errorf("bank ID cannot be empty")
+	}
+
+	return nil
+}
+
+// BankSummary is a bank known to the netting module, from the bank account
+// registry or from the credit it holds or issued, with its outstanding credit
+type BankSummary struct {
+	BankID        string `protobuf:"bytes,1,opt,name=bank_id,json=bankId,proto3" json:"bank_id"`
+	Accounts      int32  `protobuf:"varint,2,opt,name=accounts,proto3" json:"accounts"`                                // Addresses registered to act for the bank
+	HeldCredits   int32  `protobuf:"varint,3,opt,name=held_credits,json=heldCredits,proto3" json:"held_credits"`       // Non-zero credit balances the bank holds
+	IssuedCredits int32  `protobuf:"varint,4,opt,name=issued_credits,json=issuedCredits,proto3" json:"issued_credits"` // Non-zero balances of the bank's credit held by other banks
+}
+
+// ProtoMessage implements proto.Message
+func (s *BankSummary) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (s *BankSummary) Reset() { *s = BankSummary{} }
+
+// String implements proto.Message
+func (s *BankSummary) String() string {
+	return fmt.Sprintf("BankSummary{BankID: %s, Held: %d, Issued: %d}", s.BankID, s.HeldCredits, s.IssuedCredits)
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// SecondsPerDay is the length of a business day, and the largest
+// BusinessDayClose: a close at midnight UTC
+const SecondsPerDay = 24 * 60 * 60
+
+// BusinessDateOf returns the business date a time falls on: the day whose
+// close, close seconds after its UTC midnight, is the first one after it
+func BusinessDateOf(t time.Time, close int64) time.Time {
+	return t.UTC().Add(time.Duration(SecondsPerDay-close) * time.Second).Truncate(24 * time.Hour)
+}
+
+// BusinessDay is the business day currently open. Once its close has passed,
+// the end-of-day cycle nets every netting set in turn, one per block, and the
+// closing report rolls the business date forward.
+type BusinessDay struct {
+	Date       string   `protobuf:"bytes,1,opt,name=date,proto3" json:"date"`                                // YYYY-MM-DD
+	OpenedAt   int64    `protobuf:"varint,2,opt,name=opened_at,json=openedAt,proto3" json:"opened_at"`       // Block time after the previous close, or when end-of-day cycles were enabled
+	ClosedSets int32    `protobuf:"varint,3,opt,name=closed_sets,json=closedSets,proto3" json:"closed_sets"` // Netting sets the closing went through so far
+	Cycles     []uint64 `protobuf:"varint,4,rep,packed,name=cycles,proto3" json:"cycles,omitempty"`          // End-of-day cycles of the closing so far
+}
+
+// ProtoMessage implements proto.Message
+func (d *BusinessDay) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (d *BusinessDay) Reset() { *d = BusinessDay{} }
+
+// String implements proto.Message
+func (d *BusinessDay) String() string {
+	return fmt.Sprintf("BusinessDay{Date: %s, ClosedSets: %d, Cycles: %v}", d.Date, d.ClosedSets, d.Cycles)
+}
+
+// NewBusinessDay returns the business day open at a time
+func NewBusinessDay(t time.Time, close int64) BusinessDay {
+	return BusinessDay{
+		Date:     BusinessDateOf(t, close).Format(DailyReportDateLayout),
+		OpenedAt: t.Unix(),
+	}
+}
+
+// CloseTime returns when the business day closes
+func (d BusinessDay) CloseTime(close int64) time.Time {
+	date, err := ParseReportDate(d.Date)
+	if err != nil {
+		return time.Time{}
+	}
+	return date.Add(time.Duration(close) * time.Second)
+}
+
+// ClosingReport is the report of a closed business day: the activity from
+// its open to the end-of-day cycles that closed it
+type ClosingReport struct {
+	BusinessDate     string      `protobuf:"bytes,1,opt,name=business_date,json=businessDate,proto3" json:"business_date"`
+	NextBusinessDate string      `protobuf:"bytes,2,opt,name=next_business_date,json=nextBusinessDate,proto3" json:"next_business_date"`
+	ClosedHeight     int64       `protobuf:"varint,3,opt,name=closed_height,json=closedHeight,proto3" json:"closed_height"`
+	ClosedAt         int64       `protobuf:"varint,4,opt,name=closed_at,json=closedAt,proto3" json:"closed_at"`
+	Cycles           []uint64    `protobuf:"varint,5,rep,packed,name=cycles,proto3" json:"cycles"` // End-of-day cycles, one per netting set that needed netting
+	Report           DailyReport `protobuf:"bytes,6,opt,name=report,proto3" json:"report"`         // From OpenedAt to ClosedAt, dated with the business date
+}
+
+// ProtoMessage implements proto.Message
+func (r *ClosingReport) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (r *ClosingReport) Reset() { *r = ClosingReport{} }
+
+// String implements proto.Message
+func (r *ClosingReport) String() string {
+	return fmt.Sprintf("ClosingReport{BusinessDate: %s, Cycles: %v}", r.BusinessDate, r.Cycles)
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	"github.com/interbank-netting/cosmos/types"
+)
+
+// Korean messages of the x/netting errors in the message catalog
+func init() {
+	types.RegisterErrorMessages(types.LocaleKorean, map[*errors.Error]string{
+		ErrInvalidCreditToken:    "잘못된 크레딧 토큰",
+		ErrInsufficientBalance:   "크레딧 잔액 부족",
+		ErrCreditTokenNotFound:   "크레딧 토큰을 찾을 수 없음",
+		ErrInvalidBankID:         "잘못된 은행 ID",
+		ErrNettingInProgress:     "이미 진행 중인 네팅",
+		ErrNettingFailed:         "네팅 처리 실패",
+		ErrInvalidNettingCycle:   "잘못된 네팅 사이클",
+		ErrDuplicateCreditToken:  "중복 크레딧 토큰",
+		ErrInvalidAmount:         "잘못된 금액",
+		ErrUnauthorized:          "권한 없는 작업",
+		ErrNettingNotRequired:    "네팅이 필요하지 않음",
+		ErrInvalidDebtPosition:   "잘못된 채무 포지션",
+		ErrTriggerCooldown:       "수동 네팅 실행 대기 시간이 지나지 않음",
+		ErrInvalidParams:         "잘못된 파라미터",
+		ErrInvalidPriority:       "잘못된 우선순위",
+		ErrBankAccountNotFound:   "은행 계정을 찾을 수 없음",
+		ErrSettlementNotFound:    "사이클 결제를 찾을 수 없음",
+		ErrLineageNodeNotFound:   "크레딧 계보 노드를 찾을 수 없음",
+		ErrInvalidDenomMigration: "잘못된 단위 마이그레이션",
+		ErrUnknownStrategy:       "알 수 없는 네팅 전략",
+		ErrMicroCycleNotFound:    "마이크로 사이클을 찾을 수 없음",
+		ErrInvalidReserves:       "잘못된 준비금 보고",
+		ErrAttestationNotFound:   "준비금 증명을 찾을 수 없음",
+		ErrClosingReportNotFound: "마감 보고서를 찾을 수 없음",
+	})
+}
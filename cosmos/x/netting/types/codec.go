@@ -13,6 +13,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgIssueCreditToken{}, "netting/MsgIssueCreditToken", nil)
 	cdc.RegisterConcrete(&MsgBurnCreditToken{}, "netting/MsgBurnCreditToken", nil)
 	cdc.RegisterConcrete(&MsgTriggerNetting{}, "netting/MsgTriggerNetting", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "netting/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetBankAccount{}, "netting/MsgSetBankAccount", nil)
+	cdc.RegisterConcrete(&MsgRemoveBankAccount{}, "netting/MsgRemoveBankAccount", nil)
+	cdc.RegisterConcrete(&MsgCancelNettingCycle{}, "netting/MsgCancelNettingCycle", nil)
+	cdc.RegisterConcrete(&MsgScheduleDenomMigration{}, "netting/MsgScheduleDenomMigration", nil)
+	cdc.RegisterConcrete(&MsgReportReserves{}, "netting/MsgReportReserves", nil)
 }
 
 // RegisterInterfaces registers the x/netting interfaces types with the interface registry
@@ -21,6 +27,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgIssueCreditToken{},
 		&MsgBurnCreditToken{},
 		&MsgTriggerNetting{},
+		&MsgUpdateParams{},
+		&MsgSetBankAccount{},
+		&MsgRemoveBankAccount{},
+		&MsgCancelNettingCycle{},
+		&MsgScheduleDenomMigration{},
+		&MsgReportReserves{},
 	)
 
 	// Note: MsgServiceDesc registration requires protobuf generation
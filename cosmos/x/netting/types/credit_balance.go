@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// CreditBalanceSummary is the credit a bank holds in a denom, split into the
+// part frozen by open disputes and the part it can net, burn or transfer
+type CreditBalanceSummary struct {
+	Bank      string   `protobuf:"bytes,1,opt,name=bank,proto3" json:"bank"`
+	Denom     string   `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom"`
+	Balance   math.Int `protobuf:"bytes,3,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
+	Frozen    math.Int `protobuf:"bytes,4,opt,name=frozen,proto3,customtype=cosmossdk.io/math.Int" json:"frozen"`
+	Available math.Int `protobuf:"bytes,5,opt,name=available,proto3,customtype=cosmossdk.io/math.Int" json:"available"`
+}
+
+// ProtoMessage implements proto.Message
+func (s *CreditBalanceSummary) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (s *CreditBalanceSummary) Reset() { *s = CreditBalanceSummary{} }
+
+// String implements proto.Message
+func (s *CreditBalanceSummary) String() string {
+	return fmt.Sprintf("CreditBalanceSummary{Bank: %s, Denom: %s, Balance: %s, Frozen: %s}", s.Bank, s.Denom, s.Balance, s.Frozen)
+}
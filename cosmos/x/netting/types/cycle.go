@@ -0,0 +1,34 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/interbank-netting/cosmos/types"
+)
+
+// CycleHash returns a deterministic hash of an executed netting cycle, covering
+// its ID, height, pairs in execution order and net amounts sorted by bank
+func CycleHash(cycle types.NettingCycle) []byte {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d-%d-%d-%s", cycle.CycleID, cycle.BlockHeight, cycle.Status, cycle.TriggeredBy)
+
+	for _, pair := range cycle.Pairs {
+		fmt.Fprintf(&sb, "|%s:%s-%s:%s>%s:%s", pair.BankA, pair.AmountA.String(), pair.BankB, pair.AmountB.String(),
+			pair.NetDebtor, pair.NetAmount.String())
+	}
+
+	banks := make([]string, 0, len(cycle.NetAmounts))
+	for bank := range cycle.NetAmounts {
+		banks = append(banks, bank)
+	}
+	sort.Strings(banks)
+	for _, bank := range banks {
+		fmt.Fprintf(&sb, "|%s=%s", bank, cycle.NetAmounts[bank].String())
+	}
+
+	hash := sha256.Sum256([]byte(sb.String()))
+	return hash[:]
+}
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	"github.com/interbank-netting/cosmos/types"
+)
+
+// DenomRename renames a credit denom, e.g. cred-bank-a to cred-bank-a:base
+// when moving to the multi-currency format. Both denoms must be credit denoms
+// of the same issuer, so the obligations the credit stands for don't change.
+type DenomRename struct {
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
+	To   string `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
+}
+
+// ProtoMessage implements proto.Message
+func (r *DenomRename) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (r *DenomRename) Reset() { *r = DenomRename{} }
+
+// String implements proto.Message
+func (r *DenomRename) String() string {
+	return fmt.Sprintf("DenomRename{From: %s, To: %s}", r.From, r.To)
+}
+
+// Validate checks that the rename keeps the credit of the same issuer
+func (r DenomRename) Validate() error {
+	if r.From == r.To {
+		return fmt.Errorf("denom %s is renamed to itself", r.From)
+	}
+	fromIssuer, _, ok := types.ParseCreditDenom(r.From)
+	if !ok {
+		return fmt.Errorf("%s is not a credit denom", r.From)
+	}
+	toIssuer, _, ok := types.ParseCreditDenom(r.To)
+	if !ok {
+		return fmt.Errorf("%s is not a credit denom", r.To)
+	}
+	if fromIssuer != toIssuer {
+		return fmt.Errorf("renaming %s to %s changes the issuer from %s to %s", r.From, r.To, fromIssuer, toIssuer)
+	}
+	return nil
+}
+
+// DenomMigration renames credit denoms at the start of a block, across the
+// credit tokens, balances, credit lots and snapshots of cycles in progress.
+// The renames are applied together or not at all.
+type DenomMigration struct {
+	Height  int64         `protobuf:"varint,1,opt,name=height,proto3" json:"height"`
+	Renames []DenomRename `protobuf:"bytes,2,rep,name=renames,proto3" json:"renames"`
+}
+
+// ProtoMessage implements proto.Message
+func (m *DenomMigration) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (m *DenomMigration) Reset() { *m = DenomMigration{} }
+
+// String implements proto.Message
+func (m *DenomMigration) String() string {
+	return fmt.Sprintf("DenomMigration{Height: %d, Renames: %d}", m.Height, len(m.Renames))
+}
+
+// Validate checks the height and renames. A denom may be renamed once, and
+// not to a denom that is itself renamed or the target of another rename.
+func (m DenomMigration) Validate() error {
+	if m.Height <= 0 {
+		return fmt.Errorf("migration height must be positive: %d", m.Height)
+	}
+	if len(m.Renames) == 0 {
+		return fmt.Errorf("migration has no renames")
+	}
+
+	from := make(map[string]bool, len(m.Renames))
+	to := make(map[string]bool, len(m.Renames))
+	for i, rename := range m.Renames {
+		if err := rename.Validate(); err != nil {
+			return fmt.Errorf("rename %d: %w", i, err)
+		}
+		if from[rename.From] {
+			return fmt.Errorf("rename %d: %s is renamed twice", i, rename.From)
+		}
+		if to[rename.To] {
+			return fmt.Errorf("rename %d: %s is the target of two renames", i, rename.To)
+		}
+		from[rename.From] = true
+		to[rename.To] = true
+	}
+	for _, rename := range m.Renames {
+		if from[rename.To] {
+			return fmt.Errorf("%s is both renamed and the target of a rename", rename.To)
+		}
+	}
+	return nil
+}
+
+// DenomRenameReport is what a rename changes
+type DenomRenameReport struct {
+	From      string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
+	To        string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
+	Balances  int32    `protobuf:"varint,3,opt,name=balances,proto3" json:"balances"`                             // Credit balances moved to the new denom
+	Amount    math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"` // Summed over the balances
+	Lots      int32    `protobuf:"varint,5,opt,name=lots,proto3" json:"lots"`                                     // Unspent credit lots moved
+	Snapshots int32    `protobuf:"varint,6,opt,name=snapshots,proto3" json:"snapshots"`                           // Snapshot balances of cycles in progress renamed
+}
+
+// ProtoMessage implements proto.Message
+func (r *DenomRenameReport) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (r *DenomRenameReport) Reset() { *r = DenomRenameReport{} }
+
+// String implements proto.Message
+func (r *DenomRenameReport) String() string {
+	return fmt.Sprintf("DenomRenameReport{From: %s, To: %s, Balances: %d}", r.From, r.To, r.Balances)
+}
+
+// DenomMigrationReport is what a denom migration changed, or would change
+// when DryRun is set
+type DenomMigrationReport struct {
+	Height  int64               `protobuf:"varint,1,opt,name=height,proto3" json:"height"`
+	DryRun  bool                `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run"`
+	Renames []DenomRenameReport `protobuf:"bytes,3,rep,name=renames,proto3" json:"renames"`
+}
+
+// ProtoMessage implements proto.Message
+func (r *DenomMigrationReport) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (r *DenomMigrationReport) Reset() { *r = DenomMigrationReport{} }
+
+// String implements proto.Message
+func (r *DenomMigrationReport) String() string {
+	return fmt.Sprintf("DenomMigrationReport{Height: %d, DryRun: %t, Renames: %d}", r.Height, r.DryRun, len(r.Renames))
+}
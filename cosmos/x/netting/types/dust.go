@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// Dust policies decide what happens to the part of a netted amount below the
+// settlement unit
+const (
+	DustPolicyTruncate   int32 = 0 // Residual is netted off and written off
+	DustPolicyCarry      int32 = 1 // Residual stays outstanding for the next cycle
+	DustPolicyAccumulate int32 = 2 // Residual is netted off and credited to the bank's dust account
+)
+
+// DustBalance is the sub-unit residual accumulated for a bank under
+// DustPolicyAccumulate
+type DustBalance struct {
+	Bank   string   `protobuf:"bytes,1,opt,name=bank,proto3" json:"bank"`
+	Amount math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+// ProtoMessage implements proto.Message
+func (d *DustBalance) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (d *DustBalance) Reset() { *d = DustBalance{} }
+
+// String implements proto.Message
+func (d *DustBalance) String() string {
+	return fmt.Sprintf("DustBalance{Bank: %s, Amount: %s}", d.Bank, d.Amount)
+}
+
+// IsValidDustPolicy returns true if policy is a known dust policy
+func IsValidDustPolicy(policy int32) bool {
+	return policy >= DustPolicyTruncate && policy <= DustPolicyAccumulate
+}
+
+// SplitDust rounds amount down to a multiple of unit and returns the rounded
+// amount and the residual
+func SplitDust(amount math.Int, unit int64) (math.Int, math.Int) {
+	if unit <= 1 {
+		return amount, math.ZeroInt()
+	}
+
+	dust := amount.ModRaw(unit)
+	return amount.Sub(dust), dust
+}
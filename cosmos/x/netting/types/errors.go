@@ -2,6 +2,8 @@ package types
 
 import (
 	"cosmossdk.io/errors"
+
+	"github.com/interbank-netting/cosmos/types"
 )
 
 // x/netting module sentinel errors
@@ -18,4 +20,44 @@ var (
 	ErrUnauthorized           = errors.Register(ModuleName, 10, "unauthorized operation")
 	ErrNettingNotRequired     = errors.Register(ModuleName, 11, "netting not required")
 	ErrInvalidDebtPosition    = errors.Register(ModuleName, 12, "invalid debt position")
-)
\ No newline at end of file
+	ErrTriggerCooldown        = errors.Register(ModuleName, 13, "manual netting trigger cooldown not elapsed")
+	ErrInvalidParams          = errors.Register(ModuleName, 14, "invalid params")
+	ErrInvalidPriority        = errors.Register(ModuleName, 15, "invalid priority")
+	ErrBankAccountNotFound    = errors.Register(ModuleName, 16, "bank account not found")
+	ErrSettlementNotFound     = errors.Register(ModuleName, 17, "cycle settlement not found")
+	ErrLineageNodeNotFound    = errors.Register(ModuleName, 18, "credit lineage node not found")
+	ErrInvalidDenomMigration  = errors.Register(ModuleName, 19, "invalid denom migration")
+	ErrUnknownStrategy        = errors.Register(ModuleName, 20, "unknown netting strategy")
+	ErrMicroCycleNotFound     = errors.Register(ModuleName, 21, "micro-cycle not found")
+	ErrInvalidReserves        = errors.Register(ModuleName, 22, "invalid reserve report")
+	ErrAttestationNotFound    = errors.Register(ModuleName, 23, "reserve attestation not found")
+	ErrClosingReportNotFound  = errors.Register(ModuleName, 24, "closing report not found")
+)
+
+func init() {
+	types.RegisterUserErrors(
+		ErrInvalidCreditToken,
+		ErrInsufficientBalance,
+		ErrCreditTokenNotFound,
+		ErrInvalidBankID,
+		ErrDuplicateCreditToken,
+		ErrInvalidAmount,
+		ErrUnauthorized,
+		ErrInvalidDebtPosition,
+		ErrInvalidParams,
+		ErrInvalidPriority,
+		ErrBankAccountNotFound,
+		ErrSettlementNotFound,
+		ErrLineageNodeNotFound,
+		ErrInvalidDenomMigration,
+		ErrUnknownStrategy,
+		ErrMicroCycleNotFound,
+		ErrInvalidReserves,
+		ErrAttestationNotFound,
+		ErrClosingReportNotFound,
+	)
+	types.RegisterRetryableErrors(
+		ErrNettingInProgress,
+		ErrTriggerCooldown,
+	)
+}
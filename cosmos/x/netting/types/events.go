@@ -1,5 +1,7 @@
 package types
 
+import "github.com/interbank-netting/cosmos/types"
+
 // Netting module event types
 const (
 	EventTypeCreditIssued      = "credit_issued"
@@ -9,14 +11,31 @@ const (
 	EventTypeNettingCompleted  = "netting_completed"
 	EventTypeNettingFailed     = "netting_failed"
 	EventTypeNettingRollback   = "netting_rollback"
+	EventTypeNettingCancelled  = "netting_cancelled"
+	EventTypeCreditFrozen      = "credit_frozen"
+	EventTypeCreditUnfrozen    = "credit_unfrozen"
+	EventTypeBankAccountSet    = "bank_account_set"
+	EventTypeBankAccountRemove = "bank_account_removed"
+	EventTypeSettlementCreated = "settlement_created"
+	EventTypeCycleSettled      = "cycle_settled"
+	EventTypeParamsUpdated     = "params_updated"
+
+	EventTypeDenomMigrationScheduled = "denom_migration_scheduled"
+	EventTypeDenomsMigrated          = "denoms_migrated"
+	EventTypeDenomMigrationFailed    = "denom_migration_failed"
+
+	EventTypeObligationLoopCompressed = "obligation_loop_compressed"
+	EventTypeMicroCycleCompleted      = "micro_cycle_completed"
+
+	EventTypeReservesReported = "reserves_reported"
+	EventTypeReservesAttested = "reserves_attested"
+
+	EventTypeBusinessDayClosed = "business_day_closed"
 )
 
 // Netting module event attribute keys
 const (
-	AttributeKeyDenom         = "denom"
-	AttributeKeyAmount        = "amount"
 	AttributeKeyIssuerBank    = "issuer_bank"
-	AttributeKeyHolderBank    = "holder_bank"
 	AttributeKeyFromBank      = "from_bank"
 	AttributeKeyToBank        = "to_bank"
 	AttributeKeyOriginTx      = "origin_tx"
@@ -29,5 +48,39 @@ const (
 	AttributeKeyAmountA       = "amount_a"
 	AttributeKeyAmountB       = "amount_b"
 	AttributeKeyNetDebtor     = "net_debtor"
-	AttributeKeyReason        = "reason"
-)
\ No newline at end of file
+	AttributeKeyTriggeredBy   = "triggered_by"
+	AttributeKeyDeferredCount = "deferred_count"
+	AttributeKeyAddress       = "address"
+	AttributeKeyBankID        = "bank_id"
+	AttributeKeyDust          = "dust"
+	AttributeKeyDustPolicy    = "dust_policy"
+	AttributeKeyCommandCount  = "command_count"
+	AttributeKeyOutstanding   = "outstanding"
+	AttributeKeyLineageNode   = "lineage_node"
+	AttributeKeyRenameCount   = "rename_count"
+	AttributeKeyBanks         = "banks"
+	AttributeKeyLoopCount     = "loop_count"
+	AttributeKeyMicroCycleID  = "micro_cycle_id"
+	AttributeKeyAttestationID = "attestation_id"
+	AttributeKeyCovered       = "covered"
+	AttributeKeyStatementHash = "statement_hash"
+	AttributeKeyReporter      = "reporter"
+	AttributeKeyCurrencyCount = "currency_count"
+	AttributeKeyNettingSet    = "netting_set"
+	AttributeKeyEndOfDay      = "end_of_day"
+	AttributeKeyBusinessDate  = "business_date"
+	AttributeKeyNextDate      = "next_business_date"
+	AttributeKeyCycleCount    = "cycle_count"
+)
+
+// Attribute keys shared with other modules, kept for existing importers
+const (
+	// Deprecated: use types.AttributeKeyAmount
+	AttributeKeyAmount = types.AttributeKeyAmount
+	// Deprecated: use types.AttributeKeyReason
+	AttributeKeyReason = types.AttributeKeyReason
+	// Deprecated: use types.AttributeKeyDenom
+	AttributeKeyDenom = types.AttributeKeyDenom
+	// Deprecated: use types.AttributeKeyHolderBank
+	AttributeKeyHolderBank = types.AttributeKeyHolderBank
+)
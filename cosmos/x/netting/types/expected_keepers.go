@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	commontypes "github.com/interbank-netting/cosmos/types"
 )
@@ -24,4 +25,12 @@ type BankKeeper interface {
 type OracleKeeper interface {
 	SaveAuditLog(ctx sdk.Context, log commontypes.AuditLog) (uint64, error)
 	LogCreditIssued(ctx sdk.Context, credit commontypes.CreditToken) error
+	GetAuditLogsByTimeRange(ctx sdk.Context, startTime, endTime int64) []commontypes.AuditLog
+}
+
+// MultisigKeeper defines the expected multisig keeper interface for the
+// settlement commands of netting cycles
+type MultisigKeeper interface {
+	GenerateMintCommand(ctx sdk.Context, targetChain, recipient string, amount math.Int) (commontypes.MintCommand, error)
+	GetCommand(ctx sdk.Context, commandID string) (commontypes.MintCommand, bool)
 }
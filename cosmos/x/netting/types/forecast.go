@@ -0,0 +1,59 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	"github.com/interbank-netting/cosmos/types"
+)
+
+// CreditObligation returns the obligation of a credit token: the issuer owes
+// the holder the credit amount
+func CreditObligation(credit types.CreditToken) Obligation {
+	return Obligation{
+		Debtor:   credit.IssuerBank,
+		Creditor: credit.HolderBank,
+		Amount:   credit.Amount,
+		Priority: credit.Priority,
+	}
+}
+
+// ForecastNetting projects the next netting cycle as if the pending
+// obligations were added to the confirmed ones. The pairs are ordered the way
+// a cycle settles them; the ones beyond maxPairs are counted as deferred.
+func ForecastNetting(confirmed, pending []Obligation, maxPairs int) types.NettingForecast {
+	projected := make([]Obligation, 0, len(confirmed)+len(pending))
+	projected = append(projected, confirmed...)
+	projected = append(projected, pending...)
+
+	pairs, deferred := PrioritizePairs(CalculateBilateralPairs(projected), maxPairs)
+	pairs = append(pairs, deferred...)
+
+	forecast := types.NettingForecast{
+		PendingAmount: math.ZeroInt(),
+		Pairs:         pairs,
+		Deferred:      int32(len(deferred)),
+	}
+	for _, ob := range pending {
+		forecast.PendingAmount = forecast.PendingAmount.Add(ob.Amount)
+	}
+
+	confirmedPositions := CalculateNetPositions(confirmed)
+	pendingPositions := CalculateNetPositions(pending)
+	for _, bank := range sortedBanks(projected) {
+		position := types.NetPositionForecast{
+			Bank:      bank,
+			Confirmed: positionOf(confirmedPositions, bank),
+			Pending:   positionOf(pendingPositions, bank),
+		}
+		position.Projected = position.Confirmed.Add(position.Pending)
+		forecast.Positions = append(forecast.Positions, position)
+	}
+
+	return forecast
+}
+
+func positionOf(positions map[string]math.Int, bank string) math.Int {
+	if position, ok := positions[bank]; ok {
+		return position
+	}
+	return math.ZeroInt()
+}
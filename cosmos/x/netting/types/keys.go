@@ -34,6 +34,84 @@ var (
 	
 	// LastNettingBlockKeyPrefix is the prefix for last netting block height
 	LastNettingBlockKeyPrefix = []byte{0x05}
+
+	// ParamsKey is the key for the module parameters
+	ParamsKey = []byte{0x06}
+
+	// LastManualTriggerKeyPrefix is the prefix for the last MsgTriggerNetting block height
+	LastManualTriggerKeyPrefix = []byte{0x07}
+
+	// ObligationPriorityKeyPrefix is the prefix for the priority of outstanding debtor -> creditor obligations
+	ObligationPriorityKeyPrefix = []byte{0x08}
+
+	// FrozenCreditKeyPrefix is the prefix for credit frozen by an open dispute
+	FrozenCreditKeyPrefix = []byte{0x09}
+
+	// BankAccountKeyPrefix is the prefix for the address -> bank ID registry
+	BankAccountKeyPrefix = []byte{0x0A}
+
+	// DustBalanceKeyPrefix is the prefix for residuals accumulated per bank under DustPolicyAccumulate
+	DustBalanceKeyPrefix = []byte{0x0B}
+
+	// CycleSnapshotKeyPrefix is the prefix for the pre-cycle snapshots of cycles still in progress
+	CycleSnapshotKeyPrefix = []byte{0x0C}
+
+	// VelocityBucketKeyPrefix is the prefix for the hourly credit velocity ring of issuer -> holder pairs
+	VelocityBucketKeyPrefix = []byte{0x0D}
+
+	// CycleSettlementKeyPrefix is the prefix for the settlement commands of netting cycles
+	CycleSettlementKeyPrefix = []byte{0x0E}
+
+	// OpenSettlementKeyPrefix is the prefix for the cycles with settlement commands not executed yet
+	OpenSettlementKeyPrefix = []byte{0x0F}
+
+	// ParamsHistoryKeyPrefix is the prefix for the params changes keyed by version
+	ParamsHistoryKeyPrefix = []byte{0x10}
+
+	// LineageNodeKeyPrefix is the prefix for credit lineage nodes keyed by ID
+	LineageNodeKeyPrefix = []byte{0x11}
+
+	// LineageEdgeKeyPrefix is the prefix for credit lineage edges keyed by parent then child
+	LineageEdgeKeyPrefix = []byte{0x12}
+
+	// LineageParentKeyPrefix is the prefix indexing credit lineage edges by child then parent
+	LineageParentKeyPrefix = []byte{0x13}
+
+	// CreditLotKeyPrefix is the prefix for the unspent credit lots of a holder and denom, oldest first
+	CreditLotKeyPrefix = []byte{0x14}
+
+	// LastLineageNodeKey is the key for the ID of the last credit lineage node
+	LastLineageNodeKey = []byte{0x15}
+
+	// PendingDenomMigrationKey is the key for the denom migration scheduled by governance
+	PendingDenomMigrationKey = []byte{0x16}
+
+	// LastDenomMigrationKey is the key for the report of the last applied denom migration
+	LastDenomMigrationKey = []byte{0x17}
+
+	// MicroCycleKeyPrefix is the prefix for the micro-cycles of continuous corridors keyed by ID
+	MicroCycleKeyPrefix = []byte{0x18}
+
+	// LastMicroCycleKey is the key for the ID of the last micro-cycle
+	LastMicroCycleKey = []byte{0x19}
+
+	// ReserveReportKeyPrefix is the prefix for the latest reserve report of each bank
+	ReserveReportKeyPrefix = []byte{0x1A}
+
+	// ReserveAttestationKeyPrefix is the prefix for the proof-of-reserves attestations keyed by bank then ID
+	ReserveAttestationKeyPrefix = []byte{0x1B}
+
+	// LastReserveAttestationKey is the key for the ID of the last reserve attestation
+	LastReserveAttestationKey = []byte{0x1C}
+
+	// LastNettingSetKey is the key for the ID of the netting set of the last cycle
+	LastNettingSetKey = []byte{0x1D}
+
+	// BusinessDayKey is the key for the business day currently open
+	BusinessDayKey = []byte{0x1E}
+
+	// ClosingReportKeyPrefix is the prefix for the closing reports of business days keyed by business date
+	ClosingReportKeyPrefix = []byte{0x1F}
 )
 
 // GetCreditTokenKey returns the store key for a credit token
@@ -71,3 +149,155 @@ func GetLastNettingBlockKey() []byte {
 	return LastNettingBlockKeyPrefix
 }
 
+// GetLastManualTriggerKey returns the store key for the last manual trigger block height
+func GetLastManualTriggerKey() []byte {
+	return LastManualTriggerKeyPrefix
+}
+
+// GetObligationPriorityKey returns the store key for the priority of what debtor owes creditor
+func GetObligationPriorityKey(debtor, creditor string) []byte {
+	key := append(ObligationPriorityKeyPrefix, []byte(debtor)...)
+	key = append(key, []byte("/")...)
+	return append(key, []byte(creditor)...)
+}
+
+// GetFrozenCreditKey returns the store key for a bank's frozen credit of a denom
+func GetFrozenCreditKey(bank, denom string) []byte {
+	key := append(FrozenCreditKeyPrefix, []byte(bank)...)
+	key = append(key, []byte("/")...)
+	return append(key, []byte(denom)...)
+}
+
+// GetBankAccountKey returns the store key for the bank of an address
+func GetBankAccountKey(address string) []byte {
+	return append(BankAccountKeyPrefix, []byte(address)...)
+}
+
+// GetDustBalanceKey returns the store key for a bank's dust account
+func GetDustBalanceKey(bank string) []byte {
+	return append(DustBalanceKeyPrefix, []byte(bank)...)
+}
+
+// GetCycleSnapshotKey returns the store key for the snapshot of a netting cycle
+func GetCycleSnapshotKey(cycleID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, cycleID)
+	return append(CycleSnapshotKeyPrefix, bz...)
+}
+
+// GetVelocityPairPrefix returns the store prefix of an issuer -> holder velocity ring
+func GetVelocityPairPrefix(issuer, holder string) []byte {
+	key := append(VelocityBucketKeyPrefix, []byte(issuer)...)
+	key = append(key, []byte("/")...)
+	key = append(key, []byte(holder)...)
+	return append(key, []byte("/")...)
+}
+
+// GetVelocityBucketKey returns the store key for a slot of an issuer -> holder velocity ring
+func GetVelocityBucketKey(issuer, holder string, slot uint32) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, slot)
+	return append(GetVelocityPairPrefix(issuer, holder), bz...)
+}
+
+// GetCycleSettlementKey returns the store key for the settlement of a netting cycle
+func GetCycleSettlementKey(cycleID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, cycleID)
+	return append(CycleSettlementKeyPrefix, bz...)
+}
+
+// GetOpenSettlementKey returns the store key marking the settlement of a netting cycle open
+func GetOpenSettlementKey(cycleID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, cycleID)
+	return append(OpenSettlementKeyPrefix, bz...)
+}
+
+// GetParamsHistoryKey returns the store key for a params change
+func GetParamsHistoryKey(version uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, version)
+	return append(ParamsHistoryKeyPrefix, bz...)
+}
+
+// GetLineageNodeKey returns the store key for a credit lineage node
+func GetLineageNodeKey(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(LineageNodeKeyPrefix, bz...)
+}
+
+// GetLineageEdgePrefix returns the store prefix of the edges from a credit lineage node
+func GetLineageEdgePrefix(from uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, from)
+	return append(LineageEdgeKeyPrefix, bz...)
+}
+
+// GetLineageEdgeKey returns the store key for a credit lineage edge
+func GetLineageEdgeKey(from, to uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, to)
+	return append(GetLineageEdgePrefix(from), bz...)
+}
+
+// GetLineageParentPrefix returns the store prefix of the edges into a credit lineage node
+func GetLineageParentPrefix(to uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, to)
+	return append(LineageParentKeyPrefix, bz...)
+}
+
+// GetLineageParentKey returns the store key indexing a credit lineage edge by its child
+func GetLineageParentKey(to, from uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, from)
+	return append(GetLineageParentPrefix(to), bz...)
+}
+
+// GetCreditLotPrefix returns the store prefix of a holder's credit lots of a denom
+func GetCreditLotPrefix(holder, denom string) []byte {
+	key := append(CreditLotKeyPrefix, []byte(holder)...)
+	key = append(key, []byte("/")...)
+	key = append(key, []byte(denom)...)
+	return append(key, []byte("/")...)
+}
+
+// GetCreditLotKey returns the store key for a credit lot at a position in
+// the holder's lots of a denom
+func GetCreditLotKey(holder, denom string, position uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, position)
+	return append(GetCreditLotPrefix(holder, denom), bz...)
+}
+
+// GetMicroCycleKey returns the store key for a micro-cycle
+func GetMicroCycleKey(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(MicroCycleKeyPrefix, bz...)
+}
+
+// GetReserveReportKey returns the store key for the reserve report of a bank
+func GetReserveReportKey(bank string) []byte {
+	return append(ReserveReportKeyPrefix, []byte(bank)...)
+}
+
+// GetReserveAttestationPrefix returns the store prefix of a bank's reserve attestations
+func GetReserveAttestationPrefix(bank string) []byte {
+	key := append(ReserveAttestationKeyPrefix, []byte(bank)...)
+	return append(key, []byte("/")...)
+}
+
+// GetReserveAttestationKey returns the store key for a reserve attestation
+func GetReserveAttestationKey(bank string, id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(GetReserveAttestationPrefix(bank), bz...)
+}
+
+// GetClosingReportKey returns the store key for the closing report of a business date
+func GetClosingReportKey(date string) []byte {
+	return append(ClosingReportKeyPrefix, []byte(date)...)
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// Kinds of credit lineage nodes. Credit is held in lots: credit, split and
+// assignment nodes are lots, and a lot is spent once it is passed on to
+// another node.
+const (
+	LineageKindTransfer   = "transfer"    // Besu transfer the credit was issued for; Reference is the tx hash
+	LineageKindCredit     = "credit"      // Credit issued to the holder for a transfer
+	LineageKindSplit      = "split"       // Rest of a lot that was passed on in part
+	LineageKindAssignment = "assignment"  // Credit assigned to another bank; Reference is the assigning bank
+	LineageKindNetting    = "netting"     // Credit burned by a netting cycle; Reference is the cycle ID
+	LineageKindSettlement = "settlement"  // Credit burned for a settlement command; Reference is the command ID
+	LineageKindBurn       = "burn"        // Credit burned outside netting, e.g. by a dispute resolution
+	LineageKindMicroCycle = "micro_cycle" // Credit burned by a continuous corridor; Reference is the micro-cycle ID
+)
+
+// IsCreditLot returns true if nodes of the kind hold credit
+func IsCreditLot(kind string) bool {
+	return kind == LineageKindCredit || kind == LineageKindSplit || kind == LineageKindAssignment
+}
+
+// CreditLineageNode is an event in the life of credit: the transfer it was
+// issued for, a lot holding it, or the burn that retired it
+type CreditLineageNode struct {
+	ID        uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Kind      string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind"`
+	Denom     string   `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom"`
+	Bank      string   `protobuf:"bytes,4,opt,name=bank,proto3" json:"bank"` // Holder of a lot, issuer of a transfer
+	Amount    math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Reference string   `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	Spent     bool     `protobuf:"varint,7,opt,name=spent,proto3" json:"spent"` // The lot was passed on
+	Height    int64    `protobuf:"varint,8,opt,name=height,proto3" json:"height"`
+	Time      int64    `protobuf:"varint,9,opt,name=time,proto3" json:"time"`
+}
+
+// ProtoMessage implements proto.Message
+func (n *CreditLineageNode) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (n *CreditLineageNode) Reset() { *n = CreditLineageNode{} }
+
+// String implements proto.Message
+func (n *CreditLineageNode) String() string {
+	return fmt.Sprintf("CreditLineageNode{ID: %d, Kind: %s, Bank: %s, Amount: %s}", n.ID, n.Kind, n.Bank, n.Amount)
+}
+
+// CreditLineageEdge is an amount of credit passed from one node to another
+type CreditLineageEdge struct {
+	From   uint64   `protobuf:"varint,1,opt,name=from,proto3" json:"from"`
+	To     uint64   `protobuf:"varint,2,opt,name=to,proto3" json:"to"`
+	Amount math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+// ProtoMessage implements proto.Message
+func (e *CreditLineageEdge) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (e *CreditLineageEdge) Reset() { *e = CreditLineageEdge{} }
+
+// String implements proto.Message
+func (e *CreditLineageEdge) String() string {
+	return fmt.Sprintf("CreditLineageEdge{From: %d, To: %d, Amount: %s}", e.From, e.To, e.Amount)
+}
+
+// CreditLineage is the provenance graph of a node: the node with every node
+// it descends from and every node descending from it, in ID order
+type CreditLineage struct {
+	Root  uint64              `protobuf:"varint,1,opt,name=root,proto3" json:"root"`
+	Nodes []CreditLineageNode `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes"`
+	Edges []CreditLineageEdge `protobuf:"bytes,3,rep,name=edges,proto3" json:"edges"`
+}
+
+// ProtoMessage implements proto.Message
+func (l *CreditLineage) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (l *CreditLineage) Reset() { *l = CreditLineage{} }
+
+// String implements proto.Message
+func (l *CreditLineage) String() string {
+	return fmt.Sprintf("CreditLineage{Root: %d, Nodes: %d, Edges: %d}", l.Root, len(l.Nodes), len(l.Edges))
+}
+
+// Origins returns the Besu transfers the lineage traces back to
+func (l CreditLineage) Origins() []CreditLineageNode {
+	var origins []CreditLineageNode
+	for _, node := range l.Nodes {
+		if node.Kind == LineageKindTransfer {
+			origins = append(origins, node)
+		}
+	}
+	return origins
+}
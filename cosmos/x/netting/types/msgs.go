@@ -3,21 +3,37 @@ package types
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/interbank-netting/cosmos/types"
 )
 
 const (
-	TypeMsgIssueCreditToken = "issue_credit_token"
-	TypeMsgBurnCreditToken  = "burn_credit_token"
-	TypeMsgTriggerNetting   = "trigger_netting"
+	TypeMsgIssueCreditToken       = "issue_credit_token"
+	TypeMsgBurnCreditToken        = "burn_credit_token"
+	TypeMsgTriggerNetting         = "trigger_netting"
+	TypeMsgUpdateParams           = "update_params"
+	TypeMsgSetBankAccount         = "set_bank_account"
+	TypeMsgRemoveBankAccount      = "remove_bank_account"
+	TypeMsgCancelNettingCycle     = "cancel_netting_cycle"
+	TypeMsgScheduleDenomMigration = "schedule_denom_migration"
+	TypeMsgReportReserves         = "report_reserves"
 )
 
 var (
 	_ sdk.Msg = &MsgIssueCreditToken{}
 	_ sdk.Msg = &MsgBurnCreditToken{}
 	_ sdk.Msg = &MsgTriggerNetting{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetBankAccount{}
+	_ sdk.Msg = &MsgRemoveBankAccount{}
+	_ sdk.Msg = &MsgCancelNettingCycle{}
+	_ sdk.Msg = &MsgScheduleDenomMigration{}
+	_ sdk.Msg = &MsgReportReserves{}
+
+	_ types.PhasedMsg = &MsgTriggerNetting{}
 )
 
 // MsgIssueCreditToken defines a message for issuing credit tokens
@@ -73,32 +89,32 @@ func (msg MsgIssueCreditToken) GetSignBytes() []byte {
 // ValidateBasic implements the sdk.Msg interface
 func (msg MsgIssueCreditToken) ValidateBasic() error {
 	if msg.Issuer == "" {
-		return fmt.Errorf("issuer cannot be empty")
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "issuer cannot be empty")
 	}
 
 	_, err := sdk.AccAddressFromBech32(msg.Issuer)
 	if err != nil {
-		return fmt.Errorf("invalid issuer address: %w", err)
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address: %s", err)
 	}
 
 	if msg.CreditToken.Denom == "" {
-		return fmt.Errorf("credit token denom cannot be empty")
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "credit token denom cannot be empty")
 	}
 
 	if msg.CreditToken.IssuerBank == "" {
-		return fmt.Errorf("issuer bank cannot be empty")
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "issuer bank cannot be empty")
 	}
 
 	if msg.CreditToken.HolderBank == "" {
-		return fmt.Errorf("holder bank cannot be empty")
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "holder bank cannot be empty")
 	}
 
 	if msg.CreditToken.Amount.IsNil() || msg.CreditToken.Amount.LTE(math.ZeroInt()) {
-		return fmt.Errorf("credit token amount must be positive")
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "credit token amount must be positive")
 	}
 
 	if msg.CreditToken.OriginTx == "" {
-		return fmt.Errorf("origin transaction cannot be empty")
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "origin transaction cannot be empty")
 	}
 
 	return nil
@@ -159,20 +175,20 @@ func (msg MsgBurnCreditToken) GetSignBytes() []byte {
 // ValidateBasic implements the sdk.Msg interface
 func (msg MsgBurnCreditToken) ValidateBasic() error {
 	if msg.Burner == "" {
-		return fmt.Errorf("burner cannot be empty")
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "burner cannot be empty")
 	}
 
 	_, err := sdk.AccAddressFromBech32(msg.Burner)
 	if err != nil {
-		return fmt.Errorf("invalid burner address: %w", err)
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid burner address: %s", err)
 	}
 
 	if msg.Denom == "" {
-		return fmt.Errorf("denom cannot be empty")
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denom cannot be empty")
 	}
 
 	if msg.Amount.IsNil() || msg.Amount.LTE(math.ZeroInt()) {
-		return fmt.Errorf("amount must be positive")
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
 	}
 
 	return nil
@@ -229,13 +245,422 @@ func (msg MsgTriggerNetting) GetSignBytes() []byte {
 // ValidateBasic implements the sdk.Msg interface
 func (msg MsgTriggerNetting) ValidateBasic() error {
 	if msg.Triggerer == "" {
-		return fmt.Errorf("triggerer cannot be empty")
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "triggerer cannot be empty")
 	}
 
 	_, err := sdk.AccAddressFromBech32(msg.Triggerer)
 	if err != nil {
-		return fmt.Errorf("invalid triggerer address: %w", err)
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid triggerer address: %s", err)
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// MsgPhase implements types.PhasedMsg: netting triggers run after every
+// confirmation of their block, so the cycle nets the credit they issued
+func (msg MsgTriggerNetting) MsgPhase() types.MsgPhase {
+	return types.MsgPhaseNetting
+}
+
+// MsgUpdateParams defines a governance message for updating the module params,
+// including the registered netting operators. ProposalID is the ID of the
+// proposal carrying the message and is recorded in the params history.
+type MsgUpdateParams struct {
+	Authority  string `json:"authority"`
+	Params     Params `json:"params"`
+	ProposalID uint64 `json:"proposal_id,omitempty"`
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgUpdateParams) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgUpdateParams) Reset() { *msg = MsgUpdateParams{} }
+
+// String implements proto.Message
+func (msg *MsgUpdateParams) String() string {
+	return fmt.Sprintf("MsgUpdateParams{Authority: %s, Params: %s}", msg.Authority, msg.Params.String())
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgUpdateParams) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgUpdateParams) Type() string {
+	return TypeMsgUpdateParams
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidParams, err.Error())
+	}
+
+	return nil
+}
+
+// MsgSetBankAccount defines a governance message for authorizing an address
+// to act for a bank in credit messages
+type MsgSetBankAccount struct {
+	Authority string      `json:"authority"`
+	Account   BankAccount `json:"account"`
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgSetBankAccount) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgSetBankAccount) Reset() { *msg = MsgSetBankAccount{} }
+
+// String implements proto.Message
+func (msg *MsgSetBankAccount) String() string {
+	return fmt.Sprintf("MsgSetBankAccount{Authority: %s, Account: %s}", msg.Authority, msg.Account.String())
+}
+
+// NewMsgSetBankAccount creates a new MsgSetBankAccount instance
+func NewMsgSetBankAccount(authority, address, bankID string) *MsgSetBankAccount {
+	return &MsgSetBankAccount{
+		Authority: authority,
+		Account:   BankAccount{Address: address, BankID: bankID},
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgSetBankAccount) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgSetBankAccount) Type() string {
+	return TypeMsgSetBankAccount
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgSetBankAccount) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgSetBankAccount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgSetBankAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if err := msg.Account.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// MsgRemoveBankAccount defines a governance message for revoking an address's
+// authorization to act for its bank
+type MsgRemoveBankAccount struct {
+	Authority string `json:"authority"`
+	Address   string `json:"address"`
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgRemoveBankAccount) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgRemoveBankAccount) Reset() { *msg = MsgRemoveBankAccount{} }
+
+// String implements proto.Message
+func (msg *MsgRemoveBankAccount) String() string {
+	return fmt.Sprintf("MsgRemoveBankAccount{Authority: %s, Address: %s}", msg.Authority, msg.Address)
+}
+
+// NewMsgRemoveBankAccount creates a new MsgRemoveBankAccount instance
+func NewMsgRemoveBankAccount(authority, address string) *MsgRemoveBankAccount {
+	return &MsgRemoveBankAccount{
+		Authority: authority,
+		Address:   address,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgRemoveBankAccount) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgRemoveBankAccount) Type() string {
+	return TypeMsgRemoveBankAccount
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgRemoveBankAccount) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgRemoveBankAccount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgRemoveBankAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bank account address: %s", err)
+	}
+
+	return nil
+}
+
+// MsgCancelNettingCycle defines a governance message for cancelling a netting
+// cycle left in progress by a partial failure
+type MsgCancelNettingCycle struct {
+	Authority string `json:"authority"`
+	CycleID   uint64 `json:"cycle_id"`
+	Reason    string `json:"reason"`
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgCancelNettingCycle) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgCancelNettingCycle) Reset() { *msg = MsgCancelNettingCycle{} }
+
+// String implements proto.Message
+func (msg *MsgCancelNettingCycle) String() string {
+	return fmt.Sprintf("MsgCancelNettingCycle{Authority: %s, CycleID: %d}", msg.Authority, msg.CycleID)
+}
+
+// NewMsgCancelNettingCycle creates a new MsgCancelNettingCycle instance
+func NewMsgCancelNettingCycle(authority string, cycleID uint64, reason string) *MsgCancelNettingCycle {
+	return &MsgCancelNettingCycle{
+		Authority: authority,
+		CycleID:   cycleID,
+		Reason:    reason,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgCancelNettingCycle) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgCancelNettingCycle) Type() string {
+	return TypeMsgCancelNettingCycle
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgCancelNettingCycle) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgCancelNettingCycle) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgCancelNettingCycle) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if msg.CycleID == 0 {
+		return errorsmod.Wrap(ErrInvalidNettingCycle, "cycle ID cannot be zero")
+	}
+
+	if msg.Reason == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "reason cannot be empty")
+	}
+
+	return nil
+}
+
+// MsgScheduleDenomMigration defines a governance message for scheduling a
+// credit denom migration at an upgrade height
+type MsgScheduleDenomMigration struct {
+	Authority string         `json:"authority"`
+	Migration DenomMigration `json:"migration"`
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgScheduleDenomMigration) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgScheduleDenomMigration) Reset() { *msg = MsgScheduleDenomMigration{} }
+
+// String implements proto.Message
+func (msg *MsgScheduleDenomMigration) String() string {
+	return fmt.Sprintf("MsgScheduleDenomMigration{Authority: %s, Height: %d, Renames: %d}", msg.Authority, msg.Migration.Height, len(msg.Migration.Renames))
+}
+
+// NewMsgScheduleDenomMigration creates a new MsgScheduleDenomMigration instance
+func NewMsgScheduleDenomMigration(authority string, migration DenomMigration) *MsgScheduleDenomMigration {
+	return &MsgScheduleDenomMigration{
+		Authority: authority,
+		Migration: migration,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgScheduleDenomMigration) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgScheduleDenomMigration) Type() string {
+	return TypeMsgScheduleDenomMigration
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgScheduleDenomMigration) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgScheduleDenomMigration) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgScheduleDenomMigration) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if err := msg.Migration.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenomMigration, err.Error())
+	}
+
+	return nil
+}
+
+// MsgReportReserves defines a message for a bank declaring the collateral
+// and escrow it holds against its issued credit, replacing its previous
+// report
+type MsgReportReserves struct {
+	Reporter string          `json:"reporter"`
+	Bank     string          `json:"bank"`
+	Reserves []ReserveAmount `json:"reserves"`
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgReportReserves) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgReportReserves) Reset() { *msg = MsgReportReserves{} }
+
+// String implements proto.Message
+func (msg *MsgReportReserves) String() string {
+	return fmt.Sprintf("MsgReportReserves{Reporter: %s, Bank: %s, Reserves: %d}", msg.Reporter, msg.Bank, len(msg.Reserves))
+}
+
+// NewMsgReportReserves creates a new MsgReportReserves instance
+func NewMsgReportReserves(reporter, bank string, reserves []ReserveAmount) *MsgReportReserves {
+	return &MsgReportReserves{
+		Reporter: reporter,
+		Bank:     bank,
+		Reserves: reserves,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgReportReserves) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgReportReserves) Type() string {
+	return TypeMsgReportReserves
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgReportReserves) GetSigners() []sdk.AccAddress {
+	reporter, err := sdk.AccAddressFromBech32(msg.Reporter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{reporter}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgReportReserves) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgReportReserves) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Reporter); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid reporter address: %s", err)
+	}
+
+	if msg.Bank == "" {
+		return errorsmod.Wrap(ErrInvalidBankID, "bank cannot be empty")
+	}
+
+	if err := types.ValidateFieldLength("bank", msg.Bank, types.MaxFieldLength); err != nil {
+		return errorsmod.Wrap(ErrInvalidBankID, err.Error())
+	}
+
+	if err := ValidateReserves(msg.Reserves); err != nil {
+		return errorsmod.Wrap(ErrInvalidReserves, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/interbank-netting/cosmos/types"
+)
+
+// DefaultNettingSetID names the netting set of networks without configured
+// netting sets, which nets the base currency only
+const DefaultNettingSetID = "default"
+
+// NettingSet groups the credit currencies netted together under one netting
+// agreement. Obligations between two banks sum their credit in every currency
+// of the set at par, and a cycle nets a single set, so credit under different
+// agreements is never offset against each other. A currency belongs to at
+// most one set; credit in currencies of no set is not netted.
+type NettingSet struct {
+	ID         string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Currencies []string `protobuf:"bytes,2,rep,name=currencies,proto3" json:"currencies"` // Burned in this order; types.BaseCurrency for cred-{bank}
+}
+
+// ProtoMessage implements proto.Message
+func (s *NettingSet) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (s *NettingSet) Reset() { *s = NettingSet{} }
+
+// String implements proto.Message
+func (s *NettingSet) String() string {
+	return fmt.Sprintf("NettingSet{ID: %s, Currencies: %v}", s.ID, s.Currencies)
+}
+
+// DefaultNettingSet returns the netting set of the base currency
+func DefaultNettingSet() NettingSet {
+	return NettingSet{ID: DefaultNettingSetID, Currencies: []string{types.BaseCurrency}}
+}
+
+// Contains returns true if the currency is netted in the set
+func (s NettingSet) Contains(currency string) bool {
+	for _, c := range s.Currencies {
+		if c == currency {
+			return true
+		}
+	}
+	return false
+}
+
+// Denoms returns the credit denoms a bank issues in the set's currencies
+func (s NettingSet) Denoms(issuer string) []string {
+	denoms := make([]string, len(s.Currencies))
+	for i, currency := range s.Currencies {
+		denoms[i] = types.CreditDenom(issuer, currency)
+	}
+	return denoms
+}
+
+// ValidateNettingSets checks the IDs and currencies of netting sets
+func ValidateNettingSets(sets []NettingSet) error {
+	ids := make(map[string]bool, len(sets))
+	currencies := make(map[string]string)
+	for i, set := range sets {
+		if set.ID == "" {
+			return fmt.Errorf("netting set %d: ID cannot be empty", i)
+		}
+		if ids[set.ID] {
+			return fmt.Errorf("netting set %d: duplicate ID %s", i, set.ID)
+		}
+		ids[set.ID] = true
+
+		if len(set.Currencies) == 0 {
+			return fmt.Errorf("netting set %s: no currencies", set.ID)
+		}
+		for _, currency := range set.Currencies {
+			if currency == "" || strings.Contains(currency, ":") {
+				return fmt.Errorf("netting set %s: invalid currency %q", set.ID, currency)
+			}
+			if other, found := currencies[currency]; found {
+				return fmt.Errorf("netting set %s: currency %s already netted in %s", set.ID, currency, other)
+			}
+			currencies[currency] = set.ID
+		}
+	}
+	return nil
+}
+
+// nettingSetKey is the context key holding the netting set of a cycle
+type nettingSetKey struct{}
+
+// WithNettingSet returns a context whose netting calculations, including
+// those of registered strategies, are scoped to a netting set
+func WithNettingSet(ctx sdk.Context, set NettingSet) sdk.Context {
+	return ctx.WithValue(nettingSetKey{}, set)
+}
+
+// GetNettingSet returns the netting set of a context, if any
+func GetNettingSet(ctx sdk.Context) (NettingSet, bool) {
+	set, ok := ctx.Value(nettingSetKey{}).(NettingSet)
+	return set, ok
+}
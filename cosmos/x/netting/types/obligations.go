@@ -0,0 +1,309 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	"github.com/interbank-netting/cosmos/types"
+)
+
+// Obligation represents a gross bilateral obligation: Debtor owes Creditor Amount.
+// On-chain, an obligation corresponds to Creditor holding cred-{Debtor} tokens.
+type Obligation struct {
+	Debtor   string   `json:"debtor"`
+	Creditor string   `json:"creditor"`
+	Amount   math.Int `json:"amount"`
+	Priority int32    `json:"priority,omitempty"` // types.PriorityNormal unless set
+}
+
+// ValidateObligations validates a list of obligations
+func ValidateObligations(obligations []Obligation) error {
+	for i, ob := range obligations {
+		if ob.Debtor == "" || ob.Creditor == "" {
+			return fmt.Errorf("obligation %d: debtor and creditor cannot be empty", i)
+		}
+		if ob.Debtor == ob.Creditor {
+			return fmt.Errorf("obligation %d: debtor and creditor must differ", i)
+		}
+		if ob.Amount.IsNil() || ob.Amount.LTE(math.ZeroInt()) {
+			return fmt.Errorf("obligation %d: amount must be positive", i)
+		}
+		if !types.IsValidPriority(ob.Priority) {
+			return fmt.Errorf("obligation %d: unknown priority %d", i, ob.Priority)
+		}
+	}
+	return nil
+}
+
+// CalculateBilateralPairs nets obligations pairwise between banks.
+// A pair is only produced when both banks owe each other, matching the
+// on-chain CalculateNetting behaviour. Banks are visited in lexicographic
+// order so the result is deterministic whatever the order of the obligations;
+// see types.NewBankPair for which bank is the net debtor, including when both
+// owe the same. A pair takes the highest priority of the obligations between
+// its banks.
+func CalculateBilateralPairs(obligations []Obligation) []types.BankPair {
+	owed := aggregateObligations(obligations)
+	priorities := aggregatePriorities(obligations)
+	banks := sortedBanks(obligations)
+
+	var pairs []types.BankPair
+	for i := 0; i < len(banks); i++ {
+		for j := i + 1; j < len(banks); j++ {
+			bankA := banks[i]
+			bankB := banks[j]
+
+			// Amount A owes to B and amount B owes to A
+			amountA := owedAmount(owed, bankA, bankB)
+			amountB := owedAmount(owed, bankB, bankA)
+
+			// Only create pair if both banks have credits from each other
+			if !amountA.IsPositive() || !amountB.IsPositive() {
+				continue
+			}
+
+			priority := max(priorities[bankA][bankB], priorities[bankB][bankA])
+			pairs = append(pairs, types.NewBankPair(bankA, bankB, amountA, amountB, priority))
+		}
+	}
+
+	return pairs
+}
+
+// PrioritizePairs orders pairs by descending priority, then by BankA and
+// BankB, and splits off the pairs beyond maxPairs so that a partial cycle
+// settles high-priority obligations first. Pairs of the same priority are
+// ordered by bank ID, so the pairs a partial cycle defers don't depend on the
+// order they were given in.
+func PrioritizePairs(pairs []types.BankPair, maxPairs int) (selected, deferred []types.BankPair) {
+	ordered := make([]types.BankPair, len(pairs))
+	copy(ordered, pairs)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].Priority != ordered[j].Priority {
+			return ordered[i].Priority > ordered[j].Priority
+		}
+		if ordered[i].BankA != ordered[j].BankA {
+			return ordered[i].BankA < ordered[j].BankA
+		}
+		return ordered[i].BankB < ordered[j].BankB
+	})
+
+	if len(ordered) <= maxPairs {
+		return ordered, nil
+	}
+	return ordered[:maxPairs], ordered[maxPairs:]
+}
+
+// FilterMinNetting returns the pairs whose offset, the smaller of their two
+// amounts, is at least minAmount. The other pairs stay outstanding until
+// their offset grows or the end-of-day cycle nets them.
+func FilterMinNetting(pairs []types.BankPair, minAmount int64) []types.BankPair {
+	filtered := make([]types.BankPair, 0, len(pairs))
+	for _, pair := range pairs {
+		if math.MinInt(pair.AmountA, pair.AmountB).GTE(math.NewInt(minAmount)) {
+			filtered = append(filtered, pair)
+		}
+	}
+	return filtered
+}
+
+// CalculateObligationLoops finds the loops of obligations left once every
+// pair of banks has netted bilaterally, for multilateral netting to compress.
+// Loops are searched from the lexicographically smallest bank, following
+// creditors in lexicographic order, and each takes the smallest obligation on
+// it, so the result is deterministic whatever the order of the obligations.
+// Every loop removes at least one obligation, which bounds the search.
+func CalculateObligationLoops(obligations []Obligation) []types.ObligationLoop {
+	owed := aggregateObligations(obligations)
+	banks := sortedBanks(obligations)
+
+	// Residual obligations after bilateral netting
+	for i := 0; i < len(banks); i++ {
+		for j := i + 1; j < len(banks); j++ {
+			amountA := owedAmount(owed, banks[i], banks[j])
+			amountB := owedAmount(owed, banks[j], banks[i])
+			netted := math.MinInt(amountA, amountB)
+			if netted.IsPositive() {
+				owed[banks[i]][banks[j]] = amountA.Sub(netted)
+				owed[banks[j]][banks[i]] = amountB.Sub(netted)
+			}
+		}
+	}
+
+	var loops []types.ObligationLoop
+	for {
+		path := findObligationLoop(owed, banks)
+		if path == nil {
+			return loops
+		}
+
+		amount := owedAmount(owed, path[len(path)-1], path[0])
+		for i := 0; i+1 < len(path); i++ {
+			amount = math.MinInt(amount, owedAmount(owed, path[i], path[i+1]))
+		}
+		for i := range path {
+			debtor, creditor := path[i], path[(i+1)%len(path)]
+			owed[debtor][creditor] = owed[debtor][creditor].Sub(amount)
+		}
+		loops = append(loops, types.ObligationLoop{Banks: path, Amount: amount})
+	}
+}
+
+// findObligationLoop returns the first loop of positive obligations, or nil
+func findObligationLoop(owed map[string]map[string]math.Int, banks []string) []string {
+	for _, start := range banks {
+		onPath := map[string]bool{start: true}
+		if path := extendObligationLoop(owed, banks, []string{start}, onPath); path != nil {
+			return path
+		}
+	}
+	return nil
+}
+
+func extendObligationLoop(owed map[string]map[string]math.Int, banks, path []string, onPath map[string]bool) []string {
+	debtor := path[len(path)-1]
+	for _, creditor := range banks {
+		if !owedAmount(owed, debtor, creditor).IsPositive() {
+			continue
+		}
+		if creditor == path[0] {
+			if len(path) >= 3 {
+				return path
+			}
+			continue
+		}
+		// Loops through a smaller bank were already searched from it
+		if onPath[creditor] || creditor < path[0] {
+			continue
+		}
+
+		onPath[creditor] = true
+		if loop := extendObligationLoop(owed, banks, append(path, creditor), onPath); loop != nil {
+			return loop
+		}
+		onPath[creditor] = false
+	}
+	return nil
+}
+
+// CalculateNetPositions returns the multilateral net position of every bank.
+// A positive position means the bank is a net creditor, a negative one a net debtor.
+func CalculateNetPositions(obligations []Obligation) map[string]math.Int {
+	positions := make(map[string]math.Int)
+	for _, bank := range sortedBanks(obligations) {
+		positions[bank] = math.ZeroInt()
+	}
+
+	for _, ob := range obligations {
+		positions[ob.Creditor] = positions[ob.Creditor].Add(ob.Amount)
+		positions[ob.Debtor] = positions[ob.Debtor].Sub(ob.Amount)
+	}
+
+	return positions
+}
+
+// CompressionStats summarizes how much settlement volume netting removes
+type CompressionStats struct {
+	ObligationCount         int            `json:"obligation_count"`
+	BankCount               int            `json:"bank_count"`
+	GrossAmount             math.Int       `json:"gross_amount"`
+	BilateralSettlement     math.Int       `json:"bilateral_settlement"`
+	MultilateralSettlement  math.Int       `json:"multilateral_settlement"`
+	BilateralCompression    math.LegacyDec `json:"bilateral_compression"`
+	MultilateralCompression math.LegacyDec `json:"multilateral_compression"`
+}
+
+// CalculateCompressionStats computes gross versus netted settlement volumes.
+// Bilateral settlement is the sum of residual bilateral positions; multilateral
+// settlement is the sum of positive net positions.
+func CalculateCompressionStats(obligations []Obligation) CompressionStats {
+	owed := aggregateObligations(obligations)
+	banks := sortedBanks(obligations)
+
+	gross := math.ZeroInt()
+	for _, ob := range obligations {
+		gross = gross.Add(ob.Amount)
+	}
+
+	bilateral := math.ZeroInt()
+	for i := 0; i < len(banks); i++ {
+		for j := i + 1; j < len(banks); j++ {
+			amountA := owedAmount(owed, banks[i], banks[j])
+			amountB := owedAmount(owed, banks[j], banks[i])
+			bilateral = bilateral.Add(amountA.Sub(amountB).Abs())
+		}
+	}
+
+	multilateral := math.ZeroInt()
+	for _, position := range CalculateNetPositions(obligations) {
+		if position.IsPositive() {
+			multilateral = multilateral.Add(position)
+		}
+	}
+
+	return CompressionStats{
+		ObligationCount:         len(obligations),
+		BankCount:               len(banks),
+		GrossAmount:             gross,
+		BilateralSettlement:     bilateral,
+		MultilateralSettlement:  multilateral,
+		BilateralCompression:    compressionRatio(gross, bilateral),
+		MultilateralCompression: compressionRatio(gross, multilateral),
+	}
+}
+
+// compressionRatio returns 1 - settled/gross, or zero when there is no gross volume
+func compressionRatio(gross, settled math.Int) math.LegacyDec {
+	if !gross.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+	return math.LegacyOneDec().Sub(math.LegacyNewDecFromInt(settled).QuoInt(gross))
+}
+
+// aggregateObligations sums obligations by debtor -> creditor
+func aggregateObligations(obligations []Obligation) map[string]map[string]math.Int {
+	owed := make(map[string]map[string]math.Int)
+	for _, ob := range obligations {
+		if _, ok := owed[ob.Debtor]; !ok {
+			owed[ob.Debtor] = make(map[string]math.Int)
+		}
+		owed[ob.Debtor][ob.Creditor] = owedAmount(owed, ob.Debtor, ob.Creditor).Add(ob.Amount)
+	}
+	return owed
+}
+
+// aggregatePriorities returns the highest priority by debtor -> creditor
+func aggregatePriorities(obligations []Obligation) map[string]map[string]int32 {
+	priorities := make(map[string]map[string]int32)
+	for _, ob := range obligations {
+		if _, ok := priorities[ob.Debtor]; !ok {
+			priorities[ob.Debtor] = make(map[string]int32)
+		}
+		priorities[ob.Debtor][ob.Creditor] = max(priorities[ob.Debtor][ob.Creditor], ob.Priority)
+	}
+	return priorities
+}
+
+func owedAmount(owed map[string]map[string]math.Int, debtor, creditor string) math.Int {
+	if amount, ok := owed[debtor][creditor]; ok {
+		return amount
+	}
+	return math.ZeroInt()
+}
+
+// sortedBanks returns every bank that appears in the obligations, sorted
+func sortedBanks(obligations []Obligation) []string {
+	bankSet := make(map[string]bool)
+	for _, ob := range obligations {
+		bankSet[ob.Debtor] = true
+		bankSet[ob.Creditor] = true
+	}
+
+	banks := make([]string, 0, len(bankSet))
+	for bank := range bankSet {
+		banks = append(banks, bank)
+	}
+	sort.Strings(banks)
+	return banks
+}
@@ -0,0 +1,205 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Params defines the parameters for the netting module.
+type Params struct {
+	NettingInterval            int64                `protobuf:"varint,1,opt,name=netting_interval,json=nettingInterval,proto3" json:"netting_interval"`                                     // Netting interval in blocks
+	MinNettingAmount           int64                `protobuf:"varint,2,opt,name=min_netting_amount,json=minNettingAmount,proto3" json:"min_netting_amount"`                                // Minimum amount for netting
+	MaxNettingPairs            int32                `protobuf:"varint,3,opt,name=max_netting_pairs,json=maxNettingPairs,proto3" json:"max_netting_pairs"`                                   // Maximum pairs per netting cycle
+	Operators                  []string             `protobuf:"bytes,4,rep,name=operators,proto3" json:"operators"`                                                                         // Accounts allowed to send MsgTriggerNetting
+	ManualTriggerCooldown      int64                `protobuf:"varint,5,opt,name=manual_trigger_cooldown,json=manualTriggerCooldown,proto3" json:"manual_trigger_cooldown"`                 // Minimum blocks between manual triggers
+	SettlementUnit             int64                `protobuf:"varint,6,opt,name=settlement_unit,json=settlementUnit,proto3" json:"settlement_unit"`                                        // Netted amounts are rounded down to a multiple of this
+	DustPolicy                 int32                `protobuf:"varint,7,opt,name=dust_policy,json=dustPolicy,proto3" json:"dust_policy"`                                                    // What happens to the residual below SettlementUnit
+	SettlementAccounts         []SettlementAccount  `protobuf:"bytes,8,rep,name=settlement_accounts,json=settlementAccounts,proto3" json:"settlement_accounts"`                             // Banks whose netted residuals are settled by mint commands
+	MultilateralNetting        bool                 `protobuf:"varint,9,opt,name=multilateral_netting,json=multilateralNetting,proto3" json:"multilateral_netting"`                         // Also compress obligation loops among three or more banks
+	NettingStrategy            string               `protobuf:"bytes,10,opt,name=netting_strategy,json=nettingStrategy,proto3" json:"netting_strategy"`                                     // Registered strategy calculating the pairs of a cycle
+	ContinuousCorridors        []ContinuousCorridor `protobuf:"bytes,11,rep,name=continuous_corridors,json=continuousCorridors,proto3" json:"continuous_corridors"`                         // Bank pairs offset on every credit issuance
+	ReserveAttestationInterval int64                `protobuf:"varint,12,opt,name=reserve_attestation_interval,json=reserveAttestationInterval,proto3" json:"reserve_attestation_interval"` // Blocks between proof-of-reserves attestations; zero disables them
+	NettingSets                []NettingSet         `protobuf:"bytes,13,rep,name=netting_sets,json=nettingSets,proto3" json:"netting_sets"`                                                 // Currencies netted together per agreement; empty nets the base currency only
+	BusinessDayClose           int64                `protobuf:"varint,14,opt,name=business_day_close,json=businessDayClose,proto3" json:"business_day_close"`                               // Seconds after UTC midnight the business day closes; zero disables end-of-day cycles
+}
+
+// ProtoMessage implements proto.Message
+func (p *Params) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (p *Params) Reset() { *p = Params{} }
+
+// String implements proto.Message
+func (p *Params) String() string {
+	return fmt.Sprintf("Params{NettingInterval: %d, MaxNettingPairs: %d, Operators: %d, ManualTriggerCooldown: %d, SettlementUnit: %d, DustPolicy: %d}",
+		p.NettingInterval, p.MaxNettingPairs, len(p.Operators), p.ManualTriggerCooldown, p.SettlementUnit, p.DustPolicy)
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return Params{
+		NettingInterval:            10,                    // Every 10 blocks
+		MinNettingAmount:           1,                     // Minimum 1 unit
+		MaxNettingPairs:            100,                   // Maximum 100 pairs per cycle
+		Operators:                  []string{},            // Only governance until operators are registered
+		ManualTriggerCooldown:      20,                    // At most one manual trigger every 20 blocks
+		SettlementUnit:             1,                     // Whole units, no residual
+		DustPolicy:                 DustPolicyCarry,       // Residuals are never lost
+		SettlementAccounts:         []SettlementAccount{}, // Residuals stay outstanding as credit
+		MultilateralNetting:        false,                 // Bilateral netting only until enabled per network
+		NettingStrategy:            NettingStrategyBilateral,
+		ContinuousCorridors:        []ContinuousCorridor{}, // Periodic cycles only until corridors opt in
+		ReserveAttestationInterval: 0,                      // No attestations until a network schedules its disclosures
+		NettingSets:                []NettingSet{},         // The default netting set until agreements are configured
+		BusinessDayClose:           0,                      // No end-of-day cycles until a network sets its close
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if p.NettingInterval <= 0 {
+		return fmt.Errorf("netting interval must be positive: %d", p.NettingInterval)
+	}
+
+	if p.MinNettingAmount <= 0 {
+		return fmt.Errorf("minimum netting amount must be positive: %d", p.MinNettingAmount)
+	}
+
+	if p.MaxNettingPairs <= 0 {
+		return fmt.Errorf("maximum netting pairs must be positive: %d", p.MaxNettingPairs)
+	}
+
+	if p.ManualTriggerCooldown < 0 {
+		return fmt.Errorf("manual trigger cooldown cannot be negative: %d", p.ManualTriggerCooldown)
+	}
+
+	if p.SettlementUnit <= 0 {
+		return fmt.Errorf("settlement unit must be positive: %d", p.SettlementUnit)
+	}
+
+	if p.ReserveAttestationInterval < 0 {
+		return fmt.Errorf("reserve attestation interval cannot be negative: %d", p.ReserveAttestationInterval)
+	}
+
+	if p.BusinessDayClose < 0 || p.BusinessDayClose > SecondsPerDay {
+		return fmt.Errorf("business day close must be between 0 and %d seconds: %d", SecondsPerDay, p.BusinessDayClose)
+	}
+
+	if err := ValidateNettingSets(p.NettingSets); err != nil {
+		return err
+	}
+
+	if !IsValidDustPolicy(p.DustPolicy) {
+		return fmt.Errorf("unknown dust policy: %d", p.DustPolicy)
+	}
+
+	seen := make(map[string]bool, len(p.Operators))
+	for i, operator := range p.Operators {
+		if _, err := sdk.AccAddressFromBech32(operator); err != nil {
+			return fmt.Errorf("operator %d: invalid address %s: %w", i, operator, err)
+		}
+		if seen[operator] {
+			return fmt.Errorf("operator %d: duplicate address %s", i, operator)
+		}
+		seen[operator] = true
+	}
+
+	banks := make(map[string]bool, len(p.SettlementAccounts))
+	for i, account := range p.SettlementAccounts {
+		if account.BankID == "" || account.Address == "" {
+			return fmt.Errorf("settlement account %d: bank ID and address cannot be empty", i)
+		}
+		if banks[account.BankID] {
+			return fmt.Errorf("settlement account %d: duplicate bank %s", i, account.BankID)
+		}
+		banks[account.BankID] = true
+	}
+
+	for i, corridor := range p.ContinuousCorridors {
+		if corridor.BankA == "" || corridor.BankB == "" {
+			return fmt.Errorf("continuous corridor %d: banks cannot be empty", i)
+		}
+		if corridor.BankA == corridor.BankB {
+			return fmt.Errorf("continuous corridor %d: banks must differ", i)
+		}
+		for _, other := range p.ContinuousCorridors[:i] {
+			if other.Connects(corridor.BankA, corridor.BankB) {
+				return fmt.Errorf("continuous corridor %d: duplicate corridor %s-%s", i, corridor.BankA, corridor.BankB)
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsOperator returns true if the address is a registered netting operator
+func (p Params) IsOperator(address string) bool {
+	for _, operator := range p.Operators {
+		if operator == address {
+			return true
+		}
+	}
+	return false
+}
+
+// IsContinuousCorridor returns true if the two banks are netted continuously
+func (p Params) IsContinuousCorridor(bankA, bankB string) bool {
+	for _, corridor := range p.ContinuousCorridors {
+		if corridor.Connects(bankA, bankB) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetNettingSets returns the netting sets in the order cycles take turns, the
+// default netting set if none are configured
+func (p Params) GetNettingSets() []NettingSet {
+	if len(p.NettingSets) == 0 {
+		return []NettingSet{DefaultNettingSet()}
+	}
+	return p.NettingSets
+}
+
+// GetNettingSetOf returns the netting set of a currency
+func (p Params) GetNettingSetOf(currency string) (NettingSet, bool) {
+	for _, set := range p.GetNettingSets() {
+		if set.Contains(currency) {
+			return set, true
+		}
+	}
+	return NettingSet{}, false
+}
+
+// GetSettlementAccount returns the settlement address of a bank
+func (p Params) GetSettlementAccount(bankID string) (string, bool) {
+	for _, account := range p.SettlementAccounts {
+		if account.BankID == bankID {
+			return account.Address, true
+		}
+	}
+	return "", false
+}
+
+// ParamsChange records params set by governance together with when and by
+// which proposal, so audits can tell which params applied at a height
+type ParamsChange struct {
+	Version    uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version"`
+	Height     int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height"` // First height the params applied
+	Time       int64  `protobuf:"varint,3,opt,name=time,proto3" json:"time"`
+	ProposalID uint64 `protobuf:"varint,4,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id"` // Zero when not set through a proposal
+	Authority  string `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority"`
+	Params     Params `protobuf:"bytes,6,opt,name=params,proto3" json:"params"`
+}
+
+// ProtoMessage implements proto.Message
+func (c *ParamsChange) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (c *ParamsChange) Reset() { *c = ParamsChange{} }
+
+// String implements proto.Message
+func (c *ParamsChange) String() string {
+	return fmt.Sprintf("ParamsChange{Version: %d, Height: %d, ProposalID: %d}", c.Version, c.Height, c.ProposalID)
+}
@@ -0,0 +1,182 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+)
+
+// QueryCreditBalanceRequest is the request type for Query/CreditBalance
+type QueryCreditBalanceRequest struct {
+	Bank  string `json:"bank"`
+	Denom string `json:"denom"`
+}
+
+// QueryCreditBalanceResponse is the response type for Query/CreditBalance
+type QueryCreditBalanceResponse struct {
+	Balance CreditBalanceSummary `json:"balance"`
+}
+
+// QueryCreditVelocityRequest is the request type for Query/CreditVelocity
+type QueryCreditVelocityRequest struct {
+	IssuerBank string `json:"issuer_bank"`
+	HolderBank string `json:"holder_bank"`
+}
+
+// QueryCreditVelocityResponse is the response type for Query/CreditVelocity
+type QueryCreditVelocityResponse struct {
+	Velocity CreditVelocity `json:"velocity"`
+}
+
+// QueryCycleSettlementRequest is the request type for Query/CycleSettlement
+type QueryCycleSettlementRequest struct {
+	CycleID uint64 `json:"cycle_id"`
+}
+
+// QueryCycleSettlementResponse is the response type for Query/CycleSettlement
+type QueryCycleSettlementResponse struct {
+	Settlement  CycleSettlement `json:"settlement"`
+	Executed    int32           `json:"executed"`    // Commands executed on Besu
+	Outstanding math.Int        `json:"outstanding"` // Amount of the commands not executed yet
+}
+
+// QueryOpenSettlementsRequest is the request type for Query/OpenSettlements
+type QueryOpenSettlementsRequest struct{}
+
+// QueryOpenSettlementsResponse is the response type for Query/OpenSettlements
+type QueryOpenSettlementsResponse struct {
+	Settlements []CycleSettlement `json:"settlements"`
+	Outstanding math.Int          `json:"outstanding"` // Summed over the settlements
+}
+
+// QueryParamsHistoryRequest is the request type for Query/ParamsHistory
+type QueryParamsHistoryRequest struct{}
+
+// QueryParamsHistoryResponse is the response type for Query/ParamsHistory
+type QueryParamsHistoryResponse struct {
+	Changes []ParamsChange `json:"changes"` // Oldest first
+}
+
+// QueryCreditLineageRequest is the request type for Query/CreditLineage
+type QueryCreditLineageRequest struct {
+	TokenID uint64 `json:"token_id"` // Lineage node ID, e.g. from the lineage_node event attribute
+}
+
+// QueryCreditLineageResponse is the response type for Query/CreditLineage
+type QueryCreditLineageResponse struct {
+	Lineage CreditLineage       `json:"lineage"`
+	Origins []CreditLineageNode `json:"origins"` // Besu transfers the node traces back to
+}
+
+// QueryCreditLotsRequest is the request type for Query/CreditLots
+type QueryCreditLotsRequest struct {
+	Bank  string `json:"bank"`
+	Denom string `json:"denom"`
+}
+
+// QueryCreditLotsResponse is the response type for Query/CreditLots
+type QueryCreditLotsResponse struct {
+	Lots    []CreditLineageNode `json:"lots"` // Oldest first
+	Balance math.Int            `json:"balance"`
+}
+
+// QueryBanksRequest is the request type for Query/Banks
+type QueryBanksRequest struct{}
+
+// QueryBanksResponse is the response type for Query/Banks
+type QueryBanksResponse struct {
+	Banks []BankSummary `json:"banks"` // Ordered by bank ID
+}
+
+// QueryDenomMigrationRequest is the request type for Query/DenomMigration
+type QueryDenomMigrationRequest struct{}
+
+// QueryDenomMigrationResponse is the response type for Query/DenomMigration
+type QueryDenomMigrationResponse struct {
+	Pending *DenomMigration       `json:"pending,omitempty"` // Migration scheduled by governance
+	Last    *DenomMigrationReport `json:"last,omitempty"`    // Report of the last applied migration
+}
+
+// QueryDenomMigrationDryRunRequest is the request type for Query/DenomMigrationDryRun
+type QueryDenomMigrationDryRunRequest struct {
+	Renames []DenomRename `json:"renames"`
+}
+
+// QueryDenomMigrationDryRunResponse is the response type for Query/DenomMigrationDryRun
+type QueryDenomMigrationDryRunResponse struct {
+	Report DenomMigrationReport `json:"report"` // What the renames would change at the current height
+}
+
+// QueryDailyReportRequest is the request type for Query/DailyReport
+type QueryDailyReportRequest struct {
+	Date string `json:"date"` // YYYY-MM-DD, UTC
+}
+
+// QueryDailyReportResponse is the response type for Query/DailyReport
+type QueryDailyReportResponse struct {
+	Report DailyReport `json:"report"`
+}
+
+// QueryMicroCycleRequest is the request type for Query/MicroCycle
+type QueryMicroCycleRequest struct {
+	ID uint64 `json:"id"`
+}
+
+// QueryMicroCycleResponse is the response type for Query/MicroCycle
+type QueryMicroCycleResponse struct {
+	MicroCycle MicroCycle `json:"micro_cycle"`
+}
+
+// QueryReserveAttestationRequest is the request type for Query/ReserveAttestation
+type QueryReserveAttestationRequest struct {
+	Bank string `json:"bank"`
+	ID   uint64 `json:"id,omitempty"` // Zero for the latest attestation
+}
+
+// QueryReserveAttestationResponse is the response type for Query/ReserveAttestation
+type QueryReserveAttestationResponse struct {
+	Attestation ReserveAttestation `json:"attestation"`
+	Report      ReserveReport      `json:"report"` // Current report of the bank
+}
+
+// QueryBusinessDayRequest is the request type for Query/BusinessDay
+type QueryBusinessDayRequest struct{}
+
+// QueryBusinessDayResponse is the response type for Query/BusinessDay
+type QueryBusinessDayResponse struct {
+	BusinessDay BusinessDay `json:"business_day"`
+	CloseTime   int64       `json:"close_time"` // When the end-of-day cycle of the business day runs
+}
+
+// QueryClosingReportRequest is the request type for Query/ClosingReport
+type QueryClosingReportRequest struct {
+	BusinessDate string `json:"business_date"` // YYYY-MM-DD
+}
+
+// QueryClosingReportResponse is the response type for Query/ClosingReport
+type QueryClosingReportResponse struct {
+	Report ClosingReport `json:"report"`
+}
+
+// QueryServer defines the query service for the netting module
+type QueryServer interface {
+	CreditBalance(ctx context.Context, req *QueryCreditBalanceRequest) (*QueryCreditBalanceResponse, error)
+	CreditVelocity(ctx context.Context, req *QueryCreditVelocityRequest) (*QueryCreditVelocityResponse, error)
+	CycleSettlement(ctx context.Context, req *QueryCycleSettlementRequest) (*QueryCycleSettlementResponse, error)
+	OpenSettlements(ctx context.Context, req *QueryOpenSettlementsRequest) (*QueryOpenSettlementsResponse, error)
+	ParamsHistory(ctx context.Context, req *QueryParamsHistoryRequest) (*QueryParamsHistoryResponse, error)
+	CreditLineage(ctx context.Context, req *QueryCreditLineageRequest) (*QueryCreditLineageResponse, error)
+	CreditLots(ctx context.Context, req *QueryCreditLotsRequest) (*QueryCreditLotsResponse, error)
+	Banks(ctx context.Context, req *QueryBanksRequest) (*QueryBanksResponse, error)
+	DenomMigration(ctx context.Context, req *QueryDenomMigrationRequest) (*QueryDenomMigrationResponse, error)
+	DenomMigrationDryRun(ctx context.Context, req *QueryDenomMigrationDryRunRequest) (*QueryDenomMigrationDryRunResponse, error)
+	DailyReport(ctx context.Context, req *QueryDailyReportRequest) (*QueryDailyReportResponse, error)
+	MicroCycle(ctx context.Context, req *QueryMicroCycleRequest) (*QueryMicroCycleResponse, error)
+	ReserveAttestation(ctx context.Context, req *QueryReserveAttestationRequest) (*QueryReserveAttestationResponse, error)
+	BusinessDay(ctx context.Context, req *QueryBusinessDayRequest) (*QueryBusinessDayResponse, error)
+	ClosingReport(ctx context.Context, req *QueryClosingReportRequest) (*QueryClosingReportResponse, error)
+}
+
+// Placeholder for protobuf service descriptor
+// In a real implementation, this would be generated from .proto files
+var _Query_serviceDesc = struct{}{}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"errors"
+
+	"github.com/interbank-netting/cosmos/types"
+)
+
+// FailureReason returns the reason code of a failed netting cycle or denom
+// migration
+func FailureReason(err error) types.ReasonCode {
+	switch {
+	case errors.Is(err, ErrInsufficientBalance):
+		return types.ReasonInsufficientBalance
+	case errors.Is(err, ErrNettingInProgress), errors.Is(err, ErrInvalidNettingCycle),
+		errors.Is(err, ErrCreditTokenNotFound), errors.Is(err, ErrDuplicateCreditToken),
+		errors.Is(err, ErrInvalidDenomMigration):
+		return types.ReasonInvalidState
+	default:
+		return types.ReasonInternal
+	}
+}
@@ -0,0 +1,164 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+
+	"github.com/interbank-netting/cosmos/types"
+)
+
+// DailyReportDateLayout is the layout of the calendar day of a daily report.
+// Days are UTC, the zone of block times.
+const DailyReportDateLayout = "2006-01-02"
+
+// ParseReportDate parses the calendar day of a daily report
+func ParseReportDate(date string) (time.Time, error) {
+	day, err := time.ParseInLocation(DailyReportDateLayout, date, time.UTC)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("date must be YYYY-MM-DD: %w", err)
+	}
+	return day, nil
+}
+
+// DailyReport aggregates the settlement activity of one calendar day for
+// end-of-day reconciliation. Cycles count on the day they completed.
+type DailyReport struct {
+	Date               string            `protobuf:"bytes,1,opt,name=date,proto3" json:"date"`                                                                            // YYYY-MM-DD, UTC
+	StartTime          int64             `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time"`                                                // First second of the day
+	EndTime            int64             `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time"`                                                      // Last second of the day
+	TransfersConfirmed int32             `protobuf:"varint,4,opt,name=transfers_confirmed,json=transfersConfirmed,proto3" json:"transfers_confirmed"`                     // Transfers confirmed by the oracle
+	TransferVolume     math.Int          `protobuf:"bytes,5,opt,name=transfer_volume,json=transferVolume,proto3,customtype=cosmossdk.io/math.Int" json:"transfer_volume"` // Summed over the confirmed transfers
+	CreditsIssued      int32             `protobuf:"varint,6,opt,name=credits_issued,json=creditsIssued,proto3" json:"credits_issued"`
+	IssuedAmount       math.Int          `protobuf:"bytes,7,opt,name=issued_amount,json=issuedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"issued_amount"`
+	BurnedAmount       math.Int          `protobuf:"bytes,8,opt,name=burned_amount,json=burnedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"burned_amount"` // Credit burned by the day's cycles
+	CyclesExecuted     int32             `protobuf:"varint,9,opt,name=cycles_executed,json=cyclesExecuted,proto3" json:"cycles_executed"`
+	CyclesCancelled    int32             `protobuf:"varint,10,opt,name=cycles_cancelled,json=cyclesCancelled,proto3" json:"cycles_cancelled"`
+	NettedAmount       math.Int          `protobuf:"bytes,11,opt,name=netted_amount,json=nettedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"netted_amount"`    // Offset by netting, excluding dust
+	SettledAmount      math.Int          `protobuf:"bytes,12,opt,name=settled_amount,json=settledAmount,proto3,customtype=cosmossdk.io/math.Int" json:"settled_amount"` // Residuals paid out by settlement commands
+	Commands           int32             `protobuf:"varint,13,opt,name=commands,proto3" json:"commands"`                                                                // Settlement commands of the day's cycles
+	CommandsExecuted   int32             `protobuf:"varint,14,opt,name=commands_executed,json=commandsExecuted,proto3" json:"commands_executed"`                        // Of Commands, executed on Besu so far
+	Banks              []DailyBankReport `protobuf:"bytes,15,rep,name=banks,proto3" json:"banks"`                                                                       // Ordered by bank ID
+	MicroCycles        int32             `protobuf:"varint,16,opt,name=micro_cycles,json=microCycles,proto3" json:"micro_cycles"`                                       // Offsets of continuous corridors
+}
+
+// ProtoMessage implements proto.Message
+func (r *DailyReport) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (r *DailyReport) Reset() { *r = DailyReport{} }
+
+// String implements proto.Message
+func (r *DailyReport) String() string {
+	return fmt.Sprintf("DailyReport{Date: %s, Transfers: %d, Cycles: %d}", r.Date, r.TransfersConfirmed, r.CyclesExecuted)
+}
+
+// DailyBankReport is the part of a bank in the cycles of a daily report
+type DailyBankReport struct {
+	Bank     string   `protobuf:"bytes,1,opt,name=bank,proto3" json:"bank"`
+	Netted   math.Int `protobuf:"bytes,2,opt,name=netted,proto3,customtype=cosmossdk.io/math.Int" json:"netted"`     // Net amount the bank's obligations were offset by
+	Paid     math.Int `protobuf:"bytes,3,opt,name=paid,proto3,customtype=cosmossdk.io/math.Int" json:"paid"`         // Residuals settled as net debtor
+	Received math.Int `protobuf:"bytes,4,opt,name=received,proto3,customtype=cosmossdk.io/math.Int" json:"received"` // Residuals settled as creditor
+}
+
+// ProtoMessage implements proto.Message
+func (b *DailyBankReport) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (b *DailyBankReport) Reset() { *b = DailyBankReport{} }
+
+// String implements proto.Message
+func (b *DailyBankReport) String() string {
+	return fmt.Sprintf("DailyBankReport{Bank: %s, Netted: %s}", b.Bank, b.Netted)
+}
+
+// NewDailyReport returns an empty report of a calendar day
+func NewDailyReport(day time.Time) DailyReport {
+	start := day.UTC().Truncate(24 * time.Hour)
+	return NewPeriodReport(start.Format(DailyReportDateLayout), start.Unix(), start.Add(24*time.Hour).Unix()-1)
+}
+
+// NewPeriodReport returns an empty report of the seconds from startTime to
+// endTime, both included, dated with date
+func NewPeriodReport(date string, startTime, endTime int64) DailyReport {
+	return DailyReport{
+		Date:           date,
+		StartTime:      startTime,
+		EndTime:        endTime,
+		TransferVolume: math.ZeroInt(),
+		IssuedAmount:   math.ZeroInt(),
+		BurnedAmount:   math.ZeroInt(),
+		NettedAmount:   math.ZeroInt(),
+		SettledAmount:  math.ZeroInt(),
+		Banks:          []DailyBankReport{},
+	}
+}
+
+// AddCycle adds a completed cycle and its settlement, if any, to the report
+func (r *DailyReport) AddCycle(cycle types.NettingCycle, settlement CycleSettlement, netted math.Int) {
+	r.CyclesExecuted++
+	r.NettedAmount = r.NettedAmount.Add(netted)
+	r.BurnedAmount = r.BurnedAmount.Add(CycleBurned(cycle, settlement))
+
+	for bank, amount := range cycle.NetAmounts {
+		entry := r.bank(bank)
+		entry.Netted = entry.Netted.Add(amount)
+	}
+	for _, command := range settlement.Commands {
+		r.SettledAmount = r.SettledAmount.Add(command.Amount)
+		r.Commands++
+		if command.Executed() {
+			r.CommandsExecuted++
+		}
+		debtor := r.bank(command.Debtor)
+		debtor.Paid = debtor.Paid.Add(command.Amount)
+		creditor := r.bank(command.Creditor)
+		creditor.Received = creditor.Received.Add(command.Amount)
+	}
+}
+
+// AddMicroCycle adds the offset of a continuous corridor to the report
+func (r *DailyReport) AddMicroCycle(micro MicroCycle) {
+	r.MicroCycles++
+	r.NettedAmount = r.NettedAmount.Add(micro.Netted)
+	r.BurnedAmount = r.BurnedAmount.Add(micro.Netted.MulRaw(2))
+
+	for _, bank := range []string{micro.BankA, micro.BankB} {
+		entry := r.bank(bank)
+		entry.Netted = entry.Netted.Add(micro.Netted)
+	}
+}
+
+// bank returns the entry of a bank, adding it in bank order if needed
+func (r *DailyReport) bank(bank string) *DailyBankReport {
+	i := sort.Search(len(r.Banks), func(i int) bool { return r.Banks[i].Bank >= bank })
+	if i == len(r.Banks) || r.Banks[i].Bank != bank {
+		r.Banks = append(r.Banks, DailyBankReport{})
+		copy(r.Banks[i+1:], r.Banks[i:])
+		r.Banks[i] = DailyBankReport{Bank: bank, Netted: math.ZeroInt(), Paid: math.ZeroInt(), Received: math.ZeroInt()}
+	}
+	return &r.Banks[i]
+}
+
+// CycleBurned returns the credit a completed cycle burned: the offset of both
+// banks of every pair, less dust the cycle carried, every obligation of its
+// loops, and the residuals its settlement commands paid out
+func CycleBurned(cycle types.NettingCycle, settlement CycleSettlement) math.Int {
+	burned := math.ZeroInt()
+	for _, pair := range cycle.Pairs {
+		offset := math.MinInt(pair.AmountA, pair.AmountB)
+		if cycle.DustPolicy == DustPolicyCarry {
+			offset, _ = SplitDust(offset, cycle.SettlementUnit)
+		}
+		burned = burned.Add(offset.MulRaw(2))
+	}
+	for _, loop := range cycle.Loops {
+		burned = burned.Add(loop.Amount.MulRaw(int64(len(loop.Banks))))
+	}
+	for _, command := range settlement.Commands {
+		burned = burned.Add(command.Amount)
+	}
+	return burned
+}
@@ -0,0 +1,178 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/interbank-netting/cosmos/types"
+)
+
+// MaxReserveCurrencies bounds the currencies of a reserve report
+const MaxReserveCurrencies = 32
+
+// ReserveAmount is the collateral and escrow a bank holds against its credit
+// in one currency
+type ReserveAmount struct {
+	Currency string   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency"` // Credit currency; types.BaseCurrency for cred-{bank}
+	Amount   math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+// ProtoMessage implements proto.Message
+func (a *ReserveAmount) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (a *ReserveAmount) Reset() { *a = ReserveAmount{} }
+
+// String implements proto.Message
+func (a *ReserveAmount) String() string {
+	return fmt.Sprintf("ReserveAmount{Currency: %s, Amount: %s}", a.Currency, a.Amount)
+}
+
+// ValidateReserves checks the currencies and amounts of a reserve report
+func ValidateReserves(reserves []ReserveAmount) error {
+	if len(reserves) > MaxReserveCurrencies {
+		return fmt.Errorf("more than %d reserve currencies: %d", MaxReserveCurrencies, len(reserves))
+	}
+
+	seen := make(map[string]bool, len(reserves))
+	for i, reserve := range reserves {
+		if reserve.Currency == "" || strings.Contains(reserve.Currency, ":") {
+			return fmt.Errorf("reserve %d: invalid currency %q", i, reserve.Currency)
+		}
+		if err := types.ValidateFieldLength("currency", reserve.Currency, types.MaxFieldLength); err != nil {
+			return fmt.Errorf("reserve %d: %w", i, err)
+		}
+		if reserve.Amount.IsNil() || reserve.Amount.IsNegative() {
+			return fmt.Errorf("reserve %d: amount cannot be negative", i)
+		}
+		if seen[reserve.Currency] {
+			return fmt.Errorf("reserve %d: duplicate currency %s", i, reserve.Currency)
+		}
+		seen[reserve.Currency] = true
+	}
+	return nil
+}
+
+// ReserveReport is the latest collateral and escrow a bank declared. The
+// chain cannot observe reserves held off-chain, so they are as reported by
+// the bank's registered accounts.
+type ReserveReport struct {
+	Bank     string          `protobuf:"bytes,1,opt,name=bank,proto3" json:"bank"`
+	Reporter string          `protobuf:"bytes,2,opt,name=reporter,proto3" json:"reporter"` // Account that sent the report
+	Reserves []ReserveAmount `protobuf:"bytes,3,rep,name=reserves,proto3" json:"reserves"` // Ordered by currency
+	Height   int64           `protobuf:"varint,4,opt,name=height,proto3" json:"height"`
+	Time     int64           `protobuf:"varint,5,opt,name=time,proto3" json:"time"`
+}
+
+// ProtoMessage implements proto.Message
+func (r *ReserveReport) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (r *ReserveReport) Reset() { *r = ReserveReport{} }
+
+// String implements proto.Message
+func (r *ReserveReport) String() string {
+	return fmt.Sprintf("ReserveReport{Bank: %s, Reserves: %d, Height: %d}", r.Bank, len(r.Reserves), r.Height)
+}
+
+// ReserveLine compares a bank's credit outstanding in a currency with its
+// reserves in that currency
+type ReserveLine struct {
+	Currency    string   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency"`
+	Outstanding math.Int `protobuf:"bytes,2,opt,name=outstanding,proto3,customtype=cosmossdk.io/math.Int" json:"outstanding"` // The bank's credit held by other banks
+	Reserves    math.Int `protobuf:"bytes,3,opt,name=reserves,proto3,customtype=cosmossdk.io/math.Int" json:"reserves"`
+}
+
+// ProtoMessage implements proto.Message
+func (l *ReserveLine) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (l *ReserveLine) Reset() { *l = ReserveLine{} }
+
+// String implements proto.Message
+func (l *ReserveLine) String() string {
+	return fmt.Sprintf("ReserveLine{Currency: %s, Outstanding: %s, Reserves: %s}", l.Currency, l.Outstanding, l.Reserves)
+}
+
+// Covered returns true if the reserves cover the outstanding credit
+func (l ReserveLine) Covered() bool {
+	return l.Reserves.GTE(l.Outstanding)
+}
+
+// ReserveAttestation is a proof-of-reserves statement: a bank's outstanding
+// issued credit against its reported reserves at a height. It is stored in
+// the module state, so its app hash commitment is signed by the validators
+// that committed the block, and StatementHash identifies it in disclosures.
+type ReserveAttestation struct {
+	ID            uint64        `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Bank          string        `protobuf:"bytes,2,opt,name=bank,proto3" json:"bank"`
+	Height        int64         `protobuf:"varint,3,opt,name=height,proto3" json:"height"`
+	Time          int64         `protobuf:"varint,4,opt,name=time,proto3" json:"time"`
+	Lines         []ReserveLine `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines"`                                                // Ordered by currency
+	ReportHeight  int64         `protobuf:"varint,6,opt,name=report_height,json=reportHeight,proto3" json:"report_height"`             // Height of the attested report, zero if the bank never reported
+	Covered       bool          `protobuf:"varint,7,opt,name=covered,proto3" json:"covered"`                                           // Reserves cover the outstanding credit of every currency
+	StatementHash []byte        `protobuf:"bytes,8,opt,name=statement_hash,json=statementHash,proto3" json:"statement_hash,omitempty"` // SHA-256 of StatementBytes
+}
+
+// ProtoMessage implements proto.Message
+func (a *ReserveAttestation) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (a *ReserveAttestation) Reset() { *a = ReserveAttestation{} }
+
+// String implements proto.Message
+func (a *ReserveAttestation) String() string {
+	return fmt.Sprintf("ReserveAttestation{ID: %d, Bank: %s, Height: %d, Covered: %t}", a.ID, a.Bank, a.Height, a.Covered)
+}
+
+// NewReserveAttestation compares the outstanding credit of a bank per
+// currency with its report and hashes the statement. Currencies with neither
+// outstanding credit nor reserves are left out.
+func NewReserveAttestation(bank string, outstanding map[string]math.Int, report ReserveReport) ReserveAttestation {
+	lines := make(map[string]*ReserveLine)
+	line := func(currency string) *ReserveLine {
+		if _, ok := lines[currency]; !ok {
+			lines[currency] = &ReserveLine{Currency: currency, Outstanding: math.ZeroInt(), Reserves: math.ZeroInt()}
+		}
+		return lines[currency]
+	}
+	for currency, amount := range outstanding {
+		if amount.IsPositive() {
+			line(currency).Outstanding = amount
+		}
+	}
+	for _, reserve := range report.Reserves {
+		if reserve.Amount.IsPositive() {
+			line(reserve.Currency).Reserves = reserve.Amount
+		}
+	}
+
+	attestation := ReserveAttestation{Bank: bank, ReportHeight: report.Height, Covered: true}
+	for _, l := range lines {
+		attestation.Lines = append(attestation.Lines, *l)
+		attestation.Covered = attestation.Covered && l.Covered()
+	}
+	sort.Slice(attestation.Lines, func(i, j int) bool { return attestation.Lines[i].Currency < attestation.Lines[j].Currency })
+	return attestation
+}
+
+// StatementBytes returns the sorted JSON of the attestation without its
+// statement hash
+func (a ReserveAttestation) StatementBytes() []byte {
+	a.StatementHash = nil
+	bz, err := json.Marshal(a)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ComputeStatementHash returns the SHA-256 of the statement bytes
+func (a ReserveAttestation) ComputeStatementHash() []byte {
+	return types.HashAlgorithmSHA256.Sum(a.StatementBytes())
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	"github.com/interbank-netting/cosmos/types"
+)
+
+// SettlementAccount is the payout address of a bank on its Besu chain. The
+// residual a netting cycle leaves owed to the bank is minted to it.
+type SettlementAccount struct {
+	BankID  string `protobuf:"bytes,1,opt,name=bank_id,json=bankId,proto3" json:"bank_id"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address"`
+}
+
+// ProtoMessage implements proto.Message
+func (a *SettlementAccount) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (a *SettlementAccount) Reset() { *a = SettlementAccount{} }
+
+// String implements proto.Message
+func (a *SettlementAccount) String() string {
+	return fmt.Sprintf("SettlementAccount{BankID: %s, Address: %s}", a.BankID, a.Address)
+}
+
+// SettlementCommand is a mint command settling the residual of a netted pair
+type SettlementCommand struct {
+	CommandID string   `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3" json:"command_id"`
+	Creditor  string   `protobuf:"bytes,2,opt,name=creditor,proto3" json:"creditor"` // Bank paid by the command
+	Debtor    string   `protobuf:"bytes,3,opt,name=debtor,proto3" json:"debtor"`     // Net debtor of the pair
+	Amount    math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Status    int32    `protobuf:"varint,5,opt,name=status,proto3" json:"status"` // Last observed types.CommandStatus
+}
+
+// ProtoMessage implements proto.Message
+func (c *SettlementCommand) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (c *SettlementCommand) Reset() { *c = SettlementCommand{} }
+
+// String implements proto.Message
+func (c *SettlementCommand) String() string {
+	return fmt.Sprintf("SettlementCommand{CommandID: %s, Creditor: %s, Status: %d}", c.CommandID, c.Creditor, c.Status)
+}
+
+// Executed returns true once the command was executed on its target chain
+func (c SettlementCommand) Executed() bool {
+	return c.Status == int32(types.CommandStatusExecuted)
+}
+
+// CycleSettlement tracks the settlement commands produced by a netting cycle.
+// The cycle is fully settled once every command was executed on Besu.
+type CycleSettlement struct {
+	CycleID       uint64              `protobuf:"varint,1,opt,name=cycle_id,json=cycleId,proto3" json:"cycle_id"`
+	Commands      []SettlementCommand `protobuf:"bytes,2,rep,name=commands,proto3" json:"commands"`
+	FullySettled  bool                `protobuf:"varint,3,opt,name=fully_settled,json=fullySettled,proto3" json:"fully_settled"`
+	SettledAt     int64               `protobuf:"varint,4,opt,name=settled_at,json=settledAt,proto3" json:"settled_at"`
+	SettledHeight int64               `protobuf:"varint,5,opt,name=settled_height,json=settledHeight,proto3" json:"settled_height"`
+}
+
+// ProtoMessage implements proto.Message
+func (s *CycleSettlement) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (s *CycleSettlement) Reset() { *s = CycleSettlement{} }
+
+// String implements proto.Message
+func (s *CycleSettlement) String() string {
+	return fmt.Sprintf("CycleSettlement{CycleID: %d, Commands: %d, FullySettled: %t}", s.CycleID, len(s.Commands), s.FullySettled)
+}
+
+// ExecutedCount returns the number of executed commands
+func (s CycleSettlement) ExecutedCount() int {
+	count := 0
+	for _, command := range s.Commands {
+		if command.Executed() {
+			count++
+		}
+	}
+	return count
+}
+
+// Outstanding returns the amount of the commands not executed yet
+func (s CycleSettlement) Outstanding() math.Int {
+	outstanding := math.ZeroInt()
+	for _, command := range s.Commands {
+		if !command.Executed() {
+			outstanding = outstanding.Add(command.Amount)
+		}
+	}
+	return outstanding
+}
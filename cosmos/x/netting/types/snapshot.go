@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// CycleSnapshot is the stored pre-cycle state of the banks a netting cycle
+// touches. It is written when the cycle starts and deleted when it completes,
+// so a cycle left InProgress by a partial failure can be cancelled and its
+// balances restored.
+type CycleSnapshot struct {
+	CycleID      uint64            `protobuf:"varint,1,opt,name=cycle_id,json=cycleId,proto3" json:"cycle_id"`
+	Balances     []SnapshotBalance `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances"`                             // Sorted by bank, then denom
+	DustBalances []DustBalance     `protobuf:"bytes,3,rep,name=dust_balances,json=dustBalances,proto3" json:"dust_balances"` // Sorted by bank
+}
+
+// ProtoMessage implements proto.Message
+func (s *CycleSnapshot) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (s *CycleSnapshot) Reset() { *s = CycleSnapshot{} }
+
+// String implements proto.Message
+func (s *CycleSnapshot) String() string {
+	return fmt.Sprintf("CycleSnapshot{CycleID: %d, Balances: %d}", s.CycleID, len(s.Balances))
+}
+
+// SnapshotBalance is one credit balance of a CycleSnapshot
+type SnapshotBalance struct {
+	Bank   string   `protobuf:"bytes,1,opt,name=bank,proto3" json:"bank"`
+	Denom  string   `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom"`
+	Amount math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+// ProtoMessage implements proto.Message
+func (b *SnapshotBalance) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (b *SnapshotBalance) Reset() { *b = SnapshotBalance{} }
+
+// String implements proto.Message
+func (b *SnapshotBalance) String() string {
+	return fmt.Sprintf("SnapshotBalance{Bank: %s, Denom: %s, Amount: %s}", b.Bank, b.Denom, b.Amount)
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/interbank-netting/cosmos/types"
+)
+
+// NettingStrategyBilateral names the built-in strategy that nets every pair
+// of banks owing each other both ways, see CalculateBilateralPairs. Params
+// without a strategy select it.
+const NettingStrategyBilateral = "bilateral"
+
+// NettingStrategy calculates the pairs a netting cycle settles. Deployments
+// register strategies, e.g. priority-aware or liquidity-constrained ones, with
+// the keeper under a name and select one with the NettingStrategy param. The
+// pairs are validated against the credit balances before a cycle executes
+// them; PrioritizePairs still caps them at MaxNettingPairs.
+type NettingStrategy interface {
+	CalculatePairs(ctx sdk.Context) ([]types.BankPair, error)
+}
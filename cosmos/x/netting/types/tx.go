@@ -1,30 +1,69 @@
 package types
 
-import "context"
+import (
+	"context"
+
+	"cosmossdk.io/math"
+)
 
 // MsgIssueCreditTokenResponse defines the response for MsgIssueCreditToken
 type MsgIssueCreditTokenResponse struct {
-	Success bool   `json:"success"`
-	Denom   string `json:"denom"`
+	Success    bool     `json:"success"`
+	Denom      string   `json:"denom"`
+	HolderBank string   `json:"holder_bank"`
+	Balance    math.Int `json:"balance"` // Holder balance of Denom after issuance
 }
 
 // MsgBurnCreditTokenResponse defines the response for MsgBurnCreditToken
 type MsgBurnCreditTokenResponse struct {
-	Success bool `json:"success"`
+	Success    bool     `json:"success"`
+	Denom      string   `json:"denom"`
+	HolderBank string   `json:"holder_bank"`
+	Balance    math.Int `json:"balance"` // Holder balance of Denom after the burn
 }
 
 // MsgTriggerNettingResponse defines the response for MsgTriggerNetting
 type MsgTriggerNettingResponse struct {
-	Success  bool   `json:"success"`
-	CycleID  uint64 `json:"cycle_id"`
-	NetCount int    `json:"net_count"`
+	Success    bool                `json:"success"`
+	CycleID    uint64              `json:"cycle_id"`
+	NetCount   int                 `json:"net_count"`   // Number of pairs netted in the cycle
+	NetAmounts map[string]math.Int `json:"net_amounts"` // Amount netted per bank in the cycle
+	CycleHash  string              `json:"cycle_hash"`  // Hex encoded CycleHash of the stored cycle
 }
 
+// MsgUpdateParamsResponse defines the response for MsgUpdateParams
+type MsgUpdateParamsResponse struct{}
+
+// MsgSetBankAccountResponse defines the response for MsgSetBankAccount
+type MsgSetBankAccountResponse struct{}
+
+// MsgRemoveBankAccountResponse defines the response for MsgRemoveBankAccount
+type MsgRemoveBankAccountResponse struct{}
+
+// MsgCancelNettingCycleResponse defines the response for MsgCancelNettingCycle
+type MsgCancelNettingCycleResponse struct {
+	RestoredBalances int32 `json:"restored_balances"` // Credit balances restored from the cycle snapshot
+}
+
+// MsgScheduleDenomMigrationResponse defines the response for MsgScheduleDenomMigration
+type MsgScheduleDenomMigrationResponse struct {
+	Report DenomMigrationReport `json:"report"` // Dry run of the migration against the current state
+}
+
+// MsgReportReservesResponse defines the response for MsgReportReserves
+type MsgReportReservesResponse struct{}
+
 // MsgServer defines the msg service for the netting module
 type MsgServer interface {
 	IssueCreditToken(ctx context.Context, msg *MsgIssueCreditToken) (*MsgIssueCreditTokenResponse, error)
 	BurnCreditToken(ctx context.Context, msg *MsgBurnCreditToken) (*MsgBurnCreditTokenResponse, error)
 	TriggerNetting(ctx context.Context, msg *MsgTriggerNetting) (*MsgTriggerNettingResponse, error)
+	UpdateParams(ctx context.Context, msg *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	SetBankAccount(ctx context.Context, msg *MsgSetBankAccount) (*MsgSetBankAccountResponse, error)
+	RemoveBankAccount(ctx context.Context, msg *MsgRemoveBankAccount) (*MsgRemoveBankAccountResponse, error)
+	CancelNettingCycle(ctx context.Context, msg *MsgCancelNettingCycle) (*MsgCancelNettingCycleResponse, error)
+	ScheduleDenomMigration(ctx context.Context, msg *MsgScheduleDenomMigration) (*MsgScheduleDenomMigrationResponse, error)
+	ReportReserves(ctx context.Context, msg *MsgReportReserves) (*MsgReportReservesResponse, error)
 }
 
 // Placeholder for protobuf service descriptor
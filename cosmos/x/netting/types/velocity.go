@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// Credit velocity is tracked in hourly buckets kept in a ring of one week per
+// issuer -> holder pair, so the rolling windows need no pruning
+const (
+	VelocityBucketSeconds int64 = 3600   // Width of a velocity bucket
+	VelocityBucketCount   int64 = 7 * 24 // Buckets in the ring, covering the 7d window
+	VelocityDayBuckets    int64 = 24     // Buckets in the 24h window
+)
+
+// VelocityBucket is the credit issued and netted between an issuer and a
+// holder during one hour
+type VelocityBucket struct {
+	Hour   int64    `protobuf:"varint,1,opt,name=hour,proto3" json:"hour"` // Block time / VelocityBucketSeconds
+	Issued math.Int `protobuf:"bytes,2,opt,name=issued,proto3,customtype=cosmossdk.io/math.Int" json:"issued"`
+	Netted math.Int `protobuf:"bytes,3,opt,name=netted,proto3,customtype=cosmossdk.io/math.Int" json:"netted"`
+}
+
+// ProtoMessage implements proto.Message
+func (b *VelocityBucket) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (b *VelocityBucket) Reset() { *b = VelocityBucket{} }
+
+// String implements proto.Message
+func (b *VelocityBucket) String() string {
+	return fmt.Sprintf("VelocityBucket{Hour: %d, Issued: %s, Netted: %s}", b.Hour, b.Issued, b.Netted)
+}
+
+// CreditVelocity is the rolling volume of credit an issuer extended to a
+// holder and the volume netted off it
+type CreditVelocity struct {
+	IssuerBank string   `protobuf:"bytes,1,opt,name=issuer_bank,json=issuerBank,proto3" json:"issuer_bank"`
+	HolderBank string   `protobuf:"bytes,2,opt,name=holder_bank,json=holderBank,proto3" json:"holder_bank"`
+	Issued24h  math.Int `protobuf:"bytes,3,opt,name=issued_24h,json=issued24h,proto3,customtype=cosmossdk.io/math.Int" json:"issued_24h"`
+	Netted24h  math.Int `protobuf:"bytes,4,opt,name=netted_24h,json=netted24h,proto3,customtype=cosmossdk.io/math.Int" json:"netted_24h"`
+	Issued7d   math.Int `protobuf:"bytes,5,opt,name=issued_7d,json=issued7d,proto3,customtype=cosmossdk.io/math.Int" json:"issued_7d"`
+	Netted7d   math.Int `protobuf:"bytes,6,opt,name=netted_7d,json=netted7d,proto3,customtype=cosmossdk.io/math.Int" json:"netted_7d"`
+}
+
+// ProtoMessage implements proto.Message
+func (v *CreditVelocity) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (v *CreditVelocity) Reset() { *v = CreditVelocity{} }
+
+// String implements proto.Message
+func (v *CreditVelocity) String() string {
+	return fmt.Sprintf("CreditVelocity{IssuerBank: %s, HolderBank: %s, Issued24h: %s, Issued7d: %s}",
+		v.IssuerBank, v.HolderBank, v.Issued24h, v.Issued7d)
+}
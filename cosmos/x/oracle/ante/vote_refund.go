@@ -0,0 +1,72 @@
+package ante
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/interbank-netting/cosmos/x/oracle/types"
+)
+
+// VoteRefundKeeper defines the oracle keeper methods used by VoteRefundDecorator
+type VoteRefundKeeper interface {
+	RecordVoteFee(ctx sdk.Context, txHash, validator string, payer sdk.AccAddress, fee sdk.Coins)
+}
+
+// VoteRefundDecorator is a post-handler that records the fees of successful
+// MsgVote transactions so they can be refunded once the vote contributes to a
+// transfer confirmation. Failed votes and transactions mixing other messages
+// are never refunded, so refunds cannot be used to submit free spam.
+type VoteRefundDecorator struct {
+	keeper VoteRefundKeeper
+}
+
+// NewVoteRefundDecorator creates a new VoteRefundDecorator
+func NewVoteRefundDecorator(keeper VoteRefundKeeper) VoteRefundDecorator {
+	return VoteRefundDecorator{keeper: keeper}
+}
+
+// PostHandle implements sdk.PostDecorator
+func (d VoteRefundDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if simulate || !success {
+		return next(ctx, tx, simulate, success)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return next(ctx, tx, simulate, success)
+	}
+
+	votes := voteMsgs(tx)
+	fee := feeTx.GetFee()
+	if len(votes) == 0 || fee.IsZero() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	// Fees are paid by the granter when a fee grant is used
+	payer := sdk.AccAddress(feeTx.FeeGranter())
+	if payer.Empty() {
+		payer = sdk.AccAddress(feeTx.FeePayer())
+	}
+
+	// Split the fee evenly across the votes in the transaction
+	share := fee.QuoInt(math.NewInt(int64(len(votes))))
+	for _, vote := range votes {
+		d.keeper.RecordVoteFee(ctx, vote.TxHash, vote.Validator, payer, share)
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// voteMsgs returns the votes of a transaction, or nil if it contains any other message
+func voteMsgs(tx sdk.Tx) []*types.MsgVote {
+	msgs := tx.GetMsgs()
+	votes := make([]*types.MsgVote, 0, len(msgs))
+	for _, msg := range msgs {
+		vote, ok := msg.(*types.MsgVote)
+		if !ok {
+			return nil
+		}
+		votes = append(votes, vote)
+	}
+	return votes
+}
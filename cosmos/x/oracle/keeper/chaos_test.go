@@ -0,0 +1,288 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+
+	testhelpers "github.com/interbank-netting/cosmos/testutil"
+	"github.com/interbank-netting/cosmos/types"
+	multisigkeeper "github.com/interbank-netting/cosmos/x/multisig/keeper"
+	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
+	nettingkeeper "github.com/interbank-netting/cosmos/x/netting/keeper"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
+	"github.com/interbank-netting/cosmos/x/oracle/keeper"
+	oracletypes "github.com/interbank-netting/cosmos/x/oracle/types"
+)
+
+// chaosEnvironment wires the real oracle, netting and multisig keepers over a
+// shared store, as the app does, with only bank, account and staking mocked
+type chaosEnvironment struct {
+	ctx            sdk.Context
+	oracleKeeper   *keeper.Keeper
+	nettingKeeper  *nettingkeeper.Keeper
+	multisigKeeper *multisigkeeper.Keeper
+	stakingKeeper  *MockStakingKeeper
+	validators     []types.Validator
+}
+
+func setupChaosEnvironment(t *testing.T, validatorCount int) *chaosEnvironment {
+	keys := storetypes.NewKVStoreKeys(oracletypes.StoreKey, nettingtypes.StoreKey, multisigtypes.StoreKey)
+	transientKeys := storetypes.NewTransientStoreKeys("transient_test")
+	ctx := testutil.DefaultContextWithKeys(keys, transientKeys, nil).
+		WithChainID(testChainID).
+		WithBlockHeight(1).
+		WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	bankKeeper := &chaosBankKeeper{NewMockBankKeeper()}
+	stakingKeeper := NewMockStakingKeeper()
+
+	oracleKeeper := keeper.NewKeeper(cdc, keys[oracletypes.StoreKey], nil, paramtypes.Subspace{}, bankKeeper, stakingKeeper, authority)
+	nettingKeeper := nettingkeeper.NewKeeper(cdc, keys[nettingtypes.StoreKey], nil, paramtypes.Subspace{}, bankKeeper, chaosAccountKeeper{}, authority)
+	multisigKeeper := multisigkeeper.NewKeeper(cdc, keys[multisigtypes.StoreKey], nil, paramtypes.Subspace{}, bankKeeper, chaosStakingKeeper{}, authority)
+	oracleKeeper.SetNettingKeeper(nettingKeeper)
+	oracleKeeper.SetMultisigKeeper(multisigKeeper)
+
+	validators := generateValidators(validatorCount)
+	setupValidators(ctx, stakingKeeper, validators)
+	if err := multisigKeeper.UpdateValidatorSet(ctx, validators); err != nil {
+		t.Fatalf("failed to set multisig validator set: %v", err)
+	}
+
+	return &chaosEnvironment{
+		ctx:            ctx,
+		oracleKeeper:   oracleKeeper,
+		nettingKeeper:  nettingKeeper,
+		multisigKeeper: multisigKeeper,
+		stakingKeeper:  stakingKeeper,
+		validators:     validators,
+	}
+}
+
+// runBlock lets the online validators vote on every transfer, as their
+// relayers replay each Besu event until it is accepted, has the online
+// validators sign the pending commands and then runs the multisig EndBlock.
+// It returns the set of validators that were online.
+func (env *chaosEnvironment) runBlock(rng *rand.Rand, transfers []types.TransferEvent, offlinePercent int) (map[string]bool, error) {
+	online := make(map[string]bool, len(env.validators))
+	signers := make([]types.Validator, len(env.validators))
+	for i, validator := range env.validators {
+		signers[i] = validator
+		signers[i].Active = rng.Intn(100) >= offlinePercent
+		online[validator.Address] = signers[i].Active
+	}
+
+	for _, transfer := range transfers {
+		for _, validator := range env.validators {
+			if !online[validator.Address] {
+				continue
+			}
+			vote := types.Vote{
+				TxHash:           transfer.TxHash,
+				Validator:        validator.Address,
+				EventData:        transfer,
+				Signature:        signVote(env.ctx, env.stakingKeeper, validator.Address, transfer),
+				SignatureVersion: oracletypes.CurrentSignatureVersion,
+				VoteTime:         env.ctx.BlockTime().Unix(),
+			}
+			err := env.oracleKeeper.SubmitVote(env.ctx, vote)
+			if err != nil && !errors.Is(err, oracletypes.ErrDuplicateVote) && !errors.Is(err, oracletypes.ErrTransferAlreadyConfirmed) {
+				return nil, fmt.Errorf("vote of %s on %s: %w", validator.Address, transfer.TxHash, err)
+			}
+		}
+	}
+
+	// Offline validators stay in the set, so the signing threshold is unchanged
+	if err := env.multisigKeeper.UpdateValidatorSet(env.ctx, signers); err != nil {
+		return nil, err
+	}
+	for _, command := range env.multisigKeeper.GetAllPendingCommands(env.ctx) {
+		for _, validator := range signers {
+			if !validator.Active {
+				continue
+			}
+			signature, err := env.multisigKeeper.SignData(env.ctx, validator.Address, multisigtypes.CommandHash(command))
+			if err != nil {
+				return nil, err
+			}
+			err = env.multisigKeeper.SignCommand(env.ctx, validator.Address, command.CommandID, signature)
+			if err != nil && !errors.Is(err, multisigtypes.ErrDuplicateSignature) {
+				return nil, fmt.Errorf("signature of %s on %s: %w", validator.Address, command.CommandID, err)
+			}
+		}
+	}
+	if err := env.multisigKeeper.EvaluatePendingCommands(env.ctx); err != nil {
+		return nil, err
+	}
+	if err := env.multisigKeeper.ExpirePendingCommands(env.ctx); err != nil {
+		return nil, err
+	}
+	return online, nil
+}
+
+// **Feature: interbank-netting-engine, Property 28: 검증자 장애 카오스 시나리오**
+// **검증: 요구사항 3.2, 5.3 - 검증자가 무작위로 오프라인이 되어도 임계값 도달 시 이체가 확인되고, 명령은 서명되거나 만료되며, 크레딧이 중복 발행되지 않는지 검증**
+func TestProperty_Chaos_ValidatorFailuresDuringVotingAndSigning(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("random validator outages never break thresholds, expiry or credit issuance", prop.ForAll(
+		func(validatorCount, offlinePercent int, seed int64) bool {
+			env := setupChaosEnvironment(t, validatorCount)
+			rng := rand.New(rand.NewSource(seed))
+			signingTimeout := env.multisigKeeper.GetParams(env.ctx).SigningTimeout
+			blockInterval := signingTimeout / 4
+
+			// Credit denoms are per source bank, so each transfer has its own source
+			transfers := []types.TransferEvent{
+				{TxHash: "0xchaos-1", Sender: "alice", Recipient: "bob", Amount: math.NewInt(1000), Nonce: 1, SourceChain: "bank-a", DestChain: "bank-b"},
+				{TxHash: "0xchaos-2", Sender: "carol", Recipient: "dave", Amount: math.NewInt(2500), Nonce: 2, SourceChain: "bank-b", DestChain: "bank-c"},
+				{TxHash: "0xchaos-3", Sender: "erin", Recipient: "frank", Amount: math.NewInt(40), Nonce: 3, SourceChain: "bank-c", DestChain: "bank-a"},
+			}
+
+			// Votes and signatures during outages, then blocks until no command is pending
+			onlineAt := make(map[int64]map[string]bool)
+			for block := 0; block < 8 || len(env.multisigKeeper.GetAllPendingCommands(env.ctx)) > 0; block++ {
+				if block > 24 {
+					return false // Commands must sign or expire within the signing timeout
+				}
+				online, err := env.runBlock(rng, transfers, offlinePercent)
+				if err != nil {
+					t.Log(err)
+					return false
+				}
+				onlineAt[env.ctx.BlockTime().Unix()] = online
+				env.ctx = env.ctx.
+					WithBlockHeight(env.ctx.BlockHeight() + 1).
+					WithBlockTime(env.ctx.BlockTime().Add(time.Duration(blockInterval) * time.Second))
+			}
+
+			oracleThreshold := types.ConsensusThreshold(validatorCount)
+			expectedCredit := make(map[[2]string]math.Int) // By holder bank and denom
+			confirmed := 0
+			for _, transfer := range transfers {
+				status, found := env.oracleKeeper.GetVoteStatus(env.ctx, transfer.TxHash)
+				if !found {
+					continue // No validator was ever online
+				}
+
+				// Confirmed exactly when enough distinct validators got a vote in
+				if status.Confirmed != (status.VoteCount >= oracleThreshold) || int(status.VoteCount) != len(status.Votes) {
+					return false
+				}
+				if status.Confirmed {
+					confirmed++
+					token := oracletypes.TransferCreditToken(transfer, status.ConfirmedAt)
+					key := [2]string{token.HolderBank, token.Denom}
+					if _, ok := expectedCredit[key]; !ok {
+						expectedCredit[key] = math.ZeroInt()
+					}
+					expectedCredit[key] = expectedCredit[key].Add(token.Amount)
+				}
+			}
+
+			// Replayed votes never issue credit twice
+			for key, amount := range expectedCredit {
+				if !env.nettingKeeper.GetCreditBalance(env.ctx, key[0], key[1]).Equal(amount) {
+					return false
+				}
+			}
+
+			// One command per confirmed transfer, each signed or expired
+			commands := env.multisigKeeper.GetAllCommands(env.ctx)
+			if len(commands) != confirmed {
+				return false
+			}
+			signingThreshold := env.multisigKeeper.GetValidatorSet(env.ctx).Threshold
+			for _, command := range commands {
+				switch command.Status {
+				case int32(types.CommandStatusSigned):
+					if int32(len(command.Signatures)) < signingThreshold {
+						return false
+					}
+				case int32(types.CommandStatusFailed):
+					if int32(len(command.Signatures)) >= signingThreshold {
+						return false // Reached the threshold but expired anyway
+					}
+				default:
+					return false
+				}
+
+				// Only validators online in a block signed in it
+				for _, sig := range command.Signatures {
+					if !onlineAt[sig.Timestamp][sig.Validator] {
+						return false
+					}
+				}
+			}
+			return true
+		},
+		gen.IntRange(4, 10), // Validators
+		gen.IntRange(0, 90), // Chance of each validator being offline in a block, in percent
+		gen.Int64(),         // Outage schedule seed
+	))
+
+	properties.TestingRun(t)
+}
+
+// chaosBankKeeper adds the mint and burn methods the multisig module needs
+type chaosBankKeeper struct {
+	*MockBankKeeper
+}
+
+func (m *chaosBankKeeper) MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error {
+	return nil
+}
+
+func (m *chaosBankKeeper) BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error {
+	return nil
+}
+
+func (m *chaosBankKeeper) GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	return sdk.Coins{}
+}
+
+// chaosStakingKeeper leaves the multisig validator set to UpdateValidatorSet
+type chaosStakingKeeper struct{}
+
+func (chaosStakingKeeper) GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+}
+
+func (chaosStakingKeeper) GetAllValidators(ctx context.Context) ([]stakingtypes.Validator, error) {
+	return []stakingtypes.Validator{}, nil
+}
+
+func (chaosStakingKeeper) GetBondedValidatorsByPower(ctx context.Context) ([]stakingtypes.Validator, error) {
+	return []stakingtypes.Validator{}, nil
+}
+
+// chaosAccountKeeper satisfies the netting module's account keeper
+type chaosAccountKeeper struct{}
+
+func (chaosAccountKeeper) GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return nil
+}
+
+func (chaosAccountKeeper) SetAccount(ctx context.Context, acc sdk.AccountI) {}
+
+func (chaosAccountKeeper) NewAccountWithAddress(ctx context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return nil
+}
@@ -11,6 +11,8 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/suite"
@@ -55,6 +57,7 @@ func (suite *ConsensusTestSuite) SetupTest() {
 		paramtypes.Subspace{},
 		mockBankKeeper,
 		suite.stakingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 }
 
@@ -65,6 +68,10 @@ func (m *ConsensusTestMockBankKeeper) SendCoins(ctx context.Context, fromAddr sd
 	return nil
 }
 
+func (m *ConsensusTestMockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return nil
+}
+
 func (m *ConsensusTestMockBankKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
 	return sdk.NewCoin(denom, math.ZeroInt())
 }
@@ -255,7 +262,7 @@ func (suite *ConsensusTestSuite) TestTransferRejection() {
 	k := suite.keeper
 
 	// Test RejectTransfer function
-	err := k.RejectTransfer(ctx, "0xnonexistent", "timeout")
+	err := k.RejectTransfer(ctx, "0xnonexistent", commontypes.ReasonConsensusTimeout, "timeout")
 	suite.Require().Error(err, "should error for non-existent transfer")
 	suite.Require().Equal(types.ErrTransferNotFound, err, "should return transfer not found error")
 
@@ -0,0 +1,267 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	commontypes "github.com/interbank-netting/cosmos/types"
+	"github.com/interbank-netting/cosmos/x/oracle/types"
+)
+
+type querier struct {
+	keeper ViewKeeper
+}
+
+// NewQueryServerImpl returns an implementation of the QueryServer interface
+// for the provided Keeper. The querier only holds the keeper's ViewKeeper.
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return &querier{keeper: keeper}
+}
+
+var _ types.QueryServer = querier{}
+
+// TransferProof returns the proof bundle of a confirmed transfer
+func (q querier) TransferProof(goCtx context.Context, req *types.QueryTransferProofRequest) (*types.QueryTransferProofResponse, error) {
+	if req == nil || req.TxHash == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "tx hash cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proof, err := q.keeper.GetTransferProof(ctx, req.TxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTransferProofResponse{Proof: proof}, nil
+}
+
+// TransferCredit returns the credit token issued by a confirmed transfer
+func (q querier) TransferCredit(goCtx context.Context, req *types.QueryTransferCreditRequest) (*types.QueryTransferCreditResponse, error) {
+	if req == nil || req.TxHash == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "tx hash cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	credit, err := q.keeper.GetConfirmedTransferCredit(ctx, req.TxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTransferCreditResponse{CreditToken: credit}, nil
+}
+
+// WorkQueue returns the counts of work waiting for upcoming blocks
+func (q querier) WorkQueue(goCtx context.Context, req *types.QueryWorkQueueRequest) (*types.QueryWorkQueueResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	if req.NearTimeoutWindow < 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "near timeout window cannot be negative")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	window := req.NearTimeoutWindow
+	if window == 0 {
+		window = q.keeper.GetParams(ctx).VotingPeriod
+	}
+
+	return &types.QueryWorkQueueResponse{WorkQueue: q.keeper.GetWorkQueue(ctx, window)}, nil
+}
+
+// ValidatorQueue returns the pending votes and command signatures of a validator
+func (q querier) ValidatorQueue(goCtx context.Context, req *types.QueryValidatorQueueRequest) (*types.QueryValidatorQueueResponse, error) {
+	if req == nil || req.Validator == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "validator cannot be empty")
+	}
+	if _, err := sdk.ValAddressFromBech32(req.Validator); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidValidator, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryValidatorQueueResponse{ValidatorQueue: q.keeper.GetValidatorQueue(ctx, req.Validator)}, nil
+}
+
+// ForecastNetting returns the projected net positions of the next netting
+// cycle, counting the transfers still pending confirmation
+func (q querier) ForecastNetting(goCtx context.Context, req *types.QueryForecastNettingRequest) (*types.QueryForecastNettingResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryForecastNettingResponse{Forecast: q.keeper.GetNettingForecast(ctx)}, nil
+}
+
+// Suspensions returns the suspended chains and banks
+func (q querier) Suspensions(goCtx context.Context, req *types.QuerySuspensionsRequest) (*types.QuerySuspensionsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QuerySuspensionsResponse{Suspensions: q.keeper.GetAllSuspensions(ctx)}, nil
+}
+
+// ParamsHistory returns the params changes made by governance
+func (q querier) ParamsHistory(goCtx context.Context, req *types.QueryParamsHistoryRequest) (*types.QueryParamsHistoryResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsHistoryResponse{Changes: q.keeper.GetParamsHistory(ctx)}, nil
+}
+
+// AuditLogs returns a page of the audit logs matching the filter
+func (q querier) AuditLogs(goCtx context.Context, req *types.QueryAuditLogsRequest) (*types.QueryAuditLogsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	logs, nextID, err := q.keeper.FilterAuditLogs(ctx, req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAuditLogsResponse{Logs: logs, NextID: nextID}, nil
+}
+
+// ChainHealth returns the health of one or every chain derived from the
+// latest relayer heartbeats
+func (q querier) ChainHealth(goCtx context.Context, req *types.QueryChainHealthRequest) (*types.QueryChainHealthResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &types.QueryChainHealthResponse{HeartbeatTimeout: q.keeper.GetParams(ctx).HeartbeatTimeout}
+
+	if req.Chain == "" {
+		resp.Chains = q.keeper.GetAllChainHealth(ctx)
+		return resp, nil
+	}
+
+	health, found := q.keeper.GetChainHealth(ctx, req.Chain)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrHeartbeatNotFound, "chain %s", req.Chain)
+	}
+	resp.Chains = []types.ChainHealth{health}
+	return resp, nil
+}
+
+// Reporters returns how often the first reports of one or every validator
+// matched the consensus content, and which reporters are flagged
+func (q querier) Reporters(goCtx context.Context, req *types.QueryReportersRequest) (*types.QueryReportersResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := q.keeper.GetParams(ctx)
+	resp := &types.QueryReportersResponse{
+		Reporters:     []types.ReporterRecord{},
+		MismatchLimit: params.ReporterMismatchLimit,
+		GateFlagged:   params.GateFlaggedReporters,
+	}
+
+	var reporters []types.ReporterRecord
+	if req.Validator == "" {
+		reporters = q.keeper.GetAllReporters(ctx)
+	} else {
+		reporter, found := q.keeper.GetReporter(ctx, req.Validator)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrReporterNotFound, "validator %s", req.Validator)
+		}
+		reporters = []types.ReporterRecord{reporter}
+	}
+
+	for _, reporter := range reporters {
+		if req.FlaggedOnly && !reporter.Flagged {
+			continue
+		}
+		resp.Reporters = append(resp.Reporters, reporter)
+	}
+	return resp, nil
+}
+
+// ChainFinality returns the finality registered for one or every Besu chain.
+// A single unregistered chain is returned with the default finality.
+func (q querier) ChainFinality(goCtx context.Context, req *types.QueryChainFinalityRequest) (*types.QueryChainFinalityResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.Chain == "" {
+		chains := q.keeper.GetParams(ctx).ChainFinality
+		if chains == nil {
+			chains = []types.ChainFinality{}
+		}
+		return &types.QueryChainFinalityResponse{Chains: chains, Registered: true}, nil
+	}
+
+	finality, registered := q.keeper.GetParams(ctx).GetChainFinality(req.Chain)
+	return &types.QueryChainFinalityResponse{Chains: []types.ChainFinality{finality}, Registered: registered}, nil
+}
+
+// PendingTransfers returns the transfers that are not confirmed yet, served
+// from the query cache when it is enabled
+func (q querier) PendingTransfers(goCtx context.Context, req *types.QueryPendingTransfersRequest) (*types.QueryPendingTransfersResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryPendingTransfersResponse{Transfers: q.keeper.GetPendingTransfers(ctx)}, nil
+}
+
+// RejectedTransfer returns the evidence of the latest rejection of a transfer
+func (q querier) RejectedTransfer(goCtx context.Context, req *types.QueryRejectedTransferRequest) (*types.QueryRejectedTransferResponse, error) {
+	if req == nil || req.TxHash == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "tx hash cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rejected, found := q.keeper.GetRejectedTransfer(ctx, req.TxHash)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrRejectedTransferNotFound, "tx %s", req.TxHash)
+	}
+
+	return &types.QueryRejectedTransferResponse{RejectedTransfer: rejected}, nil
+}
+
+// MessageCatalog returns the human-readable messages of every error and
+// reason code in a locale, the same on every node
+func (q querier) MessageCatalog(_ context.Context, req *types.QueryMessageCatalogRequest) (*types.QueryMessageCatalogResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	locale := req.Locale
+	if locale == "" {
+		locale = commontypes.DefaultLocale
+	}
+	if !locale.IsValid() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown locale %s", locale)
+	}
+
+	return &types.QueryMessageCatalogResponse{
+		Locale:  locale,
+		Locales: commontypes.Locales,
+		Entries: commontypes.MessageCatalog(locale),
+	}, nil
+}
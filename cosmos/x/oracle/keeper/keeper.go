@@ -1,15 +1,26 @@
 package keeper
 
 import (
-	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
-	"github.com/ethereum/go-ethereum/crypto"
 
 	commontypes "github.com/interbank-netting/cosmos/types"
 	"github.com/interbank-netting/cosmos/x/oracle/types"
@@ -26,6 +37,72 @@ type Keeper struct {
 	stakingKeeper  types.StakingKeeper
 	nettingKeeper  types.NettingKeeper
 	multisigKeeper types.MultisigKeeper
+
+	// queryCache serves hot queries from the memory store when enabled
+	queryCache commontypes.QueryCache
+
+	// authority is the address allowed to update params and resolve held
+	// transfers, usually the gov module account
+	authority string
+
+	Schema             collections.Schema
+	Params             collections.Item[types.Params]
+	VoteStatuses       collections.Map[string, commontypes.VoteStatus]
+	Votes              collections.Map[collections.Pair[string, string], commontypes.Vote]
+	ConfirmedTransfers collections.Map[string, commontypes.TransferEvent]
+	TransferCredits    collections.Map[string, commontypes.CreditToken]
+	HeldTransfers      collections.Map[string, types.HeldTransfer]
+	Disputes           collections.Map[string, types.Dispute]
+	Suspensions        collections.Map[string, types.Suspension]
+	ParamsHistory      collections.Map[uint64, types.ParamsChange]
+	ParamsVersion      collections.Sequence // Last assigned params version
+	VoteFees           *collections.IndexedMap[collections.Pair[string, string], types.VoteFee, VoteFeeIndexes]
+	AuditLogs          *collections.IndexedMap[uint64, commontypes.AuditLog, AuditLogIndexes]
+	AuditLogSequence   collections.Sequence // Last assigned audit log ID
+	ChainHeartbeats    collections.Map[string, types.ChainHeartbeat]
+	Reporters          collections.Map[string, types.ReporterRecord]
+	CreditReleases     collections.Map[string, types.CreditRelease]
+	RejectedTransfers  collections.Map[string, types.RejectedTransfer]
+}
+
+// AuditLogIndexes are the secondary indexes of the audit log
+type AuditLogIndexes struct {
+	ByTime *indexes.Multi[int64, uint64, commontypes.AuditLog]
+	ByType *indexes.Multi[string, uint64, commontypes.AuditLog]
+}
+
+// IndexesList implements collections.Indexes
+func (i AuditLogIndexes) IndexesList() []collections.Index[uint64, commontypes.AuditLog] {
+	return []collections.Index[uint64, commontypes.AuditLog]{i.ByTime, i.ByType}
+}
+
+// VoteFeeIndexes are the secondary indexes of the pending vote fees
+type VoteFeeIndexes struct {
+	ByHeight *indexes.Multi[int64, collections.Pair[string, string], types.VoteFee]
+}
+
+// IndexesList implements collections.Indexes
+func (i VoteFeeIndexes) IndexesList() []collections.Index[collections.Pair[string, string], types.VoteFee] {
+	return []collections.Index[collections.Pair[string, string], types.VoteFee]{i.ByHeight}
+}
+
+func newVoteFeeIndexes(sb *collections.SchemaBuilder) VoteFeeIndexes {
+	return VoteFeeIndexes{
+		ByHeight: indexes.NewMulti(sb, types.VoteFeeByHeightKeyPrefix, "vote_fees_by_height",
+			collections.Int64Key, collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			func(_ collections.Pair[string, string], voteFee types.VoteFee) (int64, error) { return voteFee.Height, nil }),
+	}
+}
+
+func newAuditLogIndexes(sb *collections.SchemaBuilder) AuditLogIndexes {
+	return AuditLogIndexes{
+		ByTime: indexes.NewMulti(sb, types.AuditLogByTimeKeyPrefix, "audit_logs_by_time",
+			collections.Int64Key, collections.Uint64Key,
+			func(_ uint64, log commontypes.AuditLog) (int64, error) { return log.Timestamp, nil }),
+		ByType: indexes.NewMulti(sb, types.AuditLogByTypeKeyPrefix, "audit_logs_by_type",
+			collections.StringKey, collections.Uint64Key,
+			func(_ uint64, log commontypes.AuditLog) (string, error) { return log.EventType, nil }),
+	}
 }
 
 // NewKeeper creates a new oracle Keeper instance
@@ -36,15 +113,48 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	bankKeeper types.BankKeeper,
 	stakingKeeper types.StakingKeeper,
+	authority string,
 ) *Keeper {
-	return &Keeper{
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(storeKey.(*storetypes.KVStoreKey)))
+	k := &Keeper{
 		cdc:           cdc,
 		storeKey:      storeKey,
 		memKey:        memKey,
 		paramstore:    ps,
 		bankKeeper:    bankKeeper,
 		stakingKeeper: stakingKeeper,
+		authority:     authority,
+
+		Params:             collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		VoteStatuses:       collections.NewMap(sb, types.VoteStatusKeyPrefix, "vote_statuses", collections.StringKey, codec.CollValue[commontypes.VoteStatus](cdc)),
+		Votes:              collections.NewMap(sb, types.VoteKeyPrefix, "votes", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[commontypes.Vote](cdc)),
+		ConfirmedTransfers: collections.NewMap(sb, types.ConfirmedTransferKeyPrefix, "confirmed_transfers", collections.StringKey, codec.CollValue[commontypes.TransferEvent](cdc)),
+		TransferCredits:    collections.NewMap(sb, types.TransferCreditKeyPrefix, "transfer_credits", collections.StringKey, codec.CollValue[commontypes.CreditToken](cdc)),
+		HeldTransfers:      collections.NewMap(sb, types.HeldTransferKeyPrefix, "held_transfers", collections.StringKey, codec.CollValue[types.HeldTransfer](cdc)),
+		Disputes:           collections.NewMap(sb, types.DisputeKeyPrefix, "disputes", collections.StringKey, codec.CollValue[types.Dispute](cdc)),
+		Suspensions:        collections.NewMap(sb, types.SuspensionKeyPrefix, "suspensions", collections.StringKey, codec.CollValue[types.Suspension](cdc)),
+		ParamsHistory:      collections.NewMap(sb, types.ParamsHistoryKeyPrefix, "params_history", collections.Uint64Key, codec.CollValue[types.ParamsChange](cdc)),
+		ParamsVersion:      collections.NewSequence(sb, types.ParamsVersionKey, "params_version"),
+		VoteFees:           collections.NewIndexedMap(sb, types.VoteFeeKeyPrefix, "vote_fees", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.VoteFee](cdc), newVoteFeeIndexes(sb)),
+		AuditLogs:          collections.NewIndexedMap(sb, types.AuditLogKeyPrefix, "audit_logs", collections.Uint64Key, codec.CollValue[commontypes.AuditLog](cdc), newAuditLogIndexes(sb)),
+		AuditLogSequence:   collections.NewSequence(sb, types.AuditLogCounterKey, "audit_log_sequence"),
+		ChainHeartbeats:    collections.NewMap(sb, types.ChainHeartbeatKeyPrefix, "chain_heartbeats", collections.StringKey, codec.CollValue[types.ChainHeartbeat](cdc)),
+		Reporters:          collections.NewMap(sb, types.ReporterKeyPrefix, "reporters", collections.StringKey, codec.CollValue[types.ReporterRecord](cdc)),
+		CreditReleases:     collections.NewMap(sb, types.CreditReleaseKeyPrefix, "credit_releases", collections.StringKey, codec.CollValue[types.CreditRelease](cdc)),
+		RejectedTransfers:  collections.NewMap(sb, types.RejectedTransferKeyPrefix, "rejected_transfers", collections.StringKey, codec.CollValue[types.RejectedTransfer](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
 	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the module's authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
 
 // SetNettingKeeper sets the netting keeper (to avoid circular dependency)
@@ -59,7 +169,7 @@ func (k *Keeper) SetMultisigKeeper(multisigKeeper types.MultisigKeeper) {
 
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
-	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+	return commontypes.ModuleLogger(ctx, types.ModuleName)
 }
 
 // GetStoreKey returns the store key
@@ -69,19 +179,68 @@ func (k Keeper) GetStoreKey() storetypes.StoreKey {
 
 // SubmitVote submits a validator vote on a transfer event
 func (k Keeper) SubmitVote(ctx sdk.Context, vote commontypes.Vote) error {
+	_, err := k.submitVote(ctx, vote)
+	return err
+}
+
+// submitVote submits a vote and returns the confirmation result if the vote
+// completed consensus, or nil otherwise
+func (k Keeper) submitVote(ctx sdk.Context, vote commontypes.Vote) (*types.ConfirmationResult, error) {
+	ctx = commontypes.WithCorrelationID(ctx, vote.TxHash)
+
+	// Bound the vote before any lookup or signature check, so oversized event
+	// data cannot stall block processing
+	if size, maxBytes := int64(types.VoteSize(vote)), k.GetParams(ctx).MaxProofBytes; size > maxBytes {
+		return nil, errorsmod.Wrapf(types.ErrProofTooLarge, "vote of %d bytes, limit %d", size, maxBytes)
+	}
+
+	// Strict event validation rejects event data not in canonical form;
+	// permissive validation normalizes it, so votes that only differ in the
+	// form of an address agree
+	eventData, err := types.CheckTransferEvent(vote.EventData, k.GetParams(ctx).StrictEventValidation)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidEventData, err.Error())
+	}
+	vote.EventData = eventData
+
 	// Validate that the validator is active
 	if !k.IsActiveValidator(ctx, vote.Validator) {
-		return types.ErrValidatorNotActive
+		return nil, types.ErrValidatorNotActive
+	}
+
+	// Votes arriving after confirmation can no longer change the outcome, so
+	// reject them before paying for signature verification or storing them
+	if voteStatus, found := k.GetVoteStatus(ctx, vote.TxHash); found && voteStatus.Confirmed {
+		telemetry.IncrCounter(1, types.ModuleName, types.MetricKeyLateVotes)
+		return nil, types.ErrTransferAlreadyConfirmed
+	}
+
+	// Transfers on probabilistic finality chains are voted once buried by the
+	// confirmation depth, as seen by the latest relayer heartbeat
+	if finality := k.GetChainFinality(ctx, vote.EventData.SourceChain); finality.ConfirmationDepth > 0 {
+		var besuHeight int64
+		if heartbeat, found := k.GetChainHeartbeat(ctx, finality.Chain); found {
+			besuHeight = heartbeat.BesuHeight
+		}
+		if !finality.Confirmed(vote.EventData.BlockHeight, besuHeight) {
+			return nil, errorsmod.Wrapf(types.ErrInsufficientConfirmations,
+				"block %d on %s needs %d confirmations, latest heartbeat at %d",
+				vote.EventData.BlockHeight, finality.Chain, finality.ConfirmationDepth, besuHeight)
+		}
 	}
 
-	// Verify the signature
-	if !k.VerifySignature(ctx, vote.Validator, []byte(vote.TxHash), vote.Signature) {
-		return types.ErrInvalidSignature
+	// Verify the signature over the vote's signing envelope
+	envelope := k.VoteSigningEnvelope(ctx, vote)
+	if err := envelope.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSigningEnvelope, err.Error())
+	}
+	if !k.VerifySignature(ctx, vote.Validator, envelope.SignBytes(), vote.Signature) {
+		return nil, types.ErrInvalidSignature
 	}
 
 	// Check for duplicate vote
 	if k.hasVoted(ctx, vote.TxHash, vote.Validator) {
-		return types.ErrDuplicateVote
+		return nil, types.ErrDuplicateVote
 	}
 
 	// Store the vote
@@ -99,6 +258,8 @@ func (k Keeper) SubmitVote(ctx sdk.Context, vote commontypes.Vote) error {
 			VoteCount:   1,
 			CreatedAt:   ctx.BlockTime().Unix(),
 			ConfirmedAt: 0,
+			// Fixed when voting starts so later param changes don't move the goalposts
+			RequiredAttestors: k.GetParams(ctx).RequiredAttestors(vote.EventData),
 		}
 	} else {
 		// Add vote to existing status
@@ -112,34 +273,44 @@ func (k Keeper) SubmitVote(ctx sdk.Context, vote commontypes.Vote) error {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeVoteSubmitted,
-			sdk.NewAttribute(types.AttributeKeyTxHash, vote.TxHash),
-			sdk.NewAttribute(types.AttributeKeyValidator, vote.Validator),
+			sdk.NewAttribute(commontypes.AttributeKeyTxHash, vote.TxHash),
+			sdk.NewAttribute(commontypes.AttributeKeyValidator, vote.Validator),
 			sdk.NewAttribute(types.AttributeKeyVoteCount, fmt.Sprintf("%d", voteStatus.VoteCount)),
-			sdk.NewAttribute(types.AttributeKeyThreshold, fmt.Sprintf("%d", voteStatus.Threshold)),
+			sdk.NewAttribute(commontypes.AttributeKeyThreshold, fmt.Sprintf("%d", voteStatus.Threshold)),
 		),
 	)
 
 	// Check if consensus is reached
-	if voteStatus.VoteCount >= voteStatus.Threshold {
-		return k.ConfirmTransfer(ctx, vote.TxHash)
+	if k.thresholdReached(ctx, voteStatus) {
+		if missing := types.MissingAttestors(voteStatus); len(missing) > 0 {
+			k.Logger(ctx).Info("threshold reached, waiting for required attestations",
+				"tx_hash", vote.TxHash,
+				"missing", missing,
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeAttestationsMissing,
+					sdk.NewAttribute(commontypes.AttributeKeyTxHash, vote.TxHash),
+					sdk.NewAttribute(types.AttributeKeyMissing, strings.Join(missing, ",")),
+				),
+			)
+			return nil, nil
+		}
+
+		result, err := k.confirmTransfer(ctx, vote.TxHash, false)
+		if err != nil {
+			return nil, err
+		}
+		return &result, nil
 	}
 
-	return nil
+	return nil, nil
 }
 
 // GetVoteStatus retrieves the vote status for a transaction hash
 func (k Keeper) GetVoteStatus(ctx sdk.Context, txHash string) (commontypes.VoteStatus, bool) {
-	store := ctx.KVStore(k.storeKey)
-	key := types.GetVoteStatusKey(txHash)
-	
-	bz := store.Get(key)
-	if bz == nil {
-		return commontypes.VoteStatus{}, false
-	}
-
-	var voteStatus commontypes.VoteStatus
-	k.cdc.MustUnmarshal(bz, &voteStatus)
-	return voteStatus, true
+	return commontypes.CollectionValue(ctx, k.VoteStatuses, txHash)
 }
 
 // CheckConsensus checks if consensus has been reached for a transfer
@@ -149,39 +320,132 @@ func (k Keeper) CheckConsensus(ctx sdk.Context, txHash string) (bool, error) {
 		return false, types.ErrTransferNotFound
 	}
 
-	return voteStatus.VoteCount >= voteStatus.Threshold, nil
+	return k.hasConsensus(ctx, voteStatus), nil
+}
+
+// hasConsensus returns true if a transfer reached the vote threshold and every
+// required attestor voted
+func (k Keeper) hasConsensus(ctx sdk.Context, voteStatus commontypes.VoteStatus) bool {
+	return k.thresholdReached(ctx, voteStatus) && len(types.MissingAttestors(voteStatus)) == 0
+}
+
+// thresholdReached returns true if a transfer has enough votes. Under weighted
+// consensus the voters must hold 2/3+ of the bonded tokens; the vote count
+// threshold still applies when no bonded tokens are known.
+func (k Keeper) thresholdReached(ctx sdk.Context, voteStatus commontypes.VoteStatus) bool {
+	if !k.GetParams(ctx).WeightedConsensus {
+		return voteStatus.VoteCount >= voteStatus.Threshold
+	}
+
+	validators, err := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
+	if err != nil {
+		return false
+	}
+
+	voters := make(map[string]bool, len(voteStatus.Votes))
+	for _, vote := range voteStatus.Votes {
+		voters[vote.Validator] = true
+	}
+
+	total, voted := math.ZeroInt(), math.ZeroInt()
+	for _, validator := range validators {
+		if validator.Tokens.IsNil() {
+			continue
+		}
+		total = total.Add(validator.Tokens)
+		if voters[validator.OperatorAddress] {
+			voted = voted.Add(validator.Tokens)
+		}
+	}
+	if !total.IsPositive() {
+		return voteStatus.VoteCount >= voteStatus.Threshold
+	}
+	return voted.MulRaw(3).GTE(total.MulRaw(2))
 }
 
-// ConfirmTransfer confirms a transfer after consensus is reached
+// ConfirmTransfer confirms a transfer after consensus is reached.
+// A transfer above its corridor cap is held instead, see GetHeldTransfer.
 func (k Keeper) ConfirmTransfer(ctx sdk.Context, txHash string) error {
+	_, err := k.confirmTransfer(ctx, txHash, false)
+	return err
+}
+
+// confirmTransfer confirms a transfer and returns the credit and commands it
+// produced. Unless approved, a transfer above its corridor cap is held for
+// manual approval and reported with result.Held.
+func (k Keeper) confirmTransfer(ctx sdk.Context, txHash string, approved bool) (types.ConfirmationResult, error) {
+	ctx = commontypes.WithCorrelationID(ctx, txHash)
+	result := types.ConfirmationResult{TxHash: txHash, CreditBalance: math.ZeroInt()}
+
 	voteStatus, found := k.GetVoteStatus(ctx, txHash)
 	if !found {
-		return types.ErrTransferNotFound
+		return result, types.ErrTransferNotFound
 	}
 
 	if voteStatus.Confirmed {
-		return types.ErrTransferAlreadyConfirmed
+		return result, types.ErrTransferAlreadyConfirmed
 	}
 
-	if voteStatus.VoteCount < voteStatus.Threshold {
-		return types.ErrInsufficientVotes
+	if !k.thresholdReached(ctx, voteStatus) {
+		return result, types.ErrInsufficientVotes
 	}
 
-	// Mark as confirmed
-	voteStatus.Confirmed = true
-	voteStatus.ConfirmedAt = ctx.BlockTime().Unix()
-	k.setVoteStatus(ctx, voteStatus)
+	if missing := types.MissingAttestors(voteStatus); len(missing) > 0 {
+		return result, errorsmod.Wrapf(types.ErrMissingAttestations, "waiting for %s", strings.Join(missing, ", "))
+	}
 
 	// Get the transfer event data from the first vote (all votes should have the same event data)
 	if len(voteStatus.Votes) == 0 {
-		return fmt.Errorf("no votes found for confirmed transfer")
+		return result, errorsmod.Wrap(types.ErrInsufficientVotes, "no votes found for confirmed transfer")
+	}
+
+	eventData := k.confirmedEventData(ctx, voteStatus)
+
+	// Hold transfers of suspended chains and banks until resumed, and
+	// anomalous transfers above the corridor cap for manual approval
+	if !approved {
+		if _, held := k.GetHeldTransfer(ctx, txHash); held {
+			result.Held = true
+			return result, nil
+		}
+
+		if suspension, suspended := k.suspensionCovering(ctx, eventData); suspended {
+			k.holdSuspendedTransfer(ctx, txHash, eventData, suspension)
+			result.Held = true
+			return result, nil
+		}
+
+		corridorCap, capped := k.GetParams(ctx).GetCorridorCap(eventData.SourceChain, eventData.DestChain)
+		if capped && eventData.Amount.GT(corridorCap) {
+			k.holdTransfer(ctx, txHash, eventData, corridorCap)
+			result.Held = true
+			return result, nil
+		}
+
+		if position, overdrawn := k.overdraftPosition(ctx, eventData); overdrawn {
+			k.holdOverdraftTransfer(ctx, txHash, eventData, position)
+			result.Held = true
+			return result, nil
+		}
 	}
 
-	eventData := voteStatus.Votes[0].EventData
+	// Mark as confirmed
+	voteStatus.Confirmed = true
+	voteStatus.ConfirmedAt = ctx.BlockTime().Unix()
+	voteStatus.ConfirmedHeight = ctx.BlockHeight()
+	k.setVoteStatus(ctx, voteStatus)
+	k.recordFirstReport(ctx, voteStatus)
 
 	// Store confirmed transfer
 	k.setConfirmedTransfer(ctx, txHash, eventData)
 
+	k.Logger(ctx).Info("transfer confirmed",
+		"source_chain", eventData.SourceChain,
+		"dest_chain", eventData.DestChain,
+		"amount", eventData.Amount.String(),
+		"vote_count", voteStatus.VoteCount,
+	)
+
 	// Log transfer confirmation (Requirement 7.1)
 	if err := k.LogTransferConfirmed(ctx, txHash, eventData); err != nil {
 		k.Logger(ctx).Error("failed to log transfer confirmation", "error", err)
@@ -190,58 +454,79 @@ func (k Keeper) ConfirmTransfer(ctx sdk.Context, txHash string) error {
 
 	// Trigger credit token issuance through netting keeper
 	if k.nettingKeeper != nil {
-		creditToken := commontypes.CreditToken{
-			Denom:      fmt.Sprintf("cred-%s", eventData.SourceChain),
-			IssuerBank: eventData.SourceChain,
-			HolderBank: eventData.DestChain,
-			Amount:     eventData.Amount,
-			OriginTx:   txHash,
-			IssuedAt:   ctx.BlockTime().Unix(),
-		}
+		creditToken := types.TransferCreditToken(eventData, voteStatus.ConfirmedAt)
 
 		if err := k.nettingKeeper.IssueCreditToken(ctx, creditToken); err != nil {
-			return fmt.Errorf("failed to issue credit token: %w", err)
+			return result, errorsmod.Wrap(err, "failed to issue credit token")
 		}
+		k.setTransferCredit(ctx, txHash, creditToken)
+
+		result.Denom = creditToken.Denom
+		result.CreditBalance = k.nettingKeeper.GetCreditBalance(ctx, creditToken.HolderBank, creditToken.Denom)
 	}
 
 	// Generate mint command through multisig keeper (Requirement 5.1)
 	// This creates a command for minting tokens on the destination chain
+	var validatorSetVersion uint64
 	if k.multisigKeeper != nil {
-		_, err := k.multisigKeeper.GenerateMintCommand(
+		command, err := k.multisigKeeper.GenerateTokenMintCommand(
 			ctx,
 			eventData.DestChain,  // Target chain where tokens will be minted
 			eventData.Recipient,  // Recipient address on the destination chain
+			eventData.AssetID,    // Wrapped asset to mint
 			eventData.Amount,     // Amount to mint
 		)
 		if err != nil {
-			return fmt.Errorf("failed to generate mint command: %w", err)
+			return result, errorsmod.Wrap(err, "failed to generate mint command")
+		}
+
+		result.CommandIDs = append(result.CommandIDs, command.CommandID)
+		validatorSetVersion = k.multisigKeeper.GetValidatorSet(ctx).Version
+
+		// Two-phase credit release keeps the credit out of netting until
+		// the destination chain executed the mint
+		if credit, issued := k.GetTransferCredit(ctx, txHash); issued && k.GetParams(ctx).TwoPhaseCreditRelease {
+			if err := k.freezeUntilExecuted(ctx, txHash, command.CommandID, credit); err != nil {
+				return result, err
+			}
 		}
 	}
 
+	// Refund fees of the votes that contributed to this confirmation
+	k.refundVoteFees(ctx, voteStatus, eventData)
+
 	// Emit consensus reached event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeConsensusReached,
-			sdk.NewAttribute(types.AttributeKeyTxHash, txHash),
+			sdk.NewAttribute(commontypes.AttributeKeyTxHash, txHash),
 			sdk.NewAttribute(types.AttributeKeyVoteCount, fmt.Sprintf("%d", voteStatus.VoteCount)),
-			sdk.NewAttribute(types.AttributeKeyThreshold, fmt.Sprintf("%d", voteStatus.Threshold)),
+			sdk.NewAttribute(commontypes.AttributeKeyThreshold, fmt.Sprintf("%d", voteStatus.Threshold)),
 		),
 	)
 
-	// Emit transfer confirmed event
+	// Emit transfer confirmed event, with the payload hash the destination
+	// chain's tooling computes
+	hashAlgorithm := k.GetParams(ctx).EventHashAlgorithm(eventData.DestChain)
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeTransferConfirmed,
-			sdk.NewAttribute(types.AttributeKeyTxHash, txHash),
+			sdk.NewAttribute(commontypes.AttributeKeyTxHash, txHash),
 			sdk.NewAttribute(types.AttributeKeySender, eventData.Sender),
-			sdk.NewAttribute(types.AttributeKeyRecipient, eventData.Recipient),
-			sdk.NewAttribute(types.AttributeKeyAmount, eventData.Amount.String()),
+			sdk.NewAttribute(commontypes.AttributeKeyRecipient, eventData.Recipient),
+			sdk.NewAttribute(commontypes.AttributeKeyAmount, eventData.Amount.String()),
 			sdk.NewAttribute(types.AttributeKeySourceChain, eventData.SourceChain),
 			sdk.NewAttribute(types.AttributeKeyDestChain, eventData.DestChain),
+			sdk.NewAttribute(commontypes.AttributeKeyNonce, strconv.FormatUint(eventData.Nonce, 10)),
+			sdk.NewAttribute(commontypes.AttributeKeyTokenID, eventData.AssetID),
+			sdk.NewAttribute(commontypes.AttributeKeyPayloadHash, hex.EncodeToString(types.TransferPayloadHash(hashAlgorithm, eventData))),
+			sdk.NewAttribute(commontypes.AttributeKeyHashAlgorithm, hashAlgorithm.String()),
+			sdk.NewAttribute(commontypes.AttributeKeyValidatorSetVersion, strconv.FormatUint(validatorSetVersion, 10)),
+			sdk.NewAttribute(types.AttributeKeyCommandIDs, strings.Join(result.CommandIDs, ",")),
 		),
 	)
 
-	return nil
+	return result, nil
 }
 
 // IsActiveValidator checks if a validator is active
@@ -279,6 +564,13 @@ func (k Keeper) GetValidatorPubKey(ctx sdk.Context, validator string) ([]byte, b
 	return pubKey.Bytes(), true
 }
 
+// VoteSigningEnvelope returns the envelope a vote signature must cover: the
+// hash of the voted transfer event bound to this chain, the oracle module and
+// the vote purpose
+func (k Keeper) VoteSigningEnvelope(ctx sdk.Context, vote commontypes.Vote) types.SigningEnvelope {
+	return types.NewVoteSigningEnvelope(vote.SignatureVersion, ctx.ChainID(), vote.EventData)
+}
+
 // VerifySignature verifies a validator's signature using ECDSA
 func (k Keeper) VerifySignature(ctx sdk.Context, validator string, data []byte, signature []byte) bool {
 	pubKey, found := k.GetValidatorPubKey(ctx, validator)
@@ -287,72 +579,8 @@ func (k Keeper) VerifySignature(ctx sdk.Context, validator string, data []byte,
 		return false
 	}
 
-	// Basic validation
-	if len(signature) == 0 || len(data) == 0 {
-		k.Logger(ctx).Error("empty signature or data", "validator", validator)
-		return false
-	}
-
-	// For ECDSA signatures, we expect 65 bytes (r=32, s=32, v=1)
-	if len(signature) != 65 {
-		k.Logger(ctx).Error("invalid signature length", "validator", validator, "length", len(signature))
-		return false
-	}
-
-	// Hash the data using SHA256
-	hash := sha256.Sum256(data)
-
-	// Extract r, s, v from signature
-	r := signature[:32]
-	s := signature[32:64]
-	v := signature[64]
-
-	// Recover public key from signature
-	recoveredPubKey, err := crypto.SigToPub(hash[:], signature)
-	if err != nil {
-		k.Logger(ctx).Error("failed to recover public key from signature", "validator", validator, "error", err)
-		return false
-	}
-
-	// Convert recovered public key to bytes for comparison
-	recoveredPubKeyBytes := crypto.FromECDSAPub(recoveredPubKey)
-
-	// For Cosmos validators, we need to handle the public key format conversion
-	// The stored pubKey might be in Cosmos secp256k1 format
-	var expectedPubKeyBytes []byte
-	
-	// Try to parse as Cosmos secp256k1 public key first
-	if len(pubKey) == 33 { // Compressed secp256k1 public key
-		// Decompress the compressed public key to uncompressed format
-		ecdsaPubKey, err := crypto.DecompressPubkey(pubKey)
-		if err != nil {
-			k.Logger(ctx).Error("failed to decompress cosmos public key", "validator", validator, "error", err)
-			return false
-		}
-		expectedPubKeyBytes = crypto.FromECDSAPub(ecdsaPubKey)
-	} else if len(pubKey) == 65 { // Uncompressed ECDSA public key
-		expectedPubKeyBytes = pubKey
-	} else {
-		k.Logger(ctx).Error("unsupported public key format", "validator", validator, "length", len(pubKey))
-		return false
-	}
-
-	// Compare recovered public key with stored public key
-	if len(recoveredPubKeyBytes) != len(expectedPubKeyBytes) {
-		k.Logger(ctx).Error("public key length mismatch", "validator", validator)
-		return false
-	}
-
-	for i := 0; i < len(recoveredPubKeyBytes); i++ {
-		if recoveredPubKeyBytes[i] != expectedPubKeyBytes[i] {
-			k.Logger(ctx).Error("public key mismatch", "validator", validator)
-			return false
-		}
-	}
-
-	// Additional validation: verify signature components are valid
-	if !k.isValidSignatureComponents(r, s, v) {
-		k.Logger(ctx).Error("invalid signature components", "validator", validator)
+	if err := types.VerifySignature(pubKey, data, signature); err != nil {
+		k.Logger(ctx).Error("signature verification failed", "validator", validator, "error", err)
 		return false
 	}
 
@@ -360,65 +588,22 @@ func (k Keeper) VerifySignature(ctx sdk.Context, validator string, data []byte,
 	return true
 }
 
-// isValidSignatureComponents validates ECDSA signature components
-func (k Keeper) isValidSignatureComponents(r, s []byte, v byte) bool {
-	// Check that r and s are not zero
-	rIsZero := true
-	sIsZero := true
-	
-	for _, b := range r {
-		if b != 0 {
-			rIsZero = false
-			break
-		}
-	}
-	
-	for _, b := range s {
-		if b != 0 {
-			sIsZero = false
-			break
-		}
-	}
-	
-	if rIsZero || sIsZero {
-		return false
-	}
-	
-	// Check v is valid (should be 27 or 28 for Ethereum-style, or 0/1 for some implementations)
-	if v != 0 && v != 1 && v != 27 && v != 28 {
-		return false
-	}
-	
-	return true
-}
-
 // Private helper methods
 
 func (k Keeper) hasVoted(ctx sdk.Context, txHash, validator string) bool {
-	store := ctx.KVStore(k.storeKey)
-	key := types.GetVoteKey(txHash, validator)
-	return store.Has(key)
+	return commontypes.CollectionHas(ctx, k.Votes, collections.Join(txHash, validator))
 }
 
 func (k Keeper) setVote(ctx sdk.Context, vote commontypes.Vote) {
-	store := ctx.KVStore(k.storeKey)
-	key := types.GetVoteKey(vote.TxHash, vote.Validator)
-	bz := k.cdc.MustMarshal(&vote)
-	store.Set(key, bz)
+	commontypes.MustCollection(k.Votes.Set(ctx, collections.Join(vote.TxHash, vote.Validator), vote))
 }
 
 func (k Keeper) setVoteStatus(ctx sdk.Context, voteStatus commontypes.VoteStatus) {
-	store := ctx.KVStore(k.storeKey)
-	key := types.GetVoteStatusKey(voteStatus.TxHash)
-	bz := k.cdc.MustMarshal(&voteStatus)
-	store.Set(key, bz)
+	commontypes.MustCollection(k.VoteStatuses.Set(ctx, voteStatus.TxHash, voteStatus))
 }
 
 func (k Keeper) setConfirmedTransfer(ctx sdk.Context, txHash string, eventData commontypes.TransferEvent) {
-	store := ctx.KVStore(k.storeKey)
-	key := types.GetConfirmedTransferKey(txHash)
-	bz := k.cdc.MustMarshal(&eventData)
-	store.Set(key, bz)
+	commontypes.MustCollection(k.ConfirmedTransfers.Set(ctx, txHash, eventData))
 }
 
 func (k Keeper) getConsensusThreshold(ctx sdk.Context) int32 {
@@ -427,25 +612,17 @@ func (k Keeper) getConsensusThreshold(ctx sdk.Context) int32 {
 	if err != nil {
 		return 1 // Default minimum threshold
 	}
-	totalValidators := len(validators)
-
-	// Calculate 2/3 threshold
-	threshold := (totalValidators * 2) / 3
-	if (totalValidators*2)%3 != 0 {
-		threshold++ // Round up for 2/3+ majority
-	}
 
-	// Minimum threshold of 1
-	if threshold < 1 {
-		threshold = 1
-	}
-
-	return int32(threshold)
+	return commontypes.ConsensusThreshold(len(validators))
 }
 
-// RejectTransfer rejects a transfer due to insufficient votes or timeout
+// RejectTransfer rejects a transfer due to insufficient votes or timeout. The
+// event carries the reason code and the free-text reason as its detail, and a
+// RejectedTransfer record keeps the tally and the votes as evidence.
 // Requirement 3.4: WHEN 충분하지 않은 투표가 수신되면 THEN 시스템은 이체를 거부하고 현재 상태를 유지해야 합니다
-func (k Keeper) RejectTransfer(ctx sdk.Context, txHash string, reason string) error {
+func (k Keeper) RejectTransfer(ctx sdk.Context, txHash string, code commontypes.ReasonCode, reason string) error {
+	ctx = commontypes.WithCorrelationID(ctx, txHash)
+
 	voteStatus, found := k.GetVoteStatus(ctx, txHash)
 	if !found {
 		return types.ErrTransferNotFound
@@ -455,17 +632,19 @@ func (k Keeper) RejectTransfer(ctx sdk.Context, txHash string, reason string) er
 		return types.ErrTransferAlreadyConfirmed
 	}
 
-	// Mark as rejected (we don't modify the vote status, just emit an event)
-	// The transfer remains in pending state and won't be processed
+	// Record the evidence; the vote status is not modified and the transfer
+	// remains in pending state
+	k.setRejectedTransfer(ctx, types.NewRejectedTransfer(voteStatus, code, reason, ctx.BlockHeight(), ctx.BlockTime().Unix()))
 
 	// Emit transfer rejected event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeTransferRejected,
-			sdk.NewAttribute(types.AttributeKeyTxHash, txHash),
+			sdk.NewAttribute(commontypes.AttributeKeyTxHash, txHash),
 			sdk.NewAttribute(types.AttributeKeyVoteCount, fmt.Sprintf("%d", voteStatus.VoteCount)),
-			sdk.NewAttribute(types.AttributeKeyThreshold, fmt.Sprintf("%d", voteStatus.Threshold)),
-			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(commontypes.AttributeKeyThreshold, fmt.Sprintf("%d", voteStatus.Threshold)),
+			sdk.NewAttribute(commontypes.AttributeKeyReasonCode, code.String()),
+			sdk.NewAttribute(commontypes.AttributeKeyReason, reason),
 		),
 	)
 
@@ -473,12 +652,28 @@ func (k Keeper) RejectTransfer(ctx sdk.Context, txHash string, reason string) er
 		"tx_hash", txHash,
 		"vote_count", voteStatus.VoteCount,
 		"threshold", voteStatus.Threshold,
+		"reason_code", code,
 		"reason", reason,
 	)
 
 	return nil
 }
 
+// GetRejectedTransfer returns the evidence of the latest rejection of a transfer
+func (k Keeper) GetRejectedTransfer(ctx sdk.Context, txHash string) (types.RejectedTransfer, bool) {
+	return commontypes.CollectionValue(ctx, k.RejectedTransfers, txHash)
+}
+
+// GetAllRejectedTransfers returns the evidence of every rejected transfer,
+// ordered by tx hash
+func (k Keeper) GetAllRejectedTransfers(ctx sdk.Context) []types.RejectedTransfer {
+	return commontypes.CollectionValues(ctx, k.RejectedTransfers, nil)
+}
+
+func (k Keeper) setRejectedTransfer(ctx sdk.Context, rejected types.RejectedTransfer) {
+	commontypes.MustCollection(k.RejectedTransfers.Set(ctx, rejected.TxHash, rejected))
+}
+
 // CheckConsensusTimeout checks if a transfer has timed out without reaching consensus
 func (k Keeper) CheckConsensusTimeout(ctx sdk.Context, txHash string, timeoutBlocks int64) (bool, error) {
 	voteStatus, found := k.GetVoteStatus(ctx, txHash)
@@ -505,34 +700,47 @@ func (k Keeper) CheckConsensusTimeout(ctx sdk.Context, txHash string, timeoutBlo
 
 // GetConfirmedTransfer retrieves a confirmed transfer by txHash
 func (k Keeper) GetConfirmedTransfer(ctx sdk.Context, txHash string) (commontypes.TransferEvent, bool) {
-	store := ctx.KVStore(k.storeKey)
-	key := types.GetConfirmedTransferKey(txHash)
-
-	bz := store.Get(key)
-	if bz == nil {
-		return commontypes.TransferEvent{}, false
-	}
+	return commontypes.CollectionValue(ctx, k.ConfirmedTransfers, txHash)
+}
 
-	var transferEvent commontypes.TransferEvent
-	k.cdc.MustUnmarshal(bz, &transferEvent)
-	return transferEvent, true
+// GetAllConfirmedTransfers retrieves all confirmed transfers (used by genesis export)
+func (k Keeper) GetAllConfirmedTransfers(ctx sdk.Context) []commontypes.TransferEvent {
+	return commontypes.CollectionValues(ctx, k.ConfirmedTransfers, nil)
 }
 
 // GetAllVoteStatuses retrieves all vote statuses (for queries and auditing)
 func (k Keeper) GetAllVoteStatuses(ctx sdk.Context) []commontypes.VoteStatus {
-	store := ctx.KVStore(k.storeKey)
+	return commontypes.CollectionValues(ctx, k.VoteStatuses, nil)
+}
 
-	statuses := make([]commontypes.VoteStatus, 0)
-	iterator := storetypes.KVStorePrefixIterator(store, types.VoteStatusKeyPrefix)
-	defer iterator.Close()
+// SetVoteStatus stores a vote status (used by genesis import)
+func (k Keeper) SetVoteStatus(ctx sdk.Context, voteStatus commontypes.VoteStatus) {
+	k.setVoteStatus(ctx, voteStatus)
+}
 
-	for ; iterator.Valid(); iterator.Next() {
-		var voteStatus commontypes.VoteStatus
-		k.cdc.MustUnmarshal(iterator.Value(), &voteStatus)
-		statuses = append(statuses, voteStatus)
-	}
+// SetConfirmedTransfer stores a confirmed transfer (used by genesis import)
+func (k Keeper) SetConfirmedTransfer(ctx sdk.Context, transfer commontypes.TransferEvent) {
+	k.setConfirmedTransfer(ctx, transfer.TxHash, transfer)
+}
+
+// GetTransferCredit returns the credit token issued when a transfer was
+// confirmed, as recorded at issuance
+func (k Keeper) GetTransferCredit(ctx sdk.Context, txHash string) (commontypes.CreditToken, bool) {
+	return commontypes.CollectionValue(ctx, k.TransferCredits, txHash)
+}
+
+func (k Keeper) setTransferCredit(ctx sdk.Context, txHash string, credit commontypes.CreditToken) {
+	commontypes.MustCollection(k.TransferCredits.Set(ctx, txHash, credit))
+}
 
-	return statuses
+// transferCredit returns the recorded credit of a confirmed transfer, or the
+// credit it would have issued for transfers confirmed before credits were
+// recorded or without a netting keeper
+func (k Keeper) transferCredit(ctx sdk.Context, eventData commontypes.TransferEvent, confirmedAt int64) commontypes.CreditToken {
+	if credit, found := k.GetTransferCredit(ctx, eventData.TxHash); found {
+		return credit
+	}
+	return types.TransferCreditToken(eventData, confirmedAt)
 }
 
 // =============================================================================
@@ -559,30 +767,13 @@ func (k Keeper) GetDynamicThreshold(ctx sdk.Context) (threshold int32, activeCou
 		return 1, 0
 	}
 
-	// Calculate 2/3 threshold
-	threshold = int32((activeCount * 2) / 3)
-	if (activeCount*2)%3 != 0 {
-		threshold++
-	}
-
-	if threshold < 1 {
-		threshold = 1
-	}
-
-	return threshold, activeCount
+	return commontypes.ConsensusThreshold(activeCount), activeCount
 }
 
 // ProcessPendingTransfersWithTimeout processes all pending transfers and rejects timed out ones
 // Requirement 12.2: 검증자 오프라인 시 동적 임계값 조정
 func (k Keeper) ProcessPendingTransfersWithTimeout(ctx sdk.Context, timeoutBlocks int64) (processed, rejected int) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, types.VoteStatusKeyPrefix)
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		var voteStatus commontypes.VoteStatus
-		k.cdc.MustUnmarshal(iterator.Value(), &voteStatus)
-
+	for _, voteStatus := range k.GetAllVoteStatuses(ctx) {
 		// Skip already confirmed transfers
 		if voteStatus.Confirmed {
 			continue
@@ -602,7 +793,7 @@ func (k Keeper) ProcessPendingTransfersWithTimeout(ctx sdk.Context, timeoutBlock
 
 		if isTimeout {
 			// Reject the transfer due to timeout
-			if err := k.RejectTransfer(ctx, voteStatus.TxHash, "consensus timeout"); err != nil {
+			if err := k.RejectTransfer(ctx, voteStatus.TxHash, commontypes.ReasonConsensusTimeout, "consensus timeout"); err != nil {
 				k.Logger(ctx).Error("failed to reject timed out transfer",
 					"tx_hash", voteStatus.TxHash,
 					"error", err,
@@ -620,7 +811,8 @@ func (k Keeper) ProcessPendingTransfersWithTimeout(ctx sdk.Context, timeoutBlock
 // Requirement 12.2: 서명 오류 시 개별 서명 제외 처리
 func (k Keeper) ValidateVoteSignatureWithFallback(ctx sdk.Context, vote commontypes.Vote) (valid bool, excludeReason string) {
 	// First try normal signature verification
-	if k.VerifySignature(ctx, vote.Validator, []byte(vote.TxHash), vote.Signature) {
+	envelope := k.VoteSigningEnvelope(ctx, vote)
+	if envelope.Validate() == nil && k.VerifySignature(ctx, vote.Validator, envelope.SignBytes(), vote.Signature) {
 		return true, ""
 	}
 
@@ -636,14 +828,8 @@ func (k Keeper) ValidateVoteSignatureWithFallback(ctx sdk.Context, vote commonty
 
 // GetPendingTransferCount returns the count of pending (unconfirmed) transfers
 func (k Keeper) GetPendingTransferCount(ctx sdk.Context) int {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, types.VoteStatusKeyPrefix)
-	defer iterator.Close()
-
 	count := 0
-	for ; iterator.Valid(); iterator.Next() {
-		var voteStatus commontypes.VoteStatus
-		k.cdc.MustUnmarshal(iterator.Value(), &voteStatus)
+	for _, voteStatus := range k.GetAllVoteStatuses(ctx) {
 		if !voteStatus.Confirmed {
 			count++
 		}
@@ -655,6 +841,8 @@ func (k Keeper) GetPendingTransferCount(ctx sdk.Context) int {
 // RecoverFromConsensusFailure attempts to recover from consensus failure
 // by recalculating thresholds and retrying confirmation
 func (k Keeper) RecoverFromConsensusFailure(ctx sdk.Context, txHash string) error {
+	ctx = commontypes.WithCorrelationID(ctx, txHash)
+
 	voteStatus, found := k.GetVoteStatus(ctx, txHash)
 	if !found {
 		return types.ErrTransferNotFound
@@ -675,7 +863,8 @@ func (k Keeper) RecoverFromConsensusFailure(ctx sdk.Context, txHash string) erro
 		"active_validators", activeCount,
 	)
 
-	// If we now have enough votes with the dynamic threshold, confirm
+	// If we now have enough votes with the dynamic threshold, confirm.
+	// Required attestations are never relaxed.
 	if voteStatus.VoteCount >= threshold {
 		// Update threshold and attempt confirmation
 		voteStatus.Threshold = threshold
@@ -688,165 +877,1246 @@ func (k Keeper) RecoverFromConsensusFailure(ctx sdk.Context, txHash string) erro
 }
 
 // =============================================================================
-// Audit Logging System (Requirement 7.1 - 7.5)
+// Params
 // =============================================================================
 
-// SaveAuditLog saves an audit log entry with automatic ID assignment
-// Requirement 7.1: 거래 로깅 시스템
-func (k Keeper) SaveAuditLog(ctx sdk.Context, log commontypes.AuditLog) (uint64, error) {
-	store := ctx.KVStore(k.storeKey)
-
-	// Get and increment the counter
-	id := k.getNextAuditLogID(ctx)
-	log.ID = id
-	log.BlockHeight = ctx.BlockHeight()
-
-	if log.Timestamp == 0 {
-		log.Timestamp = ctx.BlockTime().Unix()
+// GetParams returns the module parameters, or the defaults if none are stored
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams()
 	}
+	commontypes.MustCollection(err)
+	return params
+}
 
-	// Marshal the log
-	bz := k.cdc.MustMarshal(&log)
-
-	// Store by ID (primary index)
-	store.Set(types.GetAuditLogKey(id), bz)
+// SetParams stores the module parameters
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	commontypes.MustCollection(k.Params.Set(ctx, params))
+}
 
-	// Store by time (secondary index for time range queries)
-	store.Set(types.GetAuditLogByTimeKey(log.Timestamp, id), bz)
+// UpdateParams stores params set by the authority and records the change in
+// the params history
+func (k Keeper) UpdateParams(ctx sdk.Context, authority string, params types.Params, proposalID uint64) (types.ParamsChange, error) {
+	if err := params.Validate(); err != nil {
+		return types.ParamsChange{}, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
 
-	// Store by event type (secondary index for type filtering)
-	store.Set(types.GetAuditLogByTypeKey(log.EventType, id), bz)
+	k.SetParams(ctx, params)
+
+	version, err := k.ParamsVersion.Next(ctx)
+	commontypes.MustCollection(err)
+	change := types.ParamsChange{
+		Version:    version + 1,
+		Height:     ctx.BlockHeight(),
+		Time:       ctx.BlockTime().Unix(),
+		ProposalID: proposalID,
+		Authority:  authority,
+		Params:     params,
+	}
+	commontypes.MustCollection(k.ParamsHistory.Set(ctx, change.Version, change))
 
-	k.Logger(ctx).Debug("audit log saved",
-		"id", id,
-		"event_type", log.EventType,
-		"tx_hash", log.TxHash,
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParamsUpdated,
+			sdk.NewAttribute(commontypes.AttributeKeyVersion, strconv.FormatUint(change.Version, 10)),
+			sdk.NewAttribute(commontypes.AttributeKeyProposalID, strconv.FormatUint(proposalID, 10)),
+		),
 	)
 
-	return id, nil
+	return change, nil
 }
 
-// getNextAuditLogID gets and increments the audit log counter
-func (k Keeper) getNextAuditLogID(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.AuditLogCounterKey)
-
-	var counter uint64 = 1
-	if bz != nil {
-		counter = uint64(bz[0])<<56 | uint64(bz[1])<<48 | uint64(bz[2])<<40 | uint64(bz[3])<<32 |
-			uint64(bz[4])<<24 | uint64(bz[5])<<16 | uint64(bz[6])<<8 | uint64(bz[7])
-		counter++
-	}
+// GetParamsHistory returns the recorded params changes, oldest first
+func (k Keeper) GetParamsHistory(ctx sdk.Context) []types.ParamsChange {
+	return commontypes.CollectionValues(ctx, k.ParamsHistory, nil)
+}
 
-	// Store incremented counter
-	newBz := make([]byte, 8)
-	newBz[0] = byte(counter >> 56)
-	newBz[1] = byte(counter >> 48)
-	newBz[2] = byte(counter >> 40)
-	newBz[3] = byte(counter >> 32)
-	newBz[4] = byte(counter >> 24)
-	newBz[5] = byte(counter >> 16)
-	newBz[6] = byte(counter >> 8)
-	newBz[7] = byte(counter)
-	store.Set(types.AuditLogCounterKey, newBz)
+// =============================================================================
+// Transfer Proofs
+// =============================================================================
 
-	return counter
-}
+// GetTransferProof returns the proof bundle of a confirmed transfer: its event
+// data, every vote with its signature, the confirmation height and the credit
+// token it issued
+func (k Keeper) GetTransferProof(ctx sdk.Context, txHash string) (types.TransferProof, error) {
+	voteStatus, found := k.GetVoteStatus(ctx, txHash)
+	if !found {
+		return types.TransferProof{}, types.ErrTransferNotFound
+	}
 
-// GetAuditLog retrieves an audit log by ID
-func (k Keeper) GetAuditLog(ctx sdk.Context, id uint64) (commontypes.AuditLog, bool) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetAuditLogKey(id))
-	if bz == nil {
-		return commontypes.AuditLog{}, false
+	eventData, confirmed := k.GetConfirmedTransfer(ctx, txHash)
+	if !voteStatus.Confirmed || !confirmed {
+		return types.TransferProof{}, types.ErrTransferNotConfirmed
 	}
 
-	var log commontypes.AuditLog
-	k.cdc.MustUnmarshal(bz, &log)
-	return log, true
+	return types.TransferProof{
+		ChainID:           ctx.ChainID(),
+		EventData:         eventData,
+		Votes:             voteStatus.Votes,
+		Threshold:         voteStatus.Threshold,
+		ConfirmedHeight:   voteStatus.ConfirmedHeight,
+		ConfirmedAt:       voteStatus.ConfirmedAt,
+		CreditToken:       k.transferCredit(ctx, eventData, voteStatus.ConfirmedAt),
+		RequiredAttestors: voteStatus.RequiredAttestors,
+	}, nil
 }
 
-// GetAuditLogsByTimeRange retrieves audit logs within a time range
-// Requirement 7.5: 감사 쿼리 API
-func (k Keeper) GetAuditLogsByTimeRange(ctx sdk.Context, startTime, endTime int64) []commontypes.AuditLog {
-	store := ctx.KVStore(k.storeKey)
-	logs := make([]commontypes.AuditLog, 0)
-
-	// Create iterator starting from startTime
-	startKey := types.GetAuditLogTimeRangePrefix(startTime)
-	endKey := types.GetAuditLogTimeRangePrefix(endTime + 1) // +1 to include endTime
+// VerifyTransferProof checks a transfer proof from an untrusted source against
+// the current bonded validator set. The proof must be within the MaxProofVotes
+// and MaxProofBytes params before any of its signatures is verified.
+func (k Keeper) VerifyTransferProof(ctx sdk.Context, proof types.TransferProof) error {
+	params := k.GetParams(ctx)
+	if err := proof.CheckLimits(params.MaxProofBytes, params.MaxProofVotes); err != nil {
+		return errorsmod.Wrap(types.ErrProofTooLarge, err.Error())
+	}
 
-	iterator := store.Iterator(startKey, endKey)
-	defer iterator.Close()
+	bonded, err := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
+	if err != nil {
+		return err
+	}
 
-	for ; iterator.Valid(); iterator.Next() {
-		var log commontypes.AuditLog
-		k.cdc.MustUnmarshal(iterator.Value(), &log)
-		logs = append(logs, log)
+	validators := make([]commontypes.Validator, 0, len(bonded))
+	for _, val := range bonded {
+		pubKey, err := val.ConsPubKey()
+		if err != nil {
+			continue
+		}
+		validators = append(validators, commontypes.Validator{
+			Address: val.GetOperator(),
+			PubKey:  pubKey.Bytes(),
+			Active:  val.IsBonded() && !val.IsJailed(),
+		})
 	}
 
-	return logs
+	if err := proof.Verify(validators); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidProof, err.Error())
+	}
+	return nil
 }
 
-// GetAuditLogsByEventType retrieves audit logs by event type
-// Requirement 7.5: 감사 쿼리 API
-func (k Keeper) GetAuditLogsByEventType(ctx sdk.Context, eventType string) []commontypes.AuditLog {
-	store := ctx.KVStore(k.storeKey)
-	logs := make([]commontypes.AuditLog, 0)
-
-	prefix := types.GetAuditLogByTypePrefix(eventType)
-	iterator := storetypes.KVStorePrefixIterator(store, prefix)
-	defer iterator.Close()
+// GetConfirmedTransferCredit returns the credit token a confirmed transfer
+// issued, so clients don't have to derive its denom from the event
+func (k Keeper) GetConfirmedTransferCredit(ctx sdk.Context, txHash string) (commontypes.CreditToken, error) {
+	voteStatus, found := k.GetVoteStatus(ctx, txHash)
+	if !found {
+		return commontypes.CreditToken{}, types.ErrTransferNotFound
+	}
 
-	for ; iterator.Valid(); iterator.Next() {
-		var log commontypes.AuditLog
-		k.cdc.MustUnmarshal(iterator.Value(), &log)
-		logs = append(logs, log)
+	eventData, confirmed := k.GetConfirmedTransfer(ctx, txHash)
+	if !voteStatus.Confirmed || !confirmed {
+		return commontypes.CreditToken{}, types.ErrTransferNotConfirmed
 	}
 
-	return logs
+	return k.transferCredit(ctx, eventData, voteStatus.ConfirmedAt), nil
 }
 
-// GetAuditLogsByTxHash retrieves audit logs by transaction hash
-// Requirement 7.3: 추적성 이벤트
-func (k Keeper) GetAuditLogsByTxHash(ctx sdk.Context, txHash string) []commontypes.AuditLog {
-	store := ctx.KVStore(k.storeKey)
-	logs := make([]commontypes.AuditLog, 0)
-
-	// Iterate all audit logs and filter by txHash
-	iterator := storetypes.KVStorePrefixIterator(store, types.AuditLogKeyPrefix)
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		var log commontypes.AuditLog
-		k.cdc.MustUnmarshal(iterator.Value(), &log)
-		if log.TxHash == txHash {
-			logs = append(logs, log)
-		}
-	}
+// =============================================================================
+// Corridor Caps and Held Transfers
+// =============================================================================
 
-	return logs
+// GetHeldTransfer returns a transfer held above its corridor cap
+func (k Keeper) GetHeldTransfer(ctx sdk.Context, txHash string) (types.HeldTransfer, bool) {
+	return commontypes.CollectionValue(ctx, k.HeldTransfers, txHash)
 }
 
-// GetAllAuditLogs retrieves all audit logs
-func (k Keeper) GetAllAuditLogs(ctx sdk.Context) []commontypes.AuditLog {
-	store := ctx.KVStore(k.storeKey)
-	logs := make([]commontypes.AuditLog, 0)
+// GetAllHeldTransfers returns all held transfers, including rejected ones
+func (k Keeper) GetAllHeldTransfers(ctx sdk.Context) []types.HeldTransfer {
+	return commontypes.CollectionValues(ctx, k.HeldTransfers, nil)
+}
 
-	iterator := storetypes.KVStorePrefixIterator(store, types.AuditLogKeyPrefix)
-	defer iterator.Close()
+// ApproveHeldTransfer confirms a held transfer despite its corridor cap
+func (k Keeper) ApproveHeldTransfer(ctx sdk.Context, txHash string) (types.ConfirmationResult, error) {
+	ctx = commontypes.WithCorrelationID(ctx, txHash)
 
-	for ; iterator.Valid(); iterator.Next() {
-		var log commontypes.AuditLog
-		k.cdc.MustUnmarshal(iterator.Value(), &log)
-		logs = append(logs, log)
+	held, found := k.GetHeldTransfer(ctx, txHash)
+	if !found {
+		return types.ConfirmationResult{}, types.ErrHeldTransferNotFound
+	}
+	if held.Rejected {
+		return types.ConfirmationResult{}, types.ErrHeldTransferRejected
+	}
+	if suspension, suspended := k.suspensionCovering(ctx, held.EventData); suspended {
+		return types.ConfirmationResult{}, errorsmod.Wrapf(types.ErrSuspended, "%s %s", suspension.Scope, suspension.Target)
 	}
 
-	return logs
-}
+	commontypes.MustCollection(k.HeldTransfers.Remove(ctx, txHash))
 
-// LogTransferConfirmed logs a transfer confirmation event
-// Requirement 7.1: 거래 로깅
+	result, err := k.confirmTransfer(ctx, txHash, true)
+	if err != nil {
+		return result, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferApproved,
+			sdk.NewAttribute(commontypes.AttributeKeyTxHash, txHash),
+			sdk.NewAttribute(commontypes.AttributeKeyAmount, held.EventData.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCorridorCap, held.Cap.String()),
+		),
+	)
+
+	return result, nil
+}
+
+// RejectHeldTransfer rejects a held transfer on behalf of the authority, with
+// ReasonGovernance and its reason. It stays held so that late votes do not
+// confirm it.
+func (k Keeper) RejectHeldTransfer(ctx sdk.Context, txHash, reason string) error {
+	held, found := k.GetHeldTransfer(ctx, txHash)
+	if !found {
+		return types.ErrHeldTransferNotFound
+	}
+	if held.Rejected {
+		return types.ErrHeldTransferRejected
+	}
+
+	held.Rejected = true
+	held.Reason = reason
+	held.ReasonCode = commontypes.ReasonGovernance
+	k.setHeldTransfer(ctx, held)
+
+	return k.RejectTransfer(ctx, txHash, held.ReasonCode, reason)
+}
+
+func (k Keeper) holdTransfer(ctx sdk.Context, txHash string, eventData commontypes.TransferEvent, corridorCap math.Int) {
+	k.setHeldTransfer(ctx, types.HeldTransfer{
+		TxHash:    txHash,
+		EventData: eventData,
+		Cap:       corridorCap,
+		HeldAt:    ctx.BlockTime().Unix(),
+	})
+
+	k.Logger(ctx).Info("transfer held above corridor cap",
+		"source_chain", eventData.SourceChain,
+		"dest_chain", eventData.DestChain,
+		"amount", eventData.Amount.String(),
+		"corridor_cap", corridorCap.String(),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferHeld,
+			sdk.NewAttribute(commontypes.AttributeKeyTxHash, txHash),
+			sdk.NewAttribute(types.AttributeKeySourceChain, eventData.SourceChain),
+			sdk.NewAttribute(types.AttributeKeyDestChain, eventData.DestChain),
+			sdk.NewAttribute(commontypes.AttributeKeyAmount, eventData.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCorridorCap, corridorCap.String()),
+		),
+	)
+}
+
+// overdraftPosition returns the net position the source bank of a transfer is
+// projected to have once it confirms, and whether the strict overdraft rule
+// holds the transfer because that position is negative
+func (k Keeper) overdraftPosition(ctx sdk.Context, eventData commontypes.TransferEvent) (commontypes.NetPositionForecast, bool) {
+	if !k.GetParams(ctx).StrictOverdraft || k.nettingKeeper == nil {
+		return commontypes.NetPositionForecast{}, false
+	}
+
+	credit := types.TransferCreditToken(eventData, ctx.BlockTime().Unix())
+	forecast := k.nettingKeeper.ForecastNetting(ctx, []commontypes.CreditToken{credit})
+	for _, position := range forecast.Positions {
+		if position.Bank == eventData.SourceChain {
+			return position, position.Projected.IsNegative()
+		}
+	}
+	return commontypes.NetPositionForecast{}, false
+}
+
+func (k Keeper) holdOverdraftTransfer(ctx sdk.Context, txHash string, eventData commontypes.TransferEvent, position commontypes.NetPositionForecast) {
+	netCredit := math.ZeroInt()
+	if position.Confirmed.IsPositive() {
+		netCredit = position.Confirmed
+	}
+
+	k.setHeldTransfer(ctx, types.HeldTransfer{
+		TxHash:    txHash,
+		EventData: eventData,
+		Cap:       netCredit,
+		HeldAt:    ctx.BlockTime().Unix(),
+		Overdraft: true,
+	})
+
+	k.Logger(ctx).Info("transfer held by strict overdraft",
+		"source_chain", eventData.SourceChain,
+		"dest_chain", eventData.DestChain,
+		"amount", eventData.Amount.String(),
+		"net_position", position.Projected.String(),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferHeld,
+			sdk.NewAttribute(commontypes.AttributeKeyTxHash, txHash),
+			sdk.NewAttribute(types.AttributeKeySourceChain, eventData.SourceChain),
+			sdk.NewAttribute(types.AttributeKeyDestChain, eventData.DestChain),
+			sdk.NewAttribute(commontypes.AttributeKeyAmount, eventData.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyNetPosition, position.Projected.String()),
+		),
+	)
+}
+
+func (k Keeper) setHeldTransfer(ctx sdk.Context, held types.HeldTransfer) {
+	commontypes.MustCollection(k.HeldTransfers.Set(ctx, held.TxHash, held))
+}
+
+// =============================================================================
+// Suspensions
+// =============================================================================
+
+// GetSuspension returns the suspension of a chain or bank
+func (k Keeper) GetSuspension(ctx sdk.Context, target string) (types.Suspension, bool) {
+	return commontypes.CollectionValue(ctx, k.Suspensions, target)
+}
+
+// GetAllSuspensions returns all suspensions
+func (k Keeper) GetAllSuspensions(ctx sdk.Context) []types.Suspension {
+	return commontypes.CollectionValues(ctx, k.Suspensions, nil)
+}
+
+// Suspend halts credit issuance and mint command generation for the
+// transfers involving target. Transfers reaching consensus while it is
+// suspended are held until Resume. Suspending a suspended target replaces
+// its scope and reason.
+func (k Keeper) Suspend(ctx sdk.Context, target, scope string, code commontypes.ReasonCode, reason string) (types.Suspension, error) {
+	if target == "" {
+		return types.Suspension{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "target cannot be empty")
+	}
+	if !types.IsValidSuspensionScope(scope) {
+		return types.Suspension{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid suspension scope: %q", scope)
+	}
+
+	suspension := types.Suspension{
+		Target:          target,
+		Scope:           scope,
+		Reason:          reason,
+		SuspendedAt:     ctx.BlockTime().Unix(),
+		SuspendedHeight: ctx.BlockHeight(),
+		ReasonCode:      code,
+	}
+	commontypes.MustCollection(k.Suspensions.Set(ctx, target, suspension))
+
+	k.Logger(ctx).Info("suspended", "target", target, "scope", scope, "reason_code", code, "reason", reason)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSuspended,
+			sdk.NewAttribute(types.AttributeKeyTarget, target),
+			sdk.NewAttribute(types.AttributeKeyScope, scope),
+			sdk.NewAttribute(commontypes.AttributeKeyReasonCode, code.String()),
+			sdk.NewAttribute(commontypes.AttributeKeyReason, reason),
+		),
+	)
+
+	return suspension, nil
+}
+
+// Resume lifts the suspension of target and confirms the transfers it held,
+// returning their tx hashes. A transfer still covered by another suspension
+// stays held under it, and one above its corridor cap is held again for
+// approval.
+func (k Keeper) Resume(ctx sdk.Context, target string) ([]string, error) {
+	if _, found := k.GetSuspension(ctx, target); !found {
+		return nil, errorsmod.Wrapf(types.ErrSuspensionNotFound, "target %s", target)
+	}
+
+	commontypes.MustCollection(k.Suspensions.Remove(ctx, target))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSuspensionLifted,
+			sdk.NewAttribute(types.AttributeKeyTarget, target),
+		),
+	)
+
+	confirmed := make([]string, 0)
+	for _, held := range k.GetAllHeldTransfers(ctx) {
+		if held.SuspendedBy != target || held.Rejected {
+			continue
+		}
+
+		if suspension, suspended := k.suspensionCovering(ctx, held.EventData); suspended {
+			held.SuspendedBy = suspension.Target
+			k.setHeldTransfer(ctx, held)
+			continue
+		}
+
+		// Confirm each transfer on its own so that one failure keeps it held
+		// without blocking the others
+		cacheCtx, write := ctx.CacheContext()
+		commontypes.MustCollection(k.HeldTransfers.Remove(cacheCtx, held.TxHash))
+		result, err := k.confirmTransfer(cacheCtx, held.TxHash, false)
+		if err != nil {
+			k.Logger(ctx).Error("failed to confirm resumed transfer", "tx_hash", held.TxHash, "error", err)
+			continue
+		}
+		write()
+
+		if !result.Held {
+			confirmed = append(confirmed, held.TxHash)
+		}
+	}
+
+	k.Logger(ctx).Info("suspension lifted", "target", target, "confirmed", len(confirmed))
+
+	return confirmed, nil
+}
+
+// suspensionCovering returns the suspension halting a transfer, if any
+func (k Keeper) suspensionCovering(ctx sdk.Context, eventData commontypes.TransferEvent) (types.Suspension, bool) {
+	for _, target := range []string{eventData.SourceChain, eventData.DestChain} {
+		if suspension, found := k.GetSuspension(ctx, target); found && suspension.Covers(eventData) {
+			return suspension, true
+		}
+	}
+	return types.Suspension{}, false
+}
+
+func (k Keeper) holdSuspendedTransfer(ctx sdk.Context, txHash string, eventData commontypes.TransferEvent, suspension types.Suspension) {
+	k.setHeldTransfer(ctx, types.HeldTransfer{
+		TxHash:      txHash,
+		EventData:   eventData,
+		Cap:         math.ZeroInt(),
+		HeldAt:      ctx.BlockTime().Unix(),
+		SuspendedBy: suspension.Target,
+	})
+
+	k.Logger(ctx).Info("transfer held by suspension",
+		"source_chain", eventData.SourceChain,
+		"dest_chain", eventData.DestChain,
+		"amount", eventData.Amount.String(),
+		"suspended_by", suspension.Target,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferHeld,
+			sdk.NewAttribute(commontypes.AttributeKeyTxHash, txHash),
+			sdk.NewAttribute(types.AttributeKeySourceChain, eventData.SourceChain),
+			sdk.NewAttribute(types.AttributeKeyDestChain, eventData.DestChain),
+			sdk.NewAttribute(commontypes.AttributeKeyAmount, eventData.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySuspendedBy, suspension.Target),
+		),
+	)
+}
+
+// =============================================================================
+// Chain Heartbeats
+// =============================================================================
+
+// GetChainHeartbeat returns the latest heartbeat of a chain
+func (k Keeper) GetChainHeartbeat(ctx sdk.Context, chain string) (types.ChainHeartbeat, bool) {
+	return commontypes.CollectionValue(ctx, k.ChainHeartbeats, chain)
+}
+
+// RecordChainHeartbeat stores the chain status posted by a relayer of an
+// active validator. A fresh, synced heartbeat lifts a suspension set for a
+// stale heartbeat and returns true; suspensions set by governance are kept.
+func (k Keeper) RecordChainHeartbeat(ctx sdk.Context, reporter, chain string, besuHeight int64, gatewayBalance math.Int, syncing bool) (bool, error) {
+	if !k.IsActiveValidator(ctx, reporter) {
+		return false, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not an active validator", reporter)
+	}
+
+	heartbeat := types.ChainHeartbeat{
+		Chain:          chain,
+		Reporter:       reporter,
+		BesuHeight:     besuHeight,
+		GatewayBalance: gatewayBalance,
+		Syncing:        syncing,
+		ReportedAt:     ctx.BlockTime().Unix(),
+		ReportedHeight: ctx.BlockHeight(),
+	}
+	commontypes.MustCollection(k.ChainHeartbeats.Set(ctx, chain, heartbeat))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChainHeartbeat,
+			sdk.NewAttribute(types.AttributeKeyChain, chain),
+			sdk.NewAttribute(commontypes.AttributeKeyReporter, reporter),
+			sdk.NewAttribute(types.AttributeKeyBesuHeight, strconv.FormatInt(besuHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyBalance, gatewayBalance.String()),
+			sdk.NewAttribute(types.AttributeKeySyncing, strconv.FormatBool(syncing)),
+		),
+	)
+
+	suspension, suspended := k.GetSuspension(ctx, chain)
+	if syncing || !suspended || suspension.Code() != commontypes.ReasonStaleHeartbeat {
+		return false, nil
+	}
+
+	if _, err := k.Resume(ctx, chain); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetChainFinality returns the finality model of a Besu chain from the chain
+// registry in the params, or the default finality if the chain is unregistered
+func (k Keeper) GetChainFinality(ctx sdk.Context, chain string) types.ChainFinality {
+	finality, _ := k.GetParams(ctx).GetChainFinality(chain)
+	return finality
+}
+
+// GetChainHealth returns the health of a chain that has posted a heartbeat
+func (k Keeper) GetChainHealth(ctx sdk.Context, chain string) (types.ChainHealth, bool) {
+	heartbeat, found := k.GetChainHeartbeat(ctx, chain)
+	if !found {
+		return types.ChainHealth{}, false
+	}
+	return k.chainHealth(ctx, heartbeat, k.GetParams(ctx).HeartbeatTimeout), true
+}
+
+// GetAllChainHealth returns the health of every chain that has posted a
+// heartbeat, ordered by chain
+func (k Keeper) GetAllChainHealth(ctx sdk.Context) []types.ChainHealth {
+	timeout := k.GetParams(ctx).HeartbeatTimeout
+	heartbeats := commontypes.CollectionValues(ctx, k.ChainHeartbeats, nil)
+
+	health := make([]types.ChainHealth, len(heartbeats))
+	for i, heartbeat := range heartbeats {
+		health[i] = k.chainHealth(ctx, heartbeat, timeout)
+	}
+	return health
+}
+
+func (k Keeper) chainHealth(ctx sdk.Context, heartbeat types.ChainHeartbeat, timeout int64) types.ChainHealth {
+	_, suspended := k.GetSuspension(ctx, heartbeat.Chain)
+	stale := heartbeat.Stale(ctx.BlockTime().Unix(), timeout)
+	return types.ChainHealth{
+		Heartbeat: heartbeat,
+		Stale:     stale,
+		Suspended: suspended,
+		Healthy:   !stale && !suspended && !heartbeat.Syncing,
+	}
+}
+
+// SuspendStaleChains suspends issuance from every chain whose latest
+// heartbeat is older than the heartbeat timeout. Chains that never posted a
+// heartbeat and chains already suspended are left alone.
+func (k Keeper) SuspendStaleChains(ctx sdk.Context) {
+	timeout := k.GetParams(ctx).HeartbeatTimeout
+	if timeout == 0 {
+		return
+	}
+
+	now := ctx.BlockTime().Unix()
+	for _, heartbeat := range commontypes.CollectionValues(ctx, k.ChainHeartbeats, nil) {
+		if !heartbeat.Stale(now, timeout) {
+			continue
+		}
+		if _, suspended := k.GetSuspension(ctx, heartbeat.Chain); suspended {
+			continue
+		}
+
+		if _, err := k.Suspend(ctx, heartbeat.Chain, types.SuspensionScopeChain, commontypes.ReasonStaleHeartbeat, types.HeartbeatSuspensionReason); err != nil {
+			k.Logger(ctx).Error("failed to suspend stale chain", "chain", heartbeat.Chain, "error", err)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeChainStale,
+				sdk.NewAttribute(types.AttributeKeyChain, heartbeat.Chain),
+				sdk.NewAttribute(types.AttributeKeyLastSeen, strconv.FormatInt(heartbeat.ReportedAt, 10)),
+			),
+		)
+	}
+}
+
+// =============================================================================
+// Reporters
+// =============================================================================
+
+// GetReporter returns the first report record of a validator
+func (k Keeper) GetReporter(ctx sdk.Context, validator string) (types.ReporterRecord, bool) {
+	return commontypes.CollectionValue(ctx, k.Reporters, validator)
+}
+
+// GetAllReporters returns the first report records of all validators that
+// voted first on a confirmed transfer, ordered by validator
+func (k Keeper) GetAllReporters(ctx sdk.Context) []types.ReporterRecord {
+	return commontypes.CollectionValues(ctx, k.Reporters, nil)
+}
+
+// ClearReporterFlag lifts the flag of a reporter, e.g. once its relayer is
+// fixed, and resets its mismatch count. Its first report count is kept.
+func (k Keeper) ClearReporterFlag(ctx sdk.Context, validator string) (types.ReporterRecord, error) {
+	record, found := k.GetReporter(ctx, validator)
+	if !found {
+		return types.ReporterRecord{}, errorsmod.Wrapf(types.ErrReporterNotFound, "validator %s", validator)
+	}
+
+	record.Mismatches = 0
+	record.Flagged = false
+	record.FlaggedHeight = 0
+	commontypes.MustCollection(k.Reporters.Set(ctx, validator, record))
+
+	k.Logger(ctx).Info("reporter flag cleared", "validator", validator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReporterFlagCleared,
+			sdk.NewAttribute(commontypes.AttributeKeyValidator, validator),
+		),
+	)
+
+	return record, nil
+}
+
+// confirmedEventData returns the content a transfer is confirmed with: that
+// of the first vote, unless GateFlaggedReporters is set and the first voter
+// is flagged, in which case the content most votes agree on is used.
+func (k Keeper) confirmedEventData(ctx sdk.Context, voteStatus commontypes.VoteStatus) commontypes.TransferEvent {
+	first := voteStatus.Votes[0]
+	if !k.GetParams(ctx).GateFlaggedReporters {
+		return first.EventData
+	}
+	if record, found := k.GetReporter(ctx, first.Validator); !found || !record.Flagged {
+		return first.EventData
+	}
+
+	eventData, _ := types.ConsensusEventData(voteStatus.Votes)
+	return eventData
+}
+
+// recordFirstReport compares the content of the first vote on a confirmed
+// transfer with the content most votes agree on, and flags the first voter
+// once its mismatches reach ReporterMismatchLimit.
+func (k Keeper) recordFirstReport(ctx sdk.Context, voteStatus commontypes.VoteStatus) {
+	first := voteStatus.Votes[0]
+	record, found := k.GetReporter(ctx, first.Validator)
+	if !found {
+		record = types.ReporterRecord{Validator: first.Validator}
+	}
+	record.FirstReports++
+
+	if !types.FirstReportMatches(voteStatus.Votes) {
+		record.Mismatches++
+		record.LastMismatchTx = voteStatus.TxHash
+		record.LastMismatchHeight = ctx.BlockHeight()
+		telemetry.IncrCounter(1, types.ModuleName, types.MetricKeyReporterMismatches)
+
+		k.Logger(ctx).Info("first report does not match consensus",
+			"validator", first.Validator,
+			"tx_hash", voteStatus.TxHash,
+			"mismatches", record.Mismatches,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReporterMismatch,
+				sdk.NewAttribute(commontypes.AttributeKeyTxHash, voteStatus.TxHash),
+				sdk.NewAttribute(commontypes.AttributeKeyValidator, first.Validator),
+				sdk.NewAttribute(types.AttributeKeyMismatches, strconv.FormatUint(record.Mismatches, 10)),
+			),
+		)
+
+		limit := uint64(k.GetParams(ctx).ReporterMismatchLimit)
+		if !record.Flagged && limit > 0 && record.Mismatches >= limit {
+			record.Flagged = true
+			record.FlaggedHeight = ctx.BlockHeight()
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeReporterFlagged,
+					sdk.NewAttribute(commontypes.AttributeKeyValidator, first.Validator),
+					sdk.NewAttribute(types.AttributeKeyMismatches, strconv.FormatUint(record.Mismatches, 10)),
+				),
+			)
+		}
+	}
+
+	commontypes.MustCollection(k.Reporters.Set(ctx, first.Validator, record))
+}
+
+// =============================================================================
+// Work Queue
+// =============================================================================
+
+// GetWorkQueue counts the pending work across the oracle, netting and multisig
+// modules. A pending transfer is near timeout when it would reach
+// ConsensusTimeout within nearTimeoutWindow seconds.
+func (k Keeper) GetWorkQueue(ctx sdk.Context, nearTimeoutWindow int64) types.WorkQueue {
+	queue := types.WorkQueue{
+		BlockHeight:   ctx.BlockHeight(),
+		HeldTransfers: int32(len(k.GetAllHeldTransfers(ctx))),
+	}
+
+	var backlog commontypes.NettingBacklog
+	if k.nettingKeeper != nil {
+		backlog = k.nettingKeeper.GetNettingBacklog(ctx)
+		queue.PendingNettingPairs = backlog.PendingPairs
+		queue.DueNettingCycles = backlog.DueCycles
+	}
+
+	timeout := k.GetParams(ctx).ConsensusTimeout
+	now := ctx.BlockTime().Unix()
+	for _, voteStatus := range k.GetAllVoteStatuses(ctx) {
+		if voteStatus.Confirmed {
+			if voteStatus.ConfirmedHeight > backlog.LastNettingBlock {
+				queue.UnnettedTransfers++
+			}
+			continue
+		}
+
+		if _, held := k.GetHeldTransfer(ctx, voteStatus.TxHash); held {
+			continue
+		}
+
+		queue.PendingVotes++
+		if now-voteStatus.CreatedAt >= timeout-nearTimeoutWindow {
+			queue.PendingVotesNearTimeout++
+		}
+	}
+
+	if k.multisigKeeper != nil {
+		queue.UnsignedCommands = int32(len(k.multisigKeeper.GetAllPendingCommands(ctx)))
+	}
+
+	return queue
+}
+
+// GetPendingCredits returns the credit the pending transfers would issue
+// once confirmed. Held transfers wait for governance and transfers past
+// ConsensusTimeout won't confirm anymore, so both are left out.
+func (k Keeper) GetPendingCredits(ctx sdk.Context) []commontypes.CreditToken {
+	timeout := k.GetParams(ctx).ConsensusTimeout
+	now := ctx.BlockTime().Unix()
+
+	var credits []commontypes.CreditToken
+	for _, voteStatus := range k.GetAllVoteStatuses(ctx) {
+		if voteStatus.Confirmed || len(voteStatus.Votes) == 0 || now-voteStatus.CreatedAt >= timeout {
+			continue
+		}
+		if _, held := k.GetHeldTransfer(ctx, voteStatus.TxHash); held {
+			continue
+		}
+		credits = append(credits, types.TransferCreditToken(voteStatus.Votes[0].EventData, now))
+	}
+
+	return credits
+}
+
+// GetNettingForecast projects the next netting cycle with the credit of the
+// pending transfers. The forecast is empty without a netting keeper.
+func (k Keeper) GetNettingForecast(ctx sdk.Context) commontypes.NettingForecast {
+	if k.nettingKeeper == nil {
+		return commontypes.NettingForecast{BlockHeight: ctx.BlockHeight(), PendingAmount: math.ZeroInt()}
+	}
+	return k.nettingKeeper.ForecastNetting(ctx, k.GetPendingCredits(ctx))
+}
+
+// GetValidatorQueue lists the unconfirmed transfers the validator has not
+// voted on and the pending commands it has not signed. Held transfers are
+// skipped since they already reached consensus.
+func (k Keeper) GetValidatorQueue(ctx sdk.Context, validator string) types.ValidatorQueue {
+	queue := types.ValidatorQueue{
+		Validator:       validator,
+		BlockHeight:     ctx.BlockHeight(),
+		Active:          k.IsActiveValidator(ctx, validator),
+		PendingVotes:    []types.PendingVote{},
+		PendingCommands: []commontypes.MintCommand{},
+	}
+
+	timeout := k.GetParams(ctx).ConsensusTimeout
+	for _, voteStatus := range k.GetAllVoteStatuses(ctx) {
+		if voteStatus.Confirmed || k.hasVoted(ctx, voteStatus.TxHash, validator) {
+			continue
+		}
+		if _, held := k.GetHeldTransfer(ctx, voteStatus.TxHash); held {
+			continue
+		}
+
+		queue.PendingVotes = append(queue.PendingVotes, types.PendingVote{
+			TxHash:    voteStatus.TxHash,
+			VoteCount: voteStatus.VoteCount,
+			Threshold: voteStatus.Threshold,
+			CreatedAt: voteStatus.CreatedAt,
+			Deadline:  voteStatus.CreatedAt + timeout,
+		})
+	}
+
+	if k.multisigKeeper != nil {
+	commands:
+		for _, command := range k.multisigKeeper.GetAllPendingCommands(ctx) {
+			for _, sig := range command.Signatures {
+				if sig.Validator == validator {
+					continue commands
+				}
+			}
+			queue.PendingCommands = append(queue.PendingCommands, command)
+		}
+	}
+
+	return queue
+}
+
+// =============================================================================
+// Reorg Disputes
+// =============================================================================
+
+// GetDispute returns the reorg dispute of a transfer
+func (k Keeper) GetDispute(ctx sdk.Context, txHash string) (types.Dispute, bool) {
+	return commontypes.CollectionValue(ctx, k.Disputes, txHash)
+}
+
+// GetAllDisputes returns all disputes, including resolved ones
+func (k Keeper) GetAllDisputes(ctx sdk.Context) []types.Dispute {
+	return commontypes.CollectionValues(ctx, k.Disputes, nil)
+}
+
+// ReportReorg opens a dispute for a confirmed transfer whose source transaction
+// was removed by a reorg, freezing the credit it issued so netting cannot
+// consume it. Only active validators and the authority can report.
+func (k Keeper) ReportReorg(ctx sdk.Context, reporter, txHash, reason string) (types.Dispute, error) {
+	ctx = commontypes.WithCorrelationID(ctx, txHash)
+
+	if reporter != k.authority && !k.IsActiveValidator(ctx, reporter) {
+		return types.Dispute{}, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not an active validator", reporter)
+	}
+
+	voteStatus, found := k.GetVoteStatus(ctx, txHash)
+	if !found {
+		return types.Dispute{}, types.ErrTransferNotFound
+	}
+	eventData, confirmed := k.GetConfirmedTransfer(ctx, txHash)
+	if !voteStatus.Confirmed || !confirmed {
+		return types.Dispute{}, types.ErrTransferNotConfirmed
+	}
+
+	if finality := k.GetChainFinality(ctx, eventData.SourceChain); !finality.ReorgHandling() {
+		return types.Dispute{}, errorsmod.Wrapf(types.ErrReorgHandlingDisabled, "%s cannot reorg", eventData.SourceChain)
+	}
+
+	if _, exists := k.GetDispute(ctx, txHash); exists {
+		return types.Dispute{}, types.ErrDisputeExists
+	}
+
+	credit := k.transferCredit(ctx, eventData, voteStatus.ConfirmedAt)
+	dispute := types.Dispute{
+		TxHash:       txHash,
+		Reporter:     reporter,
+		Reason:       reason,
+		Denom:        credit.Denom,
+		HolderBank:   credit.HolderBank,
+		FrozenAmount: math.ZeroInt(),
+		OpenedAt:     ctx.BlockTime().Unix(),
+		OpenedHeight: ctx.BlockHeight(),
+		Status:       types.DisputeStatusOpen,
+	}
+
+	// Credit still frozen by two-phase release moves to the dispute as is
+	if release, pending := k.GetCreditRelease(ctx, txHash); pending {
+		dispute.FrozenAmount = release.Amount
+		commontypes.MustCollection(k.CreditReleases.Remove(ctx, txHash))
+	} else if k.nettingKeeper != nil {
+		frozen, err := k.nettingKeeper.FreezeCredit(ctx, credit.HolderBank, credit.Denom, credit.Amount)
+		if err != nil {
+			return types.Dispute{}, errorsmod.Wrap(err, "failed to freeze credit")
+		}
+		dispute.FrozenAmount = frozen
+	}
+
+	k.setDispute(ctx, dispute)
+
+	k.Logger(ctx).Info("reorg reported, dispute opened",
+		"reporter", reporter,
+		"source_chain", eventData.SourceChain,
+		"denom", dispute.Denom,
+		"frozen_amount", dispute.FrozenAmount.String(),
+	)
+
+	if _, err := k.SaveAuditLog(ctx, commontypes.AuditLog{
+		EventType: commontypes.EventTypeDisputeOpened,
+		TxHash:    txHash,
+		Timestamp: ctx.BlockTime().Unix(),
+		Details: map[string]string{
+			"reporter":      reporter,
+			"reason":        reason,
+			"denom":         dispute.Denom,
+			"holder_bank":   dispute.HolderBank,
+			"frozen_amount": dispute.FrozenAmount.String(),
+		},
+	}); err != nil {
+		k.Logger(ctx).Error("failed to log dispute", "error", err)
+		// Don't fail for logging errors
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDisputeOpened,
+			sdk.NewAttribute(commontypes.AttributeKeyTxHash, txHash),
+			sdk.NewAttribute(commontypes.AttributeKeyReporter, reporter),
+			sdk.NewAttribute(commontypes.AttributeKeyReason, reason),
+			sdk.NewAttribute(commontypes.AttributeKeyDenom, dispute.Denom),
+			sdk.NewAttribute(commontypes.AttributeKeyHolderBank, dispute.HolderBank),
+			sdk.NewAttribute(commontypes.AttributeKeyAmount, dispute.FrozenAmount.String()),
+		),
+	)
+
+	return dispute, nil
+}
+
+// ResolveDispute closes an open dispute. If the reorg is confirmed the frozen
+// credit is burned, otherwise it is released back to netting.
+func (k Keeper) ResolveDispute(ctx sdk.Context, txHash string, reorgConfirmed bool, resolution string) (types.Dispute, error) {
+	ctx = commontypes.WithCorrelationID(ctx, txHash)
+
+	dispute, found := k.GetDispute(ctx, txHash)
+	if !found {
+		return types.Dispute{}, types.ErrDisputeNotFound
+	}
+	if !dispute.IsOpen() {
+		return types.Dispute{}, types.ErrDisputeResolved
+	}
+
+	if k.nettingKeeper != nil && dispute.FrozenAmount.IsPositive() {
+		if err := k.nettingKeeper.UnfreezeCredit(ctx, dispute.HolderBank, dispute.Denom, dispute.FrozenAmount); err != nil {
+			return types.Dispute{}, errorsmod.Wrap(err, "failed to unfreeze credit")
+		}
+		if reorgConfirmed {
+			if err := k.nettingKeeper.BurnHeldCreditToken(ctx, dispute.HolderBank, dispute.Denom, dispute.FrozenAmount); err != nil {
+				return types.Dispute{}, errorsmod.Wrap(err, "failed to burn reorged credit")
+			}
+		}
+	}
+
+	dispute.Status = types.DisputeStatusDismissed
+	if reorgConfirmed {
+		dispute.Status = types.DisputeStatusReorgConfirmed
+	}
+	dispute.Resolution = resolution
+	k.setDispute(ctx, dispute)
+
+	k.Logger(ctx).Info("dispute resolved",
+		"reorg_confirmed", reorgConfirmed,
+		"denom", dispute.Denom,
+		"amount", dispute.FrozenAmount.String(),
+	)
+
+	if _, err := k.SaveAuditLog(ctx, commontypes.AuditLog{
+		EventType: commontypes.EventTypeDisputeResolved,
+		TxHash:    txHash,
+		Timestamp: ctx.BlockTime().Unix(),
+		Details: map[string]string{
+			"status":     fmt.Sprintf("%d", dispute.Status),
+			"resolution": resolution,
+			"denom":      dispute.Denom,
+			"amount":     dispute.FrozenAmount.String(),
+		},
+	}); err != nil {
+		k.Logger(ctx).Error("failed to log dispute resolution", "error", err)
+		// Don't fail for logging errors
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDisputeResolved,
+			sdk.NewAttribute(commontypes.AttributeKeyTxHash, txHash),
+			sdk.NewAttribute(types.AttributeKeyStatus, fmt.Sprintf("%d", dispute.Status)),
+			sdk.NewAttribute(commontypes.AttributeKeyAmount, dispute.FrozenAmount.String()),
+		),
+	)
+
+	return dispute, nil
+}
+
+func (k Keeper) setDispute(ctx sdk.Context, dispute types.Dispute) {
+	commontypes.MustCollection(k.Disputes.Set(ctx, dispute.TxHash, dispute))
+}
+
+// =============================================================================
+// Two-Phase Credit Release
+// =============================================================================
+
+// GetCreditRelease returns the credit of a transfer frozen until its mint
+// command is executed
+func (k Keeper) GetCreditRelease(ctx sdk.Context, txHash string) (types.CreditRelease, bool) {
+	return commontypes.CollectionValue(ctx, k.CreditReleases, txHash)
+}
+
+// GetAllCreditReleases returns every credit awaiting the execution of its
+// mint command
+func (k Keeper) GetAllCreditReleases(ctx sdk.Context) []types.CreditRelease {
+	return commontypes.CollectionValues(ctx, k.CreditReleases, nil)
+}
+
+// freezeUntilExecuted freezes the credit a transfer issued until its mint
+// command is executed, see ReleaseExecutedCredit
+func (k Keeper) freezeUntilExecuted(ctx sdk.Context, txHash, commandID string, credit commontypes.CreditToken) error {
+	frozen, err := k.nettingKeeper.FreezeCredit(ctx, credit.HolderBank, credit.Denom, credit.Amount)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to freeze credit until release")
+	}
+
+	commontypes.MustCollection(k.CreditReleases.Set(ctx, txHash, types.CreditRelease{
+		TxHash:          txHash,
+		CommandID:       commandID,
+		HolderBank:      credit.HolderBank,
+		Denom:           credit.Denom,
+		Amount:          frozen,
+		ConfirmedHeight: ctx.BlockHeight(),
+	}))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreditPending,
+			sdk.NewAttribute(commontypes.AttributeKeyTxHash, txHash),
+			sdk.NewAttribute(types.AttributeKeyCommandID, commandID),
+			sdk.NewAttribute(commontypes.AttributeKeyDenom, credit.Denom),
+			sdk.NewAttribute(commontypes.AttributeKeyHolderBank, credit.HolderBank),
+			sdk.NewAttribute(commontypes.AttributeKeyAmount, frozen.String()),
+		),
+	)
+	return nil
+}
+
+// ReleaseExecutedCredit unfreezes the credit of transfers whose mint command
+// was executed and returns how many were released. Once two-phase credit
+// release is turned off every pending credit is released.
+func (k Keeper) ReleaseExecutedCredit(ctx sdk.Context) int {
+	if k.nettingKeeper == nil {
+		return 0
+	}
+	releaseAll := !k.GetParams(ctx).TwoPhaseCreditRelease || k.multisigKeeper == nil
+
+	var executed []types.CreditRelease
+	commontypes.MustCollection(k.CreditReleases.Walk(ctx, nil, func(_ string, release types.CreditRelease) (bool, error) {
+		if releaseAll {
+			executed = append(executed, release)
+		} else if command, found := k.multisigKeeper.GetCommand(ctx, release.CommandID); found && command.Status == int32(commontypes.CommandStatusExecuted) {
+			executed = append(executed, release)
+		}
+		return false, nil
+	}))
+
+	released := 0
+	for _, release := range executed {
+		if err := k.nettingKeeper.UnfreezeCredit(ctx, release.HolderBank, release.Denom, release.Amount); err != nil {
+			k.Logger(ctx).Error("failed to release credit", "tx_hash", release.TxHash, "error", err)
+			continue
+		}
+		commontypes.MustCollection(k.CreditReleases.Remove(ctx, release.TxHash))
+		released++
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCreditReleased,
+				sdk.NewAttribute(commontypes.AttributeKeyTxHash, release.TxHash),
+				sdk.NewAttribute(types.AttributeKeyCommandID, release.CommandID),
+				sdk.NewAttribute(commontypes.AttributeKeyDenom, release.Denom),
+				sdk.NewAttribute(commontypes.AttributeKeyHolderBank, release.HolderBank),
+				sdk.NewAttribute(commontypes.AttributeKeyAmount, release.Amount.String()),
+			),
+		)
+	}
+
+	return released
+}
+
+// =============================================================================
+// Audit Logging System (Requirement 7.1 - 7.5)
+// =============================================================================
+
+// SaveAuditLog saves an audit log entry with automatic ID assignment
+// Requirement 7.1: 거래 로깅 시스템
+func (k Keeper) SaveAuditLog(ctx sdk.Context, log commontypes.AuditLog) (uint64, error) {
+	if err := commontypes.ValidateAuditLogSize(log); err != nil {
+		return 0, errorsmod.Wrap(types.ErrAuditLogTooLarge, err.Error())
+	}
+
+	// The sequence holds the last assigned ID, so IDs start at 1
+	last, err := k.AuditLogSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	id := last + 1
+	log.ID = id
+	log.BlockHeight = ctx.BlockHeight()
+
+	if log.Timestamp == 0 {
+		log.Timestamp = ctx.BlockTime().Unix()
+	}
+
+	// Stored by ID and indexed by time and event type
+	if err := k.AuditLogs.Set(ctx, id, log); err != nil {
+		return 0, err
+	}
+
+	k.Logger(ctx).Debug("audit log saved",
+		"id", id,
+		"event_type", log.EventType,
+		"tx_hash", log.TxHash,
+	)
+
+	return id, nil
+}
+
+// GetAuditLog retrieves an audit log by ID
+func (k Keeper) GetAuditLog(ctx sdk.Context, id uint64) (commontypes.AuditLog, bool) {
+	log, err := k.AuditLogs.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return commontypes.AuditLog{}, false
+	}
+	commontypes.MustCollection(err)
+	return log, true
+}
+
+// GetAuditLogsByTimeRange retrieves audit logs within a time range
+// Requirement 7.5: 감사 쿼리 API
+func (k Keeper) GetAuditLogsByTimeRange(ctx sdk.Context, startTime, endTime int64) []commontypes.AuditLog {
+	ranger := new(collections.Range[collections.Pair[int64, uint64]]).
+		StartInclusive(collections.Join(startTime, uint64(0))).
+		EndExclusive(collections.Join(endTime+1, uint64(0))) // +1 to include endTime
+
+	iterator, err := k.AuditLogs.Indexes.ByTime.Iterate(ctx, ranger)
+	commontypes.MustCollection(err)
+	logs, err := indexes.CollectValues(ctx, k.AuditLogs, iterator)
+	commontypes.MustCollection(err)
+	return append(make([]commontypes.AuditLog, 0, len(logs)), logs...)
+}
+
+// GetAuditLogsByEventType retrieves audit logs by event type
+// Requirement 7.5: 감사 쿼리 API
+func (k Keeper) GetAuditLogsByEventType(ctx sdk.Context, eventType string) []commontypes.AuditLog {
+	iterator, err := k.AuditLogs.Indexes.ByType.MatchExact(ctx, eventType)
+	commontypes.MustCollection(err)
+	logs, err := indexes.CollectValues(ctx, k.AuditLogs, iterator)
+	commontypes.MustCollection(err)
+	return append(make([]commontypes.AuditLog, 0, len(logs)), logs...)
+}
+
+// GetAuditLogsByTxHash retrieves audit logs by transaction hash
+// Requirement 7.3: 추적성 이벤트
+func (k Keeper) GetAuditLogsByTxHash(ctx sdk.Context, txHash string) []commontypes.AuditLog {
+	logs := make([]commontypes.AuditLog, 0)
+	for _, log := range k.GetAllAuditLogs(ctx) {
+		if log.TxHash == txHash {
+			logs = append(logs, log)
+		}
+	}
+	return logs
+}
+
+// GetAllAuditLogs retrieves all audit logs
+func (k Keeper) GetAllAuditLogs(ctx sdk.Context) []commontypes.AuditLog {
+	logs := make([]commontypes.AuditLog, 0)
+	commontypes.MustCollection(k.AuditLogs.Walk(ctx, nil, func(_ uint64, log commontypes.AuditLog) (bool, error) {
+		logs = append(logs, log)
+		return false, nil
+	}))
+	return logs
+}
+
+// FilterAuditLogs returns the audit logs matching every field of the filter
+// in ID order, at most the filter limit of them. The event type or time range
+// index narrows the logs to check and the remaining fields are matched on
+// each log. The returned ID is the AfterID of the next page, zero if there
+// are no more matching logs.
+// Requirement 7.5: 감사 쿼리 API
+func (k Keeper) FilterAuditLogs(ctx sdk.Context, filter types.AuditLogFilter) ([]commontypes.AuditLog, uint64, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, 0, errorsmod.Wrap(types.ErrInvalidAuditFilter, err.Error())
+	}
+
+	limit := filter.EffectiveLimit()
+	logs := make([]commontypes.AuditLog, 0)
+	// Collect one log past the limit to tell if there is a next page
+	err := k.walkAuditLogCandidates(ctx, filter, func(id uint64) (bool, error) {
+		log, err := k.AuditLogs.Get(ctx, id)
+		if err != nil {
+			return true, err
+		}
+		if filter.Matches(log) {
+			logs = append(logs, log)
+		}
+		return len(logs) > limit, nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(logs) <= limit {
+		return logs, 0, nil
+	}
+	logs = logs[:limit]
+	return logs, logs[limit-1].ID, nil
+}
+
+// walkAuditLogCandidates calls fn in ascending order with the IDs after
+// filter.AfterID the narrowest available index selects, until fn stops
+func (k Keeper) walkAuditLogCandidates(ctx sdk.Context, filter types.AuditLogFilter, fn func(id uint64) (bool, error)) error {
+	switch {
+	case filter.EventType != "":
+		ranger := collections.NewPrefixedPairRange[string, uint64](filter.EventType).StartExclusive(filter.AfterID)
+		iterator, err := k.AuditLogs.Indexes.ByType.Iterate(ctx, ranger)
+		if err != nil {
+			return err
+		}
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			id, err := iterator.PrimaryKey()
+			if err != nil {
+				return err
+			}
+			if stop, err := fn(id); stop || err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case filter.HasTimeRange():
+		// The time index is ordered by timestamp, so the IDs are sorted first
+		ranger := new(collections.Range[collections.Pair[int64, uint64]]).
+			StartInclusive(collections.Join(filter.StartTime, uint64(0)))
+		if filter.EndTime != 0 {
+			ranger = ranger.EndExclusive(collections.Join(filter.EndTime+1, uint64(0)))
+		}
+		iterator, err := k.AuditLogs.Indexes.ByTime.Iterate(ctx, ranger)
+		if err != nil {
+			return err
+		}
+		ids, err := iterator.PrimaryKeys()
+		if err != nil {
+			return err
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+		for _, id := range ids {
+			if id <= filter.AfterID {
+				continue
+			}
+			if stop, err := fn(id); stop || err != nil {
+				return err
+			}
+		}
+		return nil
+
+	default:
+		ranger := new(collections.Range[uint64]).StartExclusive(filter.AfterID)
+		iterator, err := k.AuditLogs.Iterate(ctx, ranger)
+		if err != nil {
+			return err
+		}
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			id, err := iterator.Key()
+			if err != nil {
+				return err
+			}
+			if stop, err := fn(id); stop || err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// LogTransferConfirmed logs a transfer confirmation event
+// Requirement 7.1: 거래 로깅
 func (k Keeper) LogTransferConfirmed(ctx sdk.Context, txHash string, eventData commontypes.TransferEvent) error {
 	log := commontypes.AuditLog{
 		EventType: commontypes.EventTypeTransferConfirmed,
@@ -887,13 +2157,125 @@ func (k Keeper) LogCreditIssued(ctx sdk.Context, credit commontypes.CreditToken)
 
 // GetAuditLogCount returns the total count of audit logs
 func (k Keeper) GetAuditLogCount(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.AuditLogCounterKey)
+	count, err := k.AuditLogSequence.Peek(ctx)
+	commontypes.MustCollection(err)
+	return count
+}
 
-	if bz == nil {
-		return 0
+// =============================================================================
+// Vote Fee Refunds
+// =============================================================================
+
+// RecordVoteFee records the fee paid for a successful vote so it can be refunded
+// once the transfer is confirmed with its content. Votes that complete
+// consensus are refunded immediately; votes whose transfer is not confirmed
+// within the VoteRefundWindow param are pruned without a refund.
+func (k Keeper) RecordVoteFee(ctx sdk.Context, txHash, validator string, payer sdk.AccAddress, fee sdk.Coins) {
+	ctx = commontypes.WithCorrelationID(ctx, txHash)
+
+	if fee.IsZero() {
+		return
+	}
+
+	voteFee := types.VoteFee{
+		TxHash:    txHash,
+		Validator: validator,
+		Payer:     payer.String(),
+		Fee:       fee,
+		Height:    ctx.BlockHeight(),
+	}
+
+	// The vote that reached consensus is recorded after ConfirmTransfer ran
+	if voteStatus, found := k.GetVoteStatus(ctx, txHash); found && voteStatus.Confirmed {
+		vote, voted := commontypes.CollectionValue(ctx, k.Votes, collections.Join(txHash, validator))
+		if confirmed, found := k.GetConfirmedTransfer(ctx, txHash); voted && found && types.VoteMatchesEvent(vote, confirmed) {
+			k.refundVoteFee(ctx, voteFee)
+		}
+		return
+	}
+
+	k.setVoteFee(ctx, voteFee)
+}
+
+// GetVoteFee retrieves the pending refund for a validator's vote
+func (k Keeper) GetVoteFee(ctx sdk.Context, txHash, validator string) (types.VoteFee, bool) {
+	voteFee, err := k.VoteFees.Get(ctx, collections.Join(txHash, validator))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.VoteFee{}, false
+	}
+	commontypes.MustCollection(err)
+	return voteFee, true
+}
+
+// PruneExpiredVoteFees removes pending refunds older than the VoteRefundWindow
+// param. Only the expired heights of the height index are read.
+func (k Keeper) PruneExpiredVoteFees(ctx sdk.Context) int {
+	cutoff := ctx.BlockHeight() - k.GetParams(ctx).VoteRefundWindow - 1
+	iterator, err := k.VoteFees.Indexes.ByHeight.Iterate(ctx, collections.NewPrefixUntilPairRange[int64, collections.Pair[string, string]](cutoff))
+	commontypes.MustCollection(err)
+	expired, err := iterator.PrimaryKeys()
+	commontypes.MustCollection(err)
+
+	for _, key := range expired {
+		commontypes.MustCollection(k.VoteFees.Remove(ctx, key))
+	}
+
+	return len(expired)
+}
+
+// refundVoteFees refunds the pending vote fees of a confirmed transfer whose
+// vote carries the content it was confirmed with. Fees of votes for other
+// content are dropped without a refund.
+func (k Keeper) refundVoteFees(ctx sdk.Context, voteStatus commontypes.VoteStatus, eventData commontypes.TransferEvent) {
+	matching := make(map[string]bool, len(voteStatus.Votes))
+	for _, vote := range voteStatus.Votes {
+		matching[vote.Validator] = types.VoteMatchesEvent(vote, eventData)
+	}
+
+	var voteFees []types.VoteFee
+	commontypes.MustCollection(k.VoteFees.Walk(ctx, collections.NewPrefixedPairRange[string, string](voteStatus.TxHash), func(_ collections.Pair[string, string], voteFee types.VoteFee) (bool, error) {
+		voteFees = append(voteFees, voteFee)
+		return false, nil
+	}))
+
+	window := k.GetParams(ctx).VoteRefundWindow
+	for _, voteFee := range voteFees {
+		commontypes.MustCollection(k.VoteFees.Remove(ctx, collections.Join(voteFee.TxHash, voteFee.Validator)))
+		if matching[voteFee.Validator] && ctx.BlockHeight()-voteFee.Height <= window {
+			k.refundVoteFee(ctx, voteFee)
+		}
+	}
+}
+
+// refundVoteFee returns a vote fee from the fee collector to its payer.
+// Refund failures are logged and never fail the confirmation.
+func (k Keeper) refundVoteFee(ctx sdk.Context, voteFee types.VoteFee) {
+	payer, err := sdk.AccAddressFromBech32(voteFee.Payer)
+	if err != nil {
+		k.Logger(ctx).Error("invalid vote fee payer", "payer", voteFee.Payer, "error", err)
+		return
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, payer, voteFee.Fee); err != nil {
+		k.Logger(ctx).Error("failed to refund vote fee",
+			"tx_hash", voteFee.TxHash,
+			"validator", voteFee.Validator,
+			"error", err,
+		)
+		return
 	}
 
-	return uint64(bz[0])<<56 | uint64(bz[1])<<48 | uint64(bz[2])<<40 | uint64(bz[3])<<32 |
-		uint64(bz[4])<<24 | uint64(bz[5])<<16 | uint64(bz[6])<<8 | uint64(bz[7])
-}
\ No newline at end of file
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoteFeeRefunded,
+			sdk.NewAttribute(commontypes.AttributeKeyTxHash, voteFee.TxHash),
+			sdk.NewAttribute(commontypes.AttributeKeyValidator, voteFee.Validator),
+			sdk.NewAttribute(types.AttributeKeyPayer, voteFee.Payer),
+			sdk.NewAttribute(types.AttributeKeyFee, voteFee.Fee.String()),
+		),
+	)
+}
+
+func (k Keeper) setVoteFee(ctx sdk.Context, voteFee types.VoteFee) {
+	commontypes.MustCollection(k.VoteFees.Set(ctx, collections.Join(voteFee.TxHash, voteFee.Validator), voteFee))
+}
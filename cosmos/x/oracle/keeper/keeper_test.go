@@ -1,11 +1,23 @@
 package keeper_test
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto/ecdsa"
 	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
@@ -15,8 +27,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
@@ -25,7 +41,10 @@ import (
 
 	testhelpers "github.com/interbank-netting/cosmos/testutil"
 	"github.com/interbank-netting/cosmos/types"
+	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
 	"github.com/interbank-netting/cosmos/x/oracle/keeper"
+	oracletypes "github.com/interbank-netting/cosmos/x/oracle/types"
 )
 
 // **Feature: interbank-netting-engine, Property 6: 합의 메커니즘**
@@ -167,18 +186,19 @@ func TestProperty_DuplicateVotes_AreRejected(t *testing.T) {
 			}
 
 			// Create proper ECDSA signature
-			sig := stakingKeeper.SignData(validators[0].Address, []byte(transferEvent.TxHash))
+			sig := signVote(ctx, stakingKeeper, validators[0].Address, transferEvent)
 			if sig == nil {
 				return false // Should be able to sign
 			}
 
 			// Create valid vote with proper signature
 			validVote := types.Vote{
-				TxHash:    transferEvent.TxHash,
-				Validator: validators[0].Address,
-				EventData: transferEvent,
-				Signature: sig,
-				VoteTime:  ctx.BlockTime().Unix(),
+				TxHash:           transferEvent.TxHash,
+				Validator:        validators[0].Address,
+				EventData:        transferEvent,
+				Signature:        sig,
+				SignatureVersion: oracletypes.CurrentSignatureVersion,
+				VoteTime:         ctx.BlockTime().Unix(),
 			}
 
 			// Submit first vote (should succeed)
@@ -209,13 +229,21 @@ func TestProperty_DuplicateVotes_AreRejected(t *testing.T) {
 
 // Helper functions for testing
 
+// testChainID is the chain ID of the test context, bound into vote signatures
+const testChainID = "interbank-netting-test"
+
 func setupTestEnvironment(t *testing.T, validatorCount int) (sdk.Context, *keeper.Keeper, *MockStakingKeeper) {
+	ctx, oracleKeeper, stakingKeeper, _ := setupTestEnvironmentWithBank(t)
+	return ctx, oracleKeeper, stakingKeeper
+}
+
+func setupTestEnvironmentWithBank(t *testing.T) (sdk.Context, *keeper.Keeper, *MockStakingKeeper, *MockBankKeeper) {
 	// Create store key
 	storeKey := storetypes.NewKVStoreKey("oracle")
 
 	// Create test context with store
 	testCtx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test"))
-	ctx := testCtx.Ctx
+	ctx := testCtx.Ctx.WithChainID(testChainID)
 
 	// Create proto codec
 	interfaceRegistry := codectypes.NewInterfaceRegistry()
@@ -233,26 +261,171 @@ func setupTestEnvironment(t *testing.T, validatorCount int) (sdk.Context, *keepe
 		paramtypes.Subspace{},
 		mockBankKeeper,
 		stakingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
-	return ctx, oracleKeeper, stakingKeeper
+	return ctx, oracleKeeper, stakingKeeper, mockBankKeeper
 }
 
 // MockBankKeeper for testing
-type MockBankKeeper struct{}
+type MockBankKeeper struct {
+	refunds map[string]sdk.Coins // Coins sent from modules, by recipient
+}
 
 func NewMockBankKeeper() *MockBankKeeper {
-	return &MockBankKeeper{}
+	return &MockBankKeeper{
+		refunds: make(map[string]sdk.Coins),
+	}
 }
 
 func (m *MockBankKeeper) SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
 	return nil
 }
 
+func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	m.refunds[recipientAddr.String()] = m.refunds[recipientAddr.String()].Add(amt...)
+	return nil
+}
+
 func (m *MockBankKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
 	return sdk.NewCoin(denom, math.ZeroInt())
 }
 
+// MockNettingKeeper for testing
+type MockNettingKeeper struct {
+	balances  map[string]math.Int // By bank/denom
+	frozen    map[string]math.Int // By bank/denom
+	holders   map[string]string   // Holder bank by denom
+	backlog   types.NettingBacklog
+	positions []types.NetPositionForecast // Net positions every forecast projects
+}
+
+func NewMockNettingKeeper() *MockNettingKeeper {
+	return &MockNettingKeeper{
+		balances: make(map[string]math.Int),
+		frozen:   make(map[string]math.Int),
+		holders:  make(map[string]string),
+	}
+}
+
+func (m *MockNettingKeeper) IssueCreditToken(ctx sdk.Context, token types.CreditToken) error {
+	m.holders[token.Denom] = token.HolderBank
+	m.balances[token.HolderBank+"/"+token.Denom] = m.GetCreditBalance(ctx, token.HolderBank, token.Denom).Add(token.Amount)
+	return nil
+}
+
+func (m *MockNettingKeeper) GetCreditBalance(ctx sdk.Context, bank, denom string) math.Int {
+	if balance, ok := m.balances[bank+"/"+denom]; ok {
+		return balance
+	}
+	return math.ZeroInt()
+}
+
+func (m *MockNettingKeeper) BurnCreditToken(ctx sdk.Context, denom string, amount math.Int) error {
+	key := m.holders[denom] + "/" + denom
+	m.balances[key] = m.GetCreditBalance(ctx, m.holders[denom], denom).Sub(amount)
+	return nil
+}
+
+func (m *MockNettingKeeper) BurnHeldCreditToken(ctx sdk.Context, holder, denom string, amount math.Int) error {
+	m.balances[holder+"/"+denom] = m.GetCreditBalance(ctx, holder, denom).Sub(amount)
+	return nil
+}
+
+func (m *MockNettingKeeper) FreezeCredit(ctx sdk.Context, bank, denom string, amount math.Int) (math.Int, error) {
+	m.frozen[bank+"/"+denom] = m.getFrozen(bank, denom).Add(amount)
+	return amount, nil
+}
+
+func (m *MockNettingKeeper) UnfreezeCredit(ctx sdk.Context, bank, denom string, amount math.Int) error {
+	m.frozen[bank+"/"+denom] = m.getFrozen(bank, denom).Sub(amount)
+	return nil
+}
+
+func (m *MockNettingKeeper) TransferCreditToken(ctx sdk.Context, from, to, denom string, amount math.Int) error {
+	m.balances[from+"/"+denom] = m.GetCreditBalance(ctx, from, denom).Sub(amount)
+	m.balances[to+"/"+denom] = m.GetCreditBalance(ctx, to, denom).Add(amount)
+	return nil
+}
+
+func (m *MockNettingKeeper) GetAvailableCreditBalance(ctx sdk.Context, bank, denom string) math.Int {
+	return m.GetCreditBalance(ctx, bank, denom).Sub(m.getFrozen(bank, denom))
+}
+
+func (m *MockNettingKeeper) GetAllCreditBalances(ctx sdk.Context, bank string) map[string]math.Int {
+	balances := make(map[string]math.Int)
+	for key, balance := range m.balances {
+		if holder, denom, ok := strings.Cut(key, "/"); ok && holder == bank {
+			balances[denom] = balance
+		}
+	}
+	return balances
+}
+
+func (m *MockNettingKeeper) GetDebtPosition(ctx sdk.Context, bankA, bankB string) types.DebtPosition {
+	return types.DebtPosition{BankA: bankA, BankB: bankB, TotalAFromB: math.ZeroInt(), TotalBFromA: math.ZeroInt()}
+}
+
+func (m *MockNettingKeeper) GetNettingBacklog(ctx sdk.Context) types.NettingBacklog {
+	return m.backlog
+}
+
+func (m *MockNettingKeeper) ForecastNetting(ctx sdk.Context, pending []types.CreditToken) types.NettingForecast {
+	return types.NettingForecast{BlockHeight: ctx.BlockHeight(), PendingTransfers: int32(len(pending)), Positions: m.positions}
+}
+
+func (m *MockNettingKeeper) getFrozen(bank, denom string) math.Int {
+	if frozen, ok := m.frozen[bank+"/"+denom]; ok {
+		return frozen
+	}
+	return math.ZeroInt()
+}
+
+// MockMultisigKeeper for testing
+type MockMultisigKeeper struct {
+	commands []types.MintCommand
+}
+
+func (m *MockMultisigKeeper) GenerateMintCommand(ctx sdk.Context, targetChain, recipient string, amount math.Int) (types.MintCommand, error) {
+	return m.GenerateTokenMintCommand(ctx, targetChain, recipient, "", amount)
+}
+
+func (m *MockMultisigKeeper) GenerateTokenMintCommand(ctx sdk.Context, targetChain, recipient, tokenID string, amount math.Int) (types.MintCommand, error) {
+	command := types.MintCommand{
+		CommandID:   fmt.Sprintf("cmd-%d", len(m.commands)),
+		TargetChain: targetChain,
+		Recipient:   recipient,
+		Amount:      amount,
+		Status:      int32(types.CommandStatusPending),
+		TokenID:     tokenID,
+	}
+	m.commands = append(m.commands, command)
+	return command, nil
+}
+
+func (m *MockMultisigKeeper) GetValidatorSet(ctx sdk.Context) types.ValidatorSet {
+	return types.ValidatorSet{Threshold: 1, Version: 1}
+}
+
+func (m *MockMultisigKeeper) GetAllPendingCommands(ctx sdk.Context) []types.MintCommand {
+	pending := make([]types.MintCommand, 0, len(m.commands))
+	for _, command := range m.commands {
+		if command.Status == int32(types.CommandStatusPending) {
+			pending = append(pending, command)
+		}
+	}
+	return pending
+}
+
+func (m *MockMultisigKeeper) GetCommand(ctx sdk.Context, commandID string) (types.MintCommand, bool) {
+	for _, command := range m.commands {
+		if command.CommandID == commandID {
+			return command, true
+		}
+	}
+	return types.MintCommand{}, false
+}
+
 func generateValidators(count int) []types.Validator {
 	validators := make([]types.Validator, count)
 	for i := 0; i < count; i++ {
@@ -276,14 +449,15 @@ func setupValidators(ctx sdk.Context, stakingKeeper *MockStakingKeeper, validato
 func submitVotes(ctx sdk.Context, oracleKeeper *keeper.Keeper, transferEvent types.TransferEvent, validators []types.Validator, stakingKeeper *MockStakingKeeper) {
 	for _, validator := range validators {
 		// Create proper ECDSA signature using the validator's private key
-		sig := stakingKeeper.SignData(validator.Address, []byte(transferEvent.TxHash))
+		sig := signVote(ctx, stakingKeeper, validator.Address, transferEvent)
 
 		vote := types.Vote{
-			TxHash:    transferEvent.TxHash,
-			Validator: validator.Address,
-			EventData: transferEvent,
-			Signature: sig,
-			VoteTime:  ctx.BlockTime().Unix(),
+			TxHash:           transferEvent.TxHash,
+			Validator:        validator.Address,
+			EventData:        transferEvent,
+			Signature:        sig,
+			SignatureVersion: oracletypes.CurrentSignatureVersion,
+			VoteTime:         ctx.BlockTime().Unix(),
 		}
 
 		// Ignore errors in property tests - we're testing the overall behavior
@@ -329,6 +503,12 @@ func (m *MockStakingKeeper) SetValidator(validator types.Validator) {
 	m.stakingValidator[validator.Address] = stakingVal
 }
 
+// signVote signs the current signing envelope of a vote on event
+func signVote(ctx sdk.Context, stakingKeeper *MockStakingKeeper, validatorAddr string, event types.TransferEvent) []byte {
+	envelope := oracletypes.NewVoteSigningEnvelope(oracletypes.CurrentSignatureVersion, ctx.ChainID(), event)
+	return stakingKeeper.SignData(validatorAddr, envelope.SignBytes())
+}
+
 // SignData signs data with the validator's private key (for testing)
 func (m *MockStakingKeeper) SignData(validatorAddr string, data []byte) []byte {
 	privKey, ok := m.ethPrivKeys[validatorAddr]
@@ -422,8 +602,8 @@ func TestProperty_AuditLog_SaveAndRetrieve(t *testing.T) {
 
 			return true
 		},
-		gen.AnyString(),
-		gen.AnyString(),
+		testhelpers.GenBoundedString(types.MaxFieldLength),
+		testhelpers.GenBoundedString(types.MaxFieldLength),
 	))
 
 	properties.TestingRun(t)
@@ -626,7 +806,7 @@ func TestProperty_AuditLog_TxHashTraceability(t *testing.T) {
 
 			return true
 		},
-		gen.AnyString(),
+		testhelpers.GenBoundedString(types.MaxFieldLength),
 	))
 
 	properties.TestingRun(t)
@@ -682,3 +862,2086 @@ func TestProperty_AuditLog_AutoIncrementID(t *testing.T) {
 
 	properties.TestingRun(t)
 }
+
+// =============================================================================
+// **Feature: interbank-netting-engine, Property 15: 투표 수수료 환급**
+// **검증: 요구사항 3.2**
+// =============================================================================
+
+// TestProperty_VoteFeeRefund_OnlyContributingVotes tests that only votes which
+// contributed to a confirmation within the refund window are refunded
+func TestProperty_VoteFeeRefund_OnlyContributingVotes(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("vote fees are refunded only for contributing votes", prop.ForAll(
+		func(transferEvent types.TransferEvent, validatorCount int, feeAmount int64) bool {
+			ctx, oracleKeeper, stakingKeeper, bankKeeper := setupTestEnvironmentWithBank(t)
+			validators := generateValidators(validatorCount)
+			setupValidators(ctx, stakingKeeper, validators)
+			fee := sdk.NewCoins(sdk.NewInt64Coin("stake", feeAmount))
+
+			// Vote on a transfer that never reaches consensus, then let it expire
+			expired := transferEvent
+			expired.TxHash = transferEvent.TxHash + "-expired"
+			expiredPayer := sdk.AccAddress([]byte("expired-payer"))
+			oracleKeeper.RecordVoteFee(ctx, expired.TxHash, validators[0].Address, expiredPayer, fee)
+
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + oracletypes.DefaultParams().VoteRefundWindow + 1)
+			if oracleKeeper.PruneExpiredVoteFees(ctx) != 1 {
+				return false
+			}
+
+			// Every vote up to and including the confirming one is refunded
+			for i, validator := range validators {
+				sig := signVote(ctx, stakingKeeper, validator.Address, transferEvent)
+				err := oracleKeeper.SubmitVote(ctx, types.Vote{
+					TxHash:           transferEvent.TxHash,
+					Validator:        validator.Address,
+					EventData:        transferEvent,
+					Signature:        sig,
+					SignatureVersion: oracletypes.CurrentSignatureVersion,
+					VoteTime:         ctx.BlockTime().Unix(),
+				})
+
+				payer := sdk.AccAddress([]byte{byte(i + 1)})
+				if err != nil {
+					// Votes after confirmation fail and never reach the post-handler
+					continue
+				}
+				oracleKeeper.RecordVoteFee(ctx, transferEvent.TxHash, validator.Address, payer, fee)
+				ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+			}
+
+			threshold, _ := oracleKeeper.GetDynamicThreshold(ctx)
+			for i := 0; i < validatorCount; i++ {
+				refund := bankKeeper.refunds[sdk.AccAddress([]byte{byte(i + 1)}).String()]
+				if i < int(threshold) && !refund.Equal(fee) {
+					return false
+				}
+				if i >= int(threshold) && !refund.IsZero() {
+					return false
+				}
+			}
+
+			return bankKeeper.refunds[expiredPayer.String()].IsZero()
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(1, 9),
+		gen.Int64Range(1, 1000000),
+	))
+
+	properties.TestingRun(t)
+}
+
+// =============================================================================
+// **Feature: interbank-netting-engine, Property 16: 상관관계 ID 로깅**
+// **검증: 요구사항 7.3**
+// =============================================================================
+
+// TestProperty_CorrelationID_TagsTransferLogs tests that logs emitted while
+// confirming a transfer carry its tx hash as correlation ID
+func TestProperty_CorrelationID_TagsTransferLogs(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("transfer logs carry the tx hash as correlation ID", prop.ForAll(
+		func(transferEvent types.TransferEvent, validatorCount int) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, validatorCount)
+			validators := generateValidators(validatorCount)
+			setupValidators(ctx, stakingKeeper, validators)
+
+			var buf bytes.Buffer
+			ctx = ctx.WithLogger(log.NewLogger(&buf, log.OutputJSONOption()))
+			submitVotes(ctx, oracleKeeper, transferEvent, validators, stakingKeeper)
+
+			confirmed := false
+			for _, line := range bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n")) {
+				var entry map[string]interface{}
+				if err := json.Unmarshal(line, &entry); err != nil {
+					return false
+				}
+				if entry["message"] != "transfer confirmed" {
+					continue
+				}
+				confirmed = true
+				if entry["module"] != "x/oracle" || entry[types.LogKeyCorrelationID] != transferEvent.TxHash {
+					return false
+				}
+			}
+
+			return confirmed
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(1, 7),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 17: 서명 도메인 분리**
+// **검증: 요구사항 3.1 - 다른 체인/용도/형식으로 서명된 투표가 거부되는지 검증**
+func TestProperty_VoteSignature_DomainSeparated(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("only signatures over this chain's vote envelope are accepted", prop.ForAll(
+		func(transferEvent types.TransferEvent) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, 1)
+			validators := generateValidators(1)
+			setupValidators(ctx, stakingKeeper, validators)
+			validator := validators[0].Address
+
+			vote := types.Vote{
+				TxHash:           transferEvent.TxHash,
+				Validator:        validator,
+				EventData:        transferEvent,
+				SignatureVersion: oracletypes.CurrentSignatureVersion,
+				VoteTime:         ctx.BlockTime().Unix(),
+			}
+
+			// Legacy signature over the raw tx hash
+			vote.Signature = stakingKeeper.SignData(validator, []byte(transferEvent.TxHash))
+			if !errors.Is(oracleKeeper.SubmitVote(ctx, vote), oracletypes.ErrInvalidSignature) {
+				return false
+			}
+
+			// Signature replayed from another chain
+			otherChain := oracletypes.NewVoteSigningEnvelope(oracletypes.CurrentSignatureVersion, "other-chain", transferEvent)
+			vote.Signature = stakingKeeper.SignData(validator, otherChain.SignBytes())
+			if !errors.Is(oracleKeeper.SubmitVote(ctx, vote), oracletypes.ErrInvalidSignature) {
+				return false
+			}
+
+			// Signature over the same hash for another purpose
+			otherPurpose := oracletypes.NewVoteSigningEnvelope(oracletypes.CurrentSignatureVersion, ctx.ChainID(), transferEvent)
+			otherPurpose.Purpose = "mint_command"
+			vote.Signature = stakingKeeper.SignData(validator, otherPurpose.SignBytes())
+			if !errors.Is(oracleKeeper.SubmitVote(ctx, vote), oracletypes.ErrInvalidSignature) {
+				return false
+			}
+
+			// Signature over the tx hash does not cover tampered event data
+			vote.Signature = signVote(ctx, stakingKeeper, validator, transferEvent)
+			tampered := vote
+			tampered.EventData.Amount = transferEvent.Amount.AddRaw(1)
+			if !errors.Is(oracleKeeper.SubmitVote(ctx, tampered), oracletypes.ErrInvalidSignature) {
+				return false
+			}
+
+			// Unknown envelope versions are rejected before verification
+			vote.SignatureVersion = oracletypes.CurrentSignatureVersion + 1
+			if !errors.Is(oracleKeeper.SubmitVote(ctx, vote), oracletypes.ErrInvalidSigningEnvelope) {
+				return false
+			}
+
+			// The current envelope for this chain is accepted
+			vote.SignatureVersion = oracletypes.CurrentSignatureVersion
+			return oracleKeeper.SubmitVote(ctx, vote) == nil
+		},
+		testhelpers.GenTransferEvent(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 18: 코리도 한도 보류**
+// **검증: 요구사항 3.2 - 코리도 한도를 초과한 이체가 자동 확정되지 않고 승인 대기로 보류되는지 검증**
+func TestProperty_CorridorCap_HoldsTransfersAboveCap(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("transfers above the corridor cap are held until approved", prop.ForAll(
+		func(transferEvent types.TransferEvent, validatorCount int) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, validatorCount)
+			validators := generateValidators(validatorCount)
+			setupValidators(ctx, stakingKeeper, validators)
+
+			// Cap the corridor one unit below the transfer amount
+			params := oracletypes.DefaultParams()
+			params.CorridorCaps = []oracletypes.CorridorCap{{
+				SourceChain: transferEvent.SourceChain,
+				DestChain:   transferEvent.DestChain,
+				MaxAmount:   transferEvent.Amount,
+			}}
+			oracleKeeper.SetParams(ctx, params)
+			transferEvent.Amount = transferEvent.Amount.AddRaw(1)
+
+			submitVotes(ctx, oracleKeeper, transferEvent, validators, stakingKeeper)
+
+			voteStatus, found := oracleKeeper.GetVoteStatus(ctx, transferEvent.TxHash)
+			if !found || voteStatus.Confirmed {
+				return false
+			}
+			if _, confirmed := oracleKeeper.GetConfirmedTransfer(ctx, transferEvent.TxHash); confirmed {
+				return false
+			}
+			held, found := oracleKeeper.GetHeldTransfer(ctx, transferEvent.TxHash)
+			if !found || !held.Cap.Equal(params.CorridorCaps[0].MaxAmount) {
+				return false
+			}
+
+			// Only the governance authority can approve
+			msgServer := keeper.NewMsgServerImpl(*oracleKeeper)
+			_, err := msgServer.ApproveHeldTransfer(ctx, oracletypes.NewMsgApproveHeldTransfer(validators[0].Address, transferEvent.TxHash))
+			if !errors.Is(err, oracletypes.ErrUnauthorized) {
+				return false
+			}
+
+			_, err = msgServer.ApproveHeldTransfer(ctx, oracletypes.NewMsgApproveHeldTransfer(oracleKeeper.GetAuthority(), transferEvent.TxHash))
+			if err != nil {
+				return false
+			}
+			if _, found := oracleKeeper.GetHeldTransfer(ctx, transferEvent.TxHash); found {
+				return false
+			}
+			_, confirmed := oracleKeeper.GetConfirmedTransfer(ctx, transferEvent.TxHash)
+			return confirmed
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(1, 7),
+	))
+
+	properties.Property("transfers within the corridor cap confirm automatically", prop.ForAll(
+		func(transferEvent types.TransferEvent, validatorCount int) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, validatorCount)
+			validators := generateValidators(validatorCount)
+			setupValidators(ctx, stakingKeeper, validators)
+
+			params := oracletypes.DefaultParams()
+			params.CorridorCaps = []oracletypes.CorridorCap{{
+				SourceChain: transferEvent.SourceChain,
+				DestChain:   transferEvent.DestChain,
+				MaxAmount:   transferEvent.Amount,
+			}}
+			oracleKeeper.SetParams(ctx, params)
+
+			submitVotes(ctx, oracleKeeper, transferEvent, validators, stakingKeeper)
+
+			if _, found := oracleKeeper.GetHeldTransfer(ctx, transferEvent.TxHash); found {
+				return false
+			}
+			_, confirmed := oracleKeeper.GetConfirmedTransfer(ctx, transferEvent.TxHash)
+			return confirmed
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(1, 7),
+	))
+
+	properties.Property("rejected held transfers are never confirmed", prop.ForAll(
+		func(transferEvent types.TransferEvent, validatorCount int) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, validatorCount)
+			validators := generateValidators(validatorCount)
+			setupValidators(ctx, stakingKeeper, validators)
+
+			params := oracletypes.DefaultParams()
+			params.CorridorCaps = []oracletypes.CorridorCap{{
+				SourceChain: transferEvent.SourceChain,
+				DestChain:   transferEvent.DestChain,
+				MaxAmount:   transferEvent.Amount,
+			}}
+			oracleKeeper.SetParams(ctx, params)
+			transferEvent.Amount = transferEvent.Amount.AddRaw(1)
+
+			submitVotes(ctx, oracleKeeper, transferEvent, validators, stakingKeeper)
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			if err := oracleKeeper.RejectHeldTransfer(ctx, transferEvent.TxHash, "suspected fat-finger"); err != nil {
+				return false
+			}
+
+			// The rejection carries the governance reason code on the record and the event
+			held, _ := oracleKeeper.GetHeldTransfer(ctx, transferEvent.TxHash)
+			if held.ReasonCode != types.ReasonGovernance || held.Reason != "suspected fat-finger" {
+				return false
+			}
+			attributes := map[string]string{}
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == oracletypes.EventTypeTransferRejected {
+					for _, attribute := range event.Attributes {
+						attributes[attribute.Key] = attribute.Value
+					}
+				}
+			}
+			if attributes[types.AttributeKeyReasonCode] != string(types.ReasonGovernance) {
+				return false
+			}
+
+			if _, err := oracleKeeper.ApproveHeldTransfer(ctx, transferEvent.TxHash); !errors.Is(err, oracletypes.ErrHeldTransferRejected) {
+				return false
+			}
+			_, confirmed := oracleKeeper.GetConfirmedTransfer(ctx, transferEvent.TxHash)
+			return !confirmed
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(1, 7),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 19: 이체 증명 번들 오프라인 검증**
+// **검증: 요구사항 7.3 - 확정된 이체의 증명 번들이 검증자 집합만으로 오프라인 검증되는지 검증**
+func TestProperty_TransferProof_VerifiableOffline(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("transfer proofs verify against the validator set and detect tampering", prop.ForAll(
+		func(transferEvent types.TransferEvent, validatorCount int) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, validatorCount)
+			validators := generateValidators(validatorCount)
+			setupValidators(ctx, stakingKeeper, validators)
+			querier := keeper.NewQueryServerImpl(*oracleKeeper)
+
+			// Not confirmed yet
+			req := &oracletypes.QueryTransferProofRequest{TxHash: transferEvent.TxHash}
+			if _, err := querier.TransferProof(ctx, req); !errors.Is(err, oracletypes.ErrTransferNotFound) {
+				return false
+			}
+
+			submitVotes(ctx, oracleKeeper, transferEvent, validators, stakingKeeper)
+
+			resp, err := querier.TransferProof(ctx, req)
+			if err != nil {
+				return false
+			}
+			proof := resp.Proof
+			if proof.ConfirmedHeight != ctx.BlockHeight() || proof.CreditToken.HolderBank != transferEvent.DestChain {
+				return false
+			}
+
+			// The bank only knows the validator public keys
+			validatorSet := make([]types.Validator, len(validators))
+			for i, validator := range validators {
+				pubKey, found := oracleKeeper.GetValidatorPubKey(ctx, validator.Address)
+				if !found {
+					return false
+				}
+				validatorSet[i] = types.Validator{Address: validator.Address, PubKey: pubKey, Power: 1, Active: true}
+			}
+			if err := proof.Verify(validatorSet); err != nil {
+				return false
+			}
+
+			tampered := proof
+			tampered.EventData.Amount = proof.EventData.Amount.AddRaw(1)
+			if tampered.Verify(validatorSet) == nil {
+				return false
+			}
+
+			otherChain := proof
+			otherChain.ChainID = "other-chain"
+			if otherChain.Verify(validatorSet) == nil {
+				return false
+			}
+
+			tooFewVotes := proof
+			tooFewVotes.Votes = proof.Votes[:types.ConsensusThreshold(validatorCount)-1]
+			return tooFewVotes.Verify(validatorSet) != nil
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(1, 7),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 20: 재구성 신고 시 신용 동결**
+// **검증: 요구사항 3.2 - 재구성으로 사라진 원천 트랜잭션의 신용이 동결되고 분쟁이 열리는지 검증**
+func TestProperty_ReportReorg_FreezesCreditAndOpensDispute(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("reorg reports freeze the transfer's credit until the dispute is resolved", prop.ForAll(
+		func(transferEvent types.TransferEvent, validatorCount int, reorgConfirmed bool) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, validatorCount)
+			validators := generateValidators(validatorCount)
+			setupValidators(ctx, stakingKeeper, validators)
+			nettingKeeper := NewMockNettingKeeper()
+			oracleKeeper.SetNettingKeeper(nettingKeeper)
+			msgServer := keeper.NewMsgServerImpl(*oracleKeeper)
+			reporter := validators[0].Address
+
+			// Unconfirmed transfers cannot be disputed
+			if _, err := oracleKeeper.ReportReorg(ctx, reporter, transferEvent.TxHash, "reorg"); !errors.Is(err, oracletypes.ErrTransferNotFound) {
+				return false
+			}
+
+			submitVotes(ctx, oracleKeeper, transferEvent, validators, stakingKeeper)
+
+			// Only active validators can report
+			outsider := sdk.ValAddress([]byte("outsider")).String()
+			if _, err := oracleKeeper.ReportReorg(ctx, outsider, transferEvent.TxHash, "reorg"); !errors.Is(err, oracletypes.ErrUnauthorized) {
+				return false
+			}
+
+			resp, err := msgServer.ReportReorg(ctx, oracletypes.NewMsgReportReorg(reporter, transferEvent.TxHash, "source block replaced"))
+			if err != nil || !resp.FrozenAmount.Equal(transferEvent.Amount) {
+				return false
+			}
+			denom := types.CreditDenom(transferEvent.SourceChain, types.BaseCurrency)
+			if !nettingKeeper.getFrozen(transferEvent.DestChain, denom).Equal(transferEvent.Amount) {
+				return false
+			}
+			if _, err := oracleKeeper.ReportReorg(ctx, reporter, transferEvent.TxHash, "reorg"); !errors.Is(err, oracletypes.ErrDisputeExists) {
+				return false
+			}
+
+			// Credit of the denom issued to another bank since is not burned
+			other := types.CreditToken{Denom: denom, IssuerBank: transferEvent.SourceChain, HolderBank: "bank-other", Amount: transferEvent.Amount}
+			if err := nettingKeeper.IssueCreditToken(ctx, other); err != nil {
+				return false
+			}
+
+			// Only the authority resolves disputes
+			resolve := oracletypes.NewMsgResolveDispute(reporter, transferEvent.TxHash, reorgConfirmed, "checked")
+			if _, err := msgServer.ResolveDispute(ctx, resolve); !errors.Is(err, oracletypes.ErrUnauthorized) {
+				return false
+			}
+			resolve.Authority = oracleKeeper.GetAuthority()
+			resolved, err := msgServer.ResolveDispute(ctx, resolve)
+			if err != nil {
+				return false
+			}
+			if _, err := msgServer.ResolveDispute(ctx, resolve); !errors.Is(err, oracletypes.ErrDisputeResolved) {
+				return false
+			}
+
+			// Released credit is spendable again; confirmed reorgs burn it at
+			// the bank the dispute froze it at
+			balance := nettingKeeper.GetCreditBalance(ctx, transferEvent.DestChain, denom)
+			if !nettingKeeper.getFrozen(transferEvent.DestChain, denom).IsZero() {
+				return false
+			}
+			if !nettingKeeper.GetCreditBalance(ctx, other.HolderBank, denom).Equal(other.Amount) {
+				return false
+			}
+			if reorgConfirmed {
+				return resolved.Status == oracletypes.DisputeStatusReorgConfirmed &&
+					resolved.BurnedAmount.Equal(transferEvent.Amount) && balance.IsZero()
+			}
+			return resolved.Status == oracletypes.DisputeStatusDismissed &&
+				resolved.BurnedAmount.IsZero() && balance.Equal(transferEvent.Amount)
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(1, 7),
+		gen.Bool(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 22: 대기 작업 요약**
+// **검증: 요구사항 3.4 - WorkQueue가 투표, 확인된 이체, 넷팅 주기, 미서명 명령 수를 정확히 집계하는지 검증**
+func TestProperty_WorkQueue_CountsPendingWork(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("work queue counts match the pending work", prop.ForAll(
+		func(transferEvent types.TransferEvent, confirmedCount, pendingCount int) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, 4)
+			ctx = ctx.WithBlockHeight(10)
+			validators := generateValidators(4)
+			setupValidators(ctx, stakingKeeper, validators)
+
+			nettingKeeper := NewMockNettingKeeper()
+			nettingKeeper.backlog = types.NettingBacklog{LastNettingBlock: 5, PendingPairs: 7, DueCycles: 1}
+			oracleKeeper.SetNettingKeeper(nettingKeeper)
+			multisigKeeper := &MockMultisigKeeper{}
+			oracleKeeper.SetMultisigKeeper(multisigKeeper)
+
+			// Confirmed before the last netting cycle, not counted as unnetted
+			netted := transferEvent
+			netted.TxHash = transferEvent.TxHash + "-netted"
+			submitVotes(ctx.WithBlockHeight(3), oracleKeeper, netted, validators, stakingKeeper)
+
+			for i := 0; i < confirmedCount; i++ {
+				confirmed := transferEvent
+				confirmed.TxHash = fmt.Sprintf("%s-confirmed-%d", transferEvent.TxHash, i)
+				submitVotes(ctx, oracleKeeper, confirmed, validators, stakingKeeper)
+			}
+
+			// One vote is below the 3-of-4 threshold
+			for i := 0; i < pendingCount; i++ {
+				pending := transferEvent
+				pending.TxHash = fmt.Sprintf("%s-pending-%d", transferEvent.TxHash, i)
+				submitVotes(ctx, oracleKeeper, pending, validators[:1], stakingKeeper)
+			}
+
+			params := oracleKeeper.GetParams(ctx)
+			queue := oracleKeeper.GetWorkQueue(ctx, params.VotingPeriod)
+			if queue.PendingVotes != int32(pendingCount) || queue.PendingVotesNearTimeout != 0 {
+				return false
+			}
+			if queue.UnnettedTransfers != int32(confirmedCount) {
+				return false
+			}
+			if queue.PendingNettingPairs != 7 || queue.DueNettingCycles != 1 {
+				return false
+			}
+			if queue.UnsignedCommands != int32(confirmedCount+1) {
+				return false
+			}
+
+			// Close to the consensus timeout every pending vote is near timeout
+			later := ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(params.ConsensusTimeout-params.VotingPeriod) * time.Second))
+			return oracleKeeper.GetWorkQueue(later, params.VotingPeriod).PendingVotesNearTimeout == int32(pendingCount)
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(0, 5),
+		gen.IntRange(0, 5),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 24: 지정 검증자 증명 요구**
+// **검증: 요구사항 3.2 - 고액 이체가 2/3 임계값과 함께 지정된 검증자의 투표를 받아야만 확인되는지 검증**
+func TestProperty_RequiredAttestations_GateConfirmation(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("covered transfers wait for every required attestor", prop.ForAll(
+		func(transferEvent types.TransferEvent, validatorCount int) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, validatorCount)
+			validators := generateValidators(validatorCount)
+			setupValidators(ctx, stakingKeeper, validators)
+			attestor := validators[validatorCount-1]
+
+			params := oracletypes.DefaultParams()
+			params.AttestationRules = []oracletypes.AttestationRule{{
+				SourceChain: transferEvent.SourceChain,
+				DestChain:   transferEvent.DestChain,
+				MinAmount:   transferEvent.Amount,
+				Validators:  []string{attestor.Address},
+			}}
+			oracleKeeper.SetParams(ctx, params)
+
+			// Everyone but the attestor votes, which meets the 2/3 threshold
+			submitVotes(ctx, oracleKeeper, transferEvent, validators[:validatorCount-1], stakingKeeper)
+
+			voteStatus, found := oracleKeeper.GetVoteStatus(ctx, transferEvent.TxHash)
+			if !found || voteStatus.Confirmed || voteStatus.VoteCount < voteStatus.Threshold {
+				return false
+			}
+			if consensus, err := oracleKeeper.CheckConsensus(ctx, transferEvent.TxHash); err != nil || consensus {
+				return false
+			}
+			if err := oracleKeeper.ConfirmTransfer(ctx, transferEvent.TxHash); !errors.Is(err, oracletypes.ErrMissingAttestations) {
+				return false
+			}
+
+			submitVotes(ctx, oracleKeeper, transferEvent, []types.Validator{attestor}, stakingKeeper)
+
+			if _, confirmed := oracleKeeper.GetConfirmedTransfer(ctx, transferEvent.TxHash); !confirmed {
+				return false
+			}
+			proof, err := oracleKeeper.GetTransferProof(ctx, transferEvent.TxHash)
+			return err == nil && len(proof.RequiredAttestors) == 1 && proof.RequiredAttestors[0] == attestor.Address
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(3, 7),
+	))
+
+	properties.Property("transfers below the rule minimum confirm on the threshold alone", prop.ForAll(
+		func(transferEvent types.TransferEvent, validatorCount int) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, validatorCount)
+			validators := generateValidators(validatorCount)
+			setupValidators(ctx, stakingKeeper, validators)
+
+			params := oracletypes.DefaultParams()
+			params.AttestationRules = []oracletypes.AttestationRule{{
+				SourceChain: transferEvent.SourceChain,
+				DestChain:   transferEvent.DestChain,
+				MinAmount:   transferEvent.Amount.AddRaw(1),
+				Validators:  []string{validators[validatorCount-1].Address},
+			}}
+			oracleKeeper.SetParams(ctx, params)
+
+			submitVotes(ctx, oracleKeeper, transferEvent, validators[:validatorCount-1], stakingKeeper)
+
+			_, confirmed := oracleKeeper.GetConfirmedTransfer(ctx, transferEvent.TxHash)
+			return confirmed
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(3, 7),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 26: 확인 후 투표 거부**
+// **검증: 요구사항 3.1 - 이미 확인된 이체에 대한 투표가 서명 검증 전에 거부되고 저장되지 않는지 검증**
+func TestProperty_LateVotes_RejectedAfterConfirmation(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("votes on confirmed transfers are rejected without being stored", prop.ForAll(
+		func(transferEvent types.TransferEvent, validatorCount int) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, validatorCount)
+			validators := generateValidators(validatorCount)
+			setupValidators(ctx, stakingKeeper, validators)
+
+			// Everyone but the last validator votes, which meets the 2/3 threshold
+			submitVotes(ctx, oracleKeeper, transferEvent, validators[:validatorCount-1], stakingKeeper)
+			before, found := oracleKeeper.GetVoteStatus(ctx, transferEvent.TxHash)
+			if !found || !before.Confirmed {
+				return false
+			}
+
+			late := validators[validatorCount-1]
+			signed := types.Vote{
+				TxHash:           transferEvent.TxHash,
+				Validator:        late.Address,
+				EventData:        transferEvent,
+				Signature:        signVote(ctx, stakingKeeper, late.Address, transferEvent),
+				SignatureVersion: oracletypes.CurrentSignatureVersion,
+				VoteTime:         ctx.BlockTime().Unix(),
+			}
+			if err := oracleKeeper.SubmitVote(ctx, signed); !errors.Is(err, oracletypes.ErrTransferAlreadyConfirmed) {
+				return false
+			}
+
+			// The check runs before signature verification
+			unsigned := signed
+			unsigned.Signature = nil
+			if err := oracleKeeper.SubmitVote(ctx, unsigned); !errors.Is(err, oracletypes.ErrTransferAlreadyConfirmed) {
+				return false
+			}
+
+			after, _ := oracleKeeper.GetVoteStatus(ctx, transferEvent.TxHash)
+			return after.VoteCount == before.VoteCount && len(after.Votes) == len(before.Votes)
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(4, 7),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 27: 검증자별 대기 작업**
+// **검증: 요구사항 3.4 - ValidatorQueue가 검증자가 투표하지 않은 이체와 서명하지 않은 명령만 나열하는지 검증**
+func TestProperty_ValidatorQueue_ListsOutstandingWork(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("validator queue lists only the validator's outstanding work", prop.ForAll(
+		func(transferEvent types.TransferEvent, pendingCount, signedCount int) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, 4)
+			validators := generateValidators(4)
+			setupValidators(ctx, stakingKeeper, validators)
+			voter, other := validators[0], validators[1]
+
+			multisigKeeper := &MockMultisigKeeper{}
+			oracleKeeper.SetMultisigKeeper(multisigKeeper)
+
+			// Confirmed transfers are no longer owed, even by validators that skipped them
+			confirmed := transferEvent
+			confirmed.TxHash = transferEvent.TxHash + "-confirmed"
+			submitVotes(ctx, oracleKeeper, confirmed, validators[1:], stakingKeeper)
+
+			for i := 0; i < pendingCount; i++ {
+				pending := transferEvent
+				pending.TxHash = fmt.Sprintf("%s-pending-%d", transferEvent.TxHash, i)
+				submitVotes(ctx, oracleKeeper, pending, []types.Validator{voter}, stakingKeeper)
+			}
+
+			// The confirmation generated one command; add more signed by the other validator
+			for i := 0; i < signedCount; i++ {
+				_, _ = multisigKeeper.GenerateMintCommand(ctx, "chain-a", "recipient", math.NewInt(1))
+				multisigKeeper.commands[len(multisigKeeper.commands)-1].Signatures = []types.ECDSASignature{{Validator: other.Address}}
+			}
+			commandCount := len(multisigKeeper.GetAllPendingCommands(ctx))
+
+			voterQueue := oracleKeeper.GetValidatorQueue(ctx, voter.Address)
+			if !voterQueue.Active || len(voterQueue.PendingVotes) != 0 || len(voterQueue.PendingCommands) != commandCount {
+				return false
+			}
+
+			otherQueue := oracleKeeper.GetValidatorQueue(ctx, other.Address)
+			if len(otherQueue.PendingVotes) != pendingCount || len(otherQueue.PendingCommands) != commandCount-signedCount {
+				return false
+			}
+			timeout := oracleKeeper.GetParams(ctx).ConsensusTimeout
+			for _, pending := range otherQueue.PendingVotes {
+				if pending.VoteCount != 1 || pending.Deadline != pending.CreatedAt+timeout {
+					return false
+				}
+			}
+			return true
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(0, 5),
+		gen.IntRange(0, 3),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 29: 증명 검증 한도**
+// **검증: 요구사항 3.2, 7.3 - 크기나 복잡도 한도를 넘는 투표와 이체 증명이 서명 검증 전에 거부되는지 검증**
+func TestProperty_ProofLimits_EnforcedBeforeVerification(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("oversized votes and proofs are rejected before verification", prop.ForAll(
+		func(transferEvent types.TransferEvent, validatorCount int) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, validatorCount)
+			validators := generateValidators(validatorCount)
+			setupValidators(ctx, stakingKeeper, validators)
+			params := oracleKeeper.GetParams(ctx)
+
+			// A vote carrying oversized event data is never stored
+			oversized := transferEvent
+			oversized.TxHash = transferEvent.TxHash + "-oversized"
+			oversized.Sender = strings.Repeat("a", int(params.MaxProofBytes))
+			vote := types.Vote{
+				TxHash:           oversized.TxHash,
+				Validator:        validators[0].Address,
+				EventData:        oversized,
+				Signature:        signVote(ctx, stakingKeeper, validators[0].Address, oversized),
+				SignatureVersion: oracletypes.CurrentSignatureVersion,
+			}
+			if err := oracleKeeper.SubmitVote(ctx, vote); !errors.Is(err, oracletypes.ErrProofTooLarge) {
+				return false
+			}
+			if _, found := oracleKeeper.GetVoteStatus(ctx, oversized.TxHash); found {
+				return false
+			}
+
+			submitVotes(ctx, oracleKeeper, transferEvent, validators, stakingKeeper)
+			proof, err := oracleKeeper.GetTransferProof(ctx, transferEvent.TxHash)
+			if err != nil || oracleKeeper.VerifyTransferProof(ctx, proof) != nil {
+				return false
+			}
+
+			// Too many votes fail the limit, not the duplicate check of Verify
+			padded := proof
+			padded.Votes = make([]types.Vote, 0, params.MaxProofVotes+1)
+			for int32(len(padded.Votes)) <= params.MaxProofVotes {
+				padded.Votes = append(padded.Votes, proof.Votes[0])
+			}
+			if err := oracleKeeper.VerifyTransferProof(ctx, padded); !errors.Is(err, oracletypes.ErrProofTooLarge) {
+				return false
+			}
+
+			tampered := proof
+			tampered.EventData.Amount = proof.EventData.Amount.AddRaw(1)
+			if err := oracleKeeper.VerifyTransferProof(ctx, tampered); !errors.Is(err, oracletypes.ErrInvalidProof) {
+				return false
+			}
+
+			// The size limit is a param
+			params.MaxProofBytes = int64(proof.ByteSize()) - 1
+			oracleKeeper.SetParams(ctx, params)
+			return errors.Is(oracleKeeper.VerifyTransferProof(ctx, proof), oracletypes.ErrProofTooLarge)
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(1, 7),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 30: 확정 이체와 크레딧 토큰 연결**
+// **검증: 요구사항 2.1, 7.3 - 확정된 이체가 발행한 크레딧 토큰이 기록되고 조회로 반환되는지 검증**
+func TestProperty_TransferCredit_RecordedAtConfirmation(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("confirmed transfers link to the credit token they issued", prop.ForAll(
+		func(transferEvent types.TransferEvent, validatorCount int) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, validatorCount)
+			validators := generateValidators(validatorCount)
+			setupValidators(ctx, stakingKeeper, validators)
+			nettingKeeper := NewMockNettingKeeper()
+			oracleKeeper.SetNettingKeeper(nettingKeeper)
+			querier := keeper.NewQueryServerImpl(*oracleKeeper)
+
+			req := &oracletypes.QueryTransferCreditRequest{TxHash: transferEvent.TxHash}
+			if _, err := querier.TransferCredit(ctx, req); !errors.Is(err, oracletypes.ErrTransferNotFound) {
+				return false
+			}
+
+			submitVotes(ctx, oracleKeeper, transferEvent, validators, stakingKeeper)
+
+			recorded, found := oracleKeeper.GetTransferCredit(ctx, transferEvent.TxHash)
+			if !found || recorded.OriginTx != transferEvent.TxHash || !recorded.Amount.Equal(transferEvent.Amount) {
+				return false
+			}
+
+			// The recorded denom is the one the destination bank holds
+			if !nettingKeeper.GetCreditBalance(ctx, transferEvent.DestChain, recorded.Denom).Equal(transferEvent.Amount) {
+				return false
+			}
+
+			resp, err := querier.TransferCredit(ctx, req)
+			if err != nil || resp.CreditToken.Denom != recorded.Denom || resp.CreditToken.HolderBank != transferEvent.DestChain {
+				return false
+			}
+
+			proof, err := oracleKeeper.GetTransferProof(ctx, transferEvent.TxHash)
+			return err == nil && proof.CreditToken.Denom == recorded.Denom
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(1, 7),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 31: 압축 배치 투표**
+// **검증: 요구사항 3.1, 3.2 - 압축된 배치 투표가 개별 투표와 같이 합의에 도달하고, 크기 제한과 잘못된 투표는 배치 전체를 거부하는지 검증**
+func TestProperty_BatchVote_CompressedPayloads(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("compressed batches confirm transfers within the size caps", prop.ForAll(
+		func(transferEvent types.TransferEvent, validatorCount, transferCount int, encoding string) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, validatorCount)
+			validators := generateValidators(validatorCount)
+			setupValidators(ctx, stakingKeeper, validators)
+			oracleKeeper.SetNettingKeeper(NewMockNettingKeeper())
+			msgServer := keeper.NewMsgServerImpl(*oracleKeeper)
+
+			transfers := make([]types.TransferEvent, transferCount)
+			for i := range transfers {
+				transfers[i] = transferEvent
+				transfers[i].TxHash = fmt.Sprintf("%s-%d", transferEvent.TxHash, i)
+			}
+			batchOf := func(validator string) []oracletypes.BatchVoteEntry {
+				entries := make([]oracletypes.BatchVoteEntry, 0, transferCount+1)
+				for _, transfer := range transfers {
+					entries = append(entries, oracletypes.BatchVoteEntry{
+						TxHash:           transfer.TxHash,
+						EventData:        transfer,
+						Signature:        signVote(ctx, stakingKeeper, validator, transfer),
+						SignatureVersion: oracletypes.CurrentSignatureVersion,
+					})
+				}
+				return append(entries, entries[0]) // A replay of the first vote
+			}
+
+			// A batch with a malformed vote is rejected before any vote is stored
+			entries := batchOf(validators[0].Address)
+			entries[transferCount-1].Signature = nil
+			msg, err := oracletypes.NewMsgBatchVote(validators[0].Address, encoding, entries)
+			if err != nil {
+				return false
+			}
+			if _, err := msgServer.BatchVote(ctx, msg); !errors.Is(err, oracletypes.ErrInvalidBatch) {
+				return false
+			}
+			if _, found := oracleKeeper.GetVoteStatus(ctx, transfers[0].TxHash); found {
+				return false
+			}
+
+			// Corrupted payloads are rejected
+			corrupted := *msg
+			corrupted.Payload = bytes.Repeat([]byte{0xff}, len(msg.Payload))
+			if _, err := msgServer.BatchVote(ctx, &corrupted); !errors.Is(err, oracletypes.ErrInvalidBatch) {
+				return false
+			}
+
+			// Compressed size, decompressed size and vote count are each capped
+			msg, err = oracletypes.NewMsgBatchVote(validators[0].Address, encoding, batchOf(validators[0].Address))
+			if err != nil {
+				return false
+			}
+			for _, limit := range []func(*oracletypes.Params){
+				func(p *oracletypes.Params) { p.MaxBatchPayloadBytes = int64(len(msg.Payload)) - 1 },
+				func(p *oracletypes.Params) { p.MaxBatchBytes = int64(len(msg.Payload)) / 2 },
+				func(p *oracletypes.Params) { p.MaxBatchVotes = int32(transferCount) },
+			} {
+				params := oracletypes.DefaultParams()
+				limit(&params)
+				oracleKeeper.SetParams(ctx, params)
+				if _, err := msgServer.BatchVote(ctx, msg); !errors.Is(err, oracletypes.ErrBatchTooLarge) {
+					return false
+				}
+			}
+			oracleKeeper.SetParams(ctx, oracletypes.DefaultParams())
+
+			// Every validator votes with one batch; replays and votes after
+			// confirmation are skipped
+			threshold := int(types.ConsensusThreshold(validatorCount))
+			for i, validator := range validators {
+				msg, err := oracletypes.NewMsgBatchVote(validator.Address, encoding, batchOf(validator.Address))
+				if err != nil {
+					return false
+				}
+				resp, err := msgServer.BatchVote(ctx, msg)
+				if err != nil || len(resp.Results) != transferCount+1 || resp.Results[transferCount].Success {
+					return false
+				}
+				for _, result := range resp.Results[:transferCount] {
+					if result.Success != (i < threshold) || result.Consensus != (i == threshold-1) {
+						return false
+					}
+				}
+			}
+
+			for _, transfer := range transfers {
+				if _, confirmed := oracleKeeper.GetConfirmedTransfer(ctx, transfer.TxHash); !confirmed {
+					return false
+				}
+			}
+			return true
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(1, 7),
+		gen.IntRange(1, 20),
+		gen.OneConstOf(oracletypes.PayloadEncodingGzip, oracletypes.PayloadEncodingZstd),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 32: 컬렉션 저장소 마이그레이션**
+// **검증: 요구사항 3.1, 7.1 - 버전 2의 투표, 투표 수수료, 감사 로그가 컬렉션 레이아웃으로 옮겨진 뒤 그대로 조회되는지 검증**
+func TestProperty_Migrate2to3_MovesStoreToCollections(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("version 2 votes, vote fees and audit logs are readable after migration", prop.ForAll(
+		func(transfer types.TransferEvent, validatorCount, logCount int) bool {
+			ctx, oracleKeeper, _ := setupTestEnvironment(t, validatorCount)
+			cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+			store := ctx.KVStore(oracleKeeper.GetStoreKey())
+			be := func(v uint64) []byte { return binary.BigEndian.AppendUint64(nil, v) }
+
+			// Version 2 keys: "txHash/validator" and full audit log copies in the indexes
+			validators := generateValidators(validatorCount)
+			for _, validator := range validators {
+				vote := types.Vote{TxHash: transfer.TxHash, Validator: validator.Address, EventData: transfer}
+				voteFee := oracletypes.VoteFee{TxHash: transfer.TxHash, Validator: validator.Address, Fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), Height: ctx.BlockHeight()}
+				legacyKey := []byte(transfer.TxHash + "/" + validator.Address)
+				store.Set(append([]byte{0x02}, legacyKey...), cdc.MustMarshal(&vote))
+				store.Set(append([]byte{0x09}, legacyKey...), cdc.MustMarshal(&voteFee))
+			}
+			for id := uint64(1); id <= uint64(logCount); id++ {
+				log := types.AuditLog{ID: id, EventType: fmt.Sprintf("event-%d", id%2), TxHash: transfer.TxHash, Timestamp: int64(1000 + id/2)}
+				bz := cdc.MustMarshal(&log)
+				store.Set(append([]byte{0x05}, be(id)...), bz)
+				store.Set(append(append([]byte{0x07}, be(uint64(log.Timestamp))...), be(id)...), bz)
+				store.Set(append(append([]byte{0x08}, log.EventType+"/"...), be(id)...), bz)
+			}
+			store.Set([]byte{0x06}, be(uint64(logCount)))
+
+			if err := keeper.NewMigrator(*oracleKeeper).Migrate2to3(ctx); err != nil {
+				return false
+			}
+
+			for _, validator := range validators {
+				if _, err := oracleKeeper.Votes.Get(ctx, collections.Join(transfer.TxHash, validator.Address)); err != nil {
+					return false
+				}
+				if _, found := oracleKeeper.GetVoteFee(ctx, transfer.TxHash, validator.Address); !found {
+					return false
+				}
+			}
+
+			// Indexes return each log once, and IDs continue after the last one
+			if len(oracleKeeper.GetAuditLogsByTimeRange(ctx, 0, 1000+int64(logCount))) != logCount {
+				return false
+			}
+			if len(oracleKeeper.GetAuditLogsByEventType(ctx, "event-0"))+len(oracleKeeper.GetAuditLogsByEventType(ctx, "event-1")) != logCount {
+				return false
+			}
+			id, err := oracleKeeper.SaveAuditLog(ctx, types.AuditLog{EventType: "event-0"})
+			return err == nil && id == uint64(logCount)+1 && oracleKeeper.GetAuditLogCount(ctx) == id
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(1, 7),
+		gen.IntRange(0, 10),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 33: 체인·은행 긴급 정지**
+// **검증: 요구사항 3.2, 5.1 - 정지된 체인 또는 은행이 관련된 이체는 크레딧 발행과 민팅 명령 없이 보류되고, 정지 해제 시 확정되는지 검증**
+func TestProperty_Suspension_HoldsTransfersUntilResumed(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("suspended transfers are held without issuance until resumed", prop.ForAll(
+		func(transferEvent types.TransferEvent, validatorCount int, bankScope bool) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, validatorCount)
+			validators := generateValidators(validatorCount)
+			setupValidators(ctx, stakingKeeper, validators)
+			nettingKeeper := NewMockNettingKeeper()
+			multisigKeeper := &MockMultisigKeeper{}
+			oracleKeeper.SetNettingKeeper(nettingKeeper)
+			oracleKeeper.SetMultisigKeeper(multisigKeeper)
+
+			// A chain is suspended as a source, a bank also as a destination
+			target, scope := transferEvent.SourceChain, oracletypes.SuspensionScopeChain
+			if bankScope {
+				target, scope = transferEvent.DestChain, oracletypes.SuspensionScopeBank
+			}
+
+			// Only the governance authority can suspend
+			msgServer := keeper.NewMsgServerImpl(*oracleKeeper)
+			_, err := msgServer.Suspend(ctx, oracletypes.NewMsgSuspend(validators[0].Address, target, scope, "incident"))
+			if !errors.Is(err, oracletypes.ErrUnauthorized) {
+				return false
+			}
+			if _, err := msgServer.Suspend(ctx, oracletypes.NewMsgSuspend(oracleKeeper.GetAuthority(), target, scope, "incident")); err != nil {
+				return false
+			}
+
+			submitVotes(ctx, oracleKeeper, transferEvent, validators, stakingKeeper)
+
+			held, found := oracleKeeper.GetHeldTransfer(ctx, transferEvent.TxHash)
+			if !found || held.SuspendedBy != target {
+				return false
+			}
+			if _, confirmed := oracleKeeper.GetConfirmedTransfer(ctx, transferEvent.TxHash); confirmed {
+				return false
+			}
+			if len(nettingKeeper.holders) != 0 || len(multisigKeeper.commands) != 0 {
+				return false
+			}
+
+			// Approval cannot bypass the suspension
+			if _, err := oracleKeeper.ApproveHeldTransfer(ctx, transferEvent.TxHash); !errors.Is(err, oracletypes.ErrSuspended) {
+				return false
+			}
+
+			resp, err := msgServer.Resume(ctx, oracletypes.NewMsgResume(oracleKeeper.GetAuthority(), target))
+			if err != nil || len(resp.Confirmed) != 1 || resp.Confirmed[0] != transferEvent.TxHash {
+				return false
+			}
+			if _, found := oracleKeeper.GetHeldTransfer(ctx, transferEvent.TxHash); found {
+				return false
+			}
+			if _, found := oracleKeeper.GetSuspension(ctx, target); found {
+				return false
+			}
+			if _, err := oracleKeeper.Resume(ctx, target); !errors.Is(err, oracletypes.ErrSuspensionNotFound) {
+				return false
+			}
+
+			_, confirmed := oracleKeeper.GetConfirmedTransfer(ctx, transferEvent.TxHash)
+			return confirmed && len(nettingKeeper.holders) == 1 && len(multisigKeeper.commands) == 1
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(1, 7),
+		gen.Bool(),
+	))
+
+	properties.Property("transfers not involving the suspended target confirm", prop.ForAll(
+		func(transferEvent types.TransferEvent, validatorCount int) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, validatorCount)
+			validators := generateValidators(validatorCount)
+			setupValidators(ctx, stakingKeeper, validators)
+
+			// A chain suspension does not halt transfers to the chain
+			if _, err := oracleKeeper.Suspend(ctx, transferEvent.DestChain, oracletypes.SuspensionScopeChain, types.ReasonGovernance, "incident"); err != nil {
+				return false
+			}
+
+			submitVotes(ctx, oracleKeeper, transferEvent, validators, stakingKeeper)
+
+			if _, found := oracleKeeper.GetHeldTransfer(ctx, transferEvent.TxHash); found {
+				return false
+			}
+			_, confirmed := oracleKeeper.GetConfirmedTransfer(ctx, transferEvent.TxHash)
+			return confirmed
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(1, 7),
+	))
+
+	properties.Property("a transfer stays held while another suspension covers it", prop.ForAll(
+		func(transferEvent types.TransferEvent, validatorCount int) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, validatorCount)
+			validators := generateValidators(validatorCount)
+			setupValidators(ctx, stakingKeeper, validators)
+
+			if _, err := oracleKeeper.Suspend(ctx, transferEvent.SourceChain, oracletypes.SuspensionScopeChain, types.ReasonGovernance, "incident"); err != nil {
+				return false
+			}
+			if _, err := oracleKeeper.Suspend(ctx, transferEvent.DestChain, oracletypes.SuspensionScopeBank, types.ReasonGovernance, "incident"); err != nil {
+				return false
+			}
+
+			submitVotes(ctx, oracleKeeper, transferEvent, validators, stakingKeeper)
+
+			confirmed, err := oracleKeeper.Resume(ctx, transferEvent.SourceChain)
+			if err != nil || len(confirmed) != 0 {
+				return false
+			}
+			held, found := oracleKeeper.GetHeldTransfer(ctx, transferEvent.TxHash)
+			if !found || held.SuspendedBy != transferEvent.DestChain {
+				return false
+			}
+
+			confirmed, err = oracleKeeper.Resume(ctx, transferEvent.DestChain)
+			return err == nil && len(confirmed) == 1
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(1, 7),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 34: 파라미터 변경 이력**
+// **검증: 요구사항 7.1 - 거버넌스 파라미터 변경이 블록 높이와 제안 ID와 함께 순서대로 기록되는지 검증**
+func TestProperty_ParamsHistory_RecordsEachChange(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("each params update is recorded with its height and proposal", prop.ForAll(
+		func(timeouts []int64) bool {
+			ctx, oracleKeeper, _ := setupTestEnvironment(t, 1)
+			msgServer := keeper.NewMsgServerImpl(*oracleKeeper)
+			querier := keeper.NewQueryServerImpl(*oracleKeeper)
+
+			for i, timeout := range timeouts {
+				params := oracletypes.DefaultParams()
+				params.ConsensusTimeout = timeout
+				msg := oracletypes.NewMsgUpdateParams(oracleKeeper.GetAuthority(), params)
+				msg.ProposalID = uint64(i + 1)
+				if _, err := msgServer.UpdateParams(ctx.WithBlockHeight(int64(10*(i+1))), msg); err != nil {
+					return false
+				}
+			}
+
+			// Rejected updates are not recorded
+			_, err := msgServer.UpdateParams(ctx, oracletypes.NewMsgUpdateParams(oracleKeeper.GetAuthority(), oracletypes.Params{}))
+			if err == nil {
+				return false
+			}
+
+			resp, err := querier.ParamsHistory(ctx, &oracletypes.QueryParamsHistoryRequest{})
+			if err != nil || len(resp.Changes) != len(timeouts) {
+				return false
+			}
+			for i, change := range resp.Changes {
+				if change.Version != uint64(i+1) || change.ProposalID != uint64(i+1) || change.Height != int64(10*(i+1)) ||
+					change.Params.ConsensusTimeout != timeouts[i] || change.Authority != oracleKeeper.GetAuthority() {
+					return false
+				}
+			}
+			return true
+		},
+		gen.SliceOfN(5, gen.Int64Range(1, 3600)),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 35: 감사 로그 복합 필터**
+// **검증: 요구사항 7.5 - 이벤트 유형, 시간 범위, 은행 및 체인 필터를 조합한 감사 쿼리가 전체 로그를 교차한 결과와 같은지 검증**
+func TestProperty_AuditLogs_CombinedFilters(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("paged combined filter queries return exactly the matching logs", prop.ForAll(
+		func(seeds []int, eventType, bank, startTime, span int) bool {
+			ctx, oracleKeeper, _ := setupTestEnvironment(t, 1)
+			querier := keeper.NewQueryServerImpl(*oracleKeeper)
+
+			for i, seed := range seeds {
+				if _, err := oracleKeeper.SaveAuditLog(ctx, types.AuditLog{
+					EventType: fmt.Sprintf("event-%d", seed%3),
+					Timestamp: int64(1000 + (seed*7+i)%50),
+					Details: map[string]string{
+						"issuer_bank":  fmt.Sprintf("bank-%d", seed%4),
+						"holder_bank":  fmt.Sprintf("bank-%d", (seed/4)%4),
+						"source_chain": fmt.Sprintf("chain-%d", seed%2),
+					},
+				}); err != nil {
+					return false
+				}
+			}
+
+			filter := oracletypes.AuditLogFilter{Bank: fmt.Sprintf("bank-%d", bank), Limit: 3}
+			if eventType < 3 {
+				filter.EventType = fmt.Sprintf("event-%d", eventType)
+			}
+			if span > 0 {
+				filter.StartTime = int64(1000 + startTime)
+				filter.EndTime = filter.StartTime + int64(span)
+			}
+
+			var expected []uint64
+			for _, log := range oracleKeeper.GetAllAuditLogs(ctx) {
+				if (filter.EventType == "" || log.EventType == filter.EventType) &&
+					(span == 0 || (log.Timestamp >= filter.StartTime && log.Timestamp <= filter.EndTime)) &&
+					(log.Details["issuer_bank"] == filter.Bank || log.Details["holder_bank"] == filter.Bank) {
+					expected = append(expected, log.ID)
+				}
+			}
+
+			var actual []uint64
+			for page := 0; ; page++ {
+				resp, err := querier.AuditLogs(ctx, &oracletypes.QueryAuditLogsRequest{Filter: filter})
+				if err != nil || len(resp.Logs) > int(filter.Limit) || page > len(seeds) {
+					return false
+				}
+				for _, log := range resp.Logs {
+					actual = append(actual, log.ID)
+				}
+				if resp.NextID == 0 {
+					break
+				}
+				filter.AfterID = resp.NextID
+			}
+
+			if len(actual) != len(expected) {
+				return false
+			}
+			for i := range expected {
+				if actual[i] != expected[i] {
+					return false
+				}
+			}
+
+			// Limits and time ranges are validated
+			_, err := querier.AuditLogs(ctx, &oracletypes.QueryAuditLogsRequest{Filter: oracletypes.AuditLogFilter{Limit: oracletypes.MaxAuditLogLimit + 1}})
+			if !errors.Is(err, oracletypes.ErrInvalidAuditFilter) {
+				return false
+			}
+			_, err = querier.AuditLogs(ctx, &oracletypes.QueryAuditLogsRequest{Filter: oracletypes.AuditLogFilter{StartTime: 10, EndTime: 5}})
+			return errors.Is(err, oracletypes.ErrInvalidAuditFilter)
+		},
+		gen.SliceOfN(30, gen.IntRange(0, 1000)),
+		gen.IntRange(0, 3),
+		gen.IntRange(0, 3),
+		gen.IntRange(0, 49),
+		gen.IntRange(0, 20),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 36: 확인 이벤트 추적 속성**
+// **검증: 요구사항 6.3 - 확인된 이체 이벤트만으로 릴레이어가 게이트웨이 호출을 구성할 수 있는지 검증**
+func TestProperty_TransferConfirmed_EmitsTraceAttributes(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("transfer_confirmed carries the nonce, payload hash, validator set version and commands", prop.ForAll(
+		func(transferEvent types.TransferEvent) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, 3)
+			validators := generateValidators(3)
+			setupValidators(ctx, stakingKeeper, validators)
+			oracleKeeper.SetNettingKeeper(NewMockNettingKeeper())
+			multisigKeeper := &MockMultisigKeeper{}
+			oracleKeeper.SetMultisigKeeper(multisigKeeper)
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			submitVotes(ctx, oracleKeeper, transferEvent, validators, stakingKeeper)
+
+			attributes := map[string]string{}
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == oracletypes.EventTypeTransferConfirmed {
+					for _, attribute := range event.Attributes {
+						attributes[attribute.Key] = attribute.Value
+					}
+				}
+			}
+
+			return len(multisigKeeper.commands) == 1 &&
+				attributes[types.AttributeKeyNonce] == strconv.FormatUint(transferEvent.Nonce, 10) &&
+				attributes[types.AttributeKeyPayloadHash] == hex.EncodeToString(oracletypes.TransferPayloadHash(types.HashAlgorithmSHA256, transferEvent)) &&
+				attributes[types.AttributeKeyHashAlgorithm] == string(types.HashAlgorithmSHA256) &&
+				attributes[types.AttributeKeyValidatorSetVersion] == "1" &&
+				attributes[oracletypes.AttributeKeyCommandIDs] == multisigKeeper.commands[0].CommandID
+		},
+		testhelpers.GenTransferEvent(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 37: 자산 ID 전달**
+// **검증: 요구사항 5.1 - 이체 이벤트의 자산 ID가 목적지 체인 발행 명령의 토큰 ID로 전달되는지 검증**
+func TestProperty_ConfirmTransfer_CarriesAssetIDIntoCommand(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("the mint command mints the wrapped asset of the transfer", prop.ForAll(
+		func(transferEvent types.TransferEvent, assetID string) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, 3)
+			validators := generateValidators(3)
+			setupValidators(ctx, stakingKeeper, validators)
+			oracleKeeper.SetNettingKeeper(NewMockNettingKeeper())
+			multisigKeeper := &MockMultisigKeeper{}
+			oracleKeeper.SetMultisigKeeper(multisigKeeper)
+
+			transferEvent.AssetID = assetID
+			submitVotes(ctx, oracleKeeper, transferEvent, validators, stakingKeeper)
+
+			// Invalid asset IDs are rejected with the vote
+			invalid := oracletypes.NewMsgVote(validators[0].Address, transferEvent.TxHash, transferEvent, []byte{1})
+			invalid.EventData.AssetID = "KRW stable"
+
+			// A proof or vote for another wrapped asset is not for this transfer
+			other := "KRW-stable"
+			if assetID == other {
+				other = "USD-stable"
+			}
+			proof, err := oracleKeeper.GetTransferProof(ctx, transferEvent.TxHash)
+			if err != nil || oracleKeeper.VerifyTransferProof(ctx, proof) != nil {
+				return false
+			}
+			tampered := proof
+			tampered.EventData.AssetID = other
+			if err := oracleKeeper.VerifyTransferProof(ctx, tampered); !errors.Is(err, oracletypes.ErrInvalidProof) {
+				return false
+			}
+			vote := proof.Votes[0]
+			vote.EventData.AssetID = other
+			if oracletypes.VoteMatchesEvent(vote, proof.EventData) {
+				return false
+			}
+
+			return len(multisigKeeper.commands) == 1 &&
+				multisigKeeper.commands[0].TokenID == assetID &&
+				invalid.ValidateBasic() != nil
+		},
+		testhelpers.GenTransferEvent(),
+		gen.OneConstOf("", "KRW-stable", "USD-stable"),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 38: 체인 하트비트 자동 정지**
+// **검증: 요구사항 3.2 - 하트비트가 끊긴 체인은 발행이 자동 정지되고, 새 하트비트가 자동 정지만 해제하는지 검증**
+func TestProperty_ChainHeartbeat_SuspendsStaleChains(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("stale chains are suspended until a fresh heartbeat", prop.ForAll(
+		func(validatorCount int, timeout int64, governance bool) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, validatorCount)
+			validators := generateValidators(validatorCount)
+			setupValidators(ctx, stakingKeeper, validators)
+			msgServer := keeper.NewMsgServerImpl(*oracleKeeper)
+			querier := keeper.NewQueryServerImpl(*oracleKeeper)
+
+			params := oracletypes.DefaultParams()
+			params.HeartbeatTimeout = timeout
+			oracleKeeper.SetParams(ctx, params)
+
+			// Only active validators post heartbeats
+			outsider := sdk.ValAddress([]byte("unregistered-relayer")).String()
+			heartbeat := oracletypes.NewMsgChainHeartbeat(outsider, "bank-a", 100, math.NewInt(5000), false)
+			if _, err := msgServer.ChainHeartbeat(ctx, heartbeat); !errors.Is(err, oracletypes.ErrUnauthorized) {
+				return false
+			}
+			heartbeat.Reporter = validators[0].Address
+			if _, err := msgServer.ChainHeartbeat(ctx, heartbeat); err != nil {
+				return false
+			}
+
+			// Fresh until the timeout passes
+			atTimeout := ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(timeout) * time.Second))
+			oracleKeeper.SuspendStaleChains(atTimeout)
+			if _, suspended := oracleKeeper.GetSuspension(atTimeout, "bank-a"); suspended {
+				return false
+			}
+
+			stale := ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(timeout+1) * time.Second))
+			oracleKeeper.SuspendStaleChains(stale)
+			suspension, suspended := oracleKeeper.GetSuspension(stale, "bank-a")
+			if !suspended || suspension.Scope != oracletypes.SuspensionScopeChain {
+				return false
+			}
+			resp, err := querier.ChainHealth(stale, &oracletypes.QueryChainHealthRequest{Chain: "bank-a"})
+			if err != nil || len(resp.Chains) != 1 || !resp.Chains[0].Stale || resp.Chains[0].Healthy {
+				return false
+			}
+
+			// A governance suspension outlives fresh heartbeats
+			if governance {
+				if _, err := oracleKeeper.Suspend(stale, "bank-a", oracletypes.SuspensionScopeChain, types.ReasonGovernance, "incident"); err != nil {
+					return false
+				}
+			}
+
+			fresh, err := msgServer.ChainHeartbeat(stale, heartbeat)
+			if err != nil || fresh.Resumed == governance {
+				return false
+			}
+			_, suspended = oracleKeeper.GetSuspension(stale, "bank-a")
+			health, found := oracleKeeper.GetChainHealth(stale, "bank-a")
+			return suspended == governance && found && health.Healthy != governance &&
+				health.Heartbeat.GatewayBalance.Equal(math.NewInt(5000))
+		},
+		gen.IntRange(1, 5),
+		gen.Int64Range(1, 3600),
+		gen.Bool(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 39: 엄격/관대 이벤트 검증**
+// **검증: 요구사항 3.1 - 엄격 모드는 정규 형식이 아닌 이체 이벤트와 알 수 없는 필드를 거부하고, 관대 모드는 이를 정규화해 수락하는지 검증**
+func TestProperty_EventValidation_StrictRejectsPermissiveNormalizes(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("strict mode rejects what permissive mode normalizes", prop.ForAll(
+		func(transferEvent types.TransferEvent, senderBytes, recipientBytes []byte, validatorCount int) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, validatorCount)
+			validators := generateValidators(validatorCount)
+			setupValidators(ctx, stakingKeeper, validators)
+			oracleKeeper.SetNettingKeeper(NewMockNettingKeeper())
+
+			// Validators sign the canonical form of the event they report
+			vote := func(event types.TransferEvent, validator types.Validator) error {
+				signed, err := oracletypes.CheckTransferEvent(event, false)
+				if err != nil {
+					signed = event
+				}
+				return oracleKeeper.SubmitVote(ctx, types.Vote{
+					TxHash:           event.TxHash,
+					Validator:        validator.Address,
+					EventData:        event,
+					Signature:        signVote(ctx, stakingKeeper, validator.Address, signed),
+					SignatureVersion: oracletypes.CurrentSignatureVersion,
+				})
+			}
+
+			sender := ethcommon.BytesToAddress(senderBytes)
+			recipient := ethcommon.BytesToAddress(recipientBytes)
+			lenient := transferEvent
+			lenient.Sender = " " + strings.ToLower(sender.Hex()) + " "
+			lenient.Recipient = strings.ToLower(recipient.Hex())
+			canonical := transferEvent
+			canonical.Sender = sender.Hex()
+			canonical.Recipient = recipient.Hex()
+
+			// Strict mode rejects non-canonical and zero addresses
+			params := oracletypes.DefaultParams()
+			params.StrictEventValidation = true
+			oracleKeeper.SetParams(ctx, params)
+			zero := canonical
+			zero.Sender = ethcommon.Address{}.Hex()
+			for _, event := range []types.TransferEvent{lenient, zero, transferEvent} {
+				if err := vote(event, validators[0]); !errors.Is(err, oracletypes.ErrInvalidEventData) {
+					return false
+				}
+			}
+
+			// Batch payloads with unknown fields are rejected only in strict mode
+			bz, err := proto.Marshal(&oracletypes.VoteBatch{Votes: []oracletypes.BatchVoteEntry{{TxHash: canonical.TxHash, EventData: canonical}}})
+			if err != nil {
+				return false
+			}
+			var payload bytes.Buffer
+			gz := gzip.NewWriter(&payload)
+			gz.Write(append(bz, 0xf8, 0x07, 0x01)) // Field 127, varint 1
+			gz.Close()
+			if _, err := oracletypes.DecodeVoteBatch(oracletypes.PayloadEncodingGzip, payload.Bytes(), params.MaxBatchBytes, true); !errors.Is(err, oracletypes.ErrInvalidEventData) {
+				return false
+			}
+			if entries, err := oracletypes.DecodeVoteBatch(oracletypes.PayloadEncodingGzip, payload.Bytes(), params.MaxBatchBytes, false); err != nil || len(entries) != 1 {
+				return false
+			}
+
+			// The canonical event passes strict mode, and permissive mode
+			// normalizes the lenient votes so they agree with it
+			if err := vote(canonical, validators[0]); err != nil {
+				return false
+			}
+			oracleKeeper.SetParams(ctx, oracletypes.DefaultParams())
+			for _, validator := range validators[1:] {
+				if err := vote(lenient, validator); err != nil && !errors.Is(err, oracletypes.ErrTransferAlreadyConfirmed) {
+					return false
+				}
+			}
+
+			voteStatus, _ := oracleKeeper.GetVoteStatus(ctx, transferEvent.TxHash)
+			for _, stored := range voteStatus.Votes {
+				if stored.EventData.Sender != canonical.Sender || stored.EventData.Recipient != canonical.Recipient {
+					return false
+				}
+			}
+			confirmed, found := oracleKeeper.GetConfirmedTransfer(ctx, transferEvent.TxHash)
+			return found && confirmed.Sender == canonical.Sender
+		},
+		testhelpers.GenTransferEvent(),
+		gen.SliceOfN(20, gen.UInt8()).SuchThat(func(b []byte) bool { return !bytes.Equal(b, make([]byte, 20)) }),
+		gen.SliceOfN(20, gen.UInt8()).SuchThat(func(b []byte) bool { return !bytes.Equal(b, make([]byte, 20)) }),
+		gen.IntRange(1, 5),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 40: 비잔틴 릴레이어 탐지**
+// **검증: 요구사항 3.1 - 합의 내용과 다른 첫 보고가 반복된 검증자가 표시되고, 게이트가 켜지면 표시된 검증자의 첫 보고가 확정 내용을 정하지 못하는지 검증**
+func TestProperty_Reporters_FlagsMismatchingFirstReports(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("repeated first report mismatches flag the reporter", prop.ForAll(
+		func(transferEvent types.TransferEvent, limit uint32) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, 4)
+			validators := generateValidators(4)
+			setupValidators(ctx, stakingKeeper, validators)
+			msgServer := keeper.NewMsgServerImpl(*oracleKeeper)
+			querier := keeper.NewQueryServerImpl(*oracleKeeper)
+
+			params := oracletypes.DefaultParams()
+			params.ReporterMismatchLimit = limit
+			oracleKeeper.SetParams(ctx, params)
+
+			// The byzantine validator reports a larger amount first; the
+			// other votes carry the event and reach the threshold of 3
+			byzantine := validators[0].Address
+			report := func(i uint32, first types.Validator) types.TransferEvent {
+				event := transferEvent
+				event.TxHash = fmt.Sprintf("%s-%d", transferEvent.TxHash, i)
+				tampered := event
+				tampered.Amount = event.Amount.AddRaw(1)
+				for _, validator := range []types.Validator{first, validators[1], validators[2]} {
+					data := event
+					if validator.Address == byzantine {
+						data = tampered
+					}
+					_ = oracleKeeper.SubmitVote(ctx, types.Vote{
+						TxHash:           event.TxHash,
+						Validator:        validator.Address,
+						EventData:        data,
+						Signature:        signVote(ctx, stakingKeeper, validator.Address, data),
+						SignatureVersion: oracletypes.CurrentSignatureVersion,
+					})
+				}
+				return event
+			}
+
+			// An honest first reporter is counted without mismatches
+			report(0, validators[3])
+			for i := uint32(1); i <= limit; i++ {
+				record, _ := oracleKeeper.GetReporter(ctx, byzantine)
+				if record.Flagged {
+					return false
+				}
+				event := report(i, validators[0])
+				confirmed, found := oracleKeeper.GetConfirmedTransfer(ctx, event.TxHash)
+				if !found || confirmed.Amount.Equal(event.Amount) {
+					return false // Without the gate the first report is still confirmed
+				}
+			}
+
+			record, found := oracleKeeper.GetReporter(ctx, byzantine)
+			if !found || !record.Flagged || record.Mismatches != uint64(limit) || record.FirstReports != uint64(limit) ||
+				record.LastMismatchTx != fmt.Sprintf("%s-%d", transferEvent.TxHash, limit) {
+				return false
+			}
+			honest, found := oracleKeeper.GetReporter(ctx, validators[3].Address)
+			if !found || honest.Flagged || honest.FirstReports != 1 || honest.Mismatches != 0 {
+				return false
+			}
+			resp, err := querier.Reporters(ctx, &oracletypes.QueryReportersRequest{FlaggedOnly: true})
+			if err != nil || len(resp.Reporters) != 1 || resp.Reporters[0].Validator != byzantine || resp.MismatchLimit != limit {
+				return false
+			}
+
+			// With the gate, the content of the flagged first reporter is outvoted
+			params.GateFlaggedReporters = true
+			oracleKeeper.SetParams(ctx, params)
+			event := report(limit+1, validators[0])
+			confirmed, found := oracleKeeper.GetConfirmedTransfer(ctx, event.TxHash)
+			if !found || !confirmed.Amount.Equal(event.Amount) {
+				return false
+			}
+
+			// Only the authority clears the flag
+			clear := oracletypes.NewMsgClearReporterFlag(byzantine, byzantine)
+			if _, err := msgServer.ClearReporterFlag(ctx, clear); !errors.Is(err, oracletypes.ErrUnauthorized) {
+				return false
+			}
+			clear.Authority = oracleKeeper.GetAuthority()
+			cleared, err := msgServer.ClearReporterFlag(ctx, clear)
+			if err != nil || cleared.Reporter.Flagged || cleared.Reporter.Mismatches != 0 || cleared.Reporter.FirstReports != uint64(limit)+1 {
+				return false
+			}
+			_, err = querier.Reporters(ctx, &oracletypes.QueryReportersRequest{Validator: "unknown"})
+			return errors.Is(err, oracletypes.ErrReporterNotFound)
+		},
+		testhelpers.GenTransferEvent(),
+		gen.UInt32Range(1, 4),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 41: 모듈 기능 플래그**
+// **검증: 요구사항 3.2 - 가중 합의, 엄격 초과인출, 2단계 크레딧 해제가 파라미터로 켜질 때만 동작하는지 검증**
+func TestProperty_FeatureFlags_GateSubsystems(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("feature flags switch subsystems per network", prop.ForAll(
+		func(transferEvent types.TransferEvent, weight int64) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, 4)
+			validators := generateValidators(4)
+			setupValidators(ctx, stakingKeeper, validators)
+			nettingKeeper := NewMockNettingKeeper()
+			multisigKeeper := &MockMultisigKeeper{}
+			oracleKeeper.SetNettingKeeper(nettingKeeper)
+			oracleKeeper.SetMultisigKeeper(multisigKeeper)
+
+			vote := func(event types.TransferEvent, voters ...types.Validator) {
+				for _, validator := range voters {
+					_ = oracleKeeper.SubmitVote(ctx, types.Vote{
+						TxHash:           event.TxHash,
+						Validator:        validator.Address,
+						EventData:        event,
+						Signature:        signVote(ctx, stakingKeeper, validator.Address, event),
+						SignatureVersion: oracletypes.CurrentSignatureVersion,
+					})
+				}
+			}
+			confirmed := func(event types.TransferEvent) bool {
+				_, found := oracleKeeper.GetConfirmedTransfer(ctx, event.TxHash)
+				return found
+			}
+			event := func(suffix string) types.TransferEvent {
+				e := transferEvent
+				e.TxHash = transferEvent.TxHash + "-" + suffix
+				return e
+			}
+
+			// Weighted consensus: the first validator holds more than 2/3 of
+			// the bonded tokens, the three others fall short of it
+			for i, validator := range validators {
+				stakingValidator := stakingKeeper.stakingValidator[validator.Address]
+				stakingValidator.Tokens = math.NewInt(1)
+				if i == 0 {
+					stakingValidator.Tokens = math.NewInt(weight)
+				}
+				stakingKeeper.stakingValidator[validator.Address] = stakingValidator
+			}
+			params := oracletypes.DefaultParams()
+			params.WeightedConsensus = true
+			oracleKeeper.SetParams(ctx, params)
+
+			light := event("light")
+			vote(light, validators[1:]...)
+			if confirmed(light) {
+				return false
+			}
+			vote(light, validators[0])
+			if !confirmed(light) {
+				return false
+			}
+			heavy := event("heavy")
+			vote(heavy, validators[0])
+			if !confirmed(heavy) {
+				return false
+			}
+
+			// Strict overdraft holds transfers leaving the source bank a net debtor
+			oracleKeeper.SetParams(ctx, oracletypes.DefaultParams())
+			nettingKeeper.positions = []types.NetPositionForecast{{
+				Bank:      transferEvent.SourceChain,
+				Confirmed: math.NewInt(3),
+				Projected: math.NewInt(-5),
+			}}
+			unchecked := event("unchecked")
+			vote(unchecked, validators[:3]...)
+			if !confirmed(unchecked) {
+				return false
+			}
+			params = oracletypes.DefaultParams()
+			params.StrictOverdraft = true
+			oracleKeeper.SetParams(ctx, params)
+			overdrawn := event("overdrawn")
+			vote(overdrawn, validators[:3]...)
+			held, found := oracleKeeper.GetHeldTransfer(ctx, overdrawn.TxHash)
+			if confirmed(overdrawn) || !found || !held.Overdraft || !held.Cap.Equal(math.NewInt(3)) {
+				return false
+			}
+			nettingKeeper.positions = nil
+
+			// Two-phase release keeps the credit frozen until the mint is executed
+			params = oracletypes.DefaultParams()
+			params.TwoPhaseCreditRelease = true
+			oracleKeeper.SetParams(ctx, params)
+			pending := event("pending")
+			vote(pending, validators[:3]...)
+			credit, err := oracleKeeper.GetConfirmedTransferCredit(ctx, pending.TxHash)
+			if err != nil {
+				return false
+			}
+			release, found := oracleKeeper.GetCreditRelease(ctx, pending.TxHash)
+			if !found || !release.Amount.Equal(pending.Amount) || !nettingKeeper.getFrozen(credit.HolderBank, credit.Denom).Equal(pending.Amount) {
+				return false
+			}
+			if oracleKeeper.ReleaseExecutedCredit(ctx) != 0 {
+				return false
+			}
+			for i := range multisigKeeper.commands {
+				if multisigKeeper.commands[i].CommandID == release.CommandID {
+					multisigKeeper.commands[i].Status = int32(types.CommandStatusExecuted)
+				}
+			}
+			if oracleKeeper.ReleaseExecutedCredit(ctx) != 1 || !nettingKeeper.getFrozen(credit.HolderBank, credit.Denom).IsZero() {
+				return false
+			}
+			_, found = oracleKeeper.GetCreditRelease(ctx, pending.TxHash)
+			return !found && len(oracleKeeper.GetAllCreditReleases(ctx)) == 0
+		},
+		testhelpers.GenTransferEvent(),
+		gen.Int64Range(7, 1000),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 42: 메시지 및 감사 로그 크기 제한**
+// **검증: 요구사항 7.1 - 한도를 넘는 이벤트 필드, 사유, 감사 로그 상세가 전용 오류로 거부되는지 검증**
+func TestProperty_SizeLimits_RejectOversizedContent(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("oversized votes, reasons and audit logs are rejected", prop.ForAll(
+		func(transferEvent types.TransferEvent, excess int) bool {
+			ctx, oracleKeeper, _ := setupTestEnvironment(t, 3)
+			validator := sdk.AccAddress([]byte("validator")).String()
+			oversized := strings.Repeat("x", types.MaxFieldLength+excess)
+
+			vote := func(event types.TransferEvent) error {
+				return oracletypes.NewMsgVote(event.TxHash, validator, event, []byte{1}).ValidateBasic()
+			}
+			if err := vote(transferEvent); err != nil {
+				return false
+			}
+			for _, oversize := range []func(*types.TransferEvent){
+				func(e *types.TransferEvent) { e.TxHash = oversized },
+				func(e *types.TransferEvent) { e.Sender = oversized },
+				func(e *types.TransferEvent) { e.Recipient = oversized },
+				func(e *types.TransferEvent) { e.SourceChain = oversized },
+				func(e *types.TransferEvent) { e.DestChain = oversized },
+			} {
+				event := transferEvent
+				oversize(&event)
+				if !errors.Is(vote(event), oracletypes.ErrFieldTooLong) {
+					return false
+				}
+			}
+
+			reason := strings.Repeat("r", types.MaxReasonLength+excess)
+			if !errors.Is(oracletypes.NewMsgReportReorg(validator, transferEvent.TxHash, reason).ValidateBasic(), oracletypes.ErrFieldTooLong) {
+				return false
+			}
+
+			// Audit logs over the limits are not stored
+			details := make(map[string]string, types.MaxAuditLogDetails+excess)
+			for i := 0; i < types.MaxAuditLogDetails+excess; i++ {
+				details[fmt.Sprintf("key_%d", i)] = "value"
+			}
+			for _, log := range []types.AuditLog{
+				{EventType: types.EventTypeTransferConfirmed, TxHash: transferEvent.TxHash, Details: details},
+				{EventType: types.EventTypeTransferConfirmed, TxHash: transferEvent.TxHash, Details: map[string]string{"reason": strings.Repeat("d", types.MaxAuditLogDetailLength+excess)}},
+				{EventType: types.EventTypeTransferConfirmed, TxHash: oversized},
+			} {
+				if _, err := oracleKeeper.SaveAuditLog(ctx, log); !errors.Is(err, oracletypes.ErrAuditLogTooLarge) {
+					return false
+				}
+			}
+			return len(oracleKeeper.GetAllAuditLogs(ctx)) == 0
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(1, 64),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 43: 체인별 완결성 모델**
+// **검증: 요구사항 6.1 - 확률적 완결성 체인은 확인 깊이까지 투표를 거부하고, 즉시 완결성 체인은 재구성 신고를 거부하는지 검증**
+func TestProperty_ChainFinality_GatesVotesAndReorgs(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("votes wait for the confirmation depth and instant chains cannot reorg", prop.ForAll(
+		func(transferEvent types.TransferEvent, depth uint64, blockHeight uint64) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, 1)
+			validators := generateValidators(1)
+			setupValidators(ctx, stakingKeeper, validators)
+			oracleKeeper.SetNettingKeeper(NewMockNettingKeeper())
+			transferEvent.BlockHeight = blockHeight
+
+			params := oracleKeeper.GetParams(ctx)
+			params.ChainFinality = []oracletypes.ChainFinality{
+				{Chain: transferEvent.SourceChain, Model: oracletypes.FinalityModelProbabilistic, ConfirmationDepth: depth},
+				{Chain: transferEvent.DestChain, Model: oracletypes.FinalityModelInstant},
+			}
+			if params.Validate() != nil {
+				return false
+			}
+			oracleKeeper.SetParams(ctx, params)
+
+			vote := types.Vote{
+				TxHash:           transferEvent.TxHash,
+				Validator:        validators[0].Address,
+				EventData:        transferEvent,
+				Signature:        signVote(ctx, stakingKeeper, validators[0].Address, transferEvent),
+				SignatureVersion: oracletypes.CurrentSignatureVersion,
+				VoteTime:         ctx.BlockTime().Unix(),
+			}
+
+			// Without a heartbeat, and one block short of the depth, the vote is retried later
+			if !errors.Is(oracleKeeper.SubmitVote(ctx, vote), oracletypes.ErrInsufficientConfirmations) {
+				return false
+			}
+			short := int64(blockHeight + depth - 1)
+			if _, err := oracleKeeper.RecordChainHeartbeat(ctx, validators[0].Address, transferEvent.SourceChain, short, math.ZeroInt(), false); err != nil {
+				return false
+			}
+			if !errors.Is(oracleKeeper.SubmitVote(ctx, vote), oracletypes.ErrInsufficientConfirmations) {
+				return false
+			}
+			if _, err := oracleKeeper.RecordChainHeartbeat(ctx, validators[0].Address, transferEvent.SourceChain, short+1, math.ZeroInt(), false); err != nil {
+				return false
+			}
+			if err := oracleKeeper.SubmitVote(ctx, vote); err != nil {
+				return false
+			}
+
+			// The probabilistic source chain reports reorgs
+			if _, err := oracleKeeper.ReportReorg(ctx, validators[0].Address, transferEvent.TxHash, "reorg"); err != nil {
+				return false
+			}
+
+			// A transfer from the instant destination chain is voted at once and cannot be disputed
+			reverse := transferEvent
+			reverse.TxHash = transferEvent.TxHash + "-reverse"
+			reverse.SourceChain, reverse.DestChain = transferEvent.DestChain, transferEvent.SourceChain
+			submitVotes(ctx, oracleKeeper, reverse, validators, stakingKeeper)
+			if status, found := oracleKeeper.GetVoteStatus(ctx, reverse.TxHash); !found || !status.Confirmed {
+				return false
+			}
+			_, err := oracleKeeper.ReportReorg(ctx, validators[0].Address, reverse.TxHash, "reorg")
+			return errors.Is(err, oracletypes.ErrReorgHandlingDisabled)
+		},
+		testhelpers.GenTransferEvent(),
+		gen.UInt64Range(1, 64),
+		gen.UInt64Range(0, 1_000_000),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 44: 거부된 이체 증거 보존**
+// **검증: 요구사항 3.4 - 거부된 이체가 최종 집계와 개별 투표를 증거로 저장하는지 검증**
+func TestProperty_RejectTransfer_PersistsVoteEvidence(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("a rejected transfer keeps its final tally and every vote", prop.ForAll(
+		func(transferEvent types.TransferEvent, validatorCount, voters int) bool {
+			ctx, oracleKeeper, stakingKeeper := setupTestEnvironment(t, validatorCount)
+			validators := generateValidators(validatorCount)
+			setupValidators(ctx, stakingKeeper, validators)
+			oracleKeeper.SetNettingKeeper(NewMockNettingKeeper())
+
+			// Fewer votes than the threshold, the last one on different content
+			threshold := int(types.ConsensusThreshold(validatorCount))
+			voters = voters%(threshold-1) + 1
+			for i, validator := range validators[:voters] {
+				eventData := transferEvent
+				if i > 0 && i == voters-1 {
+					eventData.Amount = transferEvent.Amount.AddRaw(1)
+				}
+				vote := types.Vote{
+					TxHash:           transferEvent.TxHash,
+					Validator:        validator.Address,
+					EventData:        eventData,
+					Signature:        signVote(ctx, stakingKeeper, validator.Address, eventData),
+					SignatureVersion: oracletypes.CurrentSignatureVersion,
+					VoteTime:         ctx.BlockTime().Unix(),
+				}
+				if err := oracleKeeper.SubmitVote(ctx, vote); err != nil {
+					return false
+				}
+			}
+			status, _ := oracleKeeper.GetVoteStatus(ctx, transferEvent.TxHash)
+
+			querier := keeper.NewQueryServerImpl(*oracleKeeper)
+			_, err := querier.RejectedTransfer(ctx, &oracletypes.QueryRejectedTransferRequest{TxHash: transferEvent.TxHash})
+			if !errors.Is(err, oracletypes.ErrRejectedTransferNotFound) {
+				return false
+			}
+
+			if err := oracleKeeper.RejectTransfer(ctx, transferEvent.TxHash, types.ReasonConsensusTimeout, "consensus timeout"); err != nil {
+				return false
+			}
+			res, err := querier.RejectedTransfer(ctx, &oracletypes.QueryRejectedTransferRequest{TxHash: transferEvent.TxHash})
+			if err != nil {
+				return false
+			}
+			rejected := res.RejectedTransfer
+
+			agreeing := voters
+			if voters > 1 {
+				agreeing--
+			}
+			if rejected.TxHash != transferEvent.TxHash || rejected.VoteCount != int32(voters) ||
+				rejected.Threshold != int32(threshold) || rejected.AgreeingVotes != int32(agreeing) ||
+				rejected.ReasonCode != types.ReasonConsensusTimeout || rejected.RejectedHeight != ctx.BlockHeight() {
+				return false
+			}
+			if !rejected.EventData.Amount.Equal(transferEvent.Amount) || len(rejected.Votes) != voters {
+				return false
+			}
+			for i, vote := range rejected.Votes {
+				if vote.Validator != validators[i].Address || !bytes.Equal(vote.Signature, status.Votes[i].Signature) {
+					return false
+				}
+			}
+
+			// The vote status is left as it was
+			after, _ := oracleKeeper.GetVoteStatus(ctx, transferEvent.TxHash)
+			return !after.Confirmed && after.VoteCount == int32(voters)
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(4, 10),
+		gen.IntRange(0, 100),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 45: 메시지 카탈로그 완전성**
+// **검증: 요구사항 7.4 - 모든 오류 코드와 사유 코드가 로케일별 메시지를 가지는지 검증**
+func TestProperty_MessageCatalog_CoversEveryCode(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	// Last error code of each module
+	codespaces := []struct {
+		name    string
+		maxCode uint32
+	}{
+		{oracletypes.ModuleName, 34},
+		{nettingtypes.ModuleName, 24},
+		{multisigtypes.ModuleName, 26},
+	}
+
+	properties.Property("every error and reason code has a message in every locale", prop.ForAll(
+		func(module, code int, korean bool) bool {
+			ctx, oracleKeeper, _ := setupTestEnvironment(t, 4)
+			querier := keeper.NewQueryServerImpl(*oracleKeeper)
+
+			locale := types.LocaleEnglish
+			if korean {
+				locale = types.LocaleKorean
+			}
+			if _, err := querier.MessageCatalog(ctx, &oracletypes.QueryMessageCatalogRequest{Locale: "fr"}); err == nil {
+				return false
+			}
+			res, err := querier.MessageCatalog(ctx, &oracletypes.QueryMessageCatalogRequest{Locale: locale})
+			if err != nil || res.Locale != locale {
+				return false
+			}
+
+			// A registered error of the module
+			codespace := codespaces[module%len(codespaces)]
+			abciCode := uint32(code)%codespace.maxCode + 1
+			registered := errorsmod.ABCIError(codespace.name, abciCode, "detail")
+
+			var entry *types.CatalogEntry
+			for i := range res.Entries {
+				e := res.Entries[i]
+				if e.Kind == types.CatalogKindError && e.Codespace == codespace.name && e.Code == abciCode {
+					entry = &e
+					break
+				}
+			}
+			if entry == nil || entry.Fallback || entry.Message == "" {
+				return false
+			}
+			if locale == types.LocaleEnglish && registered.Error() != "detail: "+entry.Message {
+				return false
+			}
+			if types.LocalizeError(registered, locale) != entry.Message {
+				return false
+			}
+
+			// Every reason code follows the errors
+			reasons := 0
+			for _, e := range res.Entries {
+				if e.Kind == types.CatalogKindReason {
+					if !e.ReasonCode.IsValid() || e.Fallback || e.Message == "" {
+						return false
+					}
+					reasons++
+				}
+			}
+			return reasons == 6
+		},
+		gen.IntRange(0, 2),
+		gen.IntRange(0, 100),
+		gen.Bool(),
+	))
+
+	properties.TestingRun(t)
+}
+
+// **Feature: interbank-netting-engine, Property 46: 투표 내용 일치 수수료 환급**
+// **검증: 요구사항 3.2 - 확정된 이벤트와 내용이 다른 투표의 수수료는 환급되지 않고, 환급 기간이 파라미터를 따르는지 검증**
+func TestProperty_VoteFeeRefund_OnlyMatchingVotes(t *testing.T) {
+	properties := testhelpers.NewPropertyTester(t)
+
+	properties.Property("fees of votes for other content are not refunded", prop.ForAll(
+		func(transferEvent types.TransferEvent, validatorCount int, window int64) bool {
+			ctx, oracleKeeper, stakingKeeper, bankKeeper := setupTestEnvironmentWithBank(t)
+			validators := generateValidators(validatorCount)
+			setupValidators(ctx, stakingKeeper, validators)
+			fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+			params := oracleKeeper.GetParams(ctx)
+			params.VoteRefundWindow = window
+			if params.Validate() != nil {
+				return false
+			}
+			oracleKeeper.SetParams(ctx, params)
+
+			// Fees are kept for the whole window and pruned the block after
+			expired := transferEvent.TxHash + "-expired"
+			oracleKeeper.RecordVoteFee(ctx, expired, validators[0].Address, sdk.AccAddress([]byte("expired-payer")), fee)
+			if oracleKeeper.PruneExpiredVoteFees(ctx.WithBlockHeight(ctx.BlockHeight()+window)) != 0 {
+				return false
+			}
+			if oracleKeeper.PruneExpiredVoteFees(ctx.WithBlockHeight(ctx.BlockHeight()+window+1)) != 1 {
+				return false
+			}
+
+			// The second validator votes a different amount under a valid signature
+			mismatched := transferEvent
+			mismatched.Amount = transferEvent.Amount.AddRaw(1)
+
+			voted := make([]bool, validatorCount)
+			for i, validator := range validators {
+				event := transferEvent
+				if i == 1 {
+					event = mismatched
+				}
+				err := oracleKeeper.SubmitVote(ctx, types.Vote{
+					TxHash:           event.TxHash,
+					Validator:        validator.Address,
+					EventData:        event,
+					Signature:        signVote(ctx, stakingKeeper, validator.Address, event),
+					SignatureVersion: oracletypes.CurrentSignatureVersion,
+					VoteTime:         ctx.BlockTime().Unix(),
+				})
+				if err != nil {
+					continue
+				}
+				voted[i] = true
+				oracleKeeper.RecordVoteFee(ctx, event.TxHash, validator.Address, sdk.AccAddress([]byte{byte(i + 1)}), fee)
+			}
+
+			if status, found := oracleKeeper.GetVoteStatus(ctx, transferEvent.TxHash); !found || !status.Confirmed {
+				return false
+			}
+			for i := range validators {
+				refund := bankKeeper.refunds[sdk.AccAddress([]byte{byte(i + 1)}).String()]
+				if i == 1 || !voted[i] {
+					if !refund.IsZero() {
+						return false
+					}
+					continue
+				}
+				if !refund.Equal(fee) {
+					return false
+				}
+			}
+
+			_, pending := oracleKeeper.GetVoteFee(ctx, transferEvent.TxHash, validators[1].Address)
+			return voted[1] && !pending
+		},
+		testhelpers.GenTransferEvent(),
+		gen.IntRange(2, 9),
+		gen.Int64Range(1, 20),
+	))
+
+	properties.TestingRun(t)
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	commontypes "github.com/interbank-netting/cosmos/types"
+	"github.com/interbank-netting/cosmos/x/oracle/types"
+)
+
+// Migrator runs the in-place store migrations of the oracle module
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a Migrator for the keeper
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 moves the store to the collections layout. Version 2 keyed
+// votes and vote fees by "txHash/validator" and kept full audit log copies
+// in its time and type indexes, so votes and vote fees are re-keyed by
+// (tx hash, validator) pairs, with their height index, and the audit log
+// indexes are rebuilt. Version 2 params get the default vote refund window.
+// All other entries are already in the collections encoding.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+
+	var votes []commontypes.Vote
+	for _, bz := range takeLegacyEntries(store, types.VoteKeyPrefix) {
+		var vote commontypes.Vote
+		if err := m.keeper.cdc.Unmarshal(bz, &vote); err != nil {
+			return err
+		}
+		votes = append(votes, vote)
+	}
+	for _, vote := range votes {
+		m.keeper.setVote(ctx, vote)
+	}
+
+	var voteFees []types.VoteFee
+	for _, bz := range takeLegacyEntries(store, types.VoteFeeKeyPrefix) {
+		var voteFee types.VoteFee
+		if err := m.keeper.cdc.Unmarshal(bz, &voteFee); err != nil {
+			return err
+		}
+		voteFees = append(voteFees, voteFee)
+	}
+	for _, voteFee := range voteFees {
+		m.keeper.setVoteFee(ctx, voteFee)
+	}
+
+	params, err := m.keeper.Params.Get(ctx)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+	case err != nil:
+		return err
+	case params.VoteRefundWindow == 0:
+		params.VoteRefundWindow = types.DefaultParams().VoteRefundWindow
+		m.keeper.SetParams(ctx, params)
+	}
+
+	takeLegacyEntries(store, types.AuditLogByTimeKeyPrefix)
+	takeLegacyEntries(store, types.AuditLogByTypeKeyPrefix)
+	for _, log := range m.keeper.GetAllAuditLogs(ctx) {
+		if err := m.keeper.AuditLogs.Set(ctx, log.ID, log); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// takeLegacyEntries deletes every entry under prefix and returns their values
+func takeLegacyEntries(store storetypes.KVStore, prefix []byte) [][]byte {
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	var keys, values [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return values
+}
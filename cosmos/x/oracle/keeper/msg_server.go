@@ -2,7 +2,10 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	commontypes "github.com/interbank-netting/cosmos/types"
 	"github.com/interbank-netting/cosmos/x/oracle/types"
@@ -22,19 +25,75 @@ var _ types.MsgServer = msgServer{}
 
 // Vote handles MsgVote messages
 func (k msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVoteResponse, error) {
+	return k.vote(sdk.UnwrapSDKContext(goCtx), msg)
+}
+
+// BatchVote handles MsgBatchVote messages. The payload is bounded before it
+// is decompressed, and every vote is validated before any is submitted, so a
+// malformed vote rejects the whole batch.
+func (k msgServer) BatchVote(goCtx context.Context, msg *types.MsgBatchVote) (*types.MsgBatchVoteResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.Keeper.GetParams(ctx)
+
+	if size := int64(len(msg.Payload)); size > params.MaxBatchPayloadBytes {
+		return nil, errorsmod.Wrapf(types.ErrBatchTooLarge, "payload of %d bytes, limit %d", size, params.MaxBatchPayloadBytes)
+	}
+
+	entries, err := types.DecodeVoteBatch(msg.Encoding, msg.Payload, params.MaxBatchBytes, params.StrictEventValidation)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidBatch, "batch has no votes")
+	}
+	if count := int32(len(entries)); count > params.MaxBatchVotes {
+		return nil, errorsmod.Wrapf(types.ErrBatchTooLarge, "batch of %d votes, limit %d", count, params.MaxBatchVotes)
+	}
+
+	for i, entry := range entries {
+		if err := entry.Validate(); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidBatch, "vote %d: %s", i, err)
+		}
+	}
+
+	response := &types.MsgBatchVoteResponse{Results: make([]types.MsgVoteResponse, 0, len(entries))}
+	for i, entry := range entries {
+		result, err := k.vote(ctx, &types.MsgVote{
+			TxHash:           entry.TxHash,
+			Validator:        msg.Validator,
+			EventData:        entry.EventData,
+			Signature:        entry.Signature,
+			SignatureVersion: entry.SignatureVersion,
+		})
+		switch {
+		case errors.Is(err, types.ErrDuplicateVote), errors.Is(err, types.ErrTransferAlreadyConfirmed):
+			// Relayers replay events until they are accepted, so replays in a
+			// batch are skipped rather than failing the votes around them
+			result = &types.MsgVoteResponse{CreditBalance: math.ZeroInt()}
+		case err != nil:
+			return nil, errorsmod.Wrapf(err, "vote %d", i)
+		}
+		response.Results = append(response.Results, *result)
+	}
+
+	return response, nil
+}
 
+// vote submits one vote and reports what its confirmation produced, if any
+func (k msgServer) vote(ctx sdk.Context, msg *types.MsgVote) (*types.MsgVoteResponse, error) {
 	// Create vote from message
 	vote := commontypes.Vote{
-		TxHash:    msg.TxHash,
-		Validator: msg.Validator,
-		EventData: msg.EventData,
-		Signature: msg.Signature,
-		VoteTime:  ctx.BlockTime().Unix(),
+		TxHash:           msg.TxHash,
+		Validator:        msg.Validator,
+		EventData:        msg.EventData,
+		Signature:        msg.Signature,
+		VoteTime:         ctx.BlockTime().Unix(),
+		SignatureVersion: msg.SignatureVersion,
 	}
 
 	// Submit the vote
-	if err := k.Keeper.SubmitVote(ctx, vote); err != nil {
+	result, err := k.Keeper.submitVote(ctx, vote)
+	if err != nil {
 		return nil, err
 	}
 
@@ -44,8 +103,174 @@ func (k msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVo
 		return nil, err
 	}
 
-	return &types.MsgVoteResponse{
-		Success:   true,
-		Consensus: consensus,
+	response := &types.MsgVoteResponse{
+		Success:       true,
+		Consensus:     consensus,
+		CreditBalance: math.ZeroInt(),
+	}
+
+	// Report what this vote's confirmation produced
+	if result != nil {
+		response.Denom = result.Denom
+		response.CreditBalance = result.CreditBalance
+		response.CommandIDs = result.CommandIDs
+		response.Held = result.Held
+	}
+
+	return response, nil
+}
+
+// UpdateParams handles MsgUpdateParams messages
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.Keeper.UpdateParams(ctx, msg.Authority, msg.Params, msg.ProposalID); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// ApproveHeldTransfer handles MsgApproveHeldTransfer messages
+func (k msgServer) ApproveHeldTransfer(goCtx context.Context, msg *types.MsgApproveHeldTransfer) (*types.MsgApproveHeldTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	result, err := k.Keeper.ApproveHeldTransfer(ctx, msg.TxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveHeldTransferResponse{
+		Denom:         result.Denom,
+		CreditBalance: result.CreditBalance,
+		CommandIDs:    result.CommandIDs,
 	}, nil
-}
\ No newline at end of file
+}
+
+// RejectHeldTransfer handles MsgRejectHeldTransfer messages
+func (k msgServer) RejectHeldTransfer(goCtx context.Context, msg *types.MsgRejectHeldTransfer) (*types.MsgRejectHeldTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RejectHeldTransfer(ctx, msg.TxHash, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRejectHeldTransferResponse{}, nil
+}
+
+// ReportReorg handles MsgReportReorg messages
+func (k msgServer) ReportReorg(goCtx context.Context, msg *types.MsgReportReorg) (*types.MsgReportReorgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	dispute, err := k.Keeper.ReportReorg(ctx, msg.Reporter, msg.TxHash, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReportReorgResponse{
+		Denom:        dispute.Denom,
+		FrozenAmount: dispute.FrozenAmount,
+	}, nil
+}
+
+// ResolveDispute handles MsgResolveDispute messages
+func (k msgServer) ResolveDispute(goCtx context.Context, msg *types.MsgResolveDispute) (*types.MsgResolveDisputeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	dispute, err := k.Keeper.ResolveDispute(ctx, msg.TxHash, msg.ReorgConfirmed, msg.Resolution)
+	if err != nil {
+		return nil, err
+	}
+
+	burned := math.ZeroInt()
+	if dispute.Status == types.DisputeStatusReorgConfirmed {
+		burned = dispute.FrozenAmount
+	}
+
+	return &types.MsgResolveDisputeResponse{
+		Status:       dispute.Status,
+		BurnedAmount: burned,
+	}, nil
+}
+
+// Suspend handles MsgSuspend messages
+func (k msgServer) Suspend(goCtx context.Context, msg *types.MsgSuspend) (*types.MsgSuspendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.Keeper.Suspend(ctx, msg.Target, msg.Scope, commontypes.ReasonGovernance, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSuspendResponse{}, nil
+}
+
+// Resume handles MsgResume messages
+func (k msgServer) Resume(goCtx context.Context, msg *types.MsgResume) (*types.MsgResumeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	confirmed, err := k.Keeper.Resume(ctx, msg.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResumeResponse{Confirmed: confirmed}, nil
+}
+
+// ChainHeartbeat handles MsgChainHeartbeat messages
+func (k msgServer) ChainHeartbeat(goCtx context.Context, msg *types.MsgChainHeartbeat) (*types.MsgChainHeartbeatResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resumed, err := k.Keeper.RecordChainHeartbeat(ctx, msg.Reporter, msg.Chain, msg.BesuHeight, msg.GatewayBalance, msg.Syncing)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChainHeartbeatResponse{Resumed: resumed}, nil
+}
+
+// ClearReporterFlag handles MsgClearReporterFlag messages
+func (k msgServer) ClearReporterFlag(goCtx context.Context, msg *types.MsgClearReporterFlag) (*types.MsgClearReporterFlagResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	reporter, err := k.Keeper.ClearReporterFlag(ctx, msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClearReporterFlagResponse{Reporter: reporter}, nil
+}
+
+func (k msgServer) checkAuthority(authority string) error {
+	if authority != k.Keeper.GetAuthority() {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.Keeper.GetAuthority(), authority)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	commontypes "github.com/interbank-netting/cosmos/types"
+)
+
+// EnableQueryCache serves the pending transfers query from the module's
+// memory store, refreshed every EndBlock. It has no effect without a memory
+// store key.
+func (k *Keeper) EnableQueryCache() {
+	k.queryCache = commontypes.NewQueryCache(k.memKey)
+}
+
+// RefreshQueryCache replaces the cached pending transfers with the transfers
+// still unconfirmed at the end of the block. It runs last in EndBlock and does
+// nothing while the cache is disabled.
+func (k Keeper) RefreshQueryCache(ctx sdk.Context) {
+	if !k.queryCache.Enabled() {
+		return
+	}
+	k.queryCache.Reset(ctx)
+
+	for _, voteStatus := range k.pendingTransfers(ctx) {
+		k.queryCache.Set(ctx, []byte(voteStatus.TxHash), k.cdc.MustMarshal(&voteStatus))
+	}
+}
+
+// GetPendingTransfers returns the vote statuses of the transfers not confirmed
+// yet, ordered by tx hash. They are served from the query cache when the cache
+// was refreshed at the height of ctx.
+func (k Keeper) GetPendingTransfers(ctx sdk.Context) []commontypes.VoteStatus {
+	if !k.queryCache.Fresh(ctx) {
+		return k.pendingTransfers(ctx)
+	}
+
+	pending := []commontypes.VoteStatus{}
+	k.queryCache.Iterate(ctx, nil, func(_, value []byte) {
+		var voteStatus commontypes.VoteStatus
+		k.cdc.MustUnmarshal(value, &voteStatus)
+		pending = append(pending, voteStatus)
+	})
+	return pending
+}
+
+func (k Keeper) pendingTransfers(ctx sdk.Context) []commontypes.VoteStatus {
+	pending := []commontypes.VoteStatus{}
+	for _, voteStatus := range k.GetAllVoteStatuses(ctx) {
+		if !voteStatus.Confirmed {
+			pending = append(pending, voteStatus)
+		}
+	}
+	return pending
+}
@@ -0,0 +1,440 @@
+//go:build soak
+
+package keeper_test
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"math/rand"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/interbank-netting/cosmos/types"
+	"github.com/interbank-netting/cosmos/x/multisig"
+	multisigkeeper "github.com/interbank-netting/cosmos/x/multisig/keeper"
+	multisigtypes "github.com/interbank-netting/cosmos/x/multisig/types"
+	"github.com/interbank-netting/cosmos/x/netting"
+	nettingkeeper "github.com/interbank-netting/cosmos/x/netting/keeper"
+	nettingtypes "github.com/interbank-netting/cosmos/x/netting/types"
+	"github.com/interbank-netting/cosmos/x/oracle"
+	"github.com/interbank-netting/cosmos/x/oracle/keeper"
+	oracletypes "github.com/interbank-netting/cosmos/x/oracle/types"
+)
+
+var (
+	soakDuration         = flag.Duration("soak.duration", 4*time.Hour, "wall clock time the soak test runs for")
+	soakRate             = flag.Int("soak.rate", 10, "transactions per second of votes and credit transfers")
+	soakBlockTime        = flag.Duration("soak.block-time", time.Second, "wall clock and block time between blocks")
+	soakWindow           = flag.Duration("soak.window", 10*time.Minute, "interval between resource samples")
+	soakWarmup           = flag.Duration("soak.warmup", 10*time.Minute, "time before the baseline sample, while caches fill")
+	soakMaxHeapGrowth    = flag.Float64("soak.max-heap-growth", 2, "largest ratio of the last live heap to the baseline")
+	soakMaxLatencyGrowth = flag.Float64("soak.max-latency-growth", 3, "largest ratio of the last mean EndBlock latency to the baseline")
+	soakMaxStoreGrowth   = flag.Float64("soak.max-store-growth", 1.5, "largest ratio of the last store keys per confirmed transfer to the baseline")
+)
+
+// soakBanks are the banks transfers are sent to and credit moves between
+var soakBanks = []string{"bank-a", "bank-b", "bank-c", "bank-d"}
+
+// soakCredits is the number of recently issued credits credit transfers pick from
+const soakCredits = 100
+
+// soakNode runs the oracle, netting and multisig modules of a single node over
+// a committed IAVL store on disk, with only bank, account and staking mocked
+type soakNode struct {
+	dir            string
+	cms            storetypes.CommitMultiStore
+	keys           map[string]*storetypes.KVStoreKey
+	oracleKeeper   *keeper.Keeper
+	nettingKeeper  *nettingkeeper.Keeper
+	multisigKeeper *multisigkeeper.Keeper
+	stakingKeeper  *MockStakingKeeper
+	oracleModule   oracle.AppModule
+	nettingModule  netting.AppModule
+	multisigModule multisig.AppModule
+	validators     []types.Validator
+	header         cmtproto.Header
+}
+
+func newSoakNode(t *testing.T, validatorCount int) *soakNode {
+	dir := t.TempDir()
+	db, err := dbm.NewGoLevelDB("application", dir, nil)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	keys := storetypes.NewKVStoreKeys(oracletypes.StoreKey, nettingtypes.StoreKey, multisigtypes.StoreKey)
+	memKeys := storetypes.NewMemoryStoreKeys(oracletypes.MemStoreKey, nettingtypes.MemStoreKey, multisigtypes.MemStoreKey)
+
+	// Prune as a node with default settings does. A small IAVL cache fills
+	// during the warm-up, so the heap plateaus before the baseline sample.
+	cms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningDefault))
+	cms.SetIAVLCacheSize(10_000)
+	for _, key := range keys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	for _, key := range memKeys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeMemory, nil)
+	}
+	if err := cms.LoadLatestVersion(); err != nil {
+		t.Fatalf("failed to load store: %v", err)
+	}
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	bankKeeper := &chaosBankKeeper{NewMockBankKeeper()}
+	stakingKeeper := NewMockStakingKeeper()
+
+	oracleKeeper := keeper.NewKeeper(cdc, keys[oracletypes.StoreKey], memKeys[oracletypes.MemStoreKey], paramtypes.Subspace{}, bankKeeper, stakingKeeper, authority)
+	nettingKeeper := nettingkeeper.NewKeeper(cdc, keys[nettingtypes.StoreKey], memKeys[nettingtypes.MemStoreKey], paramtypes.Subspace{}, bankKeeper, chaosAccountKeeper{}, authority)
+	multisigKeeper := multisigkeeper.NewKeeper(cdc, keys[multisigtypes.StoreKey], memKeys[multisigtypes.MemStoreKey], paramtypes.Subspace{}, bankKeeper, chaosStakingKeeper{}, authority)
+	oracleKeeper.EnableQueryCache()
+	nettingKeeper.EnableQueryCache()
+	oracleKeeper.SetNettingKeeper(nettingKeeper)
+	oracleKeeper.SetMultisigKeeper(multisigKeeper)
+	nettingKeeper.SetMultisigKeeper(multisigKeeper)
+	nettingKeeper.SetOracleKeeper(oracleKeeper)
+
+	node := &soakNode{
+		dir:            dir,
+		cms:            cms,
+		keys:           keys,
+		oracleKeeper:   oracleKeeper,
+		nettingKeeper:  nettingKeeper,
+		multisigKeeper: multisigKeeper,
+		stakingKeeper:  stakingKeeper,
+		oracleModule:   oracle.NewAppModule(cdc, *oracleKeeper, chaosAccountKeeper{}, bankKeeper),
+		nettingModule:  netting.NewAppModule(cdc, *nettingKeeper, chaosAccountKeeper{}, bankKeeper),
+		multisigModule: multisig.NewAppModule(cdc, *multisigKeeper, chaosAccountKeeper{}, bankKeeper),
+		validators:     generateValidators(validatorCount),
+		header: cmtproto.Header{
+			ChainID: testChainID,
+			Height:  1,
+			Time:    time.Unix(1_700_000_000, 0),
+		},
+	}
+
+	// Genesis: the validators of both the oracle and the multisig module
+	err = node.runBlock(func(ctx sdk.Context) error {
+		setupValidators(ctx, stakingKeeper, node.validators)
+		return multisigKeeper.UpdateValidatorSet(ctx, node.validators)
+	})
+	if err != nil {
+		t.Fatalf("failed to set up validators: %v", err)
+	}
+	return node
+}
+
+// context returns a context of the committed state at the current header
+func (n *soakNode) context() sdk.Context {
+	return sdk.NewContext(n.cms, n.header, false, log.NewNopLogger())
+}
+
+// runBlock runs fn on the block state of the next block, commits it and
+// advances the header by the block time
+func (n *soakNode) runBlock(fn func(ctx sdk.Context) error) error {
+	blockStore := n.cms.CacheMultiStore()
+	ctx := sdk.NewContext(blockStore, n.header, false, log.NewNopLogger())
+	if err := fn(ctx); err != nil {
+		return err
+	}
+	blockStore.Write()
+	n.cms.Commit()
+
+	n.header.Height++
+	n.header.Time = n.header.Time.Add(*soakBlockTime)
+	return nil
+}
+
+// endBlock runs the EndBlock of every module and returns the time they took
+func (n *soakNode) endBlock(ctx sdk.Context) (time.Duration, error) {
+	start := time.Now()
+	if err := n.oracleModule.EndBlock(ctx); err != nil {
+		return 0, fmt.Errorf("oracle end block: %w", err)
+	}
+	if err := n.nettingModule.EndBlock(ctx); err != nil {
+		return 0, fmt.Errorf("netting end block: %w", err)
+	}
+	if err := n.multisigModule.EndBlock(ctx); err != nil {
+		return 0, fmt.Errorf("multisig end block: %w", err)
+	}
+	return time.Since(start), nil
+}
+
+// deliver runs a transaction on a branch of the block state, as the app does,
+// and keeps its writes only if it succeeded
+func deliver(ctx sdk.Context, tx func(ctx sdk.Context) error) error {
+	txCtx, write := ctx.CacheContext()
+	if err := tx(txCtx); err != nil {
+		return err
+	}
+	write()
+	return nil
+}
+
+// soakLoad generates the transactions of the soak test: the votes of every
+// validator on a stream of transfers, and credit transfers of the credit the
+// confirmed transfers issued
+type soakLoad struct {
+	rng      *rand.Rand
+	node     *soakNode
+	transfer types.TransferEvent
+	voter    int
+	nonce    uint64
+	credits  []types.CreditToken // Ring of the last soakCredits issued credits
+}
+
+// next delivers the next transaction and returns true if it confirmed a
+// transfer. One transaction in five is a credit transfer.
+func (l *soakLoad) next(ctx sdk.Context) (bool, error) {
+	if l.rng.Intn(5) == 0 {
+		l.transferCredit(ctx)
+		return false, nil
+	}
+
+	if l.voter == 0 {
+		l.nonce++
+		// Credit denoms are per source bank, so each transfer has its own source
+		l.transfer = types.TransferEvent{
+			TxHash:      fmt.Sprintf("0xsoak-%d", l.nonce),
+			Sender:      fmt.Sprintf("sender-%d", l.rng.Intn(1000)),
+			Recipient:   fmt.Sprintf("recipient-%d", l.rng.Intn(1000)),
+			Amount:      math.NewInt(1 + l.rng.Int63n(10_000)),
+			Nonce:       l.nonce,
+			SourceChain: fmt.Sprintf("issuer-%d", l.nonce),
+			DestChain:   soakBanks[l.rng.Intn(len(soakBanks))],
+		}
+	}
+
+	validator := l.node.validators[l.voter]
+	l.voter = (l.voter + 1) % len(l.node.validators)
+	vote := types.Vote{
+		TxHash:           l.transfer.TxHash,
+		Validator:        validator.Address,
+		EventData:        l.transfer,
+		Signature:        signVote(ctx, l.node.stakingKeeper, validator.Address, l.transfer),
+		SignatureVersion: oracletypes.CurrentSignatureVersion,
+		VoteTime:         ctx.BlockTime().Unix(),
+	}
+
+	_, wasConfirmed := l.node.oracleKeeper.GetConfirmedTransfer(ctx, l.transfer.TxHash)
+	err := deliver(ctx, func(ctx sdk.Context) error { return l.node.oracleKeeper.SubmitVote(ctx, vote) })
+	if err != nil && !errors.Is(err, oracletypes.ErrTransferAlreadyConfirmed) {
+		return false, fmt.Errorf("vote of %s on %s: %w", validator.Address, l.transfer.TxHash, err)
+	}
+	_, confirmed := l.node.oracleKeeper.GetConfirmedTransfer(ctx, l.transfer.TxHash)
+	if !confirmed || wasConfirmed {
+		return false, nil
+	}
+
+	credit := oracletypes.TransferCreditToken(l.transfer, ctx.BlockTime().Unix())
+	if len(l.credits) < soakCredits {
+		l.credits = append(l.credits, credit)
+	} else {
+		l.credits[l.nonce%soakCredits] = credit
+	}
+	return true, nil
+}
+
+// transferCredit moves part of a recently issued credit from its holder to
+// another bank. A holder that already passed the credit on fails the
+// transaction, as it would on chain.
+func (l *soakLoad) transferCredit(ctx sdk.Context) {
+	if len(l.credits) == 0 {
+		return
+	}
+	credit := l.credits[l.rng.Intn(len(l.credits))]
+	to := soakBanks[l.rng.Intn(len(soakBanks))]
+	if to == credit.HolderBank {
+		return
+	}
+
+	available := l.node.nettingKeeper.GetAvailableCreditBalance(ctx, credit.HolderBank, credit.Denom)
+	if !available.IsPositive() {
+		return
+	}
+	amount := math.NewInt(1 + l.rng.Int63n(available.Int64()/2+1))
+	_ = deliver(ctx, func(ctx sdk.Context) error {
+		return l.node.nettingKeeper.TransferCreditToken(ctx, credit.HolderBank, to, credit.Denom, amount)
+	})
+}
+
+// soakSample is the resource use of the node at the end of a window
+type soakSample struct {
+	height           int64
+	blocks           int
+	confirmed        int
+	endBlockTotal    time.Duration
+	endBlockMax      time.Duration
+	heapAlloc        uint64
+	storeKeys        int
+	auditLogs        uint64
+	commands         int
+	pendingCommands  int
+	pendingTransfers int
+	diskBytes        int64
+}
+
+func (s soakSample) meanEndBlock() time.Duration {
+	if s.blocks == 0 {
+		return 0
+	}
+	return s.endBlockTotal / time.Duration(s.blocks)
+}
+
+// sample records the resource use at the end of a window with its block,
+// confirmation and latency totals
+func (n *soakNode) sample(t *testing.T, window soakSample) soakSample {
+	ctx := n.context()
+
+	runtime.GC()
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	window.heapAlloc = memStats.HeapAlloc
+
+	for _, key := range n.keys {
+		iterator := ctx.KVStore(key).Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			window.storeKeys++
+		}
+		iterator.Close()
+	}
+
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(n.keys[multisigtypes.StoreKey]), multisigtypes.MintCommandKeyPrefix.Bytes())
+	for ; iterator.Valid(); iterator.Next() {
+		window.commands++
+	}
+	iterator.Close()
+
+	window.height = ctx.BlockHeight()
+	window.auditLogs = n.oracleKeeper.GetAuditLogCount(ctx)
+	window.pendingCommands = len(n.multisigKeeper.GetAllPendingCommands(ctx))
+	window.pendingTransfers = n.oracleKeeper.GetPendingTransferCount(ctx)
+
+	err := filepath.WalkDir(n.dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		window.diskBytes += info.Size()
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to measure database size: %v", err)
+	}
+
+	t.Logf("height %d: %d confirmed, end block mean %s max %s, heap %d KiB, %d store keys, %d audit logs, %d commands (%d pending), %d pending transfers, %d KiB on disk",
+		window.height, window.confirmed, window.meanEndBlock(), window.endBlockMax, window.heapAlloc/1024,
+		window.storeKeys, window.auditLogs, window.commands, window.pendingCommands, window.pendingTransfers, window.diskBytes/1024)
+	return window
+}
+
+// TestSoak_SustainedVoteAndCreditLoad drives votes and credit transfers at a
+// steady rate against a single node for hours. The first window after the
+// warm-up is the baseline; the test fails if, in the last window, the live
+// heap, the mean EndBlock latency or the store keys written per confirmed
+// transfer grew past their bound of the baseline. Run it with make test-soak.
+func TestSoak_SustainedVoteAndCreditLoad(t *testing.T) {
+	if *soakDuration < *soakWarmup+2**soakWindow {
+		t.Fatalf("soak duration %s leaves less than two windows of %s after the warm-up of %s", *soakDuration, *soakWindow, *soakWarmup)
+	}
+
+	node := newSoakNode(t, 4)
+	load := &soakLoad{rng: rand.New(rand.NewSource(1)), node: node}
+	txsPerBlock := int(float64(*soakRate) * soakBlockTime.Seconds())
+
+	// Samples after the warm-up, with storeKeys turned into the keys written
+	// in their window
+	var samples []soakSample
+	var previous, window soakSample
+	start := time.Now()
+	nextSample := start.Add(*soakWarmup)
+	ticker := time.NewTicker(*soakBlockTime)
+	defer ticker.Stop()
+
+	for time.Since(start) < *soakDuration {
+		<-ticker.C
+		err := node.runBlock(func(ctx sdk.Context) error {
+			if err := node.nettingModule.BeginBlock(ctx); err != nil {
+				return err
+			}
+			for i := 0; i < txsPerBlock; i++ {
+				confirmed, err := load.next(ctx)
+				if err != nil {
+					return err
+				}
+				if confirmed {
+					window.confirmed++
+				}
+			}
+
+			latency, err := node.endBlock(ctx)
+			if err != nil {
+				return err
+			}
+			window.blocks++
+			window.endBlockTotal += latency
+			if latency > window.endBlockMax {
+				window.endBlockMax = latency
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("block %d: %v", node.header.Height, err)
+		}
+
+		if time.Now().Before(nextSample) {
+			continue
+		}
+		sample := node.sample(t, window)
+		if previous.height != 0 {
+			written := sample
+			written.storeKeys -= previous.storeKeys
+			samples = append(samples, written)
+		}
+		previous, window = sample, soakSample{}
+		nextSample = nextSample.Add(*soakWindow)
+	}
+
+	if len(samples) < 2 {
+		t.Fatalf("only %d windows completed after the warm-up", len(samples))
+	}
+	baseline, last := samples[0], samples[len(samples)-1]
+	if float64(last.heapAlloc) > *soakMaxHeapGrowth*float64(baseline.heapAlloc) {
+		t.Errorf("live heap grew from %d KiB to %d KiB", baseline.heapAlloc/1024, last.heapAlloc/1024)
+	}
+	if float64(last.meanEndBlock()) > *soakMaxLatencyGrowth*float64(baseline.meanEndBlock()) {
+		t.Errorf("mean EndBlock latency grew from %s to %s", baseline.meanEndBlock(), last.meanEndBlock())
+	}
+	if baseline.confirmed == 0 || last.confirmed == 0 {
+		t.Fatalf("no transfer was confirmed in the baseline or the last window")
+	}
+	baselinePerTransfer := float64(baseline.storeKeys) / float64(baseline.confirmed)
+	lastPerTransfer := float64(last.storeKeys) / float64(last.confirmed)
+	if lastPerTransfer > *soakMaxStoreGrowth*baselinePerTransfer {
+		t.Errorf("store keys written per confirmed transfer grew from %.1f to %.1f", baselinePerTransfer, lastPerTransfer)
+	}
+}
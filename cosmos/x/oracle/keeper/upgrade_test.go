@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+
+	testhelpers "github.com/interbank-netting/cosmos/testutil"
+	"github.com/interbank-netting/cosmos/types"
+	"github.com/interbank-netting/cosmos/x/oracle/keeper"
+	oracletypes "github.com/interbank-netting/cosmos/x/oracle/types"
+)
+
+// **Unit Test: 버전 2 저장소 픽스처 업그레이드 호환성**
+func TestUpgradeFromVersion2Store_KeepsQueryResults(t *testing.T) {
+	ctx, oracleKeeper, _ := setupTestEnvironment(t, 3)
+	fixture := testhelpers.LoadStoreFixture(t, "testdata/store_v2.json")
+	fixture.Write(t, ctx.KVStore(oracleKeeper.GetStoreKey()))
+
+	require.NoError(t, keeper.NewMigrator(*oracleKeeper).Migrate2to3(ctx))
+	querier := keeper.NewQueryServerImpl(*oracleKeeper)
+
+	pending, err := querier.PendingTransfers(ctx, &oracletypes.QueryPendingTransfersRequest{})
+	require.NoError(t, err)
+	require.True(t, fixture.MatchesQuery(t, "PendingTransfers", pending))
+
+	// Votes and vote fees are found under their (tx hash, validator) keys
+	voteFees := []oracletypes.VoteFee{}
+	for _, transfer := range pending.Transfers {
+		for _, vote := range transfer.Votes {
+			_, err := oracleKeeper.Votes.Get(ctx, collections.Join(vote.TxHash, vote.Validator))
+			require.NoError(t, err)
+			voteFee, found := oracleKeeper.GetVoteFee(ctx, vote.TxHash, vote.Validator)
+			require.True(t, found)
+			voteFees = append(voteFees, voteFee)
+		}
+	}
+	require.True(t, fixture.MatchesQuery(t, "VoteFees", voteFees))
+
+	// Rebuilt indexes return each log once
+	byType, err := querier.AuditLogs(ctx, &oracletypes.QueryAuditLogsRequest{
+		Filter: oracletypes.AuditLogFilter{EventType: types.EventTypeTransferInitiated},
+	})
+	require.NoError(t, err)
+	require.True(t, fixture.MatchesQuery(t, "AuditLogs", byType))
+	byTime, err := querier.AuditLogs(ctx, &oracletypes.QueryAuditLogsRequest{
+		Filter: oracletypes.AuditLogFilter{StartTime: 1700000060, EndTime: 1700000065},
+	})
+	require.NoError(t, err)
+	require.True(t, fixture.MatchesQuery(t, "AuditLogsByTime", byTime))
+
+	// IDs continue after the last version 2 log
+	id, err := oracleKeeper.SaveAuditLog(ctx, types.AuditLog{EventType: types.EventTypeTransferInitiated})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), id)
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	commontypes "github.com/interbank-netting/cosmos/types"
+	"github.com/interbank-netting/cosmos/x/oracle/types"
+)
+
+// ViewKeeper exposes the read-only methods of the oracle keeper. Query
+// servers hold a ViewKeeper instead of the Keeper, so a query path cannot
+// write state.
+type ViewKeeper interface {
+	GetAuthority() string
+	GetParams(ctx sdk.Context) types.Params
+	GetParamsHistory(ctx sdk.Context) []types.ParamsChange
+
+	GetVoteStatus(ctx sdk.Context, txHash string) (commontypes.VoteStatus, bool)
+	GetPendingTransfers(ctx sdk.Context) []commontypes.VoteStatus
+	GetConfirmedTransfer(ctx sdk.Context, txHash string) (commontypes.TransferEvent, bool)
+	GetTransferCredit(ctx sdk.Context, txHash string) (commontypes.CreditToken, bool)
+	GetConfirmedTransferCredit(ctx sdk.Context, txHash string) (commontypes.CreditToken, error)
+	GetTransferProof(ctx sdk.Context, txHash string) (types.TransferProof, error)
+	VerifyTransferProof(ctx sdk.Context, proof types.TransferProof) error
+	GetHeldTransfer(ctx sdk.Context, txHash string) (types.HeldTransfer, bool)
+	GetRejectedTransfer(ctx sdk.Context, txHash string) (types.RejectedTransfer, bool)
+	GetDispute(ctx sdk.Context, txHash string) (types.Dispute, bool)
+	GetSuspension(ctx sdk.Context, target string) (types.Suspension, bool)
+	GetAllSuspensions(ctx sdk.Context) []types.Suspension
+	GetChainHeartbeat(ctx sdk.Context, chain string) (types.ChainHeartbeat, bool)
+	GetChainFinality(ctx sdk.Context, chain string) types.ChainFinality
+	GetChainHealth(ctx sdk.Context, chain string) (types.ChainHealth, bool)
+	GetAllChainHealth(ctx sdk.Context) []types.ChainHealth
+	GetReporter(ctx sdk.Context, validator string) (types.ReporterRecord, bool)
+	GetAllReporters(ctx sdk.Context) []types.ReporterRecord
+
+	GetDynamicThreshold(ctx sdk.Context) (threshold int32, activeCount int)
+	GetWorkQueue(ctx sdk.Context, nearTimeoutWindow int64) types.WorkQueue
+	GetValidatorQueue(ctx sdk.Context, validator string) types.ValidatorQueue
+	GetNettingForecast(ctx sdk.Context) commontypes.NettingForecast
+
+	GetAuditLog(ctx sdk.Context, id uint64) (commontypes.AuditLog, bool)
+	FilterAuditLogs(ctx sdk.Context, filter types.AuditLogFilter) ([]commontypes.AuditLog, uint64, error)
+}
+
+var _ ViewKeeper = Keeper{}
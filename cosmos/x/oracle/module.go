@@ -112,6 +112,12 @@ func (am AppModule) Name() string {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	// TODO: Register msg server when protobuf is generated
 	// types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+	// types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the oracle module's invariants.
@@ -133,7 +139,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the oracle module.
 func (am AppModule) BeginBlock(ctx context.Context) error {
@@ -142,5 +148,19 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 
 // EndBlock executes all ABCI EndBlock logic respective to the oracle module.
 func (am AppModule) EndBlock(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Drop vote fees whose transfer was not confirmed within the refund window
+	am.keeper.PruneExpiredVoteFees(sdkCtx)
+
+	// Suspend issuance from chains whose relayers stopped posting heartbeats
+	am.keeper.SuspendStaleChains(sdkCtx)
+
+	// Release credit held by two-phase release once its mint was executed
+	am.keeper.ReleaseExecutedCredit(sdkCtx)
+
+	// Cache the pending transfers of the block for the queries polling them
+	am.keeper.RefreshQueryCache(sdkCtx)
+
 	return nil
 }
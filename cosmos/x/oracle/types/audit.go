@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	commontypes "github.com/interbank-netting/cosmos/types"
+)
+
+// Audit log query limits
+const (
+	DefaultAuditLogLimit = 100  // Logs returned when no limit is given
+	MaxAuditLogLimit     = 1000 // Most logs returned by one query
+)
+
+// AuditLogFilter selects audit logs. Every set field must match; zero fields
+// match all logs.
+type AuditLogFilter struct {
+	EventType string            `json:"event_type,omitempty"`
+	StartTime int64             `json:"start_time,omitempty"` // Inclusive, unix seconds
+	EndTime   int64             `json:"end_time,omitempty"`   // Inclusive, unix seconds
+	Bank      string            `json:"bank,omitempty"`       // Matches any *_bank detail
+	Chain     string            `json:"chain,omitempty"`      // Matches any *_chain detail
+	Details   map[string]string `json:"details,omitempty"`    // Exact detail values
+	AfterID   uint64            `json:"after_id,omitempty"`   // Only logs with a greater ID
+	Limit     uint32            `json:"limit,omitempty"`      // DefaultAuditLogLimit if zero
+}
+
+// Validate checks the time range and limit of the filter
+func (f AuditLogFilter) Validate() error {
+	if f.StartTime < 0 || f.EndTime < 0 {
+		return fmt.Errorf("time range cannot be negative: [%d, %d]", f.StartTime, f.EndTime)
+	}
+	if f.EndTime != 0 && f.EndTime < f.StartTime {
+		return fmt.Errorf("end time %d is before start time %d", f.EndTime, f.StartTime)
+	}
+	if f.Limit > MaxAuditLogLimit {
+		return fmt.Errorf("limit %d exceeds %d", f.Limit, MaxAuditLogLimit)
+	}
+	return nil
+}
+
+// HasTimeRange returns true if the filter bounds the log timestamps
+func (f AuditLogFilter) HasTimeRange() bool {
+	return f.StartTime != 0 || f.EndTime != 0
+}
+
+// EffectiveLimit returns the number of logs a query returns at most
+func (f AuditLogFilter) EffectiveLimit() int {
+	if f.Limit == 0 {
+		return DefaultAuditLogLimit
+	}
+	return int(f.Limit)
+}
+
+// Matches returns true if the log passes every set field of the filter
+func (f AuditLogFilter) Matches(log commontypes.AuditLog) bool {
+	if log.ID <= f.AfterID {
+		return false
+	}
+	if f.EventType != "" && log.EventType != f.EventType {
+		return false
+	}
+	if log.Timestamp < f.StartTime || (f.EndTime != 0 && log.Timestamp > f.EndTime) {
+		return false
+	}
+	if f.Bank != "" && !hasDetailWithSuffix(log, "_bank", f.Bank) {
+		return false
+	}
+	if f.Chain != "" && !hasDetailWithSuffix(log, "_chain", f.Chain) {
+		return false
+	}
+	for key, value := range f.Details {
+		if log.Details[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// hasDetailWithSuffix returns true if a detail whose key ends with suffix
+// holds the value, e.g. issuer_bank or holder_bank for banks
+func hasDetailWithSuffix(log commontypes.AuditLog, suffix, value string) bool {
+	for key, detail := range log.Details {
+		if strings.HasSuffix(key, suffix) && detail == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+
+	commontypes "github.com/interbank-netting/cosmos/types"
+)
+
+// Korean messages of the x/oracle errors in the message catalog
+func init() {
+	commontypes.RegisterErrorMessages(commontypes.LocaleKorean, map[*errors.Error]string{
+		ErrInvalidValidator:          "잘못된 검증자",
+		ErrInvalidSignature:          "잘못된 서명",
+		ErrDuplicateVote:             "중복 투표",
+		ErrTransferNotFound:          "이체를 찾을 수 없음",
+		ErrTransferAlreadyConfirmed:  "이미 확정된 이체",
+		ErrInsufficientVotes:         "합의에 필요한 투표 부족",
+		ErrInvalidEventData:          "잘못된 이벤트 데이터",
+		ErrValidatorNotActive:        "비활성 검증자",
+		ErrConsensusTimeout:          "합의 제한 시간 초과",
+		ErrInvalidTxHash:             "잘못된 트랜잭션 해시",
+		ErrInvalidSigningEnvelope:    "잘못된 서명 봉투",
+		ErrUnauthorized:              "권한 없음",
+		ErrInvalidParams:             "잘못된 파라미터",
+		ErrHeldTransferNotFound:      "보류된 이체를 찾을 수 없음",
+		ErrHeldTransferRejected:      "이미 거부된 보류 이체",
+		ErrTransferNotConfirmed:      "확정되지 않은 이체",
+		ErrDisputeExists:             "이미 제기된 이의",
+		ErrDisputeNotFound:           "이의를 찾을 수 없음",
+		ErrDisputeResolved:           "이미 해결된 이의",
+		ErrMissingAttestations:       "필수 증명 누락",
+		ErrProofTooLarge:             "증명이 검증 한도를 초과함",
+		ErrInvalidProof:              "잘못된 증명",
+		ErrBatchTooLarge:             "투표 배치가 크기 한도를 초과함",
+		ErrInvalidBatch:              "잘못된 투표 배치",
+		ErrSuspended:                 "체인 또는 은행이 정지됨",
+		ErrSuspensionNotFound:        "정지 내역을 찾을 수 없음",
+		ErrInvalidAuditFilter:        "잘못된 감사 로그 필터",
+		ErrHeartbeatNotFound:         "체인 하트비트를 찾을 수 없음",
+		ErrReporterNotFound:          "보고자를 찾을 수 없음",
+		ErrFieldTooLong:              "필드가 크기 한도를 초과함",
+		ErrAuditLogTooLarge:          "감사 로그가 크기 한도를 초과함",
+		ErrInsufficientConfirmations: "이체가 확인 깊이에 도달하지 않음",
+		ErrReorgHandlingDisabled:     "즉시 완결성을 가진 체인",
+		ErrRejectedTransferNotFound:  "거부된 이체를 찾을 수 없음",
+	})
+}
@@ -11,12 +11,32 @@ import (
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgVote{}, "oracle/MsgVote", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "oracle/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgApproveHeldTransfer{}, "oracle/MsgApproveHeldTransfer", nil)
+	cdc.RegisterConcrete(&MsgRejectHeldTransfer{}, "oracle/MsgRejectHeldTransfer", nil)
+	cdc.RegisterConcrete(&MsgReportReorg{}, "oracle/MsgReportReorg", nil)
+	cdc.RegisterConcrete(&MsgResolveDispute{}, "oracle/MsgResolveDispute", nil)
+	cdc.RegisterConcrete(&MsgBatchVote{}, "oracle/MsgBatchVote", nil)
+	cdc.RegisterConcrete(&MsgSuspend{}, "oracle/MsgSuspend", nil)
+	cdc.RegisterConcrete(&MsgResume{}, "oracle/MsgResume", nil)
+	cdc.RegisterConcrete(&MsgChainHeartbeat{}, "oracle/MsgChainHeartbeat", nil)
+	cdc.RegisterConcrete(&MsgClearReporterFlag{}, "oracle/MsgClearReporterFlag", nil)
 }
 
 // RegisterInterfaces registers the x/oracle interfaces types with the interface registry
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgVote{},
+		&MsgUpdateParams{},
+		&MsgApproveHeldTransfer{},
+		&MsgRejectHeldTransfer{},
+		&MsgReportReorg{},
+		&MsgResolveDispute{},
+		&MsgBatchVote{},
+		&MsgSuspend{},
+		&MsgResume{},
+		&MsgChainHeartbeat{},
+		&MsgClearReporterFlag{},
 	)
 
 	// Note: MsgServiceDesc registration requires protobuf generation
@@ -0,0 +1,12 @@
+package types
+
+import "cosmossdk.io/math"
+
+// ConfirmationResult describes the state produced by confirming a transfer
+type ConfirmationResult struct {
+	TxHash        string
+	Denom         string   // Credit token issued to the destination bank
+	CreditBalance math.Int // Destination bank balance of Denom after issuance
+	CommandIDs    []string // Mint commands generated for the destination chain
+	Held          bool     // Exceeded the corridor cap and awaits manual approval
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// Dispute statuses
+const (
+	DisputeStatusOpen           int32 = 0 // Credit frozen, awaiting resolution
+	DisputeStatusReorgConfirmed int32 = 1 // Source transaction was reorged out, frozen credit burned
+	DisputeStatusDismissed      int32 = 2 // Source transaction is canonical, frozen credit released
+)
+
+// Dispute is opened when a confirmed transfer's source transaction is reported
+// as removed by a Besu reorg. The credit the transfer issued stays frozen until
+// the authority resolves the dispute.
+type Dispute struct {
+	TxHash       string   `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash"`
+	Reporter     string   `protobuf:"bytes,2,opt,name=reporter,proto3" json:"reporter"`
+	Reason       string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason"`
+	Denom        string   `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom"`
+	HolderBank   string   `protobuf:"bytes,5,opt,name=holder_bank,json=holderBank,proto3" json:"holder_bank"`
+	FrozenAmount math.Int `protobuf:"bytes,6,opt,name=frozen_amount,json=frozenAmount,proto3,customtype=cosmossdk.io/math.Int" json:"frozen_amount"` // Credit still unnetted when the dispute opened
+	OpenedAt     int64    `protobuf:"varint,7,opt,name=opened_at,json=openedAt,proto3" json:"opened_at"`
+	OpenedHeight int64    `protobuf:"varint,8,opt,name=opened_height,json=openedHeight,proto3" json:"opened_height"`
+	Status       int32    `protobuf:"varint,9,opt,name=status,proto3" json:"status"`
+	Resolution   string   `protobuf:"bytes,10,opt,name=resolution,proto3" json:"resolution"`
+}
+
+// ProtoMessage implements proto.Message
+func (d *Dispute) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (d *Dispute) Reset() { *d = Dispute{} }
+
+// String implements proto.Message
+func (d *Dispute) String() string {
+	return fmt.Sprintf("Dispute{TxHash: %s, FrozenAmount: %s, Status: %d}", d.TxHash, d.FrozenAmount, d.Status)
+}
+
+// IsOpen returns true if the dispute has not been resolved yet
+func (d Dispute) IsOpen() bool {
+	return d.Status == DisputeStatusOpen
+}
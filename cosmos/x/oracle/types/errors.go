@@ -2,6 +2,8 @@ package types
 
 import (
 	"cosmossdk.io/errors"
+
+	commontypes "github.com/interbank-netting/cosmos/types"
 )
 
 // x/oracle module sentinel errors
@@ -16,4 +18,68 @@ var (
 	ErrValidatorNotActive   = errors.Register(ModuleName, 8, "validator not active")
 	ErrConsensusTimeout     = errors.Register(ModuleName, 9, "consensus timeout")
 	ErrInvalidTxHash        = errors.Register(ModuleName, 10, "invalid transaction hash")
-)
\ No newline at end of file
+	ErrInvalidSigningEnvelope = errors.Register(ModuleName, 11, "invalid signing envelope")
+	ErrUnauthorized         = errors.Register(ModuleName, 12, "unauthorized")
+	ErrInvalidParams        = errors.Register(ModuleName, 13, "invalid params")
+	ErrHeldTransferNotFound = errors.Register(ModuleName, 14, "held transfer not found")
+	ErrHeldTransferRejected = errors.Register(ModuleName, 15, "held transfer already rejected")
+	ErrTransferNotConfirmed = errors.Register(ModuleName, 16, "transfer not confirmed")
+	ErrDisputeExists        = errors.Register(ModuleName, 17, "dispute already opened")
+	ErrDisputeNotFound      = errors.Register(ModuleName, 18, "dispute not found")
+	ErrDisputeResolved      = errors.Register(ModuleName, 19, "dispute already resolved")
+	ErrMissingAttestations  = errors.Register(ModuleName, 20, "required attestations missing")
+	ErrProofTooLarge        = errors.Register(ModuleName, 21, "proof exceeds verification limits")
+	ErrInvalidProof         = errors.Register(ModuleName, 22, "invalid proof")
+	ErrBatchTooLarge        = errors.Register(ModuleName, 23, "vote batch exceeds size limits")
+	ErrInvalidBatch         = errors.Register(ModuleName, 24, "invalid vote batch")
+	ErrSuspended            = errors.Register(ModuleName, 25, "chain or bank suspended")
+	ErrSuspensionNotFound   = errors.Register(ModuleName, 26, "suspension not found")
+	ErrInvalidAuditFilter   = errors.Register(ModuleName, 27, "invalid audit log filter")
+	ErrHeartbeatNotFound    = errors.Register(ModuleName, 28, "chain heartbeat not found")
+	ErrReporterNotFound     = errors.Register(ModuleName, 29, "reporter not found")
+	ErrFieldTooLong         = errors.Register(ModuleName, 30, "field exceeds size limits")
+	ErrAuditLogTooLarge     = errors.Register(ModuleName, 31, "audit log exceeds size limits")
+	ErrInsufficientConfirmations = errors.Register(ModuleName, 32, "transfer below confirmation depth")
+	ErrReorgHandlingDisabled = errors.Register(ModuleName, 33, "chain has instant finality")
+	ErrRejectedTransferNotFound = errors.Register(ModuleName, 34, "rejected transfer not found")
+)
+
+func init() {
+	commontypes.RegisterUserErrors(
+		ErrInvalidValidator,
+		ErrInvalidSignature,
+		ErrDuplicateVote,
+		ErrTransferNotFound,
+		ErrTransferAlreadyConfirmed,
+		ErrInvalidEventData,
+		ErrValidatorNotActive,
+		ErrInvalidTxHash,
+		ErrInvalidSigningEnvelope,
+		ErrUnauthorized,
+		ErrInvalidParams,
+		ErrHeldTransferNotFound,
+		ErrHeldTransferRejected,
+		ErrDisputeExists,
+		ErrDisputeNotFound,
+		ErrDisputeResolved,
+		ErrProofTooLarge,
+		ErrInvalidProof,
+		ErrBatchTooLarge,
+		ErrInvalidBatch,
+		ErrSuspensionNotFound,
+		ErrInvalidAuditFilter,
+		ErrHeartbeatNotFound,
+		ErrReporterNotFound,
+		ErrFieldTooLong,
+		ErrAuditLogTooLarge,
+		ErrReorgHandlingDisabled,
+		ErrRejectedTransferNotFound,
+	)
+	commontypes.RegisterRetryableErrors(
+		ErrInsufficientVotes,
+		ErrTransferNotConfirmed,
+		ErrMissingAttestations,
+		ErrSuspended,
+		ErrInsufficientConfirmations,
+	)
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	commontypes "github.com/interbank-netting/cosmos/types"
+)
+
+// CheckTransferEvent returns the transfer event in canonical form: sender and
+// recipient trimmed and, when they are EVM addresses, EIP-55 checksummed.
+// Other formats are kept as they are, so lenient deployments keep working.
+//
+// In strict mode the event is rejected instead of normalized unless it is
+// already canonical, and the sender and recipient must be non-zero EVM
+// addresses.
+func CheckTransferEvent(event commontypes.TransferEvent, strict bool) (commontypes.TransferEvent, error) {
+	sender, err := canonicalAddress("sender", event.Sender, strict)
+	if err != nil {
+		return commontypes.TransferEvent{}, err
+	}
+	recipient, err := canonicalAddress("recipient", event.Recipient, strict)
+	if err != nil {
+		return commontypes.TransferEvent{}, err
+	}
+
+	event.Sender = sender
+	event.Recipient = recipient
+	return event, nil
+}
+
+func canonicalAddress(field, address string, strict bool) (string, error) {
+	trimmed := strings.TrimSpace(address)
+	if trimmed == "" && !strict {
+		return address, nil
+	}
+	if !common.IsHexAddress(trimmed) {
+		if strict {
+			return "", fmt.Errorf("%s %q is not an EVM address", field, address)
+		}
+		return trimmed, nil
+	}
+
+	canonical := common.HexToAddress(trimmed)
+	if !strict {
+		return canonical.Hex(), nil
+	}
+	if canonical == (common.Address{}) {
+		return "", fmt.Errorf("%s is the zero address", field)
+	}
+	if address != canonical.Hex() {
+		return "", fmt.Errorf("%s %q is not in checksummed form %s", field, address, canonical.Hex())
+	}
+	return address, nil
+}
@@ -1,25 +1,82 @@
 package types
 
+import commontypes "github.com/interbank-netting/cosmos/types"
+
 // Oracle module event types
 const (
-	EventTypeVoteSubmitted     = "vote_submitted"
-	EventTypeTransferConfirmed = "transfer_confirmed"
-	EventTypeConsensusReached  = "consensus_reached"
-	EventTypeVoteRejected      = "vote_rejected"
-	EventTypeTransferRejected  = "transfer_rejected"
-	EventTypeConsensusTimeout  = "consensus_timeout"
+	EventTypeVoteSubmitted       = "vote_submitted"
+	EventTypeTransferConfirmed   = "transfer_confirmed"
+	EventTypeConsensusReached    = "consensus_reached"
+	EventTypeVoteRejected        = "vote_rejected"
+	EventTypeTransferRejected    = "transfer_rejected"
+	EventTypeConsensusTimeout    = "consensus_timeout"
+	EventTypeVoteFeeRefunded     = "vote_fee_refunded"
+	EventTypeTransferHeld        = "transfer_held"
+	EventTypeTransferApproved    = "transfer_approved"
+	EventTypeDisputeOpened       = "dispute_opened"
+	EventTypeDisputeResolved     = "dispute_resolved"
+	EventTypeAttestationsMissing = "attestations_missing"
+	EventTypeSuspended           = "suspended"
+	EventTypeSuspensionLifted    = "suspension_lifted"
+	EventTypeParamsUpdated       = "params_updated"
+	EventTypeChainHeartbeat      = "chain_heartbeat"
+	EventTypeChainStale          = "chain_stale"
+	EventTypeReporterMismatch    = "reporter_mismatch"
+	EventTypeReporterFlagged     = "reporter_flagged"
+	EventTypeReporterFlagCleared = "reporter_flag_cleared"
+	EventTypeCreditPending       = "credit_pending"
+	EventTypeCreditReleased      = "credit_released"
 )
 
 // Oracle module event attribute keys
 const (
-	AttributeKeyTxHash      = "tx_hash"
-	AttributeKeyValidator   = "validator"
 	AttributeKeySender      = "sender"
-	AttributeKeyRecipient   = "recipient"
-	AttributeKeyAmount      = "amount"
 	AttributeKeySourceChain = "source_chain"
 	AttributeKeyDestChain   = "dest_chain"
 	AttributeKeyVoteCount   = "vote_count"
-	AttributeKeyThreshold   = "threshold"
-	AttributeKeyReason      = "reason"
-)
\ No newline at end of file
+	AttributeKeyPayer       = "payer"
+	AttributeKeyFee         = "fee"
+	AttributeKeyCorridorCap = "corridor_cap"
+	AttributeKeyStatus      = "status"
+	AttributeKeyMissing     = "missing_attestors"
+	AttributeKeyTarget      = "target"
+	AttributeKeyScope       = "scope"
+	AttributeKeySuspendedBy = "suspended_by"
+	AttributeKeyCommandIDs  = "command_ids"
+	AttributeKeyChain       = "chain"
+	AttributeKeyBesuHeight  = "besu_height"
+	AttributeKeyBalance     = "gateway_balance"
+	AttributeKeySyncing     = "syncing"
+	AttributeKeyLastSeen    = "last_seen"
+	AttributeKeyMismatches  = "mismatches"
+	AttributeKeyNetPosition = "net_position"
+	AttributeKeyCommandID   = "command_id"
+)
+
+// Attribute keys shared with other modules, kept for existing importers
+const (
+	// Deprecated: use commontypes.AttributeKeyTxHash
+	AttributeKeyTxHash = commontypes.AttributeKeyTxHash
+	// Deprecated: use commontypes.AttributeKeyAmount
+	AttributeKeyAmount = commontypes.AttributeKeyAmount
+	// Deprecated: use commontypes.AttributeKeyReason
+	AttributeKeyReason = commontypes.AttributeKeyReason
+	// Deprecated: use commontypes.AttributeKeyDenom
+	AttributeKeyDenom = commontypes.AttributeKeyDenom
+	// Deprecated: use commontypes.AttributeKeyHolderBank
+	AttributeKeyHolderBank = commontypes.AttributeKeyHolderBank
+	// Deprecated: use commontypes.AttributeKeyRecipient
+	AttributeKeyRecipient = commontypes.AttributeKeyRecipient
+	// Deprecated: use commontypes.AttributeKeyValidator
+	AttributeKeyValidator = commontypes.AttributeKeyValidator
+	// Deprecated: use commontypes.AttributeKeyThreshold
+	AttributeKeyThreshold = commontypes.AttributeKeyThreshold
+	// Deprecated: use commontypes.AttributeKeyReporter
+	AttributeKeyReporter = commontypes.AttributeKeyReporter
+)
+
+// Oracle module telemetry metric keys
+const (
+	MetricKeyLateVotes          = "late_votes"          // Votes rejected because the transfer was already confirmed
+	MetricKeyReporterMismatches = "reporter_mismatches" // First reports that did not match the consensus content
+)
@@ -19,6 +19,7 @@ type AccountKeeper interface {
 // BankKeeper defines the expected bank keeper interface
 type BankKeeper interface {
 	SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
 
@@ -28,12 +29,20 @@ type StakingKeeper interface {
 	GetBondedValidatorsByPower(ctx context.Context) ([]stakingtypes.Validator, error)
 }
 
-// NettingKeeper defines the expected netting keeper interface
+// NettingKeeper defines the expected netting keeper interface: the shared
+// credit mutations and balance reads, plus the backlog and forecast the
+// oracle reports
 type NettingKeeper interface {
-	IssueCreditToken(ctx sdk.Context, creditToken commontypes.CreditToken) error
+	commontypes.CreditKeeper
+	commontypes.BalanceReader
+	GetNettingBacklog(ctx sdk.Context) commontypes.NettingBacklog
+	ForecastNetting(ctx sdk.Context, pending []commontypes.CreditToken) commontypes.NettingForecast
 }
 
 // MultisigKeeper defines the expected multisig keeper interface
 type MultisigKeeper interface {
-	GenerateMintCommand(ctx sdk.Context, targetChain, recipient string, amount math.Int) (commontypes.MintCommand, error)
+	GenerateTokenMintCommand(ctx sdk.Context, targetChain, recipient, tokenID string, amount math.Int) (commontypes.MintCommand, error)
+	GetAllPendingCommands(ctx sdk.Context) []commontypes.MintCommand
+	GetCommand(ctx sdk.Context, commandID string) (commontypes.MintCommand, bool)
+	GetValidatorSet(ctx sdk.Context) commontypes.ValidatorSet
 }
@@ -0,0 +1,73 @@
+package types
+
+import "fmt"
+
+// Finality models of a Besu chain
+const (
+	// FinalityModelInstant is the finality of IBFT 2.0 and QBFT: a block is
+	// final once it is produced, so transfers need no confirmations and a
+	// reorg cannot remove them
+	FinalityModelInstant = "instant"
+	// FinalityModelProbabilistic is the finality of proof-of-work and Clique
+	// chains: a block becomes final as it is buried, so transfers wait for
+	// their confirmation depth and reorgs are reported as disputes
+	FinalityModelProbabilistic = "probabilistic"
+)
+
+// ChainFinality registers the finality model of a Besu chain. Chains without
+// an entry keep the behavior from before finality was configurable: votes are
+// accepted at any depth and reorgs are reported.
+type ChainFinality struct {
+	Chain             string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain"`
+	Model             string `protobuf:"bytes,2,opt,name=model,proto3" json:"model"`                                                   // FinalityModelInstant or FinalityModelProbabilistic
+	ConfirmationDepth uint64 `protobuf:"varint,3,opt,name=confirmation_depth,json=confirmationDepth,proto3" json:"confirmation_depth"` // Blocks above a transfer's block before it is voted, probabilistic only
+}
+
+// ProtoMessage implements proto.Message
+func (f *ChainFinality) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (f *ChainFinality) Reset() { *f = ChainFinality{} }
+
+// String implements proto.Message
+func (f *ChainFinality) String() string {
+	return fmt.Sprintf("ChainFinality{Chain: %s, Model: %s, ConfirmationDepth: %d}", f.Chain, f.Model, f.ConfirmationDepth)
+}
+
+// ReorgHandling returns true if reorgs of the chain are reported and disputed.
+// Blocks of an instant finality chain are never reorganized, so a reorg
+// report for one is rejected.
+func (f ChainFinality) ReorgHandling() bool {
+	return f.Model != FinalityModelInstant
+}
+
+// Confirmed returns true if a transfer in block blockHeight is deep enough at
+// besuHeight, the latest block of the chain
+func (f ChainFinality) Confirmed(blockHeight uint64, besuHeight int64) bool {
+	if f.ConfirmationDepth == 0 {
+		return true
+	}
+	return besuHeight >= 0 && uint64(besuHeight) >= blockHeight && uint64(besuHeight)-blockHeight >= f.ConfirmationDepth
+}
+
+// Validate checks the model and its confirmation depth
+func (f ChainFinality) Validate() error {
+	if f.Chain == "" {
+		return fmt.Errorf("chain cannot be empty")
+	}
+
+	switch f.Model {
+	case FinalityModelInstant:
+		if f.ConfirmationDepth != 0 {
+			return fmt.Errorf("chain %s: instant finality takes no confirmation depth", f.Chain)
+		}
+	case FinalityModelProbabilistic:
+		if f.ConfirmationDepth == 0 {
+			return fmt.Errorf("chain %s: probabilistic finality needs a positive confirmation depth", f.Chain)
+		}
+	default:
+		return fmt.Errorf("chain %s: unknown finality model %q", f.Chain, f.Model)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// HeartbeatSuspensionReason is the reason of the suspensions set for stale
+// heartbeats, whose code is ReasonStaleHeartbeat. Only those suspensions are
+// lifted by a fresh heartbeat; suspensions set by governance stay until
+// MsgResume.
+const HeartbeatSuspensionReason = "stale chain heartbeat"
+
+// ChainHeartbeat is the latest status of a Besu chain posted by a relayer
+type ChainHeartbeat struct {
+	Chain          string   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain"`
+	Reporter       string   `protobuf:"bytes,2,opt,name=reporter,proto3" json:"reporter"`
+	BesuHeight     int64    `protobuf:"varint,3,opt,name=besu_height,json=besuHeight,proto3" json:"besu_height"`                                             // Latest block the relayer saw
+	GatewayBalance math.Int `protobuf:"bytes,4,opt,name=gateway_balance,json=gatewayBalance,proto3,customtype=cosmossdk.io/math.Int" json:"gateway_balance"` // Balance of the gateway contract
+	Syncing        bool     `protobuf:"varint,5,opt,name=syncing,proto3" json:"syncing"`                                                                     // The relayer's node is still syncing
+	ReportedAt     int64    `protobuf:"varint,6,opt,name=reported_at,json=reportedAt,proto3" json:"reported_at"`
+	ReportedHeight int64    `protobuf:"varint,7,opt,name=reported_height,json=reportedHeight,proto3" json:"reported_height"`
+}
+
+// ProtoMessage implements proto.Message
+func (h *ChainHeartbeat) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (h *ChainHeartbeat) Reset() { *h = ChainHeartbeat{} }
+
+// String implements proto.Message
+func (h *ChainHeartbeat) String() string {
+	return fmt.Sprintf("ChainHeartbeat{Chain: %s, BesuHeight: %d, Syncing: %t}", h.Chain, h.BesuHeight, h.Syncing)
+}
+
+// Stale returns true if the heartbeat is older than timeout seconds at now. A
+// zero timeout never goes stale.
+func (h ChainHeartbeat) Stale(now, timeout int64) bool {
+	return timeout > 0 && now-h.ReportedAt > timeout
+}
+
+// ChainHealth is the health of a chain derived from its latest heartbeat
+type ChainHealth struct {
+	Heartbeat ChainHeartbeat `protobuf:"bytes,1,opt,name=heartbeat,proto3" json:"heartbeat"`
+	Stale     bool           `protobuf:"varint,2,opt,name=stale,proto3" json:"stale"`         // No heartbeat within the heartbeat timeout
+	Suspended bool           `protobuf:"varint,3,opt,name=suspended,proto3" json:"suspended"` // Issuance from the chain is suspended
+	Healthy   bool           `protobuf:"varint,4,opt,name=healthy,proto3" json:"healthy"`     // Fresh, synced and not suspended
+}
+
+// ProtoMessage implements proto.Message
+func (h *ChainHealth) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (h *ChainHealth) Reset() { *h = ChainHealth{} }
+
+// String implements proto.Message
+func (h *ChainHealth) String() string {
+	return fmt.Sprintf("ChainHealth{Chain: %s, Healthy: %t}", h.Heartbeat.Chain, h.Healthy)
+}
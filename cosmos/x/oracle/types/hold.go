@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	commontypes "github.com/interbank-netting/cosmos/types"
+)
+
+// HeldTransfer is a transfer that reached consensus but exceeded its corridor
+// cap, involved a suspended chain or bank, or would overdraw its source bank.
+// It stays unconfirmed until the authority approves or rejects it, or until
+// the suspension is lifted.
+type HeldTransfer struct {
+	TxHash      string                    `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash"`
+	EventData   commontypes.TransferEvent `protobuf:"bytes,2,opt,name=event_data,json=eventData,proto3" json:"event_data"`
+	Cap         math.Int                  `protobuf:"bytes,3,opt,name=cap,proto3,customtype=cosmossdk.io/math.Int" json:"cap"`             // Corridor cap at the time the transfer was held
+	HeldAt      int64                     `protobuf:"varint,4,opt,name=held_at,json=heldAt,proto3" json:"held_at"`
+	Rejected    bool                      `protobuf:"varint,5,opt,name=rejected,proto3" json:"rejected"`
+	Reason      string                    `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason"`                                        // Free-text detail of ReasonCode
+	SuspendedBy string                    `protobuf:"bytes,7,opt,name=suspended_by,json=suspendedBy,proto3" json:"suspended_by,omitempty"` // Target of the suspension that held the transfer; Cap is then zero
+	Overdraft   bool                      `protobuf:"varint,8,opt,name=overdraft,proto3" json:"overdraft,omitempty"`                       // Held by the strict overdraft rule; Cap is then the source bank's net credit
+	ReasonCode  commontypes.ReasonCode    `protobuf:"bytes,9,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`    // Rejection reason code
+}
+
+// ProtoMessage implements proto.Message
+func (h *HeldTransfer) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (h *HeldTransfer) Reset() { *h = HeldTransfer{} }
+
+// String implements proto.Message
+func (h *HeldTransfer) String() string {
+	return fmt.Sprintf("HeldTransfer{TxHash: %s, Amount: %s, Cap: %s, Rejected: %t}",
+		h.TxHash, h.EventData.Amount, h.Cap, h.Rejected)
+}
@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "oracle"
@@ -14,127 +16,68 @@ const (
 	MemStoreKey = "mem_oracle"
 )
 
-// Store key prefixes
+// Store prefixes of the keeper collections
 var (
 	// VoteStatusKeyPrefix is the prefix for vote status storage
-	VoteStatusKeyPrefix = []byte{0x01}
+	VoteStatusKeyPrefix = collections.NewPrefix(1)
 
-	// VoteKeyPrefix is the prefix for individual votes storage
-	VoteKeyPrefix = []byte{0x02}
+	// VoteKeyPrefix is the prefix for individual votes, keyed by (tx hash, validator)
+	VoteKeyPrefix = collections.NewPrefix(2)
 
-	// ValidatorKeyPrefix is the prefix for validator storage
-	ValidatorKeyPrefix = []byte{0x03}
+	// Prefix 3 held validators in earlier versions and is not reused
 
 	// ConfirmedTransferKeyPrefix is the prefix for confirmed transfers
-	ConfirmedTransferKeyPrefix = []byte{0x04}
+	ConfirmedTransferKeyPrefix = collections.NewPrefix(4)
 
 	// AuditLogKeyPrefix is the prefix for audit log storage
-	AuditLogKeyPrefix = []byte{0x05}
+	AuditLogKeyPrefix = collections.NewPrefix(5)
 
-	// AuditLogCounterKey is the key for audit log ID counter
-	AuditLogCounterKey = []byte{0x06}
+	// AuditLogCounterKey is the key for the last audit log ID
+	AuditLogCounterKey = collections.NewPrefix(6)
 
-	// AuditLogByTimeKeyPrefix is the prefix for time-indexed audit logs
-	AuditLogByTimeKeyPrefix = []byte{0x07}
+	// AuditLogByTimeKeyPrefix is the prefix for the (timestamp, ID) audit log index
+	AuditLogByTimeKeyPrefix = collections.NewPrefix(7)
 
-	// AuditLogByTypeKeyPrefix is the prefix for type-indexed audit logs
-	AuditLogByTypeKeyPrefix = []byte{0x08}
-)
+	// AuditLogByTypeKeyPrefix is the prefix for the (event type, ID) audit log index
+	AuditLogByTypeKeyPrefix = collections.NewPrefix(8)
+
+	// VoteFeeKeyPrefix is the prefix for vote fees pending refund, keyed by (tx hash, validator)
+	VoteFeeKeyPrefix = collections.NewPrefix(9)
+
+	// ParamsKey is the key for the module parameters
+	ParamsKey = collections.NewPrefix(10)
+
+	// HeldTransferKeyPrefix is the prefix for transfers held above their corridor cap
+	HeldTransferKeyPrefix = collections.NewPrefix(11)
+
+	// DisputeKeyPrefix is the prefix for reorg disputes of confirmed transfers
+	DisputeKeyPrefix = collections.NewPrefix(12)
+
+	// TransferCreditKeyPrefix is the prefix for the credit token issued by each confirmed transfer
+	TransferCreditKeyPrefix = collections.NewPrefix(13)
+
+	// SuspensionKeyPrefix is the prefix for suspended chains and banks, keyed by target
+	SuspensionKeyPrefix = collections.NewPrefix(14)
 
-// GetVoteStatusKey returns the store key for a vote status
-func GetVoteStatusKey(txHash string) []byte {
-	return append(VoteStatusKeyPrefix, []byte(txHash)...)
-}
-
-// GetVoteKey returns the store key for a specific vote
-func GetVoteKey(txHash, validator string) []byte {
-	key := append(VoteKeyPrefix, []byte(txHash)...)
-	key = append(key, []byte("/")...)
-	return append(key, []byte(validator)...)
-}
-
-// GetValidatorKey returns the store key for a validator
-func GetValidatorKey(validator string) []byte {
-	return append(ValidatorKeyPrefix, []byte(validator)...)
-}
-
-// GetConfirmedTransferKey returns the store key for a confirmed transfer
-func GetConfirmedTransferKey(txHash string) []byte {
-	return append(ConfirmedTransferKeyPrefix, []byte(txHash)...)
-}
-
-// GetAuditLogKey returns the store key for an audit log by ID
-func GetAuditLogKey(id uint64) []byte {
-	bz := make([]byte, 8)
-	bz[0] = byte(id >> 56)
-	bz[1] = byte(id >> 48)
-	bz[2] = byte(id >> 40)
-	bz[3] = byte(id >> 32)
-	bz[4] = byte(id >> 24)
-	bz[5] = byte(id >> 16)
-	bz[6] = byte(id >> 8)
-	bz[7] = byte(id)
-	return append(AuditLogKeyPrefix, bz...)
-}
-
-// GetAuditLogByTimeKey returns the store key for time-indexed audit logs
-// Format: prefix + timestamp (8 bytes) + id (8 bytes)
-func GetAuditLogByTimeKey(timestamp int64, id uint64) []byte {
-	key := make([]byte, 16)
-	// Store timestamp as big-endian for proper ordering
-	key[0] = byte(timestamp >> 56)
-	key[1] = byte(timestamp >> 48)
-	key[2] = byte(timestamp >> 40)
-	key[3] = byte(timestamp >> 32)
-	key[4] = byte(timestamp >> 24)
-	key[5] = byte(timestamp >> 16)
-	key[6] = byte(timestamp >> 8)
-	key[7] = byte(timestamp)
-	// Add ID for uniqueness
-	key[8] = byte(id >> 56)
-	key[9] = byte(id >> 48)
-	key[10] = byte(id >> 40)
-	key[11] = byte(id >> 32)
-	key[12] = byte(id >> 24)
-	key[13] = byte(id >> 16)
-	key[14] = byte(id >> 8)
-	key[15] = byte(id)
-	return append(AuditLogByTimeKeyPrefix, key...)
-}
-
-// GetAuditLogByTypeKey returns the store key for type-indexed audit logs
-// Format: prefix + eventType + "/" + id (8 bytes)
-func GetAuditLogByTypeKey(eventType string, id uint64) []byte {
-	key := append(AuditLogByTypeKeyPrefix, []byte(eventType)...)
-	key = append(key, byte('/'))
-	bz := make([]byte, 8)
-	bz[0] = byte(id >> 56)
-	bz[1] = byte(id >> 48)
-	bz[2] = byte(id >> 40)
-	bz[3] = byte(id >> 32)
-	bz[4] = byte(id >> 24)
-	bz[5] = byte(id >> 16)
-	bz[6] = byte(id >> 8)
-	bz[7] = byte(id)
-	return append(key, bz...)
-}
-
-// GetAuditLogByTypePrefix returns the prefix for a specific event type
-func GetAuditLogByTypePrefix(eventType string) []byte {
-	key := append(AuditLogByTypeKeyPrefix, []byte(eventType)...)
-	return append(key, byte('/'))
-}
-
-// GetAuditLogTimeRangePrefix returns prefix for time range queries
-func GetAuditLogTimeRangePrefix(startTime int64) []byte {
-	key := make([]byte, 8)
-	key[0] = byte(startTime >> 56)
-	key[1] = byte(startTime >> 48)
-	key[2] = byte(startTime >> 40)
-	key[3] = byte(startTime >> 32)
-	key[4] = byte(startTime >> 24)
-	key[5] = byte(startTime >> 16)
-	key[6] = byte(startTime >> 8)
-	key[7] = byte(startTime)
-	return append(AuditLogByTimeKeyPrefix, key...)
-}
\ No newline at end of file
+	// ParamsHistoryKeyPrefix is the prefix for the params changes keyed by version
+	ParamsHistoryKeyPrefix = collections.NewPrefix(15)
+
+	// ParamsVersionKey is the key for the last params version
+	ParamsVersionKey = collections.NewPrefix(16)
+
+	// ChainHeartbeatKeyPrefix is the prefix for the latest heartbeat of each chain
+	ChainHeartbeatKeyPrefix = collections.NewPrefix(17)
+
+	// ReporterKeyPrefix is the prefix for the first report record of each validator
+	ReporterKeyPrefix = collections.NewPrefix(18)
+
+	// CreditReleaseKeyPrefix is the prefix for the credit of confirmed
+	// transfers frozen until their mint command is executed
+	CreditReleaseKeyPrefix = collections.NewPrefix(19)
+
+	// RejectedTransferKeyPrefix is the prefix for the evidence of rejected transfers
+	RejectedTransferKeyPrefix = collections.NewPrefix(20)
+
+	// VoteFeeByHeightKeyPrefix is the prefix for the (height, tx hash, validator) vote fee index
+	VoteFeeByHeightKeyPrefix = collections.NewPrefix(21)
+)
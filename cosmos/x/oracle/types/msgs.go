@@ -3,16 +3,40 @@ package types
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	commontypes "github.com/interbank-netting/cosmos/types"
 )
 
 const (
-	TypeMsgVote = "vote"
+	TypeMsgVote                = "vote"
+	TypeMsgUpdateParams        = "update_params"
+	TypeMsgApproveHeldTransfer = "approve_held_transfer"
+	TypeMsgRejectHeldTransfer  = "reject_held_transfer"
+	TypeMsgReportReorg         = "report_reorg"
+	TypeMsgResolveDispute      = "resolve_dispute"
+	TypeMsgBatchVote           = "batch_vote"
+	TypeMsgSuspend             = "suspend"
+	TypeMsgResume              = "resume"
+	TypeMsgChainHeartbeat      = "chain_heartbeat"
+	TypeMsgClearReporterFlag   = "clear_reporter_flag"
 )
 
-var _ sdk.Msg = &MsgVote{}
+var (
+	_ sdk.Msg = &MsgVote{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgApproveHeldTransfer{}
+	_ sdk.Msg = &MsgRejectHeldTransfer{}
+	_ sdk.Msg = &MsgReportReorg{}
+	_ sdk.Msg = &MsgResolveDispute{}
+	_ sdk.Msg = &MsgBatchVote{}
+	_ sdk.Msg = &MsgSuspend{}
+	_ sdk.Msg = &MsgResume{}
+	_ sdk.Msg = &MsgChainHeartbeat{}
+	_ sdk.Msg = &MsgClearReporterFlag{}
+)
 
 // MsgVote defines a message for submitting a vote on a transfer event
 type MsgVote struct {
@@ -20,6 +44,7 @@ type MsgVote struct {
 	Validator   string                   `json:"validator"`
 	EventData   commontypes.TransferEvent `json:"event_data"`
 	Signature   []byte                   `json:"signature"`
+	SignatureVersion uint32              `json:"signature_version"` // Signing envelope version of Signature
 }
 
 // ProtoMessage implements proto.Message
@@ -36,10 +61,11 @@ func (msg *MsgVote) String() string {
 // NewMsgVote creates a new MsgVote instance
 func NewMsgVote(txHash, validator string, eventData commontypes.TransferEvent, signature []byte) *MsgVote {
 	return &MsgVote{
-		TxHash:    txHash,
-		Validator: validator,
-		EventData: eventData,
-		Signature: signature,
+		TxHash:           txHash,
+		Validator:        validator,
+		EventData:        eventData,
+		Signature:        signature,
+		SignatureVersion: CurrentSignatureVersion,
 	}
 }
 
@@ -71,45 +97,792 @@ func (msg MsgVote) GetSignBytes() []byte {
 // ValidateBasic implements the sdk.Msg interface
 func (msg MsgVote) ValidateBasic() error {
 	if msg.TxHash == "" {
-		return fmt.Errorf("tx hash cannot be empty")
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "tx hash cannot be empty")
 	}
 	
 	if msg.Validator == "" {
-		return fmt.Errorf("validator cannot be empty")
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "validator cannot be empty")
 	}
 	
 	_, err := sdk.AccAddressFromBech32(msg.Validator)
 	if err != nil {
-		return fmt.Errorf("invalid validator address: %w", err)
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address: %s", err)
+	}
+	
+	return validateVoteContent(msg.TxHash, msg.EventData, msg.Signature, msg.SignatureVersion)
+}
+
+// validateVoteContent checks the signed content of a vote, shared by MsgVote
+// and the entries of a MsgBatchVote
+func validateVoteContent(txHash string, event commontypes.TransferEvent, signature []byte, signatureVersion uint32) error {
+	if txHash == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "tx hash cannot be empty")
+	}
+
+	if event.TxHash != txHash {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "event data tx hash must match message tx hash")
+	}
+
+	// The event is stored with the vote, so its strings are bounded
+	if err := commontypes.ValidateEventSize(event); err != nil {
+		return errorsmod.Wrapf(ErrFieldTooLong, "event data %s", err)
 	}
 	
-	if msg.EventData.TxHash != msg.TxHash {
-		return fmt.Errorf("event data tx hash must match message tx hash")
+	if event.Sender == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "event data sender cannot be empty")
 	}
 	
-	if msg.EventData.Sender == "" {
-		return fmt.Errorf("event data sender cannot be empty")
+	if event.Recipient == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "event data recipient cannot be empty")
 	}
 	
-	if msg.EventData.Recipient == "" {
-		return fmt.Errorf("event data recipient cannot be empty")
+	if event.Amount.IsNil() || event.Amount.LTE(math.ZeroInt()) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "event data amount must be positive")
 	}
 	
-	if msg.EventData.Amount.IsNil() || msg.EventData.Amount.LTE(math.ZeroInt()) {
-		return fmt.Errorf("event data amount must be positive")
+	if event.SourceChain == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "event data source chain cannot be empty")
 	}
 	
-	if msg.EventData.SourceChain == "" {
-		return fmt.Errorf("event data source chain cannot be empty")
+	if event.DestChain == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "event data dest chain cannot be empty")
 	}
 	
-	if msg.EventData.DestChain == "" {
-		return fmt.Errorf("event data dest chain cannot be empty")
+	if !commontypes.IsValidPriority(event.Priority) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "event data priority is unknown: %d", event.Priority)
+	}
+
+	if err := commontypes.ValidateTokenID(event.AssetID); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "event data asset ID: %s", err)
 	}
 	
-	if len(msg.Signature) == 0 {
-		return fmt.Errorf("signature cannot be empty")
+	if len(signature) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "signature cannot be empty")
 	}
 	
+	if !IsSupportedSignatureVersion(signatureVersion) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unsupported signature version: %d", signatureVersion)
+	}
+	
+	return nil
+}
+
+// MsgUpdateParams defines a governance message for updating the module
+// params, including the corridor caps. ProposalID is the ID of the proposal
+// carrying the message and is recorded in the params history.
+type MsgUpdateParams struct {
+	Authority  string `json:"authority"`
+	Params     Params `json:"params"`
+	ProposalID uint64 `json:"proposal_id,omitempty"`
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgUpdateParams) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgUpdateParams) Reset() { *msg = MsgUpdateParams{} }
+
+// String implements proto.Message
+func (msg *MsgUpdateParams) String() string {
+	return fmt.Sprintf("MsgUpdateParams{Authority: %s, Params: %s}", msg.Authority, msg.Params.String())
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgUpdateParams) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgUpdateParams) Type() string {
+	return TypeMsgUpdateParams
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidParams, err.Error())
+	}
+
+	return nil
+}
+
+// MsgApproveHeldTransfer defines a governance message confirming a transfer
+// held above its corridor cap
+type MsgApproveHeldTransfer struct {
+	Authority string `json:"authority"`
+	TxHash    string `json:"tx_hash"`
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgApproveHeldTransfer) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgApproveHeldTransfer) Reset() { *msg = MsgApproveHeldTransfer{} }
+
+// String implements proto.Message
+func (msg *MsgApproveHeldTransfer) String() string {
+	return fmt.Sprintf("MsgApproveHeldTransfer{Authority: %s, TxHash: %s}", msg.Authority, msg.TxHash)
+}
+
+// NewMsgApproveHeldTransfer creates a new MsgApproveHeldTransfer instance
+func NewMsgApproveHeldTransfer(authority, txHash string) *MsgApproveHeldTransfer {
+	return &MsgApproveHeldTransfer{
+		Authority: authority,
+		TxHash:    txHash,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgApproveHeldTransfer) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgApproveHeldTransfer) Type() string {
+	return TypeMsgApproveHeldTransfer
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgApproveHeldTransfer) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgApproveHeldTransfer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgApproveHeldTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if msg.TxHash == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "tx hash cannot be empty")
+	}
+
+	return nil
+}
+
+// MsgRejectHeldTransfer defines a governance message rejecting a transfer
+// held above its corridor cap
+type MsgRejectHeldTransfer struct {
+	Authority string `json:"authority"`
+	TxHash    string `json:"tx_hash"`
+	Reason    string `json:"reason"`
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgRejectHeldTransfer) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgRejectHeldTransfer) Reset() { *msg = MsgRejectHeldTransfer{} }
+
+// String implements proto.Message
+func (msg *MsgRejectHeldTransfer) String() string {
+	return fmt.Sprintf("MsgRejectHeldTransfer{Authority: %s, TxHash: %s}", msg.Authority, msg.TxHash)
+}
+
+// NewMsgRejectHeldTransfer creates a new MsgRejectHeldTransfer instance
+func NewMsgRejectHeldTransfer(authority, txHash, reason string) *MsgRejectHeldTransfer {
+	return &MsgRejectHeldTransfer{
+		Authority: authority,
+		TxHash:    txHash,
+		Reason:    reason,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgRejectHeldTransfer) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgRejectHeldTransfer) Type() string {
+	return TypeMsgRejectHeldTransfer
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgRejectHeldTransfer) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgRejectHeldTransfer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgRejectHeldTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if msg.TxHash == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "tx hash cannot be empty")
+	}
+
+	if msg.Reason == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "reason cannot be empty")
+	}
+
+	if err := commontypes.ValidateFieldLength("reason", msg.Reason, commontypes.MaxReasonLength); err != nil {
+		return errorsmod.Wrap(ErrFieldTooLong, err.Error())
+	}
+
+	return nil
+}
+
+// MsgReportReorg reports that a Besu reorg removed the source transaction of a
+// confirmed transfer. It freezes the transfer's credit and opens a dispute.
+type MsgReportReorg struct {
+	Reporter string `json:"reporter"`
+	TxHash   string `json:"tx_hash"`
+	Reason   string `json:"reason"`
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgReportReorg) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgReportReorg) Reset() { *msg = MsgReportReorg{} }
+
+// String implements proto.Message
+func (msg *MsgReportReorg) String() string {
+	return fmt.Sprintf("MsgReportReorg{Reporter: %s, TxHash: %s}", msg.Reporter, msg.TxHash)
+}
+
+// NewMsgReportReorg creates a new MsgReportReorg instance
+func NewMsgReportReorg(reporter, txHash, reason string) *MsgReportReorg {
+	return &MsgReportReorg{
+		Reporter: reporter,
+		TxHash:   txHash,
+		Reason:   reason,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgReportReorg) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgReportReorg) Type() string {
+	return TypeMsgReportReorg
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgReportReorg) GetSigners() []sdk.AccAddress {
+	reporter, err := sdk.AccAddressFromBech32(msg.Reporter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{reporter}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgReportReorg) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgReportReorg) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Reporter); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid reporter address: %s", err)
+	}
+
+	if msg.TxHash == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "tx hash cannot be empty")
+	}
+
+	if msg.Reason == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "reason cannot be empty")
+	}
+
+	if err := commontypes.ValidateFieldLength("reason", msg.Reason, commontypes.MaxReasonLength); err != nil {
+		return errorsmod.Wrap(ErrFieldTooLong, err.Error())
+	}
+
 	return nil
-}
\ No newline at end of file
+}
+
+// MsgResolveDispute defines a governance message closing a reorg dispute,
+// burning the frozen credit if the reorg is confirmed or releasing it otherwise
+type MsgResolveDispute struct {
+	Authority      string `json:"authority"`
+	TxHash         string `json:"tx_hash"`
+	ReorgConfirmed bool   `json:"reorg_confirmed"`
+	Resolution     string `json:"resolution"`
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgResolveDispute) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgResolveDispute) Reset() { *msg = MsgResolveDispute{} }
+
+// String implements proto.Message
+func (msg *MsgResolveDispute) String() string {
+	return fmt.Sprintf("MsgResolveDispute{Authority: %s, TxHash: %s, ReorgConfirmed: %t}",
+		msg.Authority, msg.TxHash, msg.ReorgConfirmed)
+}
+
+// NewMsgResolveDispute creates a new MsgResolveDispute instance
+func NewMsgResolveDispute(authority, txHash string, reorgConfirmed bool, resolution string) *MsgResolveDispute {
+	return &MsgResolveDispute{
+		Authority:      authority,
+		TxHash:         txHash,
+		ReorgConfirmed: reorgConfirmed,
+		Resolution:     resolution,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgResolveDispute) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgResolveDispute) Type() string {
+	return TypeMsgResolveDispute
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgResolveDispute) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgResolveDispute) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgResolveDispute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if msg.TxHash == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "tx hash cannot be empty")
+	}
+
+	if msg.Resolution == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "resolution cannot be empty")
+	}
+
+	if err := commontypes.ValidateFieldLength("resolution", msg.Resolution, commontypes.MaxReasonLength); err != nil {
+		return errorsmod.Wrap(ErrFieldTooLong, err.Error())
+	}
+
+	return nil
+}
+
+// MsgBatchVote submits many votes of one validator in a single message. The
+// votes are a VoteBatch compressed with Encoding, which keeps block space
+// reasonable when a burst of Besu events is relayed at once.
+type MsgBatchVote struct {
+	Validator string `json:"validator"`
+	Encoding  string `json:"encoding"` // PayloadEncodingGzip or PayloadEncodingZstd
+	Payload   []byte `json:"payload"`
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgBatchVote) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgBatchVote) Reset() { *msg = MsgBatchVote{} }
+
+// String implements proto.Message
+func (msg *MsgBatchVote) String() string {
+	return fmt.Sprintf("MsgBatchVote{Validator: %s, Encoding: %s, Payload: %d bytes}", msg.Validator, msg.Encoding, len(msg.Payload))
+}
+
+// NewMsgBatchVote compresses votes with encoding into a new MsgBatchVote
+func NewMsgBatchVote(validator, encoding string, votes []BatchVoteEntry) (*MsgBatchVote, error) {
+	payload, err := EncodeVoteBatch(encoding, votes)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgBatchVote{
+		Validator: validator,
+		Encoding:  encoding,
+		Payload:   payload,
+	}, nil
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgBatchVote) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgBatchVote) Type() string {
+	return TypeMsgBatchVote
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgBatchVote) GetSigners() []sdk.AccAddress {
+	validator, err := sdk.AccAddressFromBech32(msg.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{validator}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgBatchVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface. The payload is decompressed
+// and its votes validated by the msg server, under the oracle batch params.
+func (msg MsgBatchVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Validator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address: %s", err)
+	}
+
+	if !IsSupportedPayloadEncoding(msg.Encoding) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unsupported payload encoding: %q", msg.Encoding)
+	}
+
+	if len(msg.Payload) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "payload cannot be empty")
+	}
+
+	return nil
+}
+
+// MsgSuspend defines a governance message suspending a source chain or a
+// bank. It is meant to be submitted in an expedited proposal, so that an
+// incident halts issuance and command generation within the shorter
+// expedited voting period.
+type MsgSuspend struct {
+	Authority string `json:"authority"`
+	Target    string `json:"target"` // Chain or bank ID
+	Scope     string `json:"scope"`  // SuspensionScopeChain or SuspensionScopeBank
+	Reason    string `json:"reason"`
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgSuspend) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgSuspend) Reset() { *msg = MsgSuspend{} }
+
+// String implements proto.Message
+func (msg *MsgSuspend) String() string {
+	return fmt.Sprintf("MsgSuspend{Authority: %s, Target: %s, Scope: %s}", msg.Authority, msg.Target, msg.Scope)
+}
+
+// NewMsgSuspend creates a new MsgSuspend instance
+func NewMsgSuspend(authority, target, scope, reason string) *MsgSuspend {
+	return &MsgSuspend{
+		Authority: authority,
+		Target:    target,
+		Scope:     scope,
+		Reason:    reason,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgSuspend) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgSuspend) Type() string {
+	return TypeMsgSuspend
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgSuspend) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgSuspend) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgSuspend) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if msg.Target == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "target cannot be empty")
+	}
+
+	if !IsValidSuspensionScope(msg.Scope) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid suspension scope: %q", msg.Scope)
+	}
+
+	if msg.Reason == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "reason cannot be empty")
+	}
+
+	if err := commontypes.ValidateFieldLength("reason", msg.Reason, commontypes.MaxReasonLength); err != nil {
+		return errorsmod.Wrap(ErrFieldTooLong, err.Error())
+	}
+
+	return nil
+}
+
+// MsgResume defines a governance message lifting the suspension of a chain
+// or bank. Transfers the suspension held are confirmed again.
+type MsgResume struct {
+	Authority string `json:"authority"`
+	Target    string `json:"target"`
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgResume) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgResume) Reset() { *msg = MsgResume{} }
+
+// String implements proto.Message
+func (msg *MsgResume) String() string {
+	return fmt.Sprintf("MsgResume{Authority: %s, Target: %s}", msg.Authority, msg.Target)
+}
+
+// NewMsgResume creates a new MsgResume instance
+func NewMsgResume(authority, target string) *MsgResume {
+	return &MsgResume{
+		Authority: authority,
+		Target:    target,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgResume) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgResume) Type() string {
+	return TypeMsgResume
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgResume) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgResume) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgResume) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if msg.Target == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "target cannot be empty")
+	}
+
+	return nil
+}
+
+// MsgChainHeartbeat posts the status of a Besu chain as seen by the relayer of
+// an active validator. Relayers post it periodically; a chain without a recent
+// heartbeat is considered stale.
+type MsgChainHeartbeat struct {
+	Reporter       string   `json:"reporter"`
+	Chain          string   `json:"chain"`
+	BesuHeight     int64    `json:"besu_height"`     // Latest block the relayer saw
+	GatewayBalance math.Int `json:"gateway_balance"` // Balance of the gateway contract
+	Syncing        bool     `json:"syncing"`         // The relayer's node is still syncing
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgChainHeartbeat) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgChainHeartbeat) Reset() { *msg = MsgChainHeartbeat{} }
+
+// String implements proto.Message
+func (msg *MsgChainHeartbeat) String() string {
+	return fmt.Sprintf("MsgChainHeartbeat{Reporter: %s, Chain: %s, BesuHeight: %d}", msg.Reporter, msg.Chain, msg.BesuHeight)
+}
+
+// NewMsgChainHeartbeat creates a new MsgChainHeartbeat instance
+func NewMsgChainHeartbeat(reporter, chain string, besuHeight int64, gatewayBalance math.Int, syncing bool) *MsgChainHeartbeat {
+	return &MsgChainHeartbeat{
+		Reporter:       reporter,
+		Chain:          chain,
+		BesuHeight:     besuHeight,
+		GatewayBalance: gatewayBalance,
+		Syncing:        syncing,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgChainHeartbeat) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgChainHeartbeat) Type() string {
+	return TypeMsgChainHeartbeat
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgChainHeartbeat) GetSigners() []sdk.AccAddress {
+	reporter, err := sdk.AccAddressFromBech32(msg.Reporter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{reporter}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgChainHeartbeat) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgChainHeartbeat) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Reporter); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid reporter address: %s", err)
+	}
+
+	if msg.Chain == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "chain cannot be empty")
+	}
+
+	if msg.BesuHeight < 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "besu height cannot be negative: %d", msg.BesuHeight)
+	}
+
+	if msg.GatewayBalance.IsNil() || msg.GatewayBalance.IsNegative() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "gateway balance cannot be negative")
+	}
+
+	return nil
+}
+
+// MsgClearReporterFlag defines a governance message lifting the flag of a
+// reporter whose first reports repeatedly did not match consensus
+type MsgClearReporterFlag struct {
+	Authority string `json:"authority"`
+	Validator string `json:"validator"`
+}
+
+// ProtoMessage implements proto.Message
+func (msg *MsgClearReporterFlag) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (msg *MsgClearReporterFlag) Reset() { *msg = MsgClearReporterFlag{} }
+
+// String implements proto.Message
+func (msg *MsgClearReporterFlag) String() string {
+	return fmt.Sprintf("MsgClearReporterFlag{Authority: %s, Validator: %s}", msg.Authority, msg.Validator)
+}
+
+// NewMsgClearReporterFlag creates a new MsgClearReporterFlag instance
+func NewMsgClearReporterFlag(authority, validator string) *MsgClearReporterFlag {
+	return &MsgClearReporterFlag{
+		Authority: authority,
+		Validator: validator,
+	}
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgClearReporterFlag) Route() string {
+	return RouterKey
+}
+
+// Type implements the sdk.Msg interface
+func (msg MsgClearReporterFlag) Type() string {
+	return TypeMsgClearReporterFlag
+}
+
+// GetSigners implements the sdk.Msg interface
+func (msg MsgClearReporterFlag) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface
+func (msg MsgClearReporterFlag) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface
+func (msg MsgClearReporterFlag) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if msg.Validator == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "validator cannot be empty")
+	}
+
+	return nil
+}
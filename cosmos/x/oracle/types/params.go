@@ -0,0 +1,308 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	commontypes "github.com/interbank-netting/cosmos/types"
+)
+
+// Params defines the parameters for the oracle module.
+type Params struct {
+	VotingPeriod          int64                            `protobuf:"varint,1,opt,name=voting_period,json=votingPeriod,proto3" json:"voting_period"`                                 // Voting period in seconds
+	ConsensusTimeout      int64                            `protobuf:"varint,2,opt,name=consensus_timeout,json=consensusTimeout,proto3" json:"consensus_timeout"`                     // Consensus timeout in seconds
+	MinValidatorCount     int32                            `protobuf:"varint,3,opt,name=min_validator_count,json=minValidatorCount,proto3" json:"min_validator_count"`                // Minimum validator count for consensus
+	CorridorCaps          []CorridorCap                    `protobuf:"bytes,4,rep,name=corridor_caps,json=corridorCaps,proto3" json:"corridor_caps"`                                  // Maximum auto-confirmed amount per corridor
+	AttestationRules      []AttestationRule                `protobuf:"bytes,5,rep,name=attestation_rules,json=attestationRules,proto3" json:"attestation_rules"`                      // Validators that must vote on large transfers
+	MaxProofBytes         int64                            `protobuf:"varint,6,opt,name=max_proof_bytes,json=maxProofBytes,proto3" json:"max_proof_bytes"`                            // Largest vote or transfer proof accepted for verification
+	MaxProofVotes         int32                            `protobuf:"varint,7,opt,name=max_proof_votes,json=maxProofVotes,proto3" json:"max_proof_votes"`                            // Most votes a transfer proof may carry
+	MaxBatchPayloadBytes  int64                            `protobuf:"varint,8,opt,name=max_batch_payload_bytes,json=maxBatchPayloadBytes,proto3" json:"max_batch_payload_bytes"`     // Largest compressed MsgBatchVote payload
+	MaxBatchBytes         int64                            `protobuf:"varint,9,opt,name=max_batch_bytes,json=maxBatchBytes,proto3" json:"max_batch_bytes"`                            // Largest MsgBatchVote payload once decompressed
+	MaxBatchVotes         int32                            `protobuf:"varint,10,opt,name=max_batch_votes,json=maxBatchVotes,proto3" json:"max_batch_votes"`                           // Most votes a MsgBatchVote may carry
+	HeartbeatTimeout      int64                            `protobuf:"varint,11,opt,name=heartbeat_timeout,json=heartbeatTimeout,proto3" json:"heartbeat_timeout"`                    // Seconds without a chain heartbeat before the chain is suspended, zero to never suspend
+	StrictEventValidation bool                             `protobuf:"varint,12,opt,name=strict_event_validation,json=strictEventValidation,proto3" json:"strict_event_validation"`   // Reject transfer events not in canonical form instead of normalizing them
+	ReporterMismatchLimit uint32                           `protobuf:"varint,13,opt,name=reporter_mismatch_limit,json=reporterMismatchLimit,proto3" json:"reporter_mismatch_limit"`   // First reports not matching consensus before a reporter is flagged, zero to never flag
+	GateFlaggedReporters  bool                             `protobuf:"varint,14,opt,name=gate_flagged_reporters,json=gateFlaggedReporters,proto3" json:"gate_flagged_reporters"`      // Prefer the content of a non-flagged voter over a flagged first reporter
+	WeightedConsensus     bool                             `protobuf:"varint,15,opt,name=weighted_consensus,json=weightedConsensus,proto3" json:"weighted_consensus"`                 // Require 2/3+ of the bonded tokens instead of 2/3+ of the validators
+	StrictOverdraft       bool                             `protobuf:"varint,16,opt,name=strict_overdraft,json=strictOverdraft,proto3" json:"strict_overdraft"`                       // Hold transfers that would leave their source bank a net debtor
+	TwoPhaseCreditRelease bool                             `protobuf:"varint,17,opt,name=two_phase_credit_release,json=twoPhaseCreditRelease,proto3" json:"two_phase_credit_release"` // Keep issued credit frozen until its mint command is executed
+	ChainFinality         []ChainFinality                  `protobuf:"bytes,18,rep,name=chain_finality,json=chainFinality,proto3" json:"chain_finality"`                              // Finality model of each registered Besu chain
+	EventHashAlgorithms   []commontypes.ChainHashAlgorithm `protobuf:"bytes,19,rep,name=event_hash_algorithms,json=eventHashAlgorithms,proto3" json:"event_hash_algorithms"`          // Payload hash algorithm of confirmed transfers per destination chain, SHA-256 for chains not listed
+	VoteRefundWindow      int64                            `protobuf:"varint,20,opt,name=vote_refund_window,json=voteRefundWindow,proto3" json:"vote_refund_window"`                  // Blocks a vote may precede the confirmation of its transfer and still have its fee refunded
+}
+
+// ProtoMessage implements proto.Message
+func (p *Params) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (p *Params) Reset() { *p = Params{} }
+
+// String implements proto.Message
+func (p *Params) String() string {
+	return fmt.Sprintf("Params{VotingPeriod: %d, ConsensusTimeout: %d, CorridorCaps: %d}",
+		p.VotingPeriod, p.ConsensusTimeout, len(p.CorridorCaps))
+}
+
+// CorridorCap is the largest transfer from SourceChain to DestChain that is
+// confirmed automatically. Larger transfers are held for manual approval.
+type CorridorCap struct {
+	SourceChain string   `protobuf:"bytes,1,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain"`
+	DestChain   string   `protobuf:"bytes,2,opt,name=dest_chain,json=destChain,proto3" json:"dest_chain"`
+	MaxAmount   math.Int `protobuf:"bytes,3,opt,name=max_amount,json=maxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount"`
+}
+
+// ProtoMessage implements proto.Message
+func (c *CorridorCap) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (c *CorridorCap) Reset() { *c = CorridorCap{} }
+
+// String implements proto.Message
+func (c *CorridorCap) String() string {
+	return fmt.Sprintf("CorridorCap{%s->%s: %s}", c.SourceChain, c.DestChain, c.MaxAmount)
+}
+
+// AttestationRule requires every listed validator, typically the nodes run by
+// the two counterparty banks, to vote on transfers from SourceChain to
+// DestChain of at least MinAmount, in addition to the 2/3+ threshold.
+type AttestationRule struct {
+	SourceChain string   `protobuf:"bytes,1,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain"`
+	DestChain   string   `protobuf:"bytes,2,opt,name=dest_chain,json=destChain,proto3" json:"dest_chain"`
+	MinAmount   math.Int `protobuf:"bytes,3,opt,name=min_amount,json=minAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_amount"`
+	Validators  []string `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators"`
+}
+
+// ProtoMessage implements proto.Message
+func (r *AttestationRule) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (r *AttestationRule) Reset() { *r = AttestationRule{} }
+
+// String implements proto.Message
+func (r *AttestationRule) String() string {
+	return fmt.Sprintf("AttestationRule{%s->%s >= %s: %d validators}", r.SourceChain, r.DestChain, r.MinAmount, len(r.Validators))
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return Params{
+		VotingPeriod:          300,                 // 5 minutes
+		ConsensusTimeout:      1800,                // 30 minutes
+		MinValidatorCount:     1,                   // Minimum 1 validator
+		CorridorCaps:          []CorridorCap{},     // Uncapped until corridors are configured
+		AttestationRules:      []AttestationRule{}, // Threshold only until rules are configured
+		MaxProofBytes:         64 * 1024,           // 64 KiB
+		MaxProofVotes:         100,                 // Matches the multisig maximum validator count
+		MaxBatchPayloadBytes:  128 * 1024,          // 128 KiB
+		MaxBatchBytes:         1024 * 1024,         // 1 MiB
+		MaxBatchVotes:         256,
+		HeartbeatTimeout:      0,     // Heartbeats are reported without suspending stale chains
+		StrictEventValidation: false, // Normalize until relayers send canonical events
+		ReporterMismatchLimit: 3,
+		GateFlaggedReporters:  false,                              // Flagged reporters are reported without changing their weight
+		WeightedConsensus:     false,                              // One vote per validator
+		StrictOverdraft:       false,                              // Banks may run net debit positions until netting
+		TwoPhaseCreditRelease: false,                              // Credit is released on confirmation
+		ChainFinality:         []ChainFinality{},                  // Chains keep the default finality until registered
+		EventHashAlgorithms:   []commontypes.ChainHashAlgorithm{}, // Every chain uses SHA-256
+		VoteRefundWindow:      10,
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if p.VotingPeriod <= 0 {
+		return fmt.Errorf("voting period must be positive: %d", p.VotingPeriod)
+	}
+
+	if p.ConsensusTimeout <= 0 {
+		return fmt.Errorf("consensus timeout must be positive: %d", p.ConsensusTimeout)
+	}
+
+	if p.MinValidatorCount <= 0 {
+		return fmt.Errorf("minimum validator count must be positive: %d", p.MinValidatorCount)
+	}
+
+	if p.MaxProofBytes <= 0 {
+		return fmt.Errorf("max proof bytes must be positive: %d", p.MaxProofBytes)
+	}
+
+	if p.MaxProofVotes <= 0 {
+		return fmt.Errorf("max proof votes must be positive: %d", p.MaxProofVotes)
+	}
+
+	if p.MaxBatchPayloadBytes <= 0 {
+		return fmt.Errorf("max batch payload bytes must be positive: %d", p.MaxBatchPayloadBytes)
+	}
+
+	if p.MaxBatchBytes < p.MaxBatchPayloadBytes {
+		return fmt.Errorf("max batch bytes must be at least max batch payload bytes: %d < %d", p.MaxBatchBytes, p.MaxBatchPayloadBytes)
+	}
+
+	if p.MaxBatchVotes <= 0 {
+		return fmt.Errorf("max batch votes must be positive: %d", p.MaxBatchVotes)
+	}
+
+	if p.HeartbeatTimeout < 0 {
+		return fmt.Errorf("heartbeat timeout cannot be negative: %d", p.HeartbeatTimeout)
+	}
+
+	if p.VoteRefundWindow <= 0 {
+		return fmt.Errorf("vote refund window must be positive: %d", p.VoteRefundWindow)
+	}
+
+	seen := make(map[string]bool, len(p.CorridorCaps))
+	for i, corridor := range p.CorridorCaps {
+		if corridor.SourceChain == "" || corridor.DestChain == "" {
+			return fmt.Errorf("corridor cap %d: source and dest chain cannot be empty", i)
+		}
+		if corridor.SourceChain == corridor.DestChain {
+			return fmt.Errorf("corridor cap %d: source and dest chain must differ", i)
+		}
+		if corridor.MaxAmount.IsNil() || !corridor.MaxAmount.IsPositive() {
+			return fmt.Errorf("corridor cap %d: max amount must be positive", i)
+		}
+
+		key := corridor.SourceChain + "/" + corridor.DestChain
+		if seen[key] {
+			return fmt.Errorf("corridor cap %d: duplicate corridor %s -> %s", i, corridor.SourceChain, corridor.DestChain)
+		}
+		seen[key] = true
+	}
+
+	rules := make(map[string]bool, len(p.AttestationRules))
+	for i, rule := range p.AttestationRules {
+		if rule.SourceChain == "" || rule.DestChain == "" {
+			return fmt.Errorf("attestation rule %d: source and dest chain cannot be empty", i)
+		}
+		if rule.MinAmount.IsNil() || !rule.MinAmount.IsPositive() {
+			return fmt.Errorf("attestation rule %d: min amount must be positive", i)
+		}
+		if len(rule.Validators) == 0 {
+			return fmt.Errorf("attestation rule %d: validators cannot be empty", i)
+		}
+
+		validators := make(map[string]bool, len(rule.Validators))
+		for _, validator := range rule.Validators {
+			if validator == "" {
+				return fmt.Errorf("attestation rule %d: validator cannot be empty", i)
+			}
+			if validators[validator] {
+				return fmt.Errorf("attestation rule %d: duplicate validator %s", i, validator)
+			}
+			validators[validator] = true
+		}
+
+		key := rule.SourceChain + "/" + rule.DestChain + "/" + rule.MinAmount.String()
+		if rules[key] {
+			return fmt.Errorf("attestation rule %d: duplicate rule for %s -> %s at %s", i, rule.SourceChain, rule.DestChain, rule.MinAmount)
+		}
+		rules[key] = true
+	}
+
+	chains := make(map[string]bool, len(p.ChainFinality))
+	for i, finality := range p.ChainFinality {
+		if err := finality.Validate(); err != nil {
+			return fmt.Errorf("chain finality %d: %w", i, err)
+		}
+		if chains[finality.Chain] {
+			return fmt.Errorf("chain finality %d: duplicate chain %s", i, finality.Chain)
+		}
+		chains[finality.Chain] = true
+	}
+
+	if err := commontypes.ValidateChainHashAlgorithms(p.EventHashAlgorithms); err != nil {
+		return fmt.Errorf("event %w", err)
+	}
+
+	return nil
+}
+
+// EventHashAlgorithm returns the payload hash algorithm of transfers to
+// destChain
+func (p Params) EventHashAlgorithm(destChain string) commontypes.HashAlgorithm {
+	return commontypes.ChainHashAlgorithmOf(p.EventHashAlgorithms, destChain)
+}
+
+// GetChainFinality returns the finality registered for chain, or the default
+// finality of unregistered chains: probabilistic without a confirmation depth
+func (p Params) GetChainFinality(chain string) (ChainFinality, bool) {
+	for _, finality := range p.ChainFinality {
+		if finality.Chain == chain {
+			return finality, true
+		}
+	}
+	return ChainFinality{Chain: chain, Model: FinalityModelProbabilistic}, false
+}
+
+// GetCorridorCap returns the cap of the sourceChain -> destChain corridor, if any
+func (p Params) GetCorridorCap(sourceChain, destChain string) (math.Int, bool) {
+	for _, corridor := range p.CorridorCaps {
+		if corridor.SourceChain == sourceChain && corridor.DestChain == destChain {
+			return corridor.MaxAmount, true
+		}
+	}
+	return math.Int{}, false
+}
+
+// RequiredAttestors returns the validators that must vote on a transfer: the
+// union of the validators of every rule matching its corridor and amount, in
+// rule order
+func (p Params) RequiredAttestors(event commontypes.TransferEvent) []string {
+	var attestors []string
+	seen := make(map[string]bool)
+	for _, rule := range p.AttestationRules {
+		if rule.SourceChain != event.SourceChain || rule.DestChain != event.DestChain {
+			continue
+		}
+		if event.Amount.IsNil() || event.Amount.LT(rule.MinAmount) {
+			continue
+		}
+		for _, validator := range rule.Validators {
+			if !seen[validator] {
+				seen[validator] = true
+				attestors = append(attestors, validator)
+			}
+		}
+	}
+	return attestors
+}
+
+// MissingAttestors returns the required attestors that have not voted yet
+func MissingAttestors(voteStatus commontypes.VoteStatus) []string {
+	voted := make(map[string]bool, len(voteStatus.Votes))
+	for _, vote := range voteStatus.Votes {
+		voted[vote.Validator] = true
+	}
+
+	var missing []string
+	for _, attestor := range voteStatus.RequiredAttestors {
+		if !voted[attestor] {
+			missing = append(missing, attestor)
+		}
+	}
+	return missing
+}
+
+// ParamsChange records params set by governance together with when and by
+// which proposal, so audits can tell which params applied at a height
+type ParamsChange struct {
+	Version    uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version"`
+	Height     int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height"` // First height the params applied
+	Time       int64  `protobuf:"varint,3,opt,name=time,proto3" json:"time"`
+	ProposalID uint64 `protobuf:"varint,4,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id"` // Zero when not set through a proposal
+	Authority  string `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority"`
+	Params     Params `protobuf:"bytes,6,opt,name=params,proto3" json:"params"`
+}
+
+// ProtoMessage implements proto.Message
+func (c *ParamsChange) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (c *ParamsChange) Reset() { *c = ParamsChange{} }
+
+// String implements proto.Message
+func (c *ParamsChange) String() string {
+	return fmt.Sprintf("ParamsChange{Version: %d, Height: %d, ProposalID: %d}", c.Version, c.Height, c.ProposalID)
+}
@@ -0,0 +1,159 @@
+package types
+
+import (
+	"fmt"
+
+	commontypes "github.com/interbank-netting/cosmos/types"
+)
+
+// TransferProof is a self-contained bundle showing that a transfer was
+// confirmed by the validator set. A bank holding the validator public keys
+// can verify it offline with Verify.
+type TransferProof struct {
+	ChainID           string                    `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id"`
+	EventData         commontypes.TransferEvent `protobuf:"bytes,2,opt,name=event_data,json=eventData,proto3" json:"event_data"`
+	Votes             []commontypes.Vote        `protobuf:"bytes,3,rep,name=votes,proto3" json:"votes"`
+	Threshold         int32                     `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold"`
+	ConfirmedHeight   int64                     `protobuf:"varint,5,opt,name=confirmed_height,json=confirmedHeight,proto3" json:"confirmed_height"`
+	ConfirmedAt       int64                     `protobuf:"varint,6,opt,name=confirmed_at,json=confirmedAt,proto3" json:"confirmed_at"`
+	CreditToken       commontypes.CreditToken   `protobuf:"bytes,7,opt,name=credit_token,json=creditToken,proto3" json:"credit_token"`                   // Credit issued to the destination bank
+	RequiredAttestors []string                  `protobuf:"bytes,8,rep,name=required_attestors,json=requiredAttestors,proto3" json:"required_attestors"` // Validators that had to vote besides the threshold
+}
+
+// ProtoMessage implements proto.Message
+func (p *TransferProof) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (p *TransferProof) Reset() { *p = TransferProof{} }
+
+// String implements proto.Message
+func (p *TransferProof) String() string {
+	return fmt.Sprintf("TransferProof{TxHash: %s, Votes: %d, ConfirmedHeight: %d}",
+		p.EventData.TxHash, len(p.Votes), p.ConfirmedHeight)
+}
+
+// ConsensusThreshold returns the number of votes needed for a 2/3+ majority
+// of validatorCount validators, at least 1
+//
+// Deprecated: use commontypes.ConsensusThreshold
+func ConsensusThreshold(validatorCount int) int32 {
+	return commontypes.ConsensusThreshold(validatorCount)
+}
+
+// TransferCreditToken returns the credit token a confirmed transfer issues:
+// cred-{SourceChain} held by the destination bank
+func TransferCreditToken(event commontypes.TransferEvent, confirmedAt int64) commontypes.CreditToken {
+	return commontypes.CreditToken{
+		Denom:      commontypes.CreditDenom(event.SourceChain, commontypes.BaseCurrency),
+		IssuerBank: event.SourceChain,
+		HolderBank: event.DestChain,
+		Amount:     event.Amount,
+		OriginTx:   event.TxHash,
+		IssuedAt:   confirmedAt,
+		Priority:   event.Priority,
+	}
+}
+
+// VoteSize returns the number of bytes a verifier has to parse and hash for a
+// vote: its identifiers, signature and event data
+func VoteSize(vote commontypes.Vote) int {
+	return len(vote.TxHash) + len(vote.Validator) + len(vote.Signature) + eventSize(vote.EventData)
+}
+
+func eventSize(event commontypes.TransferEvent) int {
+	size := len(event.TxHash) + len(event.Sender) + len(event.Recipient) + len(event.SourceChain) + len(event.DestChain)
+	if !event.Amount.IsNil() {
+		size += len(event.Amount.String())
+	}
+	return size
+}
+
+// ByteSize returns the number of bytes of the proof's variable-length fields
+func (p TransferProof) ByteSize() int {
+	size := len(p.ChainID) + eventSize(p.EventData) + len(p.CreditToken.Denom) + len(p.CreditToken.OriginTx)
+	for _, vote := range p.Votes {
+		size += VoteSize(vote)
+	}
+	for _, attestor := range p.RequiredAttestors {
+		size += len(attestor)
+	}
+	return size
+}
+
+// CheckLimits rejects a proof with more than maxVotes votes or more than
+// maxBytes bytes. It is cheap next to Verify, which recovers a public key per
+// vote, and must run first on proofs from untrusted sources.
+func (p TransferProof) CheckLimits(maxBytes int64, maxVotes int32) error {
+	if int64(len(p.Votes)) > int64(maxVotes) {
+		return fmt.Errorf("%d votes, limit %d", len(p.Votes), maxVotes)
+	}
+	if size := p.ByteSize(); int64(size) > maxBytes {
+		return fmt.Errorf("%d bytes, limit %d", size, maxBytes)
+	}
+	return nil
+}
+
+// Verify checks the proof against a known validator set: every vote must be
+// for the proven event and signed by a distinct active validator over this
+// chain's vote envelope, the votes must reach a 2/3+ majority of the set and
+// include every required attestor, and the credit token must be the one the
+// event issues.
+func (p TransferProof) Verify(validators []commontypes.Validator) error {
+	pubKeys := make(map[string][]byte, len(validators))
+	for _, validator := range validators {
+		if validator.Active {
+			pubKeys[validator.Address] = validator.PubKey
+		}
+	}
+
+	signers := make(map[string]bool, len(p.Votes))
+	for i, vote := range p.Votes {
+		if vote.TxHash != p.EventData.TxHash || !sameTransferEvent(vote.EventData, p.EventData) {
+			return fmt.Errorf("vote %d: not for the proven event", i)
+		}
+
+		pubKey, known := pubKeys[vote.Validator]
+		if !known {
+			return fmt.Errorf("vote %d: %s is not an active validator of the set", i, vote.Validator)
+		}
+		if signers[vote.Validator] {
+			return fmt.Errorf("vote %d: duplicate vote by %s", i, vote.Validator)
+		}
+
+		envelope := NewVoteSigningEnvelope(vote.SignatureVersion, p.ChainID, vote.EventData)
+		if err := envelope.Validate(); err != nil {
+			return fmt.Errorf("vote %d: %w", i, err)
+		}
+		if err := VerifySignature(pubKey, envelope.SignBytes(), vote.Signature); err != nil {
+			return fmt.Errorf("vote %d: %w", i, err)
+		}
+		signers[vote.Validator] = true
+	}
+
+	if threshold := commontypes.ConsensusThreshold(len(pubKeys)); int32(len(signers)) < threshold {
+		return fmt.Errorf("%d valid votes, %d required", len(signers), threshold)
+	}
+
+	for _, attestor := range p.RequiredAttestors {
+		if !signers[attestor] {
+			return fmt.Errorf("required attestor %s did not vote", attestor)
+		}
+	}
+
+	expected := TransferCreditToken(p.EventData, p.ConfirmedAt)
+	if p.CreditToken.Denom != expected.Denom || p.CreditToken.IssuerBank != expected.IssuerBank ||
+		p.CreditToken.HolderBank != expected.HolderBank || p.CreditToken.OriginTx != expected.OriginTx ||
+		p.CreditToken.Amount.IsNil() || !p.CreditToken.Amount.Equal(expected.Amount) {
+		return fmt.Errorf("credit token does not match the event")
+	}
+
+	return nil
+}
+
+func sameTransferEvent(a, b commontypes.TransferEvent) bool {
+	return a.TxHash == b.TxHash && a.Sender == b.Sender && a.Recipient == b.Recipient &&
+		!a.Amount.IsNil() && !b.Amount.IsNil() && a.Amount.Equal(b.Amount) &&
+		a.Nonce == b.Nonce && a.SourceChain == b.SourceChain && a.DestChain == b.DestChain &&
+		a.BlockHeight == b.BlockHeight && a.Timestamp == b.Timestamp && a.Priority == b.Priority &&
+		a.AssetID == b.AssetID
+}
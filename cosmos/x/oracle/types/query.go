@@ -0,0 +1,169 @@
+package types
+
+import (
+	"context"
+
+	commontypes "github.com/interbank-netting/cosmos/types"
+)
+
+// QueryTransferProofRequest is the request type for Query/TransferProof
+type QueryTransferProofRequest struct {
+	TxHash string `json:"tx_hash"`
+}
+
+// QueryTransferProofResponse is the response type for Query/TransferProof
+type QueryTransferProofResponse struct {
+	Proof TransferProof `json:"proof"`
+}
+
+// QueryTransferCreditRequest is the request type for Query/TransferCredit
+type QueryTransferCreditRequest struct {
+	TxHash string `json:"tx_hash"`
+}
+
+// QueryTransferCreditResponse is the response type for Query/TransferCredit
+type QueryTransferCreditResponse struct {
+	CreditToken commontypes.CreditToken `json:"credit_token"`
+}
+
+// QueryWorkQueueRequest is the request type for Query/WorkQueue
+type QueryWorkQueueRequest struct {
+	NearTimeoutWindow int64 `json:"near_timeout_window"` // Seconds before ConsensusTimeout; defaults to VotingPeriod
+}
+
+// QueryWorkQueueResponse is the response type for Query/WorkQueue
+type QueryWorkQueueResponse struct {
+	WorkQueue WorkQueue `json:"work_queue"`
+}
+
+// QueryValidatorQueueRequest is the request type for Query/ValidatorQueue
+type QueryValidatorQueueRequest struct {
+	Validator string `json:"validator"`
+}
+
+// QueryValidatorQueueResponse is the response type for Query/ValidatorQueue
+type QueryValidatorQueueResponse struct {
+	ValidatorQueue ValidatorQueue `json:"validator_queue"`
+}
+
+// QueryForecastNettingRequest is the request type for Query/ForecastNetting
+type QueryForecastNettingRequest struct{}
+
+// QueryForecastNettingResponse is the response type for Query/ForecastNetting
+type QueryForecastNettingResponse struct {
+	Forecast commontypes.NettingForecast `json:"forecast"`
+}
+
+// QuerySuspensionsRequest is the request type for Query/Suspensions
+type QuerySuspensionsRequest struct{}
+
+// QuerySuspensionsResponse is the response type for Query/Suspensions
+type QuerySuspensionsResponse struct {
+	Suspensions []Suspension `json:"suspensions"`
+}
+
+// QueryParamsHistoryRequest is the request type for Query/ParamsHistory
+type QueryParamsHistoryRequest struct{}
+
+// QueryParamsHistoryResponse is the response type for Query/ParamsHistory
+type QueryParamsHistoryResponse struct {
+	Changes []ParamsChange `json:"changes"` // Oldest first
+}
+
+// QueryAuditLogsRequest is the request type for Query/AuditLogs
+type QueryAuditLogsRequest struct {
+	Filter AuditLogFilter `json:"filter"`
+}
+
+// QueryAuditLogsResponse is the response type for Query/AuditLogs
+type QueryAuditLogsResponse struct {
+	Logs   []commontypes.AuditLog `json:"logs"`    // In ID order
+	NextID uint64                 `json:"next_id"` // AfterID of the next page, zero on the last page
+}
+
+// QueryChainHealthRequest is the request type for Query/ChainHealth
+type QueryChainHealthRequest struct {
+	Chain string `json:"chain,omitempty"` // Every chain with a heartbeat if empty
+}
+
+// QueryChainHealthResponse is the response type for Query/ChainHealth
+type QueryChainHealthResponse struct {
+	Chains           []ChainHealth `json:"chains"`            // Ordered by chain
+	HeartbeatTimeout int64         `json:"heartbeat_timeout"` // Zero if stale chains are not suspended
+}
+
+// QueryReportersRequest is the request type for Query/Reporters
+type QueryReportersRequest struct {
+	Validator   string `json:"validator,omitempty"`    // Every reporter if empty
+	FlaggedOnly bool   `json:"flagged_only,omitempty"` // Only reporters that are flagged
+}
+
+// QueryReportersResponse is the response type for Query/Reporters
+type QueryReportersResponse struct {
+	Reporters     []ReporterRecord `json:"reporters"`      // Ordered by validator
+	MismatchLimit uint32           `json:"mismatch_limit"` // Zero if reporters are never flagged
+	GateFlagged   bool             `json:"gate_flagged"`   // Flagged first reporters don't set the confirmed content
+}
+
+// QueryChainFinalityRequest is the request type for Query/ChainFinality
+type QueryChainFinalityRequest struct {
+	Chain string `json:"chain,omitempty"` // Every registered chain if empty
+}
+
+// QueryChainFinalityResponse is the response type for Query/ChainFinality
+type QueryChainFinalityResponse struct {
+	Chains     []ChainFinality `json:"chains"`     // In registry order
+	Registered bool            `json:"registered"` // False if the requested chain has the default finality
+}
+
+// QueryPendingTransfersRequest is the request type for Query/PendingTransfers
+type QueryPendingTransfersRequest struct{}
+
+// QueryPendingTransfersResponse is the response type for Query/PendingTransfers
+type QueryPendingTransfersResponse struct {
+	Transfers []commontypes.VoteStatus `json:"transfers"` // Unconfirmed, ordered by tx hash
+}
+
+// QueryRejectedTransferRequest is the request type for Query/RejectedTransfer
+type QueryRejectedTransferRequest struct {
+	TxHash string `json:"tx_hash"`
+}
+
+// QueryRejectedTransferResponse is the response type for Query/RejectedTransfer
+type QueryRejectedTransferResponse struct {
+	RejectedTransfer RejectedTransfer `json:"rejected_transfer"`
+}
+
+// QueryMessageCatalogRequest is the request type for Query/MessageCatalog
+type QueryMessageCatalogRequest struct {
+	Locale commontypes.Locale `json:"locale"` // Defaults to commontypes.DefaultLocale
+}
+
+// QueryMessageCatalogResponse is the response type for Query/MessageCatalog
+type QueryMessageCatalogResponse struct {
+	Locale  commontypes.Locale         `json:"locale"`
+	Locales []commontypes.Locale       `json:"locales"`
+	Entries []commontypes.CatalogEntry `json:"entries"` // Errors by codespace and code, then reason codes
+}
+
+// QueryServer defines the query service for the oracle module
+type QueryServer interface {
+	TransferProof(ctx context.Context, req *QueryTransferProofRequest) (*QueryTransferProofResponse, error)
+	TransferCredit(ctx context.Context, req *QueryTransferCreditRequest) (*QueryTransferCreditResponse, error)
+	WorkQueue(ctx context.Context, req *QueryWorkQueueRequest) (*QueryWorkQueueResponse, error)
+	ValidatorQueue(ctx context.Context, req *QueryValidatorQueueRequest) (*QueryValidatorQueueResponse, error)
+	ForecastNetting(ctx context.Context, req *QueryForecastNettingRequest) (*QueryForecastNettingResponse, error)
+	Suspensions(ctx context.Context, req *QuerySuspensionsRequest) (*QuerySuspensionsResponse, error)
+	ParamsHistory(ctx context.Context, req *QueryParamsHistoryRequest) (*QueryParamsHistoryResponse, error)
+	AuditLogs(ctx context.Context, req *QueryAuditLogsRequest) (*QueryAuditLogsResponse, error)
+	ChainHealth(ctx context.Context, req *QueryChainHealthRequest) (*QueryChainHealthResponse, error)
+	Reporters(ctx context.Context, req *QueryReportersRequest) (*QueryReportersResponse, error)
+	ChainFinality(ctx context.Context, req *QueryChainFinalityRequest) (*QueryChainFinalityResponse, error)
+	PendingTransfers(ctx context.Context, req *QueryPendingTransfersRequest) (*QueryPendingTransfersResponse, error)
+	RejectedTransfer(ctx context.Context, req *QueryRejectedTransferRequest) (*QueryRejectedTransferResponse, error)
+	MessageCatalog(ctx context.Context, req *QueryMessageCatalogRequest) (*QueryMessageCatalogResponse, error)
+}
+
+// Placeholder for protobuf service descriptor
+// In a real implementation, this would be generated from .proto files
+var _Query_serviceDesc = struct{}{}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	commontypes "github.com/interbank-netting/cosmos/types"
+)
+
+// VoteFee records the fee paid for a vote that is waiting on consensus.
+// It is refunded to Payer once the transfer is confirmed with the content of
+// the vote within the VoteRefundWindow param.
+type VoteFee struct {
+	TxHash    string    `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash"`
+	Validator string    `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator"`
+	Payer     string    `protobuf:"bytes,3,opt,name=payer,proto3" json:"payer"`
+	Fee       sdk.Coins `protobuf:"bytes,4,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	Height    int64     `protobuf:"varint,5,opt,name=height,proto3" json:"height"`
+}
+
+func (vf *VoteFee) ProtoMessage() {}
+func (vf *VoteFee) Reset()        { *vf = VoteFee{} }
+func (vf *VoteFee) String() string {
+	return fmt.Sprintf("VoteFee{TxHash: %s, Validator: %s, Fee: %s}", vf.TxHash, vf.Validator, vf.Fee)
+}
+
+// VoteMatchesEvent reports whether a vote carries the content its transfer
+// was confirmed with
+func VoteMatchesEvent(vote commontypes.Vote, confirmed commontypes.TransferEvent) bool {
+	return sameTransferEvent(vote.EventData, confirmed)
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+
+	commontypes "github.com/interbank-netting/cosmos/types"
+)
+
+// RejectedTransfer is the evidence kept for a rejected transfer: the final
+// vote tally and every vote it was rejected with, so audits and resubmissions
+// don't depend on indexed events. A transfer rejected again keeps the record
+// of its latest rejection.
+type RejectedTransfer struct {
+	TxHash           string                    `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash"`
+	EventData        commontypes.TransferEvent `protobuf:"bytes,2,opt,name=event_data,json=eventData,proto3" json:"event_data"` // Content most votes agree on
+	Votes            []commontypes.Vote        `protobuf:"bytes,3,rep,name=votes,proto3" json:"votes"`                          // In submission order, with their signatures
+	VoteCount        int32                     `protobuf:"varint,4,opt,name=vote_count,json=voteCount,proto3" json:"vote_count"`
+	AgreeingVotes    int32                     `protobuf:"varint,5,opt,name=agreeing_votes,json=agreeingVotes,proto3" json:"agreeing_votes"` // Votes for EventData
+	Threshold        int32                     `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold"`
+	MissingAttestors []string                  `protobuf:"bytes,7,rep,name=missing_attestors,json=missingAttestors,proto3" json:"missing_attestors,omitempty"`
+	ReasonCode       commontypes.ReasonCode    `protobuf:"bytes,8,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code"`
+	Reason           string                    `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason"` // Free-text detail of ReasonCode
+	RejectedAt       int64                     `protobuf:"varint,10,opt,name=rejected_at,json=rejectedAt,proto3" json:"rejected_at"`
+	RejectedHeight   int64                     `protobuf:"varint,11,opt,name=rejected_height,json=rejectedHeight,proto3" json:"rejected_height"`
+}
+
+// ProtoMessage implements proto.Message
+func (r *RejectedTransfer) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (r *RejectedTransfer) Reset() { *r = RejectedTransfer{} }
+
+// String implements proto.Message
+func (r *RejectedTransfer) String() string {
+	return fmt.Sprintf("RejectedTransfer{TxHash: %s, Votes: %d, Threshold: %d, ReasonCode: %s}",
+		r.TxHash, r.VoteCount, r.Threshold, r.ReasonCode)
+}
+
+// NewRejectedTransfer returns the record of a transfer rejected with its vote
+// status at the given height and time
+func NewRejectedTransfer(voteStatus commontypes.VoteStatus, code commontypes.ReasonCode, reason string, height, time int64) RejectedTransfer {
+	eventData, agreeing := ConsensusEventData(voteStatus.Votes)
+	return RejectedTransfer{
+		TxHash:           voteStatus.TxHash,
+		EventData:        eventData,
+		Votes:            append([]commontypes.Vote{}, voteStatus.Votes...),
+		VoteCount:        voteStatus.VoteCount,
+		AgreeingVotes:    int32(agreeing),
+		Threshold:        voteStatus.Threshold,
+		MissingAttestors: MissingAttestors(voteStatus),
+		ReasonCode:       code,
+		Reason:           reason,
+		RejectedAt:       time,
+		RejectedHeight:   height,
+	}
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// CreditRelease is the credit of a confirmed transfer kept frozen by two-phase
+// credit release: issued on confirmation, it is only released to netting once
+// the transfer's mint command is executed on the destination chain.
+type CreditRelease struct {
+	TxHash          string   `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash"`
+	CommandID       string   `protobuf:"bytes,2,opt,name=command_id,json=commandId,proto3" json:"command_id"`
+	HolderBank      string   `protobuf:"bytes,3,opt,name=holder_bank,json=holderBank,proto3" json:"holder_bank"`
+	Denom           string   `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom"`
+	Amount          math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"` // Credit frozen on confirmation
+	ConfirmedHeight int64    `protobuf:"varint,6,opt,name=confirmed_height,json=confirmedHeight,proto3" json:"confirmed_height"`
+}
+
+// ProtoMessage implements proto.Message
+func (r *CreditRelease) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (r *CreditRelease) Reset() { *r = CreditRelease{} }
+
+// String implements proto.Message
+func (r *CreditRelease) String() string {
+	return fmt.Sprintf("CreditRelease{TxHash: %s, CommandID: %s, Amount: %s}", r.TxHash, r.CommandID, r.Amount)
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	commontypes "github.com/interbank-netting/cosmos/types"
+)
+
+// ReporterRecord tracks how often the first vote of a validator on a transfer,
+// usually cast by its relayer as soon as it sees the event, agreed with the
+// content the transfer was eventually voted in with. A reporter is flagged
+// once its mismatches reach the ReporterMismatchLimit param.
+type ReporterRecord struct {
+	Validator          string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator"`
+	FirstReports       uint64 `protobuf:"varint,2,opt,name=first_reports,json=firstReports,proto3" json:"first_reports"` // Confirmed transfers the validator voted on first
+	Mismatches         uint64 `protobuf:"varint,3,opt,name=mismatches,proto3" json:"mismatches"`                         // First reports that differed from the consensus content
+	LastMismatchTx     string `protobuf:"bytes,4,opt,name=last_mismatch_tx,json=lastMismatchTx,proto3" json:"last_mismatch_tx,omitempty"`
+	LastMismatchHeight int64  `protobuf:"varint,5,opt,name=last_mismatch_height,json=lastMismatchHeight,proto3" json:"last_mismatch_height,omitempty"`
+	Flagged            bool   `protobuf:"varint,6,opt,name=flagged,proto3" json:"flagged"`
+	FlaggedHeight      int64  `protobuf:"varint,7,opt,name=flagged_height,json=flaggedHeight,proto3" json:"flagged_height,omitempty"`
+}
+
+// ProtoMessage implements proto.Message
+func (r *ReporterRecord) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (r *ReporterRecord) Reset() { *r = ReporterRecord{} }
+
+// String implements proto.Message
+func (r *ReporterRecord) String() string {
+	return fmt.Sprintf("ReporterRecord{Validator: %s, FirstReports: %d, Mismatches: %d, Flagged: %t}",
+		r.Validator, r.FirstReports, r.Mismatches, r.Flagged)
+}
+
+// ConsensusEventData returns the event data most votes agree on and the
+// number of votes for it. Ties go to the content voted first.
+func ConsensusEventData(votes []commontypes.Vote) (commontypes.TransferEvent, int) {
+	var (
+		consensus commontypes.TransferEvent
+		best      int
+	)
+	for i, vote := range votes {
+		count := 0
+		for _, other := range votes[i:] {
+			if sameTransferEvent(vote.EventData, other.EventData) {
+				count++
+			}
+		}
+		if count > best {
+			consensus, best = vote.EventData, count
+		}
+	}
+	return consensus, best
+}
+
+// FirstReportMatches reports whether the first of the votes carries the
+// content most votes agree on
+func FirstReportMatches(votes []commontypes.Vote) bool {
+	if len(votes) == 0 {
+		return true
+	}
+	consensus, _ := ConsensusEventData(votes)
+	return sameTransferEvent(votes[0].EventData, consensus)
+}
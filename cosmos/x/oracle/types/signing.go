@@ -0,0 +1,197 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	commontypes "github.com/interbank-netting/cosmos/types"
+)
+
+// Signature envelope versions. A new version is added whenever the signed
+// layout changes; the oracle only accepts the versions listed in
+// SupportedSignatureVersions.
+const (
+	SignatureVersionV1 uint32 = 1
+
+	// CurrentSignatureVersion is the envelope version new votes are signed with
+	CurrentSignatureVersion = SignatureVersionV1
+)
+
+// SupportedSignatureVersions lists the envelope versions accepted by SubmitVote
+var SupportedSignatureVersions = []uint32{SignatureVersionV1}
+
+const (
+	// SigningDomain prefixes every envelope so a vote signature is never valid
+	// as any other message of the validator key
+	SigningDomain = "interbank-netting/signature"
+
+	// SigningPurposeTransferVote is the purpose of MsgVote signatures
+	SigningPurposeTransferVote = "transfer_vote"
+)
+
+// SigningEnvelope binds a signed event hash to the chain, module and purpose
+// it was signed for. Validators sign sha256(SignBytes()).
+type SigningEnvelope struct {
+	Version   uint32
+	ChainID   string
+	Module    string
+	Purpose   string
+	EventHash string
+}
+
+// NewVoteSigningEnvelope returns the envelope a validator signs to vote on a
+// transfer. Its event hash is TransferEventHash of the voted event in its
+// canonical form, so a signature is only valid for that exact event.
+func NewVoteSigningEnvelope(version uint32, chainID string, event commontypes.TransferEvent) SigningEnvelope {
+	return SigningEnvelope{
+		Version:   version,
+		ChainID:   chainID,
+		Module:    ModuleName,
+		Purpose:   SigningPurposeTransferVote,
+		EventHash: TransferEventHash(event),
+	}
+}
+
+// TransferEventHash returns the hex-encoded sha256 of the canonical encoding
+// of a transfer event: the length-prefixed tx hash, sender, recipient, amount,
+// source chain, dest chain and asset ID, followed by the big-endian nonce,
+// block height, timestamp and priority
+func TransferEventHash(event commontypes.TransferEvent) string {
+	amount := ""
+	if !event.Amount.IsNil() {
+		amount = event.Amount.String()
+	}
+
+	var bz []byte
+	for _, field := range []string{event.TxHash, event.Sender, event.Recipient, amount, event.SourceChain, event.DestChain, event.AssetID} {
+		bz = binary.BigEndian.AppendUint32(bz, uint32(len(field)))
+		bz = append(bz, field...)
+	}
+	bz = binary.BigEndian.AppendUint64(bz, event.Nonce)
+	bz = binary.BigEndian.AppendUint64(bz, event.BlockHeight)
+	bz = binary.BigEndian.AppendUint64(bz, uint64(event.Timestamp))
+	bz = binary.BigEndian.AppendUint32(bz, uint32(event.Priority))
+
+	hash := sha256.Sum256(bz)
+	return hex.EncodeToString(hash[:])
+}
+
+// IsSupportedSignatureVersion returns true if the envelope version is accepted
+func IsSupportedSignatureVersion(version uint32) bool {
+	for _, supported := range SupportedSignatureVersions {
+		if version == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the envelope can be signed and verified
+func (e SigningEnvelope) Validate() error {
+	if !IsSupportedSignatureVersion(e.Version) {
+		return fmt.Errorf("unsupported signature version %d", e.Version)
+	}
+	if e.ChainID == "" {
+		return fmt.Errorf("signing envelope chain ID cannot be empty")
+	}
+	if e.Module == "" || e.Purpose == "" {
+		return fmt.Errorf("signing envelope module and purpose cannot be empty")
+	}
+	if e.EventHash == "" {
+		return fmt.Errorf("signing envelope event hash cannot be empty")
+	}
+	return nil
+}
+
+// SignBytes returns the canonical encoding of the envelope: the signing
+// domain, the big-endian version and each field prefixed by its length, so no
+// two distinct envelopes share an encoding
+func (e SigningEnvelope) SignBytes() []byte {
+	bz := []byte(SigningDomain)
+	bz = binary.BigEndian.AppendUint32(bz, e.Version)
+	for _, field := range []string{e.ChainID, e.Module, e.Purpose, e.EventHash} {
+		bz = binary.BigEndian.AppendUint32(bz, uint32(len(field)))
+		bz = append(bz, field...)
+	}
+	return bz
+}
+
+// TransferPayloadHash returns the hash, with algorithm, of the canonical
+// encoding of a confirmed transfer: each field the destination mint depends
+// on, length-prefixed like SignBytes, followed by the big-endian nonce and,
+// for wrapped assets other than the default token, the length-prefixed asset
+// ID
+func TransferPayloadHash(algorithm commontypes.HashAlgorithm, event commontypes.TransferEvent) []byte {
+	var bz []byte
+	for _, field := range []string{event.TxHash, event.SourceChain, event.DestChain, event.Sender, event.Recipient, event.Amount.String()} {
+		bz = binary.BigEndian.AppendUint32(bz, uint32(len(field)))
+		bz = append(bz, field...)
+	}
+	bz = binary.BigEndian.AppendUint64(bz, event.Nonce)
+	if event.AssetID != "" {
+		bz = binary.BigEndian.AppendUint32(bz, uint32(len(event.AssetID)))
+		bz = append(bz, event.AssetID...)
+	}
+	return algorithm.Sum(bz)
+}
+
+// VerifySignature checks a 65-byte ECDSA signature over sha256(data) against
+// a compressed (33-byte) or uncompressed (65-byte) secp256k1 public key. It
+// needs no chain state, so proofs can be verified offline.
+func VerifySignature(pubKey, data, signature []byte) error {
+	if len(signature) == 0 || len(data) == 0 {
+		return fmt.Errorf("empty signature or data")
+	}
+
+	// For ECDSA signatures, we expect 65 bytes (r=32, s=32, v=1)
+	if len(signature) != 65 {
+		return fmt.Errorf("invalid signature length: %d", len(signature))
+	}
+
+	hash := sha256.Sum256(data)
+	recoveredPubKey, err := crypto.SigToPub(hash[:], signature)
+	if err != nil {
+		return fmt.Errorf("failed to recover public key from signature: %w", err)
+	}
+
+	var expectedPubKey []byte
+	switch len(pubKey) {
+	case 33: // Compressed secp256k1 public key
+		ecdsaPubKey, err := crypto.DecompressPubkey(pubKey)
+		if err != nil {
+			return fmt.Errorf("failed to decompress public key: %w", err)
+		}
+		expectedPubKey = crypto.FromECDSAPub(ecdsaPubKey)
+	case 65: // Uncompressed ECDSA public key
+		expectedPubKey = pubKey
+	default:
+		return fmt.Errorf("unsupported public key length: %d", len(pubKey))
+	}
+
+	if !bytes.Equal(crypto.FromECDSAPub(recoveredPubKey), expectedPubKey) {
+		return fmt.Errorf("public key mismatch")
+	}
+
+	if !isValidSignatureComponents(signature[:32], signature[32:64], signature[64]) {
+		return fmt.Errorf("invalid signature components")
+	}
+
+	return nil
+}
+
+// isValidSignatureComponents validates ECDSA signature components
+func isValidSignatureComponents(r, s []byte, v byte) bool {
+	// Check that r and s are not zero
+	zero := make([]byte, 32)
+	if bytes.Equal(r, zero) || bytes.Equal(s, zero) {
+		return false
+	}
+
+	// Check v is valid (should be 27 or 28 for Ethereum-style, or 0/1 for some implementations)
+	return v == 0 || v == 1 || v == 27 || v == 28
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	commontypes "github.com/interbank-netting/cosmos/types"
+)
+
+// Suspension scopes
+const (
+	SuspensionScopeChain = "chain" // Transfers from the chain
+	SuspensionScopeBank  = "bank"  // Transfers from or to the bank
+)
+
+// IsValidSuspensionScope returns true for a known suspension scope
+func IsValidSuspensionScope(scope string) bool {
+	return scope == SuspensionScopeChain || scope == SuspensionScopeBank
+}
+
+// Suspension halts credit issuance and mint command generation for the
+// transfers involving a source chain or a bank. Governance sets it with an
+// expedited proposal during an incident and lifts it once resolved.
+type Suspension struct {
+	Target          string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target"` // Chain or bank ID
+	Scope           string                 `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope"`
+	Reason          string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason"` // Free-text detail of ReasonCode
+	SuspendedAt     int64                  `protobuf:"varint,4,opt,name=suspended_at,json=suspendedAt,proto3" json:"suspended_at"`
+	SuspendedHeight int64                  `protobuf:"varint,5,opt,name=suspended_height,json=suspendedHeight,proto3" json:"suspended_height"`
+	ReasonCode      commontypes.ReasonCode `protobuf:"bytes,6,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+}
+
+// ProtoMessage implements proto.Message
+func (s *Suspension) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (s *Suspension) Reset() { *s = Suspension{} }
+
+// String implements proto.Message
+func (s *Suspension) String() string {
+	return fmt.Sprintf("Suspension{Target: %s, Scope: %s}", s.Target, s.Scope)
+}
+
+// Code returns the reason code of the suspension. Suspensions stored before
+// reason codes are recognized as heartbeat suspensions by their reason, and
+// as governance suspensions otherwise.
+func (s Suspension) Code() commontypes.ReasonCode {
+	if s.ReasonCode != "" {
+		return s.ReasonCode
+	}
+	if s.Reason == HeartbeatSuspensionReason {
+		return commontypes.ReasonStaleHeartbeat
+	}
+	return commontypes.ReasonGovernance
+}
+
+// Covers returns true if the suspension halts the transfer. A chain is
+// suspended as a source only; a bank is suspended as issuer and as holder,
+// which also halts the mint commands to its chain.
+func (s Suspension) Covers(event commontypes.TransferEvent) bool {
+	switch s.Scope {
+	case SuspensionScopeChain:
+		return event.SourceChain == s.Target
+	case SuspensionScopeBank:
+		return event.SourceChain == s.Target || event.DestChain == s.Target
+	default:
+		return false
+	}
+}
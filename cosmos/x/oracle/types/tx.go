@@ -1,16 +1,87 @@
 package types
 
-import "context"
+import (
+	"context"
 
-// MsgVoteResponse defines the response for MsgVote
+	"cosmossdk.io/math"
+)
+
+// MsgVoteResponse defines the response for MsgVote.
+// When the vote completes consensus it also reports the credit issued to the
+// destination bank and the mint commands generated for the transfer.
 type MsgVoteResponse struct {
-	Success   bool `json:"success"`
-	Consensus bool `json:"consensus"`
+	Success       bool     `json:"success"`
+	Consensus     bool     `json:"consensus"`
+	Denom         string   `json:"denom,omitempty"`
+	CreditBalance math.Int `json:"credit_balance"`
+	CommandIDs    []string `json:"command_ids,omitempty"`
+	Held          bool     `json:"held,omitempty"` // Consensus reached above the corridor cap, awaiting approval
+}
+
+// MsgUpdateParamsResponse defines the response for MsgUpdateParams
+type MsgUpdateParamsResponse struct{}
+
+// MsgApproveHeldTransferResponse defines the response for MsgApproveHeldTransfer
+type MsgApproveHeldTransferResponse struct {
+	Denom         string   `json:"denom,omitempty"`
+	CreditBalance math.Int `json:"credit_balance"`
+	CommandIDs    []string `json:"command_ids,omitempty"`
+}
+
+// MsgRejectHeldTransferResponse defines the response for MsgRejectHeldTransfer
+type MsgRejectHeldTransferResponse struct{}
+
+// MsgReportReorgResponse defines the response for MsgReportReorg
+type MsgReportReorgResponse struct {
+	Denom        string   `json:"denom"`
+	FrozenAmount math.Int `json:"frozen_amount"`
+}
+
+// MsgResolveDisputeResponse defines the response for MsgResolveDispute
+type MsgResolveDisputeResponse struct {
+	Status       int32    `json:"status"`
+	BurnedAmount math.Int `json:"burned_amount"`
+}
+
+// MsgBatchVoteResponse defines the response for MsgBatchVote, with one
+// result per vote in batch order. A vote rejected as a duplicate or as late
+// has Success false.
+type MsgBatchVoteResponse struct {
+	Results []MsgVoteResponse `json:"results"`
+}
+
+// MsgSuspendResponse defines the response for MsgSuspend
+type MsgSuspendResponse struct{}
+
+// MsgResumeResponse defines the response for MsgResume, listing the held
+// transfers that were confirmed when the suspension was lifted
+type MsgResumeResponse struct {
+	Confirmed []string `json:"confirmed,omitempty"`
+}
+
+// MsgChainHeartbeatResponse defines the response for MsgChainHeartbeat
+type MsgChainHeartbeatResponse struct {
+	Resumed bool `json:"resumed,omitempty"` // The heartbeat lifted a stale heartbeat suspension
+}
+
+// MsgClearReporterFlagResponse defines the response for MsgClearReporterFlag
+type MsgClearReporterFlagResponse struct {
+	Reporter ReporterRecord `json:"reporter"`
 }
 
 // MsgServer defines the msg service for the oracle module
 type MsgServer interface {
 	Vote(ctx context.Context, msg *MsgVote) (*MsgVoteResponse, error)
+	UpdateParams(ctx context.Context, msg *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	ApproveHeldTransfer(ctx context.Context, msg *MsgApproveHeldTransfer) (*MsgApproveHeldTransferResponse, error)
+	RejectHeldTransfer(ctx context.Context, msg *MsgRejectHeldTransfer) (*MsgRejectHeldTransferResponse, error)
+	ReportReorg(ctx context.Context, msg *MsgReportReorg) (*MsgReportReorgResponse, error)
+	ResolveDispute(ctx context.Context, msg *MsgResolveDispute) (*MsgResolveDisputeResponse, error)
+	BatchVote(ctx context.Context, msg *MsgBatchVote) (*MsgBatchVoteResponse, error)
+	Suspend(ctx context.Context, msg *MsgSuspend) (*MsgSuspendResponse, error)
+	Resume(ctx context.Context, msg *MsgResume) (*MsgResumeResponse, error)
+	ChainHeartbeat(ctx context.Context, msg *MsgChainHeartbeat) (*MsgChainHeartbeatResponse, error)
+	ClearReporterFlag(ctx context.Context, msg *MsgClearReporterFlag) (*MsgClearReporterFlagResponse, error)
 }
 
 // Placeholder for protobuf service descriptor
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/klauspost/compress/zstd"
+
+	commontypes "github.com/interbank-netting/cosmos/types"
+)
+
+// Payload encodings of MsgBatchVote
+const (
+	PayloadEncodingGzip = "gzip"
+	PayloadEncodingZstd = "zstd"
+)
+
+// IsSupportedPayloadEncoding reports whether a batch payload encoding can be
+// decompressed by this version
+func IsSupportedPayloadEncoding(encoding string) bool {
+	return encoding == PayloadEncodingGzip || encoding == PayloadEncodingZstd
+}
+
+// BatchVoteEntry is one vote of a batch. The validator is the batch signer.
+type BatchVoteEntry struct {
+	TxHash           string                    `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash"`
+	EventData        commontypes.TransferEvent `protobuf:"bytes,2,opt,name=event_data,json=eventData,proto3" json:"event_data"`
+	Signature        []byte                    `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature"`
+	SignatureVersion uint32                    `protobuf:"varint,4,opt,name=signature_version,json=signatureVersion,proto3" json:"signature_version"`
+}
+
+// ProtoMessage implements proto.Message
+func (e *BatchVoteEntry) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (e *BatchVoteEntry) Reset() { *e = BatchVoteEntry{} }
+
+// String implements proto.Message
+func (e *BatchVoteEntry) String() string {
+	return fmt.Sprintf("BatchVoteEntry{TxHash: %s}", e.TxHash)
+}
+
+// Validate checks the entry as MsgVote.ValidateBasic checks a single vote
+func (e BatchVoteEntry) Validate() error {
+	return validateVoteContent(e.TxHash, e.EventData, e.Signature, e.SignatureVersion)
+}
+
+// VoteBatch is the decompressed payload of a MsgBatchVote
+type VoteBatch struct {
+	Votes []BatchVoteEntry `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes"`
+}
+
+// ProtoMessage implements proto.Message
+func (b *VoteBatch) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (b *VoteBatch) Reset() { *b = VoteBatch{} }
+
+// String implements proto.Message
+func (b *VoteBatch) String() string {
+	return fmt.Sprintf("VoteBatch{Votes: %d}", len(b.Votes))
+}
+
+// EncodeVoteBatch marshals votes and compresses them with encoding
+func EncodeVoteBatch(encoding string, votes []BatchVoteEntry) ([]byte, error) {
+	bz, err := proto.Marshal(&VoteBatch{Votes: votes})
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	switch encoding {
+	case PayloadEncodingGzip:
+		w = gzip.NewWriter(&buf)
+	case PayloadEncodingZstd:
+		if w, err = zstd.NewWriter(&buf); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported payload encoding: %q", encoding)
+	}
+
+	if _, err := w.Write(bz); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeVoteBatch decompresses a batch payload and unmarshals its votes.
+// Decompression stops after maxBytes, so a small payload cannot expand into
+// an unbounded allocation. It returns ErrBatchTooLarge past the limit and
+// ErrInvalidBatch for a payload that does not decode. In strict mode a payload
+// with fields this version doesn't know, or fields not encoded canonically, is
+// rejected with ErrInvalidEventData instead of having them dropped.
+func DecodeVoteBatch(encoding string, payload []byte, maxBytes int64, strict bool) ([]BatchVoteEntry, error) {
+	var r io.Reader
+	switch encoding {
+	case PayloadEncodingGzip:
+		gz, err := gzip.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return nil, errorsmod.Wrap(ErrInvalidBatch, err.Error())
+		}
+		defer gz.Close()
+		r = gz
+	case PayloadEncodingZstd:
+		zr, err := zstd.NewReader(bytes.NewReader(payload),
+			zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(uint64(maxBytes)))
+		if err != nil {
+			return nil, errorsmod.Wrap(ErrInvalidBatch, err.Error())
+		}
+		defer zr.Close()
+		r = zr
+	default:
+		return nil, errorsmod.Wrapf(ErrInvalidBatch, "unsupported payload encoding: %q", encoding)
+	}
+
+	// A zstd frame declaring more than maxBytes fails before it is decoded
+	bz, err := io.ReadAll(io.LimitReader(r, maxBytes+1))
+	if int64(len(bz)) > maxBytes || errors.Is(err, zstd.ErrDecoderSizeExceeded) || errors.Is(err, zstd.ErrWindowSizeExceeded) {
+		return nil, errorsmod.Wrapf(ErrBatchTooLarge, "decompressed payload exceeds %d bytes", maxBytes)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidBatch, err.Error())
+	}
+
+	var batch VoteBatch
+	if err := proto.Unmarshal(bz, &batch); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidBatch, err.Error())
+	}
+	// Unknown fields are dropped on unmarshal, so they show as a size mismatch
+	if strict && proto.Size(&batch) != len(bz) {
+		return nil, errorsmod.Wrapf(ErrInvalidEventData, "payload of %d bytes has unknown or non-canonical fields", len(bz))
+	}
+	return batch.Votes, nil
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+
+	commontypes "github.com/interbank-netting/cosmos/types"
+)
+
+// WorkQueue counts the work waiting for upcoming blocks, so operators can
+// anticipate heavy EndBlocks and tune params
+type WorkQueue struct {
+	BlockHeight             int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height"`
+	PendingVotes            int32 `protobuf:"varint,2,opt,name=pending_votes,json=pendingVotes,proto3" json:"pending_votes"`                                      // Unconfirmed transfers still collecting votes
+	PendingVotesNearTimeout int32 `protobuf:"varint,3,opt,name=pending_votes_near_timeout,json=pendingVotesNearTimeout,proto3" json:"pending_votes_near_timeout"` // Of those, transfers within the window of ConsensusTimeout
+	HeldTransfers           int32 `protobuf:"varint,4,opt,name=held_transfers,json=heldTransfers,proto3" json:"held_transfers"`                                   // Transfers awaiting manual approval
+	UnnettedTransfers       int32 `protobuf:"varint,5,opt,name=unnetted_transfers,json=unnettedTransfers,proto3" json:"unnetted_transfers"`                       // Confirmed after the last netting cycle
+	PendingNettingPairs     int32 `protobuf:"varint,6,opt,name=pending_netting_pairs,json=pendingNettingPairs,proto3" json:"pending_netting_pairs"`
+	DueNettingCycles        int32 `protobuf:"varint,7,opt,name=due_netting_cycles,json=dueNettingCycles,proto3" json:"due_netting_cycles"`
+	UnsignedCommands        int32 `protobuf:"varint,8,opt,name=unsigned_commands,json=unsignedCommands,proto3" json:"unsigned_commands"`
+}
+
+// ProtoMessage implements proto.Message
+func (w *WorkQueue) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (w *WorkQueue) Reset() { *w = WorkQueue{} }
+
+// String implements proto.Message
+func (w *WorkQueue) String() string {
+	return fmt.Sprintf("WorkQueue{PendingVotes: %d, UnnettedTransfers: %d, DueNettingCycles: %d, UnsignedCommands: %d}",
+		w.PendingVotes, w.UnnettedTransfers, w.DueNettingCycles, w.UnsignedCommands)
+}
+
+// ValidatorQueue lists the work a single validator still owes, so validator
+// sidecars can poll one endpoint instead of scanning every vote status and
+// command
+type ValidatorQueue struct {
+	Validator       string                    `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator"`
+	BlockHeight     int64                     `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height"`
+	Active          bool                      `protobuf:"varint,3,opt,name=active,proto3" json:"active"`                                         // Only active validators can vote
+	PendingVotes    []PendingVote             `protobuf:"bytes,4,rep,name=pending_votes,json=pendingVotes,proto3" json:"pending_votes"`          // Unconfirmed transfers without the validator's vote
+	PendingCommands []commontypes.MintCommand `protobuf:"bytes,5,rep,name=pending_commands,json=pendingCommands,proto3" json:"pending_commands"` // Pending commands without the validator's signature
+}
+
+// ProtoMessage implements proto.Message
+func (q *ValidatorQueue) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (q *ValidatorQueue) Reset() { *q = ValidatorQueue{} }
+
+// String implements proto.Message
+func (q *ValidatorQueue) String() string {
+	return fmt.Sprintf("ValidatorQueue{Validator: %s, PendingVotes: %d, PendingCommands: %d}",
+		q.Validator, len(q.PendingVotes), len(q.PendingCommands))
+}
+
+// PendingVote is an unconfirmed transfer a validator has not voted on
+type PendingVote struct {
+	TxHash    string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash"`
+	VoteCount int32  `protobuf:"varint,2,opt,name=vote_count,json=voteCount,proto3" json:"vote_count"`
+	Threshold int32  `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold"`
+	CreatedAt int64  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	Deadline  int64  `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline"` // Time the transfer reaches ConsensusTimeout
+}
+
+// ProtoMessage implements proto.Message
+func (v *PendingVote) ProtoMessage() {}
+
+// Reset implements proto.Message
+func (v *PendingVote) Reset() { *v = PendingVote{} }
+
+// String implements proto.Message
+func (v *PendingVote) String() string {
+	return fmt.Sprintf("PendingVote{TxHash: %s, VoteCount: %d, Threshold: %d}", v.TxHash, v.VoteCount, v.Threshold)
+}
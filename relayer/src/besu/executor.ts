@@ -1,16 +1,18 @@
 import { ethers, Contract, Wallet } from 'ethers';
-import { MintCommand, ECDSASignature } from '../types';
+import { MintCommand, ECDSASignature, ExecutionCost } from '../types';
 import { Logger } from 'winston';
+import { createBesuProvider } from './provider';
 
 /**
  * BesuExecutor handles executing MintCommands on Besu blockchain
  * Requirement 6.4: Besu 명령 실행 구현
  */
 export class BesuExecutor {
-  private provider: ethers.JsonRpcProvider;
+  private provider: ethers.JsonRpcProvider | ethers.FallbackProvider;
   private wallet: Wallet;
   private executor: Contract;
   private logger: Logger;
+  private dryRun: boolean;
 
   // Executor contract ABI (matches Executor.sol contract)
   private static readonly EXECUTOR_ABI = [
@@ -24,12 +26,13 @@ export class BesuExecutor {
   ];
 
   constructor(
-    rpcUrl: string,
+    rpcUrl: string | string[],
     executorAddress: string,
     privateKey: string,
-    logger: Logger
+    logger: Logger,
+    dryRun: boolean = false
   ) {
-    this.provider = new ethers.JsonRpcProvider(rpcUrl);
+    this.provider = createBesuProvider(rpcUrl);
     this.wallet = new Wallet(privateKey, this.provider);
     this.executor = new Contract(
       executorAddress,
@@ -37,6 +40,7 @@ export class BesuExecutor {
       this.wallet
     );
     this.logger = logger;
+    this.dryRun = dryRun;
   }
 
   /**
@@ -87,6 +91,19 @@ export class BesuExecutor {
       const feeData = await this.provider.getFeeData();
       const gasPrice = feeData.gasPrice || ethers.parseUnits('20', 'gwei');
 
+      if (this.dryRun) {
+        this.logger.info('Dry run: mint command not executed', {
+          commandId: command.commandId,
+          from: this.wallet.address,
+          recipient: command.recipient,
+          amount: command.amount.toString(),
+          signatureCount: command.signatures.length,
+          gasLimit,
+          gasPrice: gasPrice.toString(),
+        });
+        return ''; // Nothing was sent, so there is no execution to report
+      }
+
       // Execute the mint command
       const tx = await this.executor.executeMint(
         commandIdBytes,
@@ -130,6 +147,28 @@ export class BesuExecutor {
     }
   }
 
+  /**
+   * Get the gas used and wei paid by an execution transaction
+   */
+  async getExecutionCost(txHash: string): Promise<ExecutionCost | null> {
+    try {
+      const receipt = await this.provider.getTransactionReceipt(txHash);
+      if (!receipt) {
+        return null;
+      }
+      return {
+        gasUsed: receipt.gasUsed.toString(),
+        costWei: receipt.fee.toString(),
+      };
+    } catch (error) {
+      this.logger.error('Failed to get execution cost', {
+        txHash,
+        error: error instanceof Error ? error.message : String(error),
+      });
+      return null;
+    }
+  }
+
   /**
    * Check if a command has already been executed
    */
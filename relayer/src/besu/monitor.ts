@@ -1,16 +1,22 @@
 import { ethers, Contract, EventLog } from 'ethers';
-import { TransferEvent } from '../types';
+import { ChainStatus, TransferEvent } from '../types';
 import { Logger } from 'winston';
+import { createBesuProvider } from './provider';
 
 /**
  * BesuMonitor monitors Besu blockchain for TransferInitiated events
  * Requirement 6.1: Besu 이벤트 모니터링 구현
  */
 export class BesuMonitor {
-  private provider: ethers.WebSocketProvider | ethers.JsonRpcProvider;
+  private provider:
+    | ethers.WebSocketProvider
+    | ethers.JsonRpcProvider
+    | ethers.FallbackProvider;
   private gateway: Contract;
   private logger: Logger;
   private lastProcessedBlock: number;
+  private pollTimer: NodeJS.Timeout | null = null;
+  private isRunning: boolean = false;
 
   // Gateway contract ABI (minimal, only the events we need)
   private static readonly GATEWAY_ABI = [
@@ -18,7 +24,7 @@ export class BesuMonitor {
   ];
 
   constructor(
-    rpcUrl: string,
+    rpcUrl: string | string[],
     wsUrl: string | null,
     gatewayAddress: string,
     startBlock: number,
@@ -28,7 +34,7 @@ export class BesuMonitor {
     if (wsUrl) {
       this.provider = new ethers.WebSocketProvider(wsUrl);
     } else {
-      this.provider = new ethers.JsonRpcProvider(rpcUrl);
+      this.provider = createBesuProvider(rpcUrl);
     }
 
     this.gateway = new Contract(
@@ -52,6 +58,7 @@ export class BesuMonitor {
       startBlock: this.lastProcessedBlock,
     });
 
+    this.isRunning = true;
     if (this.provider instanceof ethers.WebSocketProvider) {
       // Real-time WebSocket monitoring
       await this.startWebSocketMonitoring(callback);
@@ -113,6 +120,10 @@ export class BesuMonitor {
     });
 
     const poll = async () => {
+      if (!this.isRunning) {
+        return;
+      }
+
       try {
         const currentBlock = await this.provider.getBlockNumber();
 
@@ -144,7 +155,9 @@ export class BesuMonitor {
       }
 
       // Schedule next poll
-      setTimeout(poll, pollInterval);
+      if (this.isRunning) {
+        this.pollTimer = setTimeout(poll, pollInterval);
+      }
     };
 
     // Start polling
@@ -170,6 +183,8 @@ export class BesuMonitor {
     return {
       txHash: event.transactionHash,
       blockNumber: event.blockNumber,
+      blockHash: event.blockHash,
+      logIndex: event.index,
       sender: parsed.args.sender,
       recipient: parsed.args.recipient,
       amount: parsed.args.amount,
@@ -180,6 +195,49 @@ export class BesuMonitor {
     };
   }
 
+  /**
+   * Check whether a transaction is still included in the given block.
+   * Returns false once a reorg has removed it or moved it to another block.
+   */
+  async isTransactionCanonical(
+    txHash: string,
+    blockHash: string
+  ): Promise<boolean> {
+    const receipt = await this.provider.getTransactionReceipt(txHash);
+    return receipt !== null && receipt.blockHash === blockHash;
+  }
+
+  /**
+   * Get the current Besu block number
+   */
+  async getBlockNumber(): Promise<number> {
+    return this.provider.getBlockNumber();
+  }
+
+  /**
+   * Get the chain status reported in heartbeats. Providers that cannot
+   * answer eth_syncing are reported as synced.
+   */
+  async getChainStatus(): Promise<ChainStatus> {
+    const [chain, besuHeight, gatewayBalance] = await Promise.all([
+      this.getSourceChainId(),
+      this.provider.getBlockNumber(),
+      this.provider.getBalance(this.gateway.target as string),
+    ]);
+
+    let syncing = false;
+    if (!(this.provider instanceof ethers.FallbackProvider)) {
+      syncing = (await this.provider.send('eth_syncing', [])) !== false;
+    }
+
+    return {
+      chain,
+      besuHeight,
+      gatewayBalance: gatewayBalance.toString(),
+      syncing,
+    };
+  }
+
   /**
    * Get the source chain identifier
    */
@@ -193,6 +251,11 @@ export class BesuMonitor {
    */
   async stop(): Promise<void> {
     this.logger.info('Stopping Besu event monitor');
+    this.isRunning = false;
+    if (this.pollTimer) {
+      clearTimeout(this.pollTimer);
+      this.pollTimer = null;
+    }
 
     // Remove all listeners
     await this.gateway.removeAllListeners();
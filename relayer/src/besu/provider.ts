@@ -0,0 +1,29 @@
+import { ethers } from 'ethers';
+
+/**
+ * Create an HTTP provider for one or more Besu RPC URLs
+ * With several URLs the first is preferred and the rest are used in order
+ * while it stalls or fails; a single healthy backend is enough to answer.
+ */
+export function createBesuProvider(
+  rpcUrls: string | string[]
+): ethers.JsonRpcProvider | ethers.FallbackProvider {
+  const urls = Array.isArray(rpcUrls) ? rpcUrls : [rpcUrls];
+  if (urls.length === 0) {
+    throw new Error('At least one Besu RPC URL is required');
+  }
+
+  if (urls.length === 1) {
+    return new ethers.JsonRpcProvider(urls[0]);
+  }
+
+  return new ethers.FallbackProvider(
+    urls.map((url, index) => ({
+      provider: new ethers.JsonRpcProvider(url),
+      priority: index + 1,
+      weight: 1,
+    })),
+    undefined,
+    { quorum: 1 }
+  );
+}
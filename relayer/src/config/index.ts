@@ -1,4 +1,5 @@
 import dotenv from 'dotenv';
+import { ChainFinality } from '../types';
 
 // Load environment variables
 dotenv.config();
@@ -17,6 +18,13 @@ export interface RelayerConfig {
     privateKey: string;
     startBlock: number;
     pollInterval: number;
+    reorgWindow: number; // blocks to keep re-checking voted transfers
+    heartbeatInterval?: number; // ms between chain heartbeats, 0 disables
+    chainName?: string; // only mint commands targeting this chain are executed
+    fallbackRpcUrls?: string[]; // tried in order when rpcUrl fails
+    dedupCapacity?: number; // transfer events remembered to suppress duplicate deliveries
+    dedupPath?: string; // file the de-dup cache is saved to across restarts
+    finality?: ChainFinality; // used when the oracle chain registry cannot be queried
   };
 
   // Cosmos configuration
@@ -26,6 +34,7 @@ export interface RelayerConfig {
     gasPrice: string;
     startHeight: number;
     pollInterval: number;
+    fallbackRpcEndpoints?: string[]; // tried in order when rpcEndpoint fails
   };
 
   // Logging configuration
@@ -40,6 +49,9 @@ export interface RelayerConfig {
     backoffMs: number;
     maxBackoffMs: number;
   };
+
+  // Observe both chains and log transactions instead of broadcasting them
+  dryRun?: boolean;
 }
 
 /**
@@ -71,6 +83,16 @@ export function loadConfig(): RelayerConfig {
       privateKey: process.env.BESU_PRIVATE_KEY!,
       startBlock: parseInt(process.env.BESU_START_BLOCK || '0'),
       pollInterval: parseInt(process.env.BESU_POLL_INTERVAL || '5000'),
+      reorgWindow: parseInt(process.env.BESU_REORG_WINDOW || '64'),
+      heartbeatInterval: parseInt(process.env.BESU_HEARTBEAT_INTERVAL || '60000'),
+      dedupCapacity: parseInt(process.env.BESU_DEDUP_CAPACITY || '10000'),
+      dedupPath: process.env.BESU_DEDUP_PATH || undefined,
+      finality: process.env.BESU_FINALITY_MODEL
+        ? {
+            model: process.env.BESU_FINALITY_MODEL as ChainFinality['model'],
+            confirmationDepth: parseInt(process.env.BESU_CONFIRMATION_DEPTH || '0'),
+          }
+        : undefined,
     },
 
     cosmos: {
@@ -105,6 +127,12 @@ export function validateConfig(config: RelayerConfig): void {
     throw new Error('Invalid BESU_RPC_URL: must start with http or https');
   }
 
+  for (const url of config.besu.fallbackRpcUrls ?? []) {
+    if (!url.startsWith('http')) {
+      throw new Error('Invalid Besu fallback RPC URL: must start with http or https');
+    }
+  }
+
   if (config.besu.wsUrl && !config.besu.wsUrl.startsWith('ws')) {
     throw new Error('Invalid BESU_WS_URL: must start with ws or wss');
   }
@@ -121,11 +149,29 @@ export function validateConfig(config: RelayerConfig): void {
     throw new Error('Invalid BESU_PRIVATE_KEY: must be 32-byte hex string');
   }
 
+  if (config.besu.reorgWindow < 1) {
+    throw new Error('BESU_REORG_WINDOW must be at least 1');
+  }
+
+  if (config.besu.finality) {
+    validateFinality(config.besu.finality);
+  }
+
+  if (config.besu.dedupCapacity !== undefined && config.besu.dedupCapacity < 1) {
+    throw new Error('BESU_DEDUP_CAPACITY must be at least 1');
+  }
+
   // Validate Cosmos configuration
   if (!config.cosmos.rpcEndpoint.startsWith('http')) {
     throw new Error('Invalid COSMOS_RPC_ENDPOINT: must start with http or https');
   }
 
+  for (const endpoint of config.cosmos.fallbackRpcEndpoints ?? []) {
+    if (!endpoint.startsWith('http')) {
+      throw new Error('Invalid Cosmos fallback RPC endpoint: must start with http or https');
+    }
+  }
+
   const mnemonicWords = config.cosmos.mnemonic.split(' ');
   if (mnemonicWords.length < 12) {
     throw new Error('Invalid COSMOS_MNEMONIC: must be at least 12 words');
@@ -144,3 +190,23 @@ export function validateConfig(config: RelayerConfig): void {
     throw new Error('RETRY_MAX_BACKOFF_MS must be greater than RETRY_BACKOFF_MS');
   }
 }
+
+/**
+ * Validate a finality model, with the same rules as the oracle chain registry
+ */
+export function validateFinality(finality: ChainFinality): void {
+  switch (finality.model) {
+    case 'instant':
+      if (finality.confirmationDepth !== 0) {
+        throw new Error('BESU_CONFIRMATION_DEPTH must be 0 for instant finality');
+      }
+      break;
+    case 'probabilistic':
+      if (!(finality.confirmationDepth >= 1)) {
+        throw new Error('BESU_CONFIRMATION_DEPTH must be at least 1 for probabilistic finality');
+      }
+      break;
+    default:
+      throw new Error(`Invalid BESU_FINALITY_MODEL: ${finality.model}`);
+  }
+}
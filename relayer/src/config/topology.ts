@@ -0,0 +1,292 @@
+import { readFileSync } from 'fs';
+import { RelayerConfig, validateConfig } from './index';
+import { ChainFinality } from '../types';
+
+/**
+ * Besu chain entry of a relayer topology file
+ * Secrets are not stored in the file; privateKeyEnv names the environment
+ * variable that holds the executor key of the chain.
+ */
+export interface BesuChainConfig {
+  name: string;
+  enabled: boolean;
+  rpcUrls: string[]; // failover list, first is preferred
+  wsUrl: string | null;
+  gatewayAddress: string;
+  executorAddress: string;
+  privateKeyEnv: string;
+  startBlock: number;
+  pollInterval: number;
+  reorgWindow: number;
+  dedupCapacity: number;
+  dedupPath: string | null; // file the de-dup cache is saved to, in memory only if null
+  finality: ChainFinality | null; // fallback when the oracle chain registry cannot be queried
+  cosmos: string; // name of the Cosmos endpoint the chain relays to
+}
+
+/**
+ * Cosmos endpoint entry of a relayer topology file
+ */
+export interface CosmosEndpointConfig {
+  name: string;
+  enabled: boolean;
+  rpcUrls: string[]; // failover list, first is preferred
+  mnemonicEnv: string;
+  gasPrice: string;
+  startHeight: number;
+  pollInterval: number;
+}
+
+/**
+ * Relayer topology: N Besu chains relayed to M Cosmos endpoints
+ */
+export interface TopologyConfig {
+  besuChains: BesuChainConfig[];
+  cosmosEndpoints: CosmosEndpointConfig[];
+  logging: RelayerConfig['logging'];
+  retry: RelayerConfig['retry'];
+}
+
+/**
+ * Resolved configuration of one relayed Besu chain
+ */
+export interface NetworkConfig {
+  name: string;
+  config: RelayerConfig;
+}
+
+/**
+ * Changes needed to move from the running networks to a new topology
+ */
+export interface ReloadPlan {
+  start: string[]; // newly enabled networks
+  stop: string[]; // removed or disabled networks
+  restart: string[]; // networks whose configuration changed
+  unchanged: string[];
+}
+
+/**
+ * Load a topology file, filling in defaults for omitted fields
+ */
+export function loadTopology(path: string): TopologyConfig {
+  let raw: any;
+  try {
+    raw = JSON.parse(readFileSync(path, 'utf8'));
+  } catch (error) {
+    throw new Error(
+      `Failed to read topology file ${path}: ${error instanceof Error ? error.message : String(error)}`
+    );
+  }
+
+  if (!Array.isArray(raw.besuChains) || !Array.isArray(raw.cosmosEndpoints)) {
+    throw new Error('Topology must define besuChains and cosmosEndpoints arrays');
+  }
+
+  const topology: TopologyConfig = {
+    besuChains: raw.besuChains.map((chain: any) => ({
+      name: chain.name,
+      enabled: chain.enabled ?? true,
+      rpcUrls: chain.rpcUrls ?? [],
+      wsUrl: chain.wsUrl ?? null,
+      gatewayAddress: chain.gatewayAddress,
+      executorAddress: chain.executorAddress,
+      privateKeyEnv: chain.privateKeyEnv ?? 'BESU_PRIVATE_KEY',
+      startBlock: chain.startBlock ?? 0,
+      pollInterval: chain.pollInterval ?? 5000,
+      reorgWindow: chain.reorgWindow ?? 64,
+      dedupCapacity: chain.dedupCapacity ?? 10000,
+      dedupPath: chain.dedupPath ?? null,
+      finality: chain.finality
+        ? {
+            model: chain.finality.model,
+            confirmationDepth: chain.finality.confirmationDepth ?? 0,
+          }
+        : null,
+      cosmos: chain.cosmos,
+    })),
+
+    cosmosEndpoints: raw.cosmosEndpoints.map((endpoint: any) => ({
+      name: endpoint.name,
+      enabled: endpoint.enabled ?? true,
+      rpcUrls: endpoint.rpcUrls ?? [],
+      mnemonicEnv: endpoint.mnemonicEnv ?? 'COSMOS_MNEMONIC',
+      gasPrice: endpoint.gasPrice ?? '0.025uatom',
+      startHeight: endpoint.startHeight ?? 0,
+      pollInterval: endpoint.pollInterval ?? 3000,
+    })),
+
+    logging: {
+      level: raw.logging?.level ?? 'info',
+      format: raw.logging?.format ?? 'json',
+    },
+
+    retry: {
+      maxAttempts: raw.retry?.maxAttempts ?? 3,
+      backoffMs: raw.retry?.backoffMs ?? 1000,
+      maxBackoffMs: raw.retry?.maxBackoffMs ?? 30000,
+    },
+  };
+
+  validateTopology(topology);
+  return topology;
+}
+
+/**
+ * Validate the structure of a topology
+ * Per-chain settings are validated when the networks are resolved.
+ */
+export function validateTopology(topology: TopologyConfig): void {
+  if (topology.besuChains.length === 0) {
+    throw new Error('Topology must define at least one Besu chain');
+  }
+
+  const endpoints = new Set<string>();
+  for (const endpoint of topology.cosmosEndpoints) {
+    if (!endpoint.name) {
+      throw new Error('Cosmos endpoint name is required');
+    }
+    if (endpoints.has(endpoint.name)) {
+      throw new Error(`Duplicate Cosmos endpoint: ${endpoint.name}`);
+    }
+    if (endpoint.rpcUrls.length === 0) {
+      throw new Error(`Cosmos endpoint ${endpoint.name}: rpcUrls must not be empty`);
+    }
+    endpoints.add(endpoint.name);
+  }
+
+  const chains = new Set<string>();
+  const dedupPaths = new Set<string>();
+  for (const chain of topology.besuChains) {
+    if (!chain.name) {
+      throw new Error('Besu chain name is required');
+    }
+    if (chains.has(chain.name)) {
+      throw new Error(`Duplicate Besu chain: ${chain.name}`);
+    }
+    if (chain.rpcUrls.length === 0) {
+      throw new Error(`Besu chain ${chain.name}: rpcUrls must not be empty`);
+    }
+    if (!endpoints.has(chain.cosmos)) {
+      throw new Error(`Besu chain ${chain.name}: unknown Cosmos endpoint ${chain.cosmos}`);
+    }
+    if (chain.dedupPath) {
+      if (dedupPaths.has(chain.dedupPath)) {
+        throw new Error(`Besu chain ${chain.name}: dedupPath ${chain.dedupPath} is used by another chain`);
+      }
+      dedupPaths.add(chain.dedupPath);
+    }
+    chains.add(chain.name);
+  }
+}
+
+/**
+ * Resolve the relayer configuration of every enabled Besu chain
+ * A chain is skipped when it or its Cosmos endpoint is disabled.
+ */
+export function resolveNetworks(
+  topology: TopologyConfig,
+  env: NodeJS.ProcessEnv = process.env
+): NetworkConfig[] {
+  const endpoints = new Map(
+    topology.cosmosEndpoints.map((endpoint) => [endpoint.name, endpoint])
+  );
+
+  const networks: NetworkConfig[] = [];
+  for (const chain of topology.besuChains) {
+    const endpoint = endpoints.get(chain.cosmos)!;
+    if (!chain.enabled || !endpoint.enabled) {
+      continue;
+    }
+
+    const privateKey = env[chain.privateKeyEnv];
+    if (!privateKey) {
+      throw new Error(
+        `Besu chain ${chain.name}: missing environment variable ${chain.privateKeyEnv}`
+      );
+    }
+
+    const mnemonic = env[endpoint.mnemonicEnv];
+    if (!mnemonic) {
+      throw new Error(
+        `Cosmos endpoint ${endpoint.name}: missing environment variable ${endpoint.mnemonicEnv}`
+      );
+    }
+
+    const config: RelayerConfig = {
+      besu: {
+        rpcUrl: chain.rpcUrls[0],
+        wsUrl: chain.wsUrl,
+        gatewayAddress: chain.gatewayAddress,
+        executorAddress: chain.executorAddress,
+        privateKey,
+        startBlock: chain.startBlock,
+        pollInterval: chain.pollInterval,
+        reorgWindow: chain.reorgWindow,
+        dedupCapacity: chain.dedupCapacity,
+        dedupPath: chain.dedupPath ?? undefined,
+        finality: chain.finality ?? undefined,
+        chainName: chain.name,
+        fallbackRpcUrls: chain.rpcUrls.slice(1),
+      },
+
+      cosmos: {
+        rpcEndpoint: endpoint.rpcUrls[0],
+        mnemonic,
+        gasPrice: endpoint.gasPrice,
+        startHeight: endpoint.startHeight,
+        pollInterval: endpoint.pollInterval,
+        fallbackRpcEndpoints: endpoint.rpcUrls.slice(1),
+      },
+
+      logging: topology.logging,
+      retry: topology.retry,
+    };
+
+    try {
+      validateConfig(config);
+    } catch (error) {
+      throw new Error(
+        `Besu chain ${chain.name}: ${error instanceof Error ? error.message : String(error)}`
+      );
+    }
+
+    networks.push({ name: chain.name, config });
+  }
+
+  return networks;
+}
+
+/**
+ * Compare the running networks with a newly resolved set
+ * A network restarts when any of its settings changed, including the
+ * settings of the Cosmos endpoint it relays to.
+ */
+export function planReload(
+  running: NetworkConfig[],
+  next: NetworkConfig[]
+): ReloadPlan {
+  const current = new Map(
+    running.map((network) => [network.name, network.config])
+  );
+  const plan: ReloadPlan = { start: [], stop: [], restart: [], unchanged: [] };
+
+  for (const network of next) {
+    const config = current.get(network.name);
+    if (!config) {
+      plan.start.push(network.name);
+    } else if (JSON.stringify(config) !== JSON.stringify(network.config)) {
+      plan.restart.push(network.name);
+    } else {
+      plan.unchanged.push(network.name);
+    }
+  }
+
+  const names = new Set(next.map((network) => network.name));
+  for (const network of running) {
+    if (!names.has(network.name)) {
+      plan.stop.push(network.name);
+    }
+  }
+
+  return plan;
+}
@@ -2,6 +2,10 @@ import { StargateClient } from '@cosmjs/stargate';
 import { MintCommand, ECDSASignature } from '../types';
 import { Logger } from 'winston';
 
+// Events of commands that reached the signature threshold. threshold_reached
+// is emitted by the multisig module; mint_command_created is the legacy name.
+const SIGNED_COMMAND_EVENTS = ['threshold_reached', 'mint_command_created'];
+
 /**
  * CosmosMonitor monitors Cosmos Hub for MintCommand events
  * Requirement 6.3: Cosmos 이벤트 모니터링 구현
@@ -9,18 +13,21 @@ import { Logger } from 'winston';
 export class CosmosMonitor {
   private client: StargateClient | null = null;
   private logger: Logger;
-  private rpcEndpoint: string;
+  private rpcEndpoints: string[];
+  private endpointIndex: number = 0;
   private lastProcessedHeight: number;
   private pollInterval: number;
   private isRunning: boolean = false;
 
   constructor(
-    rpcEndpoint: string,
+    rpcEndpoint: string | string[],
     startHeight: number,
     pollInterval: number,
     logger: Logger
   ) {
-    this.rpcEndpoint = rpcEndpoint;
+    this.rpcEndpoints = Array.isArray(rpcEndpoint)
+      ? rpcEndpoint
+      : [rpcEndpoint];
     this.lastProcessedHeight = startHeight;
     this.pollInterval = pollInterval;
     this.logger = logger;
@@ -31,10 +38,16 @@ export class CosmosMonitor {
    */
   async connect(): Promise<void> {
     this.logger.info('Connecting to Cosmos Hub for monitoring', {
-      rpcEndpoint: this.rpcEndpoint,
+      rpcEndpoint: this.getRpcEndpoint(),
     });
 
-    this.client = await StargateClient.connect(this.rpcEndpoint);
+    try {
+      this.client = await StargateClient.connect(this.getRpcEndpoint());
+    } catch (error) {
+      // Fail over to the next endpoint on the caller's next attempt
+      this.rotateEndpoint();
+      throw error;
+    }
 
     const height = await this.client.getHeight();
     this.logger.info('Connected to Cosmos Hub', {
@@ -96,6 +109,7 @@ export class CosmosMonitor {
         this.logger.error('Error polling for events', {
           error: error instanceof Error ? error.message : String(error),
         });
+        await this.failover();
       }
 
       // Wait for next poll interval
@@ -128,9 +142,9 @@ export class CosmosMonitor {
           continue;
         }
 
-        // Look for MintCommandCreated events
+        // Signed commands carry everything the gateway call needs
         for (const event of txResult.events) {
-          if (event.type === 'mint_command_created') {
+          if (SIGNED_COMMAND_EVENTS.includes(event.type)) {
             const mintCommand = this.parseMintCommandEvent(event, height);
             if (mintCommand) {
               await callback(mintCommand);
@@ -170,7 +184,7 @@ export class CosmosMonitor {
           v: parseInt(sig.v),
           r: sig.r,
           s: sig.s,
-          signer: sig.signer,
+          validator: sig.validator ?? sig.signer,
         });
       }
 
@@ -182,6 +196,13 @@ export class CosmosMonitor {
         signatures,
         createdAt: parseInt(attributes['created_at'] || '0'),
         status: 'pending',
+        nonce: parseInt(attributes['nonce'] || '0'),
+        idempotencyKey: attributes['idempotency_key'] || '',
+        payloadHash: attributes['payload_hash'],
+        validatorSetVersion: attributes['validator_set_version']
+          ? parseInt(attributes['validator_set_version'])
+          : undefined,
+        tokenId: attributes['token_id'] || undefined,
       };
 
       this.logger.info('Parsed MintCommand event', {
@@ -231,6 +252,51 @@ export class CosmosMonitor {
     this.isRunning = false;
   }
 
+  /**
+   * Get the RPC endpoint currently in use
+   */
+  getRpcEndpoint(): string {
+    return this.rpcEndpoints[this.endpointIndex];
+  }
+
+  /**
+   * Move to the next configured RPC endpoint, wrapping around
+   */
+  private rotateEndpoint(): void {
+    if (this.rpcEndpoints.length < 2) {
+      return;
+    }
+
+    const failed = this.getRpcEndpoint();
+    this.endpointIndex = (this.endpointIndex + 1) % this.rpcEndpoints.length;
+    this.logger.warn('Failing over to next Cosmos RPC endpoint', {
+      failed,
+      next: this.getRpcEndpoint(),
+    });
+  }
+
+  /**
+   * Reconnect to the next RPC endpoint after a polling error
+   * A failed reconnect leaves the client unset, so the next poll fails over
+   * again instead of retrying the same endpoint.
+   */
+  private async failover(): Promise<void> {
+    if (this.rpcEndpoints.length < 2) {
+      return;
+    }
+
+    this.rotateEndpoint();
+    this.disconnect();
+    try {
+      this.client = await StargateClient.connect(this.getRpcEndpoint());
+    } catch (error) {
+      this.logger.error('Failed to connect to Cosmos RPC endpoint', {
+        rpcEndpoint: this.getRpcEndpoint(),
+        error: error instanceof Error ? error.message : String(error),
+      });
+    }
+  }
+
   /**
    * Disconnect from Cosmos Hub
    */
@@ -1,7 +1,14 @@
 import { DirectSecp256k1HdWallet } from '@cosmjs/proto-signing';
 import { SigningStargateClient, GasPrice, StdFee } from '@cosmjs/stargate';
-import { TransferEvent, ECDSASignature } from '../types';
+import {
+  TransferEvent,
+  ECDSASignature,
+  ExecutionCost,
+  ChainStatus,
+  ChainFinality,
+} from '../types';
 import { Logger } from 'winston';
+import { CosmosTxError, toCosmosTxError } from '../utils/cosmos-errors';
 
 /**
  * CosmosSubmitter handles submitting MsgVote transactions to Cosmos Hub
@@ -12,23 +19,35 @@ export class CosmosSubmitter {
   private wallet: DirectSecp256k1HdWallet | null = null;
   private validatorAddress: string = '';
   private logger: Logger;
-  private rpcEndpoint: string;
+  private rpcEndpoints: string[];
+  private endpointIndex: number = 0;
   private mnemonic: string;
   private gasPrice: GasPrice;
+  private dryRun: boolean;
 
   // Custom message type for oracle module
   private static readonly MSG_VOTE_TYPE = '/interbank.netting.oracle.MsgVote';
+  private static readonly MSG_REPORT_REORG_TYPE =
+    '/interbank.netting.oracle.MsgReportReorg';
+  private static readonly MSG_CHAIN_HEARTBEAT_TYPE =
+    '/interbank.netting.oracle.MsgChainHeartbeat';
+  private static readonly MSG_REPORT_EXECUTION_TYPE =
+    '/interbank.netting.multisig.MsgReportExecution';
 
   constructor(
-    rpcEndpoint: string,
+    rpcEndpoint: string | string[],
     mnemonic: string,
     gasPrice: string,
-    logger: Logger
+    logger: Logger,
+    dryRun: boolean = false
   ) {
-    this.rpcEndpoint = rpcEndpoint;
+    this.rpcEndpoints = Array.isArray(rpcEndpoint)
+      ? rpcEndpoint
+      : [rpcEndpoint];
     this.mnemonic = mnemonic;
     this.gasPrice = GasPrice.fromString(gasPrice);
     this.logger = logger;
+    this.dryRun = dryRun;
   }
 
   /**
@@ -36,7 +55,7 @@ export class CosmosSubmitter {
    */
   async connect(): Promise<void> {
     this.logger.info('Connecting to Cosmos Hub', {
-      rpcEndpoint: this.rpcEndpoint,
+      rpcEndpoint: this.getRpcEndpoint(),
     });
 
     // Create wallet from mnemonic
@@ -49,13 +68,19 @@ export class CosmosSubmitter {
     this.validatorAddress = firstAccount.address;
 
     // Create signing client
-    this.client = await SigningStargateClient.connectWithSigner(
-      this.rpcEndpoint,
-      this.wallet,
-      {
-        gasPrice: this.gasPrice,
-      }
-    );
+    try {
+      this.client = await SigningStargateClient.connectWithSigner(
+        this.getRpcEndpoint(),
+        this.wallet,
+        {
+          gasPrice: this.gasPrice,
+        }
+      );
+    } catch (error) {
+      // Fail over to the next endpoint on the caller's next attempt
+      this.rotateEndpoint();
+      throw error;
+    }
 
     this.logger.info('Connected to Cosmos Hub', {
       validatorAddress: this.validatorAddress,
@@ -102,6 +127,10 @@ export class CosmosSubmitter {
       // Calculate fee with 20% buffer
       const fee = this.calculateFee(gasEstimate);
 
+      if (this.dryRun) {
+        return this.logDryRun(msgVote, fee, `Vote for transfer ${event.txHash}`);
+      }
+
       // Broadcast transaction
       const result = await this.client.signAndBroadcast(
         this.validatorAddress,
@@ -111,9 +140,7 @@ export class CosmosSubmitter {
       );
 
       if (result.code !== 0) {
-        throw new Error(
-          `Transaction failed: ${result.rawLog || 'Unknown error'}`
-        );
+        throw new CosmosTxError('oracle', result.code, result.rawLog || '');
       }
 
       this.logger.info('Vote submitted successfully', {
@@ -125,12 +152,173 @@ export class CosmosSubmitter {
 
       return result.transactionHash;
     } catch (error) {
+      const txError = toCosmosTxError(error);
       this.logger.error('Failed to submit vote', {
         txHash: event.txHash,
-        error: error instanceof Error ? error.message : String(error),
+        error: txError instanceof Error ? txError.message : String(txError),
+        retryable: txError instanceof CosmosTxError ? txError.retryable : undefined,
       });
-      throw error;
+      throw txError;
+    }
+  }
+
+  /**
+   * Report that a reorg removed a confirmed transfer's source transaction.
+   * The oracle freezes the issued credit and opens a dispute.
+   */
+  async submitReorgReport(txHash: string, reason: string): Promise<string> {
+    if (!this.client || !this.validatorAddress) {
+      throw new Error('Cosmos client not initialized. Call connect() first.');
+    }
+
+    this.logger.warn('Reporting reorged transfer', { txHash, reason });
+
+    const msgReportReorg = {
+      typeUrl: CosmosSubmitter.MSG_REPORT_REORG_TYPE,
+      value: {
+        reporter: this.validatorAddress,
+        txHash,
+        reason,
+      },
+    };
+
+    const gasEstimate = await this.estimateGas(msgReportReorg);
+    const fee = this.calculateFee(gasEstimate);
+
+    if (this.dryRun) {
+      return this.logDryRun(msgReportReorg, fee, `Report reorg of ${txHash}`);
+    }
+
+    const result = await this.client.signAndBroadcast(
+      this.validatorAddress,
+      [msgReportReorg],
+      fee,
+      `Report reorg of ${txHash}`
+    ).catch((error) => {
+      throw toCosmosTxError(error);
+    });
+
+    if (result.code !== 0) {
+      throw new CosmosTxError('oracle', result.code, result.rawLog || '');
     }
+
+    this.logger.info('Reorg report submitted', {
+      txHash,
+      cosmosTxHash: result.transactionHash,
+    });
+
+    return result.transactionHash;
+  }
+
+  /**
+   * Post a heartbeat with the latest status of a Besu chain. The oracle
+   * suspends issuance from chains without a fresh heartbeat.
+   */
+  async submitChainHeartbeat(status: ChainStatus): Promise<string> {
+    if (!this.client || !this.validatorAddress) {
+      throw new Error('Cosmos client not initialized. Call connect() first.');
+    }
+
+    const msgChainHeartbeat = {
+      typeUrl: CosmosSubmitter.MSG_CHAIN_HEARTBEAT_TYPE,
+      value: {
+        reporter: this.validatorAddress,
+        chain: status.chain,
+        besuHeight: status.besuHeight.toString(),
+        gatewayBalance: status.gatewayBalance,
+        syncing: status.syncing,
+      },
+    };
+
+    const gasEstimate = await this.estimateGas(msgChainHeartbeat);
+    const fee = this.calculateFee(gasEstimate);
+
+    if (this.dryRun) {
+      return this.logDryRun(
+        msgChainHeartbeat,
+        fee,
+        `Heartbeat of ${status.chain}`
+      );
+    }
+
+    const result = await this.client.signAndBroadcast(
+      this.validatorAddress,
+      [msgChainHeartbeat],
+      fee,
+      `Heartbeat of ${status.chain}`
+    ).catch((error) => {
+      throw toCosmosTxError(error);
+    });
+
+    if (result.code !== 0) {
+      throw new CosmosTxError('oracle', result.code, result.rawLog || '');
+    }
+
+    this.logger.debug('Chain heartbeat submitted', {
+      chain: status.chain,
+      besuHeight: status.besuHeight,
+      cosmosTxHash: result.transactionHash,
+    });
+
+    return result.transactionHash;
+  }
+
+  /**
+   * Report that a mint command was executed on Besu. Reports are keyed by the
+   * command's idempotency key, so resubmitting the same execution is harmless.
+   * The cost of the first report is recorded for charge-back.
+   */
+  async submitExecutionReport(
+    idempotencyKey: string,
+    txHash: string,
+    cost?: ExecutionCost
+  ): Promise<string> {
+    if (!this.client || !this.validatorAddress) {
+      throw new Error('Cosmos client not initialized. Call connect() first.');
+    }
+
+    const msgReportExecution = {
+      typeUrl: CosmosSubmitter.MSG_REPORT_EXECUTION_TYPE,
+      value: {
+        reporter: this.validatorAddress,
+        idempotencyKey,
+        txHash,
+        gasUsed: cost?.gasUsed ?? '0',
+        costWei: cost?.costWei ?? '0',
+      },
+    };
+
+    const gasEstimate = await this.estimateGas(msgReportExecution);
+    const fee = this.calculateFee(gasEstimate);
+
+    if (this.dryRun) {
+      return this.logDryRun(
+        msgReportExecution,
+        fee,
+        `Report execution ${idempotencyKey}`
+      );
+    }
+
+    const result = await this.client.signAndBroadcast(
+      this.validatorAddress,
+      [msgReportExecution],
+      fee,
+      `Report execution ${idempotencyKey}`
+    ).catch((error) => {
+      throw toCosmosTxError(error);
+    });
+
+    if (result.code !== 0) {
+      throw new CosmosTxError('multisig', result.code, result.rawLog || '');
+    }
+
+    this.logger.info('Execution report submitted', {
+      idempotencyKey,
+      txHash,
+      cosmosTxHash: result.transactionHash,
+    });
+
+    return result.transactionHash;
   }
 
   /**
@@ -170,6 +358,44 @@ export class CosmosSubmitter {
     }
   }
 
+  /**
+   * Query the finality model of a Besu chain from the oracle chain registry
+   * Unregistered chains are returned with the oracle's default finality.
+   */
+  async queryChainFinality(chain: string): Promise<ChainFinality | null> {
+    if (!this.client) {
+      throw new Error('Cosmos client not initialized. Call connect() first.');
+    }
+
+    try {
+      const queryData = {
+        chain_finality: {
+          chain,
+        },
+      };
+
+      const response = await this.client.queryContractSmart(
+        'oracle', // Module query endpoint
+        queryData
+      );
+
+      const finality = response.chains?.[0];
+      if (!finality) {
+        return null;
+      }
+      return {
+        model: finality.model,
+        confirmationDepth: parseInt(finality.confirmation_depth),
+      };
+    } catch (error) {
+      this.logger.error('Failed to query chain finality', {
+        chain,
+        error: error instanceof Error ? error.message : String(error),
+      });
+      return null;
+    }
+  }
+
   /**
    * Check if a transfer has reached consensus (2/3+ votes)
    */
@@ -208,6 +434,21 @@ export class CosmosSubmitter {
     }
   }
 
+  /**
+   * Log the transaction that would have been broadcast in dry-run mode
+   * Returns an empty transaction hash, as nothing was submitted.
+   */
+  private logDryRun(msg: any, fee: StdFee, memo: string): string {
+    this.logger.info('Dry run: transaction not broadcast', {
+      signer: this.validatorAddress,
+      typeUrl: msg.typeUrl,
+      value: msg.value,
+      fee,
+      memo,
+    });
+    return '';
+  }
+
   /**
    * Calculate fee based on gas estimate
    */
@@ -231,6 +472,29 @@ export class CosmosSubmitter {
     return this.validatorAddress;
   }
 
+  /**
+   * Get the RPC endpoint currently in use
+   */
+  getRpcEndpoint(): string {
+    return this.rpcEndpoints[this.endpointIndex];
+  }
+
+  /**
+   * Move to the next configured RPC endpoint, wrapping around
+   */
+  private rotateEndpoint(): void {
+    if (this.rpcEndpoints.length < 2) {
+      return;
+    }
+
+    const failed = this.getRpcEndpoint();
+    this.endpointIndex = (this.endpointIndex + 1) % this.rpcEndpoints.length;
+    this.logger.warn('Failing over to next Cosmos RPC endpoint', {
+      failed,
+      next: this.getRpcEndpoint(),
+    });
+  }
+
   /**
    * Disconnect from Cosmos Hub
    */
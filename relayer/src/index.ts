@@ -1,9 +1,45 @@
 #!/usr/bin/env node
 
-import { Relayer } from './relayer';
 import { loadConfig, validateConfig } from './config';
+import {
+  NetworkConfig,
+  loadTopology,
+  resolveNetworks,
+} from './config/topology';
+import { RelayerSupervisor } from './supervisor';
 import { createLogger } from './utils/logger';
 
+/**
+ * Load the networks to relay
+ * RELAYER_CONFIG names a topology file of several Besu chains and Cosmos
+ * endpoints; without it a single network is configured from the environment.
+ */
+function loadNetworks(
+  topologyPath: string | undefined,
+  dryRun: boolean
+): {
+  networks: NetworkConfig[];
+  logging: { level: string; format: 'json' | 'simple' };
+} {
+  if (topologyPath) {
+    const topology = loadTopology(topologyPath);
+    return {
+      networks: resolveNetworks(topology).map((network) => ({
+        name: network.name,
+        config: { ...network.config, dryRun },
+      })),
+      logging: topology.logging,
+    };
+  }
+
+  const config = loadConfig();
+  validateConfig(config);
+  return {
+    networks: [{ name: 'default', config: { ...config, dryRun } }],
+    logging: config.logging,
+  };
+}
+
 /**
  * Main entry point for Astra Clear Relayer
  */
@@ -12,23 +48,27 @@ async function main() {
 
   try {
     // Load configuration
-    const config = loadConfig();
+    const topologyPath = process.env.RELAYER_CONFIG;
+    const dryRun = process.argv.includes('--dry-run');
+    const { networks, logging } = loadNetworks(topologyPath, dryRun);
 
     // Create logger
-    logger = createLogger(config.logging.level, config.logging.format);
+    logger = createLogger(logging.level, logging.format);
 
     logger.info('Astra Clear Relayer starting', {
       version: '1.0.0',
-      besuRpc: config.besu.rpcUrl,
-      cosmosRpc: config.cosmos.rpcEndpoint,
+      topology: topologyPath ?? null,
+      dryRun,
+      networks: networks.map((network) => ({
+        chain: network.name,
+        besuRpc: network.config.besu.rpcUrl,
+        cosmosRpc: network.config.cosmos.rpcEndpoint,
+      })),
     });
-
-    // Validate configuration
-    validateConfig(config);
     logger.info('Configuration validated successfully');
 
-    // Create relayer instance
-    const relayer = new Relayer(config, logger);
+    // Create one relayer per network
+    const supervisor = new RelayerSupervisor(logger);
 
     // Handle graceful shutdown
     let isShuttingDown = false;
@@ -43,7 +83,7 @@ async function main() {
       logger.info(`Received ${signal}, shutting down gracefully`);
 
       try {
-        await relayer.stop();
+        await supervisor.stop();
         logger.info('Relayer shutdown complete');
         process.exit(0);
       } catch (error) {
@@ -58,6 +98,29 @@ async function main() {
     process.on('SIGINT', () => shutdown('SIGINT'));
     process.on('SIGTERM', () => shutdown('SIGTERM'));
 
+    // Reload the topology; an invalid file leaves the running networks as is
+    process.on('SIGHUP', async () => {
+      if (!topologyPath) {
+        logger.warn('Received SIGHUP, but reload requires RELAYER_CONFIG');
+        return;
+      }
+
+      logger.info('Received SIGHUP, reloading topology', {
+        topology: topologyPath,
+      });
+
+      try {
+        await supervisor.apply(loadNetworks(topologyPath, dryRun).networks);
+        logger.info('Topology reloaded', {
+          networks: supervisor.getNetworks(),
+        });
+      } catch (error) {
+        logger.error('Failed to reload topology, keeping current networks', {
+          error: error instanceof Error ? error.message : String(error),
+        });
+      }
+    });
+
     // Handle uncaught errors
     process.on('uncaughtException', (error) => {
       logger.error('Uncaught exception', {
@@ -74,15 +137,21 @@ async function main() {
       });
     });
 
-    // Start relayer
-    await relayer.start();
+    // Start relayers
+    await supervisor.apply(networks);
+    if (networks.length > 0 && supervisor.getNetworks().length === 0) {
+      throw new Error('No network could be started');
+    }
 
     // Log status periodically
     setInterval(() => {
-      const status = relayer.getStatus();
+      const status = supervisor.getStatus();
       logger.info('Relayer status', status);
     }, 60000); // Log status every 60 seconds
 
+    if (dryRun) {
+      logger.warn('Dry-run mode: transactions are logged, not broadcast');
+    }
     logger.info('Relayer is running. Press Ctrl+C to stop.');
   } catch (error) {
     if (logger) {
@@ -5,7 +5,18 @@ import { CosmosSubmitter } from './cosmos/submitter';
 import { CosmosMonitor } from './cosmos/monitor';
 import { RelayerConfig } from './config';
 import { retry, retryBlockchain, CircuitBreaker } from './utils/retry';
-import { TransferEvent, MintCommand } from './types';
+import {
+  DEFAULT_DEDUP_CAPACITY,
+  DedupMetrics,
+  EventDeduplicator,
+} from './utils/dedup';
+import { TransferEvent, MintCommand, ChainFinality } from './types';
+
+// Finality the oracle applies to chains missing from its registry
+const DEFAULT_FINALITY: ChainFinality = {
+  model: 'probabilistic',
+  confirmationDepth: 0,
+};
 
 /**
  * Main Relayer class that orchestrates cross-chain communication
@@ -24,16 +35,35 @@ export class Relayer {
   private besuCircuitBreaker: CircuitBreaker;
 
   // Tracking processed events to avoid duplicates
-  private processedTransfers: Set<string> = new Set();
+  private transferDedup: EventDeduplicator;
   private processedCommands: Set<string> = new Set();
 
+  // Voted transfers still inside the reorg window, re-checked until final
+  private unfinalizedTransfers: Map<string, TransferEvent> = new Map();
+  // Transfers not yet buried by the confirmation depth, voted once they are
+  private unconfirmedTransfers: Map<string, TransferEvent> = new Map();
+  // Resolved from the oracle chain registry at start
+  private finality: ChainFinality = DEFAULT_FINALITY;
+  private reorgCheckTimer: NodeJS.Timeout | null = null;
+  private heartbeatTimer: NodeJS.Timeout | null = null;
+  private dedupSaveTimer: NodeJS.Timeout | null = null;
+
   constructor(config: RelayerConfig, logger: Logger) {
     this.config = config;
     this.logger = logger;
 
+    const besuRpcUrls = [
+      config.besu.rpcUrl,
+      ...(config.besu.fallbackRpcUrls ?? []),
+    ];
+    const cosmosRpcEndpoints = [
+      config.cosmos.rpcEndpoint,
+      ...(config.cosmos.fallbackRpcEndpoints ?? []),
+    ];
+
     // Initialize Besu components
     this.besuMonitor = new BesuMonitor(
-      config.besu.rpcUrl,
+      besuRpcUrls,
       config.besu.wsUrl,
       config.besu.gatewayAddress,
       config.besu.startBlock,
@@ -41,27 +71,35 @@ export class Relayer {
     );
 
     this.besuExecutor = new BesuExecutor(
-      config.besu.rpcUrl,
+      besuRpcUrls,
       config.besu.executorAddress,
       config.besu.privateKey,
-      logger
+      logger,
+      config.dryRun
     );
 
     // Initialize Cosmos components
     this.cosmosSubmitter = new CosmosSubmitter(
-      config.cosmos.rpcEndpoint,
+      cosmosRpcEndpoints,
       config.cosmos.mnemonic,
       config.cosmos.gasPrice,
-      logger
+      logger,
+      config.dryRun
     );
 
     this.cosmosMonitor = new CosmosMonitor(
-      config.cosmos.rpcEndpoint,
+      cosmosRpcEndpoints,
       config.cosmos.startHeight,
       config.cosmos.pollInterval,
       logger
     );
 
+    this.transferDedup = new EventDeduplicator(
+      config.besu.dedupCapacity ?? DEFAULT_DEDUP_CAPACITY,
+      config.besu.dedupPath ?? null,
+      logger
+    );
+
     // Initialize circuit breakers
     this.cosmosCircuitBreaker = new CircuitBreaker(5, 60000, logger);
     this.besuCircuitBreaker = new CircuitBreaker(5, 60000, logger);
@@ -71,9 +109,14 @@ export class Relayer {
    * Start the relayer
    */
   async start(): Promise<void> {
-    this.logger.info('Starting Astra Clear Relayer');
+    this.logger.info('Starting Astra Clear Relayer', {
+      dryRun: this.config.dryRun ?? false,
+    });
 
     try {
+      // Events voted before a restart are not voted again
+      this.transferDedup.load();
+
       // Connect to Cosmos Hub
       await retry(
         () => this.cosmosSubmitter.connect(),
@@ -91,13 +134,44 @@ export class Relayer {
         validatorAddress: this.cosmosSubmitter.getValidatorAddress(),
       });
 
+      await this.resolveFinality();
+
       // Start monitoring Besu for TransferInitiated events
       this.logger.info('Starting Besu monitor');
       await this.besuMonitor.start(this.handleBesuTransfer.bind(this));
 
       // Start monitoring Cosmos for MintCommand events
       this.logger.info('Starting Cosmos monitor');
-      await this.cosmosMonitor.start(this.handleCosmosCommand.bind(this));
+      // The poll loop runs until stop(), so it is not awaited
+      this.cosmosMonitor
+        .start(this.handleCosmosCommand.bind(this))
+        .catch((error) => {
+          this.logger.error('Cosmos monitor stopped', {
+            error: error instanceof Error ? error.message : String(error),
+          });
+        });
+
+      // Vote transfers once confirmed and re-check voted ones for reorgs
+      this.reorgCheckTimer = setInterval(
+        () => this.checkFinality(),
+        this.config.besu.pollInterval
+      );
+
+      // Post chain heartbeats so the oracle can detect a stale chain
+      if (this.config.besu.heartbeatInterval) {
+        this.heartbeatTimer = setInterval(
+          () => this.sendHeartbeat(),
+          this.config.besu.heartbeatInterval
+        );
+      }
+
+      // Persist the de-dup cache so a crash loses at most one interval
+      if (this.config.besu.dedupPath) {
+        this.dedupSaveTimer = setInterval(
+          () => this.transferDedup.save(),
+          this.config.besu.pollInterval
+        );
+      }
 
       this.logger.info('Relayer started successfully');
     } catch (error) {
@@ -113,11 +187,20 @@ export class Relayer {
    * Flow: Besu Transfer -> Cosmos Vote (Requirement 6.1 -> 6.2)
    */
   private async handleBesuTransfer(event: TransferEvent): Promise<void> {
-    // Check if already processed
-    if (this.processedTransfers.has(event.txHash)) {
-      this.logger.debug('Transfer already processed, skipping', {
-        txHash: event.txHash,
-      });
+    // The oracle rejects votes below the confirmation depth, so hold them
+    if (this.finality.confirmationDepth > 0) {
+      const currentBlock = await this.besuMonitor.getBlockNumber();
+      if (currentBlock - event.blockNumber < this.finality.confirmationDepth) {
+        this.unconfirmedTransfers.set(event.txHash, event);
+        return;
+      }
+    }
+
+    // Duplicate deliveries of the same log are suppressed, including ones
+    // arriving while the first is still being voted
+    if (
+      !this.transferDedup.claim(event.sourceChain, event.txHash, event.logIndex)
+    ) {
       return;
     }
 
@@ -140,12 +223,23 @@ export class Relayer {
       });
 
       // Mark as processed
-      this.processedTransfers.add(event.txHash);
-
-      this.logger.info('Successfully submitted vote for transfer', {
-        txHash: event.txHash,
-      });
+      this.transferDedup.commit(event.sourceChain, event.txHash, event.logIndex);
+      if (this.finality.model !== 'instant') {
+        this.unfinalizedTransfers.set(event.txHash, event);
+      }
+
+      this.logger.info(
+        this.config.dryRun
+          ? 'Dry run: vote for transfer logged'
+          : 'Successfully submitted vote for transfer',
+        { txHash: event.txHash }
+      );
     } catch (error) {
+      this.transferDedup.release(
+        event.sourceChain,
+        event.txHash,
+        event.logIndex
+      );
       this.logger.error('Failed to process Besu transfer', {
         txHash: event.txHash,
         error: error instanceof Error ? error.message : String(error),
@@ -156,11 +250,154 @@ export class Relayer {
     }
   }
 
+  /**
+   * Resolve the finality model of the relayed chain from the oracle chain
+   * registry, falling back to the configured model if it cannot be queried
+   */
+  private async resolveFinality(): Promise<void> {
+    let registered: ChainFinality | null = null;
+    try {
+      const status = await this.besuMonitor.getChainStatus();
+      registered = await this.cosmosSubmitter.queryChainFinality(status.chain);
+    } catch (error) {
+      this.logger.warn('Failed to query chain finality', {
+        error: error instanceof Error ? error.message : String(error),
+      });
+    }
+
+    this.finality = registered ?? this.config.besu.finality ?? DEFAULT_FINALITY;
+    this.logger.info('Using chain finality', {
+      model: this.finality.model,
+      confirmationDepth: this.finality.confirmationDepth,
+      source: registered ? 'registry' : 'config',
+    });
+  }
+
+  /**
+   * Vote transfers that reached the confirmation depth, then check voted
+   * transfers for reorgs. Instant finality chains cannot reorg.
+   */
+  private async checkFinality(): Promise<void> {
+    if (this.unconfirmedTransfers.size > 0) {
+      try {
+        const currentBlock = await this.besuMonitor.getBlockNumber();
+        for (const [txHash, event] of this.unconfirmedTransfers) {
+          if (currentBlock - event.blockNumber < this.finality.confirmationDepth) {
+            continue;
+          }
+          this.unconfirmedTransfers.delete(txHash);
+
+          // A transfer removed by a reorg before its depth is never voted
+          const canonical = await this.besuMonitor.isTransactionCanonical(
+            txHash,
+            event.blockHash
+          );
+          if (canonical) {
+            await this.handleBesuTransfer(event);
+          } else {
+            this.logger.warn('Unconfirmed transfer removed by reorg', {
+              txHash,
+              blockNumber: event.blockNumber,
+            });
+          }
+        }
+      } catch (error) {
+        this.logger.error('Failed to check transfer confirmations', {
+          error: error instanceof Error ? error.message : String(error),
+        });
+      }
+    }
+
+    if (this.finality.model !== 'instant') {
+      await this.checkReorgs();
+    }
+  }
+
+  /**
+   * Report voted transfers whose source transaction was removed by a reorg
+   * so the oracle freezes their credit before netting consumes it.
+   * Transfers deeper than the reorg window are considered final.
+   */
+  private async checkReorgs(): Promise<void> {
+    if (this.unfinalizedTransfers.size === 0) {
+      return;
+    }
+
+    try {
+      const currentBlock = await this.besuMonitor.getBlockNumber();
+
+      for (const [txHash, event] of this.unfinalizedTransfers) {
+        if (currentBlock - event.blockNumber > this.config.besu.reorgWindow) {
+          this.unfinalizedTransfers.delete(txHash);
+          continue;
+        }
+
+        const canonical = await this.besuMonitor.isTransactionCanonical(
+          txHash,
+          event.blockHash
+        );
+        if (canonical) {
+          continue;
+        }
+
+        this.logger.warn('Voted transfer removed by reorg', {
+          txHash,
+          blockNumber: event.blockNumber,
+          blockHash: event.blockHash,
+        });
+
+        await this.cosmosCircuitBreaker.execute(async () => {
+          await retryBlockchain(
+            () =>
+              this.cosmosSubmitter.submitReorgReport(
+                txHash,
+                `source block ${event.blockHash} at height ${event.blockNumber} is no longer canonical`
+              ),
+            this.config.retry,
+            this.logger
+          );
+        });
+
+        // The dispute now tracks it on the Cosmos side
+        this.unfinalizedTransfers.delete(txHash);
+      }
+    } catch (error) {
+      this.logger.error('Failed to check for reorgs', {
+        error: error instanceof Error ? error.message : String(error),
+      });
+    }
+  }
+
+  /**
+   * Post the Besu chain status to the oracle. A missed heartbeat is only
+   * logged; the next interval tries again.
+   */
+  private async sendHeartbeat(): Promise<void> {
+    try {
+      const status = await this.besuMonitor.getChainStatus();
+      await this.cosmosCircuitBreaker.execute(() =>
+        this.cosmosSubmitter.submitChainHeartbeat(status)
+      );
+    } catch (error) {
+      this.logger.error('Failed to send chain heartbeat', {
+        error: error instanceof Error ? error.message : String(error),
+      });
+    }
+  }
+
   /**
    * Handle MintCommand event from Cosmos
    * Flow: Cosmos MintCommand -> Besu Execution (Requirement 6.3 -> 6.4)
    */
   private async handleCosmosCommand(command: MintCommand): Promise<void> {
+    // In a multi-network deployment each relayer executes only its own chain
+    if (
+      this.config.besu.chainName &&
+      command.targetChain !== this.config.besu.chainName
+    ) {
+      return;
+    }
+
     // Check if already processed
     if (this.processedCommands.has(command.commandId)) {
       this.logger.debug('Command already processed, skipping', {
@@ -179,8 +416,9 @@ export class Relayer {
 
     try {
       // Execute mint command on Besu with circuit breaker and retry
+      let besuTxHash = '';
       await this.besuCircuitBreaker.execute(async () => {
-        await retryBlockchain(
+        besuTxHash = await retryBlockchain(
           () => this.besuExecutor.executeMintCommand(command),
           this.config.retry,
           this.logger
@@ -190,9 +428,30 @@ export class Relayer {
       // Mark as processed
       this.processedCommands.add(command.commandId);
 
-      this.logger.info('Successfully executed mint command', {
-        commandId: command.commandId,
-      });
+      // Report the execution; Cosmos counts each idempotency key once, so a
+      // duplicate report from another relayer is acknowledged as a no-op
+      if (besuTxHash && command.idempotencyKey) {
+        const cost = await this.besuExecutor.getExecutionCost(besuTxHash);
+        await this.cosmosCircuitBreaker.execute(async () => {
+          await retryBlockchain(
+            () =>
+              this.cosmosSubmitter.submitExecutionReport(
+                command.idempotencyKey,
+                besuTxHash,
+                cost ?? undefined
+              ),
+            this.config.retry,
+            this.logger
+          );
+        });
+      }
+
+      this.logger.info(
+        this.config.dryRun
+          ? 'Dry run: mint command logged'
+          : 'Successfully executed mint command',
+        { commandId: command.commandId }
+      );
     } catch (error) {
       this.logger.error('Failed to process Cosmos command', {
         commandId: command.commandId,
@@ -211,6 +470,19 @@ export class Relayer {
 
     try {
       // Stop monitors
+      if (this.reorgCheckTimer) {
+        clearInterval(this.reorgCheckTimer);
+        this.reorgCheckTimer = null;
+      }
+      if (this.heartbeatTimer) {
+        clearInterval(this.heartbeatTimer);
+        this.heartbeatTimer = null;
+      }
+      if (this.dedupSaveTimer) {
+        clearInterval(this.dedupSaveTimer);
+        this.dedupSaveTimer = null;
+      }
+      this.transferDedup.save();
       this.besuMonitor.stop();
       this.cosmosMonitor.stop();
 
@@ -240,15 +512,18 @@ export class Relayer {
         lastProcessedHeight: this.cosmosMonitor.getLastProcessedHeight(),
         validatorAddress: this.cosmosSubmitter.getValidatorAddress(),
         connected: this.cosmosSubmitter.isConnected(),
+        rpcEndpoint: this.cosmosMonitor.getRpcEndpoint(),
       },
       circuitBreakers: {
         cosmos: this.cosmosCircuitBreaker.getState(),
         besu: this.besuCircuitBreaker.getState(),
       },
       processed: {
-        transferCount: this.processedTransfers.size,
+        transferCount: this.transferDedup.size(),
         commandCount: this.processedCommands.size,
       },
+      duplicates: this.transferDedup.getMetrics(),
+      dryRun: this.config.dryRun ?? false,
     };
   }
 }
@@ -266,6 +541,7 @@ export interface RelayerStatus {
     lastProcessedHeight: number;
     validatorAddress: string;
     connected: boolean;
+    rpcEndpoint: string; // endpoint currently in use after any failover
   };
   circuitBreakers: {
     cosmos: 'closed' | 'open' | 'half-open';
@@ -275,4 +551,6 @@ export interface RelayerStatus {
     transferCount: number;
     commandCount: number;
   };
+  duplicates: DedupMetrics; // transfer events suppressed by the de-dup cache
+  dryRun: boolean;
 }
@@ -0,0 +1,110 @@
+import { Logger } from 'winston';
+import { Relayer, RelayerStatus } from './relayer';
+import { RelayerConfig } from './config';
+import { NetworkConfig, planReload } from './config/topology';
+
+/**
+ * RelayerSupervisor runs one Relayer per relayed Besu chain and applies
+ * topology changes without restarting the process
+ */
+export class RelayerSupervisor {
+  private logger: Logger;
+  private createRelayer: (config: RelayerConfig, logger: Logger) => Relayer;
+  private running: Map<string, { config: RelayerConfig; relayer: Relayer }> =
+    new Map();
+
+  // Serializes apply() calls so overlapping reloads do not interleave
+  private pending: Promise<void> = Promise.resolve();
+
+  constructor(
+    logger: Logger,
+    createRelayer: (config: RelayerConfig, logger: Logger) => Relayer = (
+      config,
+      logger
+    ) => new Relayer(config, logger)
+  ) {
+    this.logger = logger;
+    this.createRelayer = createRelayer;
+  }
+
+  /**
+   * Bring the running relayers in line with the given networks
+   * Networks that fail to start are logged and retried on the next apply.
+   */
+  apply(networks: NetworkConfig[]): Promise<void> {
+    this.pending = this.pending
+      .catch(() => undefined)
+      .then(() => this.doApply(networks));
+    return this.pending;
+  }
+
+  private async doApply(networks: NetworkConfig[]): Promise<void> {
+    const running = Array.from(this.running.entries()).map(
+      ([name, entry]) => ({ name, config: entry.config })
+    );
+    const plan = planReload(running, networks);
+
+    this.logger.info('Applying relayer topology', plan);
+
+    for (const name of [...plan.stop, ...plan.restart]) {
+      await this.stopNetwork(name);
+    }
+
+    const configs = new Map(
+      networks.map((network) => [network.name, network.config])
+    );
+    for (const name of [...plan.restart, ...plan.start]) {
+      const config = configs.get(name)!;
+      const relayer = this.createRelayer(
+        config,
+        this.logger.child({ chain: name })
+      );
+      try {
+        await relayer.start();
+        this.running.set(name, { config, relayer });
+      } catch (error) {
+        this.logger.error('Failed to start network', {
+          chain: name,
+          error: error instanceof Error ? error.message : String(error),
+        });
+        await relayer.stop();
+      }
+    }
+  }
+
+  private async stopNetwork(name: string): Promise<void> {
+    const entry = this.running.get(name);
+    if (!entry) {
+      return;
+    }
+
+    this.logger.info('Stopping network', { chain: name });
+    await entry.relayer.stop();
+    this.running.delete(name);
+  }
+
+  /**
+   * Stop every running relayer
+   */
+  async stop(): Promise<void> {
+    await this.apply([]);
+  }
+
+  /**
+   * Get the names of the running networks
+   */
+  getNetworks(): string[] {
+    return Array.from(this.running.keys());
+  }
+
+  /**
+   * Get the status of every running relayer, keyed by chain
+   */
+  getStatus(): Record<string, RelayerStatus> {
+    const status: Record<string, RelayerStatus> = {};
+    for (const [name, entry] of this.running) {
+      status[name] = entry.relayer.getStatus();
+    }
+    return status;
+  }
+}
@@ -7,6 +7,8 @@ import { BigNumber } from 'ethers';
 export interface TransferEvent {
   txHash: string;
   blockNumber: number;
+  blockHash: string;
+  logIndex: number; // position of the log in its block
   sender: string;
   recipient: string;
   amount: BigNumber;
@@ -28,6 +30,11 @@ export interface MintCommand {
   signatures: ECDSASignature[];
   createdAt: number;
   status: MintCommandStatus;
+  nonce: number; // Per target chain sequence
+  idempotencyKey: string; // Hash of commandId, nonce and target chain; dedups execution reports
+  payloadHash?: string; // Hex sha256 of the payload the validators signed
+  validatorSetVersion?: number; // Multisig validator set that signed the command
+  tokenId?: string; // Wrapped asset to mint; the gateway default token if absent
 }
 
 /**
@@ -41,6 +48,35 @@ export interface ECDSASignature {
   v: number;
 }
 
+/**
+ * Relaying cost of an executed command, reported back to Cosmos so operators
+ * can charge it back to the bank of the target chain
+ */
+export interface ExecutionCost {
+  gasUsed: string;
+  costWei: string; // gasUsed * effective gas price
+}
+
+/**
+ * Latest status of a Besu chain, posted to the oracle as a heartbeat
+ */
+export interface ChainStatus {
+  chain: string; // source chain identifier, e.g. besu-1337
+  besuHeight: number;
+  gatewayBalance: string; // wei held by the gateway contract
+  syncing: boolean;
+}
+
+/**
+ * Finality model of a Besu chain, registered in the oracle chain registry
+ * Instant finality (IBFT 2.0, QBFT) needs no confirmations and cannot reorg;
+ * probabilistic finality waits confirmationDepth blocks and checks for reorgs.
+ */
+export interface ChainFinality {
+  model: 'instant' | 'probabilistic';
+  confirmationDepth: number;
+}
+
 export enum MintCommandStatus {
   Pending = 'pending',
   Signed = 'signed',
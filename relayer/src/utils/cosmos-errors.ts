@@ -0,0 +1,76 @@
+/**
+ * Classification of Cosmos transaction errors by (codespace, code).
+ * Mirrors the RegisterRetryableErrors/RegisterUserErrors calls of the Cosmos
+ * modules (cosmos/types/errors.go and x/<module>/types/errors.go). Codes are
+ * stable, so branch on them instead of parsing raw logs.
+ */
+
+const RETRYABLE_ERRORS: Record<string, number[]> = {
+  sdk: [11, 20, 32], // out of gas, mempool is full, incorrect account sequence
+  oracle: [6, 16, 20, 25, 32], // insufficient votes, transfer not confirmed, attestations missing, suspended, below confirmation depth
+  netting: [5, 13], // netting in progress, trigger cooldown
+  multisig: [8, 18, 25], // insufficient signatures, command not batched, nonce gap
+};
+
+const USER_ERRORS: Record<string, number[]> = {
+  sdk: [4, 5, 7, 13, 18],
+  oracle: [1, 2, 3, 4, 5, 7, 8, 10, 11, 12, 13, 14, 15, 17, 18, 19, 21, 22, 23, 24, 26, 27, 28, 29, 30, 31, 33, 34],
+  netting: [1, 2, 3, 4, 8, 9, 10, 12, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24],
+  multisig: [1, 2, 3, 4, 5, 6, 7, 9, 10, 11, 12, 13, 14, 15, 16, 17, 19, 20, 21, 22, 23, 24, 26],
+};
+
+/**
+ * A transaction rejected by Cosmos, carrying the ABCI codespace and code
+ */
+export class CosmosTxError extends Error {
+  constructor(
+    public readonly codespace: string,
+    public readonly code: number,
+    public readonly rawLog: string
+  ) {
+    super(`Transaction failed (${codespace}/${code}): ${rawLog || 'Unknown error'}`);
+    this.name = 'CosmosTxError';
+  }
+
+  /** The same message may succeed when resubmitted later */
+  get retryable(): boolean {
+    return isRetryableCode(this.codespace, this.code);
+  }
+
+  /** The message itself is invalid; resubmitting it unchanged fails again */
+  get userError(): boolean {
+    return isUserErrorCode(this.codespace, this.code);
+  }
+}
+
+export function isRetryableCode(codespace: string, code: number): boolean {
+  return RETRYABLE_ERRORS[codespace]?.includes(code) ?? false;
+}
+
+export function isUserErrorCode(codespace: string, code: number): boolean {
+  return USER_ERRORS[codespace]?.includes(code) ?? false;
+}
+
+/**
+ * Convert an error thrown while broadcasting (CosmJS BroadcastTxError carries
+ * the CheckTx codespace and code) into a CosmosTxError; other errors are
+ * returned unchanged
+ */
+export function toCosmosTxError(error: unknown): unknown {
+  if (error instanceof CosmosTxError) {
+    return error;
+  }
+  const candidate = error as { codespace?: unknown; code?: unknown; log?: unknown };
+  if (
+    typeof candidate?.codespace === 'string' &&
+    typeof candidate.code === 'number' &&
+    candidate.code !== 0
+  ) {
+    return new CosmosTxError(
+      candidate.codespace,
+      candidate.code,
+      typeof candidate.log === 'string' ? candidate.log : String(error)
+    );
+  }
+  return error;
+}
@@ -0,0 +1,189 @@
+import { existsSync, readFileSync, renameSync, writeFileSync } from 'fs';
+import { Logger } from 'winston';
+
+/**
+ * Default number of events remembered by the de-dup cache
+ */
+export const DEFAULT_DEDUP_CAPACITY = 10000;
+
+/**
+ * Counters of duplicate event deliveries suppressed by the de-dup cache
+ */
+export interface DedupMetrics {
+  size: number; // events remembered
+  capacity: number;
+  suppressed: number; // duplicates suppressed since start
+  suppressedByChain: Record<string, number>;
+  evicted: number; // oldest events dropped to stay within capacity
+}
+
+/**
+ * Event de-duplication window
+ * Remembers the most recent events by (chain, txHash, logIndex) so RPC
+ * reconnects, polling overlaps and duplicate websocket deliveries of the same
+ * log never produce a second vote. An event is claimed while its vote is in
+ * flight, committed once the vote is submitted and released if it fails, so
+ * a failed event is still retried on its next delivery. With a path, the
+ * committed events are saved and reloaded across restarts.
+ */
+export class EventDeduplicator {
+  // Insertion ordered: the first key is the least recently seen
+  private seen: Map<string, true> = new Map();
+  private inFlight: Set<string> = new Set();
+  private suppressed = 0;
+  private suppressedByChain: Record<string, number> = {};
+  private evicted = 0;
+  private dirty = false;
+
+  constructor(
+    private capacity: number = DEFAULT_DEDUP_CAPACITY,
+    private path: string | null = null,
+    private logger?: Logger
+  ) {
+    if (capacity < 1) {
+      throw new Error('De-dup capacity must be at least 1');
+    }
+  }
+
+  /**
+   * Key of an event in the cache
+   */
+  static key(chain: string, txHash: string, logIndex: number): string {
+    return `${chain}:${txHash.toLowerCase()}:${logIndex}`;
+  }
+
+  /**
+   * Claim an event for processing. Returns false, counting a suppressed
+   * duplicate, if the event was already processed or is being processed.
+   */
+  claim(chain: string, txHash: string, logIndex: number): boolean {
+    const key = EventDeduplicator.key(chain, txHash, logIndex);
+    if (this.seen.has(key) || this.inFlight.has(key)) {
+      // Refresh the entry so a chain replaying old logs keeps them cached
+      if (this.seen.delete(key)) {
+        this.seen.set(key, true);
+      }
+      this.suppressed++;
+      this.suppressedByChain[chain] = (this.suppressedByChain[chain] ?? 0) + 1;
+      this.logger?.debug('Duplicate event suppressed', {
+        chain,
+        txHash,
+        logIndex,
+      });
+      return false;
+    }
+
+    this.inFlight.add(key);
+    return true;
+  }
+
+  /**
+   * Record a claimed event as processed
+   */
+  commit(chain: string, txHash: string, logIndex: number): void {
+    const key = EventDeduplicator.key(chain, txHash, logIndex);
+    this.inFlight.delete(key);
+    this.remember(key);
+  }
+
+  /**
+   * Release a claimed event whose processing failed, so its next delivery
+   * is processed again
+   */
+  release(chain: string, txHash: string, logIndex: number): void {
+    this.inFlight.delete(EventDeduplicator.key(chain, txHash, logIndex));
+  }
+
+  /**
+   * Check whether an event was processed
+   */
+  has(chain: string, txHash: string, logIndex: number): boolean {
+    return this.seen.has(EventDeduplicator.key(chain, txHash, logIndex));
+  }
+
+  /**
+   * Number of events remembered
+   */
+  size(): number {
+    return this.seen.size;
+  }
+
+  /**
+   * Get the suppression counters
+   */
+  getMetrics(): DedupMetrics {
+    return {
+      size: this.seen.size,
+      capacity: this.capacity,
+      suppressed: this.suppressed,
+      suppressedByChain: { ...this.suppressedByChain },
+      evicted: this.evicted,
+    };
+  }
+
+  /**
+   * Load the events saved by a previous run. A missing file starts an empty
+   * cache; an unreadable one is logged and ignored.
+   */
+  load(): void {
+    if (!this.path || !existsSync(this.path)) {
+      return;
+    }
+
+    try {
+      const keys = JSON.parse(readFileSync(this.path, 'utf8'));
+      if (!Array.isArray(keys)) {
+        throw new Error('expected an array of event keys');
+      }
+      for (const key of keys) {
+        if (typeof key === 'string') {
+          this.remember(key);
+        }
+      }
+      this.dirty = false;
+      this.logger?.info('Loaded de-dup cache', {
+        path: this.path,
+        events: this.seen.size,
+      });
+    } catch (error) {
+      this.logger?.warn('Failed to load de-dup cache, starting empty', {
+        path: this.path,
+        error: error instanceof Error ? error.message : String(error),
+      });
+    }
+  }
+
+  /**
+   * Save the processed events if they changed since the last save. The file
+   * is replaced atomically, so a crash mid-write keeps the previous one.
+   */
+  save(): void {
+    if (!this.path || !this.dirty) {
+      return;
+    }
+
+    try {
+      const tmp = `${this.path}.tmp`;
+      writeFileSync(tmp, JSON.stringify([...this.seen.keys()]));
+      renameSync(tmp, this.path);
+      this.dirty = false;
+    } catch (error) {
+      this.logger?.error('Failed to save de-dup cache', {
+        path: this.path,
+        error: error instanceof Error ? error.message : String(error),
+      });
+    }
+  }
+
+  private remember(key: string): void {
+    this.seen.delete(key);
+    this.seen.set(key, true);
+    this.dirty = true;
+
+    while (this.seen.size > this.capacity) {
+      const oldest = this.seen.keys().next().value as string;
+      this.seen.delete(oldest);
+      this.evicted++;
+    }
+  }
+}
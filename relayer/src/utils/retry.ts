@@ -1,4 +1,5 @@
 import { Logger } from 'winston';
+import { CosmosTxError } from './cosmos-errors';
 
 /**
  * Retry configuration
@@ -136,6 +137,11 @@ export async function retryBlockchain<T>(
     {
       ...config,
       shouldRetry: (error) => {
+        // Cosmos rejections carry a stable code, so trust its classification
+        if (error instanceof CosmosTxError) {
+          return error.retryable;
+        }
+
         const errorMessage = error.message.toLowerCase();
 
         // Don't retry on transaction errors that are permanent
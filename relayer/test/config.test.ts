@@ -47,6 +47,7 @@ describe('config', () => {
 
       expect(config.besu.startBlock).toBe(0);
       expect(config.besu.pollInterval).toBe(5000);
+      expect(config.besu.reorgWindow).toBe(64);
       expect(config.cosmos.startHeight).toBe(0);
       expect(config.cosmos.pollInterval).toBe(3000);
       expect(config.logging.level).toBe('info');
@@ -67,6 +68,7 @@ describe('config', () => {
           privateKey: 'abcdef0123456789abcdef0123456789abcdef0123456789abcdef0123456789',
           startBlock: 0,
           pollInterval: 5000,
+          reorgWindow: 64,
         },
         cosmos: {
           rpcEndpoint: 'http://localhost:26657',
@@ -160,5 +162,20 @@ describe('config', () => {
         'RETRY_MAX_BACKOFF_MS must be greater than RETRY_BACKOFF_MS'
       );
     });
+
+    it('should throw for a finality depth that does not match the model', () => {
+      validConfig.besu.finality = { model: 'probabilistic', confirmationDepth: 12 };
+      expect(() => validateConfig(validConfig)).not.toThrow();
+
+      validConfig.besu.finality = { model: 'instant', confirmationDepth: 12 };
+      expect(() => validateConfig(validConfig)).toThrow(
+        'BESU_CONFIRMATION_DEPTH must be 0 for instant finality'
+      );
+
+      validConfig.besu.finality = { model: 'probabilistic', confirmationDepth: 0 };
+      expect(() => validateConfig(validConfig)).toThrow(
+        'BESU_CONFIRMATION_DEPTH must be at least 1 for probabilistic finality'
+      );
+    });
   });
 });
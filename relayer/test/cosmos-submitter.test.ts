@@ -0,0 +1,89 @@
+import { describe, it, expect, beforeEach, vi } from 'vitest';
+import { CosmosSubmitter } from '../src/cosmos/submitter';
+import { createLogger } from '../src/utils/logger';
+import { TransferEvent } from '../src/types';
+
+describe('CosmosSubmitter', () => {
+  const logger = createLogger('error', 'simple');
+  const mnemonic =
+    'word1 word2 word3 word4 word5 word6 word7 word8 word9 word10 word11 word12';
+
+  const event: TransferEvent = {
+    txHash: '0xabc',
+    blockNumber: 100,
+    blockHash: '0xdef',
+    logIndex: 0,
+    sender: '0x1234567890123456789012345678901234567890',
+    recipient: 'cosmos1recipient',
+    amount: 1000n as any,
+    sourceChain: 'bank-a',
+    destChain: 'bank-b',
+    nonce: 1n as any,
+    timestamp: 1700000000,
+  };
+
+  let client: { simulate: any; signAndBroadcast: any };
+
+  // Stand in for a connected signing client
+  const connect = (submitter: CosmosSubmitter) => {
+    (submitter as any).client = client;
+    (submitter as any).validatorAddress = 'cosmos1validator';
+  };
+
+  beforeEach(() => {
+    client = {
+      simulate: vi.fn().mockResolvedValue(100000),
+      signAndBroadcast: vi.fn().mockResolvedValue({
+        code: 0,
+        transactionHash: 'COSMOSTX',
+        gasUsed: 90000,
+        gasWanted: 120000,
+      }),
+    };
+  });
+
+  describe('dry run', () => {
+    it('should simulate but not broadcast votes', async () => {
+      const submitter = new CosmosSubmitter(
+        'http://localhost:26657',
+        mnemonic,
+        '0.025uatom',
+        logger,
+        true
+      );
+      connect(submitter);
+
+      await expect(submitter.submitVote(event)).resolves.toBe('');
+      expect(client.simulate).toHaveBeenCalledOnce();
+      expect(client.signAndBroadcast).not.toHaveBeenCalled();
+    });
+
+    it('should not broadcast reorg or execution reports', async () => {
+      const submitter = new CosmosSubmitter(
+        'http://localhost:26657',
+        mnemonic,
+        '0.025uatom',
+        logger,
+        true
+      );
+      connect(submitter);
+
+      await expect(submitter.submitReorgReport('0xabc', 'reorg')).resolves.toBe('');
+      await expect(submitter.submitExecutionReport('key', '0xbesu')).resolves.toBe('');
+      expect(client.signAndBroadcast).not.toHaveBeenCalled();
+    });
+
+    it('should broadcast when dry run is off', async () => {
+      const submitter = new CosmosSubmitter(
+        'http://localhost:26657',
+        mnemonic,
+        '0.025uatom',
+        logger
+      );
+      connect(submitter);
+
+      await expect(submitter.submitVote(event)).resolves.toBe('COSMOSTX');
+      expect(client.signAndBroadcast).toHaveBeenCalledOnce();
+    });
+  });
+});
@@ -0,0 +1,108 @@
+import { describe, it, expect, beforeEach, afterEach } from 'vitest';
+import { mkdtempSync, writeFileSync, rmSync } from 'fs';
+import { tmpdir } from 'os';
+import { join } from 'path';
+import { EventDeduplicator } from '../src/utils/dedup';
+import { createLogger } from '../src/utils/logger';
+
+describe('EventDeduplicator', () => {
+  const logger = createLogger('error', 'simple');
+  const txHash = '0xabc123';
+  let dir: string;
+
+  beforeEach(() => {
+    dir = mkdtempSync(join(tmpdir(), 'dedup-'));
+  });
+
+  afterEach(() => {
+    rmSync(dir, { recursive: true, force: true });
+  });
+
+  it('should suppress a committed event and count it per chain', () => {
+    const dedup = new EventDeduplicator(10, null, logger);
+
+    expect(dedup.claim('besu-1337', txHash, 0)).toBe(true);
+    dedup.commit('besu-1337', txHash, 0);
+
+    expect(dedup.claim('besu-1337', txHash, 0)).toBe(false);
+    expect(dedup.claim('besu-1337', '0xABC123', 0)).toBe(false);
+    expect(dedup.getMetrics()).toMatchObject({
+      size: 1,
+      suppressed: 2,
+      suppressedByChain: { 'besu-1337': 2 },
+    });
+  });
+
+  it('should key events by chain, transaction and log index', () => {
+    const dedup = new EventDeduplicator(10, null, logger);
+    dedup.claim('besu-1337', txHash, 0);
+    dedup.commit('besu-1337', txHash, 0);
+
+    expect(dedup.claim('besu-1337', txHash, 1)).toBe(true);
+    expect(dedup.claim('besu-2024', txHash, 0)).toBe(true);
+  });
+
+  it('should suppress a duplicate delivered while the first is in flight', () => {
+    const dedup = new EventDeduplicator(10, null, logger);
+
+    expect(dedup.claim('besu-1337', txHash, 0)).toBe(true);
+    expect(dedup.claim('besu-1337', txHash, 0)).toBe(false);
+    expect(dedup.has('besu-1337', txHash, 0)).toBe(false);
+  });
+
+  it('should process a released event again', () => {
+    const dedup = new EventDeduplicator(10, null, logger);
+
+    dedup.claim('besu-1337', txHash, 0);
+    dedup.release('besu-1337', txHash, 0);
+
+    expect(dedup.claim('besu-1337', txHash, 0)).toBe(true);
+    expect(dedup.getMetrics().suppressed).toBe(0);
+  });
+
+  it('should evict the least recently seen event beyond capacity', () => {
+    const dedup = new EventDeduplicator(2, null, logger);
+    for (const logIndex of [0, 1]) {
+      dedup.claim('besu-1337', txHash, logIndex);
+      dedup.commit('besu-1337', txHash, logIndex);
+    }
+
+    // A duplicate refreshes log 0, so log 1 is evicted first
+    dedup.claim('besu-1337', txHash, 0);
+    dedup.claim('besu-1337', txHash, 2);
+    dedup.commit('besu-1337', txHash, 2);
+
+    expect(dedup.has('besu-1337', txHash, 0)).toBe(true);
+    expect(dedup.has('besu-1337', txHash, 1)).toBe(false);
+    expect(dedup.getMetrics()).toMatchObject({ size: 2, evicted: 1 });
+  });
+
+  it('should reload committed events saved by a previous run', () => {
+    const path = join(dir, 'dedup.json');
+    const first = new EventDeduplicator(10, path, logger);
+    first.claim('besu-1337', txHash, 0);
+    first.commit('besu-1337', txHash, 0);
+    first.claim('besu-1337', txHash, 1); // in flight when the relayer stops
+    first.save();
+
+    const second = new EventDeduplicator(10, path, logger);
+    second.load();
+
+    expect(second.claim('besu-1337', txHash, 0)).toBe(false);
+    expect(second.claim('besu-1337', txHash, 1)).toBe(true);
+  });
+
+  it('should start empty when the saved file is unreadable', () => {
+    const path = join(dir, 'dedup.json');
+    writeFileSync(path, 'not json');
+
+    const dedup = new EventDeduplicator(10, path, logger);
+    dedup.load();
+
+    expect(dedup.size()).toBe(0);
+  });
+
+  it('should reject a capacity below 1', () => {
+    expect(() => new EventDeduplicator(0)).toThrow('De-dup capacity must be at least 1');
+  });
+});
@@ -1,6 +1,7 @@
 import { describe, it, expect, vi, beforeEach } from 'vitest';
 import { retry, retryNetwork, retryBlockchain, CircuitBreaker } from '../src/utils/retry';
 import { createLogger } from '../src/utils/logger';
+import { CosmosTxError } from '../src/utils/cosmos-errors';
 
 describe('retry', () => {
   const logger = createLogger('error', 'simple');
@@ -143,6 +144,31 @@ describe('retry', () => {
 
       expect(fn).toHaveBeenCalledTimes(1);
     });
+
+    it('should retry on retryable Cosmos error codes', async () => {
+      const notConfirmed = new CosmosTxError('oracle', 16, 'transfer not confirmed');
+      const fn = vi
+        .fn()
+        .mockRejectedValueOnce(notConfirmed)
+        .mockResolvedValue('success');
+
+      const result = await retryBlockchain(fn, { maxAttempts: 3, backoffMs: 10 }, logger);
+      expect(result).toBe('success');
+      expect(fn).toHaveBeenCalledTimes(2);
+    });
+
+    it('should not retry on Cosmos user errors', async () => {
+      // The raw log alone would look temporary; the code decides
+      const unauthorized = new CosmosTxError('oracle', 12, 'unauthorized: connection validator');
+      const fn = vi.fn().mockRejectedValue(unauthorized);
+
+      await expect(
+        retryBlockchain(fn, { maxAttempts: 3, backoffMs: 10 }, logger)
+      ).rejects.toThrow('oracle/12');
+
+      expect(unauthorized.userError).toBe(true);
+      expect(fn).toHaveBeenCalledTimes(1);
+    });
   });
 
   describe('CircuitBreaker', () => {
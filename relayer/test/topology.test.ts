@@ -0,0 +1,179 @@
+import { describe, it, expect, beforeEach, afterEach } from 'vitest';
+import { mkdtempSync, writeFileSync, rmSync } from 'fs';
+import { tmpdir } from 'os';
+import { join } from 'path';
+import {
+  loadTopology,
+  resolveNetworks,
+  planReload,
+  NetworkConfig,
+} from '../src/config/topology';
+
+describe('topology', () => {
+  let dir: string;
+
+  const env = {
+    BANK_A_KEY: 'abcdef0123456789abcdef0123456789abcdef0123456789abcdef0123456789',
+    BANK_B_KEY: '0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef',
+    COSMOS_MNEMONIC: 'word1 word2 word3 word4 word5 word6 word7 word8 word9 word10 word11 word12',
+  };
+
+  const chain = (name: string, privateKeyEnv: string) => ({
+    name,
+    rpcUrls: [`http://${name}-1:8545`, `http://${name}-2:8545`],
+    gatewayAddress: '0x1234567890123456789012345678901234567890',
+    executorAddress: '0x0987654321098765432109876543210987654321',
+    privateKeyEnv,
+    cosmos: 'hub',
+  });
+
+  const writeTopology = (topology: unknown): string => {
+    const path = join(dir, 'topology.json');
+    writeFileSync(path, JSON.stringify(topology));
+    return path;
+  };
+
+  const baseTopology = () => ({
+    besuChains: [chain('bank-a', 'BANK_A_KEY'), chain('bank-b', 'BANK_B_KEY')],
+    cosmosEndpoints: [
+      {
+        name: 'hub',
+        rpcUrls: ['http://hub-1:26657', 'http://hub-2:26657'],
+      },
+    ],
+  });
+
+  beforeEach(() => {
+    dir = mkdtempSync(join(tmpdir(), 'relayer-topology-'));
+  });
+
+  afterEach(() => {
+    rmSync(dir, { recursive: true, force: true });
+  });
+
+  describe('loadTopology', () => {
+    it('should apply defaults for omitted fields', () => {
+      const topology = loadTopology(writeTopology(baseTopology()));
+
+      expect(topology.besuChains).toHaveLength(2);
+      expect(topology.besuChains[0].enabled).toBe(true);
+      expect(topology.besuChains[0].wsUrl).toBeNull();
+      expect(topology.besuChains[0].reorgWindow).toBe(64);
+      expect(topology.cosmosEndpoints[0].mnemonicEnv).toBe('COSMOS_MNEMONIC');
+      expect(topology.cosmosEndpoints[0].gasPrice).toBe('0.025uatom');
+      expect(topology.retry.maxAttempts).toBe(3);
+    });
+
+    it('should throw for an unreadable file', () => {
+      expect(() => loadTopology(join(dir, 'missing.json'))).toThrow(
+        'Failed to read topology file'
+      );
+    });
+
+    it('should throw for duplicate chain names', () => {
+      const topology = baseTopology();
+      topology.besuChains[1].name = 'bank-a';
+      expect(() => loadTopology(writeTopology(topology))).toThrow(
+        'Duplicate Besu chain: bank-a'
+      );
+    });
+
+    it('should throw for an unknown Cosmos endpoint', () => {
+      const topology = baseTopology();
+      topology.besuChains[0].cosmos = 'other';
+      expect(() => loadTopology(writeTopology(topology))).toThrow(
+        'Besu chain bank-a: unknown Cosmos endpoint other'
+      );
+    });
+
+    it('should throw for an empty RPC list', () => {
+      const topology = baseTopology();
+      topology.besuChains[0].rpcUrls = [];
+      expect(() => loadTopology(writeTopology(topology))).toThrow(
+        'Besu chain bank-a: rpcUrls must not be empty'
+      );
+    });
+  });
+
+  describe('resolveNetworks', () => {
+    it('should resolve one network per enabled chain with failover lists', () => {
+      const networks = resolveNetworks(loadTopology(writeTopology(baseTopology())), env);
+
+      expect(networks.map((network) => network.name)).toEqual(['bank-a', 'bank-b']);
+      expect(networks[0].config.besu.chainName).toBe('bank-a');
+      expect(networks[0].config.besu.rpcUrl).toBe('http://bank-a-1:8545');
+      expect(networks[0].config.besu.fallbackRpcUrls).toEqual(['http://bank-a-2:8545']);
+      expect(networks[0].config.besu.privateKey).toBe(env.BANK_A_KEY);
+      expect(networks[1].config.besu.privateKey).toBe(env.BANK_B_KEY);
+      expect(networks[0].config.cosmos.rpcEndpoint).toBe('http://hub-1:26657');
+      expect(networks[0].config.cosmos.fallbackRpcEndpoints).toEqual(['http://hub-2:26657']);
+    });
+
+    it('should skip disabled chains and chains of disabled endpoints', () => {
+      const topology: any = baseTopology();
+      topology.besuChains[1].enabled = false;
+      expect(
+        resolveNetworks(loadTopology(writeTopology(topology)), env).map((n) => n.name)
+      ).toEqual(['bank-a']);
+
+      topology.cosmosEndpoints[0].enabled = false;
+      expect(resolveNetworks(loadTopology(writeTopology(topology)), env)).toEqual([]);
+    });
+
+    it('should throw when a secret is missing from the environment', () => {
+      const topology = loadTopology(writeTopology(baseTopology()));
+      expect(() => resolveNetworks(topology, { ...env, BANK_B_KEY: undefined })).toThrow(
+        'Besu chain bank-b: missing environment variable BANK_B_KEY'
+      );
+    });
+
+    it('should prefix per-chain validation errors with the chain name', () => {
+      const topology = baseTopology();
+      topology.besuChains[0].gatewayAddress = 'invalid-address';
+      expect(() => resolveNetworks(loadTopology(writeTopology(topology)), env)).toThrow(
+        'Besu chain bank-a: Invalid BESU_GATEWAY_ADDRESS'
+      );
+    });
+  });
+
+  describe('planReload', () => {
+    let running: NetworkConfig[];
+
+    beforeEach(() => {
+      running = resolveNetworks(loadTopology(writeTopology(baseTopology())), env);
+    });
+
+    it('should keep networks whose configuration is unchanged', () => {
+      const next = resolveNetworks(loadTopology(writeTopology(baseTopology())), env);
+      expect(planReload(running, next)).toEqual({
+        start: [],
+        stop: [],
+        restart: [],
+        unchanged: ['bank-a', 'bank-b'],
+      });
+    });
+
+    it('should start, stop and restart changed networks', () => {
+      const topology: any = baseTopology();
+      topology.besuChains[0].rpcUrls = ['http://bank-a-3:8545'];
+      topology.besuChains[1].enabled = false;
+      topology.besuChains.push(chain('bank-c', 'BANK_A_KEY'));
+
+      const next = resolveNetworks(loadTopology(writeTopology(topology)), env);
+      expect(planReload(running, next)).toEqual({
+        start: ['bank-c'],
+        stop: ['bank-b'],
+        restart: ['bank-a'],
+        unchanged: [],
+      });
+    });
+
+    it('should restart every chain of a changed Cosmos endpoint', () => {
+      const topology = baseTopology();
+      topology.cosmosEndpoints[0].rpcUrls = ['http://hub-3:26657'];
+
+      const next = resolveNetworks(loadTopology(writeTopology(topology)), env);
+      expect(planReload(running, next).restart).toEqual(['bank-a', 'bank-b']);
+    });
+  });
+});